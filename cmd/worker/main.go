@@ -0,0 +1,14 @@
+package main
+
+import (
+	"os"
+
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/cli"
+)
+
+// main ejecuta los trabajos programados y los procesos en segundo plano fuera de la API, equivale a
+// delivery-app worker. La API se inicia con DISABLE_API_WORKERS=true y las migraciones se aplican con
+// migrate up o serve -migrate
+func main() {
+	os.Exit(cli.Execute(append([]string{"worker"}, os.Args[1:]...)))
+}
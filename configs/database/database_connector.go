@@ -2,6 +2,7 @@ package database
 
 import (
 	"fmt"
+	config "github.com/MarlonG1/delivery-backend/configs"
 	errPackage "github.com/MarlonG1/delivery-backend/configs/error"
 	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
 	"gorm.io/gorm"
@@ -21,10 +22,28 @@ type DbConnection struct {
 	Err    error
 }
 
+// SelectDatabaseDriver obtiene el driver configurado en DB_DRIVER, un driver desconocido retorna nil
+func SelectDatabaseDriver(envConfig *config.EnvConfig) DriverConfig {
+	switch envConfig.Database.Driver {
+	case "mysql":
+		return NewMysqlDriver(envConfig)
+	case "postgres":
+		return NewPostgresDriver(envConfig)
+	default:
+		logs.Fatal("Invalid database driver type", map[string]interface{}{
+			"database_type": envConfig.Database.Driver,
+		})
+		return nil
+	}
+}
+
 func NewDatabaseConnection(driver DriverConfig) *DbConnection {
 	return &DbConnection{
 		Driver: driver,
-		Config: &gorm.Config{},
+		Config: &gorm.Config{
+			// Traduce los errores del motor a los de gorm, por ejemplo gorm.ErrDuplicatedKey
+			TranslateError: true,
+		},
 	}
 }
 
@@ -15,6 +15,8 @@ type EnvConfig struct {
 		Port      string
 		JWTSecret string
 		Debug     bool
+		// DisableWorkers evita que la API ejecute los procesos en segundo plano cuando corren en cmd/worker
+		DisableWorkers bool
 	}
 	Database struct {
 		Host     string
@@ -34,6 +36,15 @@ type EnvConfig struct {
 		Level       string
 		FileLogging bool
 	}
+	Payment struct {
+		WebhookSecret string
+	}
+	Notification struct {
+		TrackingURL string
+	}
+	EventBus struct {
+		Driver string
+	}
 }
 
 func NewEnvConfig() (*EnvConfig, error) {
@@ -100,8 +111,18 @@ func MapEnvKeys(v *viper.Viper) {
 	v.Set("server.port", v.GetString("server_port"))
 	v.Set("server.jwtSecret", v.GetString("jwt_secret"))
 	v.Set("server.debug", v.GetBool("debug"))
+	v.Set("server.disableWorkers", v.GetBool("disable_api_workers"))
 
 	// .env keys for log configuration
 	v.Set("log.level", v.GetString("log_level"))
 	v.Set("log.fileLogging", v.GetString("log_file_logging"))
+
+	// .env keys for payment provider configuration
+	v.Set("payment.webhookSecret", v.GetString("payment_webhook_secret"))
+
+	// .env keys for notification configuration
+	v.Set("notification.trackingURL", v.GetString("notification_tracking_url"))
+
+	// .env keys for event bus configuration
+	v.Set("eventBus.driver", v.GetString("event_bus_driver"))
 }
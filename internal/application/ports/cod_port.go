@@ -0,0 +1,15 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+)
+
+type CODUseCase interface {
+	GetDriverLedger(ctx context.Context, driverID, startDate, endDate string) (*dto.CashLedgerResponse, error)
+	ReconcileDriverCash(ctx context.Context, req *dto.CashReconciliationRequest) (*entities.CashReconciliation, error)
+	GetReconciliations(ctx context.Context, driverID string) ([]entities.CashReconciliation, error)
+	GetSettlementReport(ctx context.Context, companyID, startDate, endDate string) (*dto.CODSettlementReportResponse, error)
+}
@@ -0,0 +1,28 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+)
+
+// PayoutStatementRenderer genera el documento imprimible de un estado de pago
+type PayoutStatementRenderer interface {
+	Render(statement *entities.PayoutStatement) ([]byte, error)
+	ContentType() string
+	FileExtension() string
+}
+
+type EarningUseCase interface {
+	GetEarningRules(ctx context.Context) ([]entities.EarningRule, error)
+	CreateEarningRule(ctx context.Context, req *dto.EarningRuleRequest) (*entities.EarningRule, error)
+	UpdateEarningRule(ctx context.Context, id string, req *dto.EarningRuleRequest) (*entities.EarningRule, error)
+	GetDriverEarnings(ctx context.Context, driverID, startDate, endDate string) (*dto.DriverEarningsResponse, error)
+	AddAdjustment(ctx context.Context, req *dto.EarningAdjustmentRequest) (*entities.DriverEarning, error)
+	GeneratePayoutStatement(ctx context.Context, req *dto.PayoutStatementGenerateRequest) (*entities.PayoutStatement, error)
+	GetPayoutStatements(ctx context.Context, driverID string) ([]entities.PayoutStatement, error)
+	GetPayoutStatementByID(ctx context.Context, id string) (*entities.PayoutStatement, error)
+	ExportPayoutStatement(ctx context.Context, id string) (*dto.PayoutStatementDocument, error)
+	RunPayoutCycle(ctx context.Context) error
+}
@@ -0,0 +1,7 @@
+package ports
+
+import "context"
+
+type EventBusUseCase interface {
+	ConsumeEvents(ctx context.Context) error
+}
@@ -0,0 +1,14 @@
+package ports
+
+import (
+	"context"
+	"time"
+)
+
+// IdempotencyStore guarda las respuestas de las solicitudes asociadas a una Idempotency-Key
+type IdempotencyStore interface {
+	Reserve(ctx context.Context, key string, record []byte, ttl time.Duration) (bool, error) // Reserve guarda el registro solo si la llave no existe
+	Get(ctx context.Context, key string) ([]byte, error)                                     // Get obtiene el registro guardado para la llave
+	Save(ctx context.Context, key string, record []byte, ttl time.Duration) error            // Save reemplaza el registro de la llave
+	Release(ctx context.Context, key string) error                                           // Release elimina la llave para permitir un nuevo intento
+}
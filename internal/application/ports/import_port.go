@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+)
+
+type ImportUseCase interface {
+	CreateImport(ctx context.Context, format string, content []byte) (*entities.ImportJob, error)
+	GetImportJobs(ctx context.Context) ([]entities.ImportJob, error)
+	GetImportJobByID(ctx context.Context, id string) (*entities.ImportJob, error)
+	GetImportReport(ctx context.Context, id string) ([]dto.OrderImportRowResult, error)
+
+	// ProcessPendingImports crea los pedidos de las importaciones pendientes
+	ProcessPendingImports(ctx context.Context) error
+}
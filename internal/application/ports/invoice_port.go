@@ -0,0 +1,23 @@
+package ports
+
+import (
+	"context"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+)
+
+// InvoiceRenderer genera el documento imprimible de una factura
+type InvoiceRenderer interface {
+	Render(invoice *entities.Invoice) ([]byte, error)
+	ContentType() string
+	FileExtension() string
+}
+
+type InvoiceUseCase interface {
+	GenerateInvoice(ctx context.Context, req *dto.InvoiceGenerateRequest) (*entities.Invoice, error)
+	GetInvoices(ctx context.Context, companyID, status string) ([]entities.Invoice, error)
+	GetInvoiceByID(ctx context.Context, id string) (*entities.Invoice, error)
+	ChangeInvoiceStatus(ctx context.Context, id string, req *dto.InvoiceStatusRequest) (*entities.Invoice, error)
+	ExportInvoice(ctx context.Context, id, format string) (*dto.InvoiceDocument, error)
+	RunBillingCycle(ctx context.Context) error
+}
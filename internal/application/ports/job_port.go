@@ -0,0 +1,22 @@
+package ports
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+)
+
+// JobLocker reserva una ejecución programada para que solo una instancia del servicio la realice
+type JobLocker interface {
+	Acquire(ctx context.Context, key string, ttl time.Duration) (bool, error) // Acquire reserva la llave si ninguna instancia la tiene
+}
+
+type JobUseCase interface {
+	GetJobNames() []string
+	NextRun(name string, after time.Time) (time.Time, error)
+	RunJob(ctx context.Context, name string, scheduledAt time.Time) error
+	RunJobNow(ctx context.Context, name string) (string, error)
+	GetJobRuns(ctx context.Context, request *http.Request) (*dto.PaginatedResponse, error)
+}
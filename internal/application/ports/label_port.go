@@ -0,0 +1,19 @@
+package ports
+
+import (
+	"context"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+)
+
+// LabelRenderer genera el documento imprimible con una etiqueta por pedido
+type LabelRenderer interface {
+	Render(orders []entities.Order) ([]byte, error)
+	ContentType() string
+	FileExtension() string
+}
+
+type LabelUseCase interface {
+	GenerateLabel(ctx context.Context, orderID, format string) (*dto.LabelDocument, error)
+	GenerateLabels(ctx context.Context, req *dto.LabelBatchRequest) (*dto.LabelDocument, error)
+}
@@ -0,0 +1,29 @@
+package ports
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+)
+
+type NotificationUseCase interface {
+	GetCompanySettings(ctx context.Context, companyID string) (*dto.CompanyNotificationSettingsResponse, error)
+	UpdateCompanySettings(ctx context.Context, req *dto.UpdateCompanyNotificationSettingsRequest) (*dto.CompanyNotificationSettingsResponse, error)
+	GetNotifications(ctx context.Context, request *http.Request) (*dto.PaginatedResponse, error)
+	GetUnreadCounts(ctx context.Context) (*dto.UnreadNotificationsResponse, error)
+	MarkAsRead(ctx context.Context, notificationID string) error
+	MarkAllAsRead(ctx context.Context) (*dto.MarkAllNotificationsReadResponse, error)
+	SubscribeInbox(ctx context.Context) (<-chan entities.InboxEvent, func(), int64, error)
+	GetPreferences(ctx context.Context) (*dto.NotificationPreferencesResponse, error)
+	UpdatePreferences(ctx context.Context, req *dto.UpdateNotificationPreferencesRequest) (*dto.NotificationPreferencesResponse, error)
+	GetDevices(ctx context.Context) ([]dto.NotificationDeviceResponse, error)
+	RegisterDevice(ctx context.Context, req *dto.RegisterNotificationDeviceRequest) (*dto.NotificationDeviceResponse, error)
+	DeregisterDevice(ctx context.Context, deviceID string) error
+}
+
+// InboxStream permite a los clientes conectados recibir en tiempo real los eventos de la bandeja del usuario
+type InboxStream interface {
+	Subscribe(userID string) (<-chan entities.InboxEvent, func())
+}
@@ -15,4 +15,7 @@ type OrdererUseCase interface {
 	ChangeStatus(ctx context.Context, id, status string) error
 	DeleteOrder(ctx context.Context, id string) error
 	RestoreOrder(ctx context.Context, id string) error
+	DeliverOrder(ctx context.Context, orderID string, req *dto.OrderDeliverRequest) error
+	RegisterDeliveryAttempt(ctx context.Context, orderID string, req *dto.DeliveryAttemptRequest) (*entities.DeliveryAttempt, error)
+	GetDeliveryAttempts(ctx context.Context, orderID string) ([]entities.DeliveryAttempt, error)
 }
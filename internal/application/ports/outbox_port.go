@@ -0,0 +1,7 @@
+package ports
+
+import "context"
+
+type OutboxUseCase interface {
+	RunOutboxRelay(ctx context.Context) error
+}
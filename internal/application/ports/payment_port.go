@@ -0,0 +1,12 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+type PaymentUseCase interface {
+	GetOrderPayment(ctx context.Context, orderID string) (*entities.OrderPayment, error)
+	HandleWebhook(ctx context.Context, payload []byte, signature string) error
+}
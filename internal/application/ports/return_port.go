@@ -0,0 +1,15 @@
+package ports
+
+import (
+	"context"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+)
+
+type ReturnUseCase interface {
+	CreateReturn(ctx context.Context, orderID string, req *dto.ReturnCreateRequest) (*entities.OrderReturn, error)
+	GetReturnByID(ctx context.Context, id string) (*entities.OrderReturn, error)
+	GetReturnsByOrder(ctx context.Context, orderID string) ([]entities.OrderReturn, error)
+	GetReturnsByCompany(ctx context.Context, companyID, status string) ([]entities.OrderReturn, error)
+	ChangeReturnStatus(ctx context.Context, id, status string) error
+}
@@ -0,0 +1,10 @@
+package ports
+
+import (
+	"context"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+)
+
+type ScanUseCase interface {
+	ScanOrder(ctx context.Context, req *dto.ScanRequest) (*dto.ScanResponse, error)
+}
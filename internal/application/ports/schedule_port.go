@@ -0,0 +1,20 @@
+package ports
+
+import (
+	"context"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+)
+
+type ScheduleUseCase interface {
+	CreateSchedule(ctx context.Context, req *dto.ScheduleRequest) (*entities.OrderSchedule, error)
+	UpdateSchedule(ctx context.Context, id string, req *dto.ScheduleRequest) (*entities.OrderSchedule, error)
+	GetScheduleByID(ctx context.Context, id string) (*entities.OrderSchedule, error)
+	GetSchedules(ctx context.Context, status string) ([]entities.OrderSchedule, error)
+	PauseSchedule(ctx context.Context, id string) error
+	ResumeSchedule(ctx context.Context, id string) error
+	CancelSchedule(ctx context.Context, id string) error
+
+	// RunDueSchedules materializa los pedidos de las programaciones pendientes
+	RunDueSchedules(ctx context.Context) error
+}
@@ -0,0 +1,12 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+)
+
+type SLAUseCase interface {
+	GetOrdersSLA(ctx context.Context, companyID, branchID, slaStatus string) (*dto.OrdersSLAResponse, error)
+	RunSLAMonitor(ctx context.Context) error
+}
@@ -0,0 +1,20 @@
+package ports
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+)
+
+type WebhookUseCase interface {
+	CreateWebhook(ctx context.Context, req *dto.CreateWebhookRequest) (*dto.WebhookEndpointResponse, error)
+	GetWebhooks(ctx context.Context, companyID string) ([]dto.WebhookEndpointResponse, error)
+	GetWebhook(ctx context.Context, id string) (*dto.WebhookEndpointResponse, error)
+	UpdateWebhook(ctx context.Context, id string, req *dto.UpdateWebhookRequest) (*dto.WebhookEndpointResponse, error)
+	DeleteWebhook(ctx context.Context, id string) error
+	RotateWebhookSecret(ctx context.Context, id string) (*dto.WebhookEndpointResponse, error)
+	GetWebhookDeliveries(ctx context.Context, id string, request *http.Request) (*dto.PaginatedResponse, error)
+	RedeliverWebhook(ctx context.Context, deliveryID string) (*dto.WebhookDeliveryResponse, error)
+	RunWebhookDeliveries(ctx context.Context) error
+}
@@ -0,0 +1,46 @@
+package events
+
+import (
+	"context"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+type EventBusUseCase struct {
+	bus         interfaces.EventBus
+	subscribers []interfaces.EventSubscriber
+}
+
+// NewEventBusUseCase crea el caso de uso con los grupos de consumidores que procesan los eventos del bus,
+// cada suscriptor es un grupo y recibe todos los eventos de sus tipos
+func NewEventBusUseCase(bus interfaces.EventBus, subscribers ...interfaces.EventSubscriber) *EventBusUseCase {
+	return &EventBusUseCase{
+		bus:         bus,
+		subscribers: subscribers,
+	}
+}
+
+// ConsumeEvents procesa los mensajes pendientes de cada grupo de consumidores, un grupo con error no detiene al resto
+func (uc *EventBusUseCase) ConsumeEvents(ctx context.Context) error {
+	var firstErr error
+	for _, subscriber := range uc.subscribers {
+		processed, err := uc.bus.Consume(ctx, subscriber, constants.EventBusBatchSize)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+
+		if processed > 0 {
+			logs.Info("Event bus messages processed", map[string]interface{}{
+				"group":  subscriber.Name(),
+				"events": processed,
+			})
+		}
+	}
+
+	return firstErr
+}
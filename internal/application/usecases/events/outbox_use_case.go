@@ -0,0 +1,34 @@
+package events
+
+import (
+	"context"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+type OutboxUseCase struct {
+	relay interfaces.OutboxRelayer
+}
+
+func NewOutboxUseCase(relay interfaces.OutboxRelayer) *OutboxUseCase {
+	return &OutboxUseCase{
+		relay: relay,
+	}
+}
+
+// RunOutboxRelay publica a sus suscriptores los eventos de dominio pendientes del outbox
+func (uc *OutboxUseCase) RunOutboxRelay(ctx context.Context) error {
+	published, err := uc.relay.RelayPendingEvents(ctx)
+	if err != nil {
+		return err
+	}
+
+	if published > 0 {
+		logs.Info("Outbox events published", map[string]interface{}{
+			"events": published,
+		})
+	}
+
+	return nil
+}
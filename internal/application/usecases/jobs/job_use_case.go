@@ -0,0 +1,280 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/auth"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/value_objects"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+	error2 "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/mappers/response_mapper"
+)
+
+// job trabajo periódico con su programación y los reintentos permitidos tras un intento fallido
+type job struct {
+	schedule   *value_objects.CronSchedule
+	maxRetries int
+	run        func(ctx context.Context) (string, error)
+}
+
+type JobUseCase struct {
+	recorder   interfaces.JobRecorder
+	locker     ports.JobLocker
+	maintainer interfaces.Maintainer
+	metrics    interfaces.MetricsService
+	orders     interfaces.Orderer
+	payments   interfaces.PaymentProcessor
+	jobs       map[string]*job
+}
+
+func NewJobUseCase(recorder interfaces.JobRecorder, locker ports.JobLocker, maintainer interfaces.Maintainer, metrics interfaces.MetricsService, orders interfaces.Orderer, payments interfaces.PaymentProcessor) *JobUseCase {
+	uc := &JobUseCase{
+		recorder:   recorder,
+		locker:     locker,
+		maintainer: maintainer,
+		metrics:    metrics,
+		orders:     orders,
+		payments:   payments,
+		jobs:       make(map[string]*job),
+	}
+
+	uc.register(constants.JobCleanExpiredSessions, "*/15 * * * *", 2, uc.cleanExpiredSessions)
+	uc.register(constants.JobPurgeDeletedRecords, "30 3 * * *", 3, uc.purgeDeletedRecords)
+	uc.register(constants.JobRecomputeMetrics, "*/10 * * * *", 1, uc.recomputeMetrics)
+	uc.register(constants.JobRescheduleDeliveries, "*/5 * * * *", 1, uc.rescheduleDeliveries)
+	uc.register(constants.JobRetryPendingPayments, "*/5 * * * *", 1, uc.retryPendingPayments)
+
+	return uc
+}
+
+// GetJobNames obtiene los trabajos registrados ordenados por nombre
+func (uc *JobUseCase) GetJobNames() []string {
+	names := make([]string, 0, len(uc.jobs))
+	for name := range uc.jobs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// NextRun obtiene la siguiente ejecución programada del trabajo posterior a after
+func (uc *JobUseCase) NextRun(name string, after time.Time) (time.Time, error) {
+	job, ok := uc.jobs[name]
+	if !ok {
+		return time.Time{}, error2.NewGeneralServiceError("JobUseCase", "NextRun", error2.ErrJobNotFound)
+	}
+
+	next, ok := job.schedule.Next(after)
+	if !ok {
+		return time.Time{}, error2.NewGeneralServiceError("JobUseCase", "NextRun", fmt.Errorf("job %s has no next run", name))
+	}
+
+	return next, nil
+}
+
+// RunJob ejecuta la ejecución programada del trabajo si ninguna otra instancia la reservó, registrando cada
+// intento en el historial y reintentando con espera exponencial mientras queden reintentos
+func (uc *JobUseCase) RunJob(ctx context.Context, name string, scheduledAt time.Time) error {
+	job, ok := uc.jobs[name]
+	if !ok {
+		return error2.NewGeneralServiceError("JobUseCase", "RunJob", error2.ErrJobNotFound)
+	}
+
+	// 1. Reservar la ejecución, la llave incluye la hora programada para que cada ejecución se reserve una vez
+	acquired, err := uc.locker.Acquire(ctx, name+":"+strconv.FormatInt(scheduledAt.Unix(), 10), constants.JobLockTTL)
+	if err != nil {
+		return err
+	}
+	if !acquired {
+		logs.Debug("Job run already taken by another instance", map[string]interface{}{
+			"job":         name,
+			"scheduledAt": scheduledAt,
+		})
+		return nil
+	}
+
+	// 2. Ejecutar el trabajo hasta que termine sin error o se agoten los reintentos
+	for attempt := 1; ; attempt++ {
+		_, runErr := uc.runAttempt(ctx, name, job, attempt, scheduledAt)
+		if runErr == nil {
+			return nil
+		}
+
+		if attempt > job.maxRetries {
+			return runErr
+		}
+
+		// 3. Esperar antes del siguiente intento, la espera se duplica en cada intento fallido
+		delay := constants.JobRetryBaseDelay << (attempt - 1)
+		logs.Warn("Job failed, retrying", map[string]interface{}{
+			"job":     name,
+			"attempt": attempt,
+			"retryIn": delay.String(),
+			"error":   runErr.Error(),
+		})
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// RunJobNow ejecuta el trabajo una sola vez fuera de su programación, p. ej. desde la CLI, sin bloqueo ni reintentos.
+// La ejecución queda en el historial y se retorna su resultado
+func (uc *JobUseCase) RunJobNow(ctx context.Context, name string) (string, error) {
+	job, ok := uc.jobs[name]
+	if !ok {
+		return "", error2.NewGeneralServiceError("JobUseCase", "RunJobNow", error2.ErrJobNotFound)
+	}
+
+	return uc.runAttempt(ctx, name, job, 1, time.Now())
+}
+
+// GetJobRuns obtiene el historial paginado de ejecuciones, se puede filtrar por trabajo con el parámetro job
+func (uc *JobUseCase) GetJobRuns(ctx context.Context, request *http.Request) (*dto.PaginatedResponse, error) {
+	// 1. Solo los administradores pueden consultar el historial
+	claims, ok := ctx.Value("claims").(*auth.AuthClaims)
+	if !ok {
+		return nil, error2.NewGeneralServiceError("JobUseCase", "GetJobRuns", nil)
+	}
+
+	if claims.Role != constants.AdminRole {
+		logs.Warn("User does not have permissions to get job runs", map[string]interface{}{
+			"user_id": claims.UserID,
+			"role":    claims.Role,
+		})
+		return nil, errPackage.NewDomainError("JobUseCase", "GetJobRuns", "User does not have sufficient permissions")
+	}
+
+	// 2. Validar el trabajo indicado
+	jobName := request.URL.Query().Get("job")
+	if _, ok = uc.jobs[jobName]; jobName != "" && !ok {
+		return nil, error2.NewGeneralServiceError("JobUseCase", "GetJobRuns", error2.ErrJobNotFound)
+	}
+
+	// 3. Obtener el historial
+	params := parseJobRunParams(request)
+	runs, total, err := uc.recorder.GetRuns(ctx, jobName, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return response_mapper.MapJobRunsToResponse(runs, params, total), nil
+}
+
+// runAttempt ejecuta un intento del trabajo registrando su inicio y su resultado en el historial
+func (uc *JobUseCase) runAttempt(ctx context.Context, name string, job *job, attempt int, scheduledAt time.Time) (string, error) {
+	run, err := uc.recorder.StartRun(ctx, name, attempt, scheduledAt)
+	if err != nil {
+		return "", err
+	}
+
+	result, runErr := job.run(ctx)
+	if err = uc.recorder.FinishRun(ctx, run, result, runErr); err != nil {
+		logs.Warn("Failed to record job run result", map[string]interface{}{
+			"job":   name,
+			"runID": run.ID,
+		})
+	}
+
+	if runErr == nil {
+		logs.Info("Job finished", map[string]interface{}{
+			"job":     name,
+			"attempt": attempt,
+			"result":  result,
+		})
+	}
+
+	return result, runErr
+}
+
+// register agrega el trabajo con su programación en formato cron, una programación inválida no lo registra
+func (uc *JobUseCase) register(name, expression string, maxRetries int, run func(ctx context.Context) (string, error)) {
+	schedule, err := value_objects.NewCronSchedule(expression)
+	if err != nil {
+		logs.Error("Invalid job schedule", map[string]interface{}{
+			"job":      name,
+			"schedule": expression,
+			"error":    err.Error(),
+		})
+		return
+	}
+
+	uc.jobs[name] = &job{
+		schedule:   schedule,
+		maxRetries: maxRetries,
+		run:        run,
+	}
+}
+
+func (uc *JobUseCase) cleanExpiredSessions(ctx context.Context) (string, error) {
+	deleted, err := uc.maintainer.CleanExpiredSessions(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("deleted %d expired sessions", deleted), nil
+}
+
+func (uc *JobUseCase) purgeDeletedRecords(ctx context.Context) (string, error) {
+	purged, err := uc.maintainer.PurgeDeletedRecords(ctx)
+	return fmt.Sprintf("purged %d orders and %d webhook endpoints", purged["orders"], purged["webhook_endpoints"]), err
+}
+
+func (uc *JobUseCase) recomputeMetrics(ctx context.Context) (string, error) {
+	updated, err := uc.metrics.RecomputeCompanyMetrics(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("recomputed metrics of %d companies", updated), nil
+}
+
+func (uc *JobUseCase) rescheduleDeliveries(ctx context.Context) (string, error) {
+	rescheduled, err := uc.orders.RescheduleDueDeliveries(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("rescheduled %d failed deliveries", rescheduled), nil
+}
+
+func (uc *JobUseCase) retryPendingPayments(ctx context.Context) (string, error) {
+	completed, err := uc.payments.RetryPendingPayments(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("completed %d pending payments", completed), nil
+}
+
+// parseJobRunParams extrae la paginación del historial de ejecuciones de la request
+func parseJobRunParams(r *http.Request) *entities.PaginationQueryParams {
+	params := &entities.PaginationQueryParams{
+		Page:     1,  // Default
+		PageSize: 20, // Default
+	}
+
+	if page, err := strconv.Atoi(r.URL.Query().Get("page")); err == nil && page > 0 {
+		params.Page = page
+	}
+	if pageSize, err := strconv.Atoi(r.URL.Query().Get("page_size")); err == nil && pageSize > 0 {
+		params.PageSize = pageSize
+	}
+
+	return params
+}
@@ -0,0 +1,171 @@
+package order
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/auth"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+	error2 "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/mappers/response_mapper"
+)
+
+type CODUseCase struct {
+	cashService interfaces.CashLedger
+}
+
+func NewCODUseCase(cashService interfaces.CashLedger) *CODUseCase {
+	return &CODUseCase{
+		cashService: cashService,
+	}
+}
+
+// GetDriverLedger obtiene el libro de efectivo de un repartidor, los repartidores solo pueden consultar el suyo
+func (uc *CODUseCase) GetDriverLedger(ctx context.Context, driverID, startDate, endDate string) (*dto.CashLedgerResponse, error) {
+	claims, ok := ctx.Value("claims").(*auth.AuthClaims)
+	if !ok {
+		return nil, error2.NewGeneralServiceError("CODUseCase", "GetDriverLedger", nil)
+	}
+
+	// 1. Determinar el repartidor a consultar
+	driverID, err := resolveCashDriverID(claims, driverID, "GetDriverLedger")
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Determinar el periodo a consultar
+	start, end, err := parseReportPeriod(startDate, endDate)
+	if err != nil {
+		return nil, error2.NewGeneralServiceError("CODUseCase", "GetDriverLedger", err)
+	}
+
+	// 3. Obtener los movimientos y saldos del repartidor
+	entries, balances, err := uc.cashService.GetDriverLedger(ctx, driverID, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	return response_mapper.CashLedgerToResponseDTO(driverID, entries, balances), nil
+}
+
+// ReconcileDriverCash concilia el efectivo entregado por un repartidor al cierre del día
+func (uc *CODUseCase) ReconcileDriverCash(ctx context.Context, req *dto.CashReconciliationRequest) (*entities.CashReconciliation, error) {
+	claims, ok := ctx.Value("claims").(*auth.AuthClaims)
+	if !ok {
+		return nil, error2.NewGeneralServiceError("CODUseCase", "ReconcileDriverCash", nil)
+	}
+
+	// 1. Solo administradores y personal de almacén pueden recibir efectivo
+	if claims.Role != constants.AdminRole && claims.Role != constants.WarehouseStaff {
+		logs.Warn("User does not have permissions to reconcile driver cash", map[string]interface{}{
+			"user_id": claims.UserID,
+			"role":    claims.Role,
+		})
+		return nil, errPackage.NewDomainError("CODUseCase", "ReconcileDriverCash", "User does not have sufficient permissions")
+	}
+
+	// 2. Conciliar el efectivo
+	return uc.cashService.ReconcileDriverCash(ctx, req.DriverID, strings.ToUpper(req.Currency), req.HandedInAmount, claims.UserID, req.Notes)
+}
+
+// GetReconciliations obtiene las conciliaciones de un repartidor, los repartidores solo pueden consultar las suyas
+func (uc *CODUseCase) GetReconciliations(ctx context.Context, driverID string) ([]entities.CashReconciliation, error) {
+	claims, ok := ctx.Value("claims").(*auth.AuthClaims)
+	if !ok {
+		return nil, error2.NewGeneralServiceError("CODUseCase", "GetReconciliations", nil)
+	}
+
+	driverID, err := resolveCashDriverID(claims, driverID, "GetReconciliations")
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.cashService.GetReconciliations(ctx, driverID)
+}
+
+// GetSettlementReport obtiene los cobros contra entrega que se le deben a la empresa del usuario,
+// los administradores pueden indicar la empresa
+func (uc *CODUseCase) GetSettlementReport(ctx context.Context, companyID, startDate, endDate string) (*dto.CODSettlementReportResponse, error) {
+	claims, ok := ctx.Value("claims").(*auth.AuthClaims)
+	if !ok {
+		return nil, error2.NewGeneralServiceError("CODUseCase", "GetSettlementReport", nil)
+	}
+
+	// 1. Determinar la empresa a consultar
+	if claims.Role != constants.AdminRole || companyID == "" {
+		companyID = claims.CompanyID
+	}
+
+	if companyID == "" {
+		return nil, error2.NewGeneralServiceError("CODUseCase", "GetSettlementReport", errPackage.ErrCompanyIDRequired)
+	}
+
+	// 2. Determinar el periodo a consultar
+	start, end, err := parseReportPeriod(startDate, endDate)
+	if err != nil {
+		return nil, error2.NewGeneralServiceError("CODUseCase", "GetSettlementReport", err)
+	}
+
+	// 3. Obtener la liquidación por moneda
+	settlements, err := uc.cashService.GetSettlementReport(ctx, companyID, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	return response_mapper.CODSettlementReportToResponseDTO(companyID, start, end, settlements), nil
+}
+
+// resolveCashDriverID obliga a los repartidores a consultar su propio efectivo, el resto de roles con acceso
+// debe indicar el repartidor
+func resolveCashDriverID(claims *auth.AuthClaims, driverID, method string) (string, error) {
+	switch claims.Role {
+	case constants.Driver:
+		return claims.UserID, nil
+	case constants.AdminRole, constants.WarehouseStaff:
+		if driverID == "" {
+			return "", error2.NewGeneralServiceError("CODUseCase", method, errPackage.ErrDriverIDRequired)
+		}
+		return driverID, nil
+	default:
+		logs.Warn("User does not have permissions to view driver cash", map[string]interface{}{
+			"user_id": claims.UserID,
+			"role":    claims.Role,
+		})
+		return "", errPackage.NewDomainError("CODUseCase", method, "User does not have sufficient permissions")
+	}
+}
+
+// parseReportPeriod interpreta un periodo en formato RFC3339, por defecto desde el inicio del mes actual hasta ahora
+func parseReportPeriod(startDate, endDate string) (time.Time, time.Time, error) {
+	now := time.Now()
+	start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	end := now
+
+	if startDate != "" {
+		parsed, err := time.Parse(time.RFC3339, startDate)
+		if err != nil {
+			return time.Time{}, time.Time{}, errPackage.ErrInvalidReportDates
+		}
+		start = parsed
+	}
+
+	if endDate != "" {
+		parsed, err := time.Parse(time.RFC3339, endDate)
+		if err != nil {
+			return time.Time{}, time.Time{}, errPackage.ErrInvalidReportDates
+		}
+		end = parsed
+	}
+
+	if !start.Before(end) {
+		return time.Time{}, time.Time{}, errPackage.ErrInvalidReportDates
+	}
+
+	return start, end, nil
+}
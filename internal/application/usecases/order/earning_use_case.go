@@ -0,0 +1,254 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/auth"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+	error2 "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/mappers/request_mapper"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/mappers/response_mapper"
+)
+
+type EarningUseCase struct {
+	earningService interfaces.DriverEarner
+	pdfRenderer    ports.PayoutStatementRenderer
+}
+
+func NewEarningUseCase(earningService interfaces.DriverEarner, pdfRenderer ports.PayoutStatementRenderer) *EarningUseCase {
+	return &EarningUseCase{
+		earningService: earningService,
+		pdfRenderer:    pdfRenderer,
+	}
+}
+
+// GetEarningRules obtiene las reglas de ganancias configuradas, solo para administradores
+func (uc *EarningUseCase) GetEarningRules(ctx context.Context) ([]entities.EarningRule, error) {
+	if err := requireEarningsAdmin(ctx, "GetEarningRules"); err != nil {
+		return nil, err
+	}
+
+	return uc.earningService.GetEarningRules(ctx)
+}
+
+// CreateEarningRule crea la regla por defecto o la regla de una zona
+func (uc *EarningUseCase) CreateEarningRule(ctx context.Context, req *dto.EarningRuleRequest) (*entities.EarningRule, error) {
+	if err := requireEarningsAdmin(ctx, "CreateEarningRule"); err != nil {
+		return nil, err
+	}
+
+	rule := request_mapper.EarningRuleRequestToEarningRule(req)
+	if err := uc.earningService.CreateEarningRule(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// UpdateEarningRule actualiza las tarifas de una regla, las entregas ya registradas no se recalculan
+func (uc *EarningUseCase) UpdateEarningRule(ctx context.Context, id string, req *dto.EarningRuleRequest) (*entities.EarningRule, error) {
+	if err := requireEarningsAdmin(ctx, "UpdateEarningRule"); err != nil {
+		return nil, err
+	}
+
+	// 1. Obtener la regla actual
+	rule, err := uc.earningService.GetEarningRuleByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Aplicar las nuevas tarifas
+	request_mapper.ApplyEarningRuleRequest(rule, req)
+	rule.UpdatedAt = time.Now()
+	if err = uc.earningService.UpdateEarningRule(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// GetDriverEarnings obtiene el libro de ganancias de un repartidor, los repartidores solo pueden consultar el suyo
+func (uc *EarningUseCase) GetDriverEarnings(ctx context.Context, driverID, startDate, endDate string) (*dto.DriverEarningsResponse, error) {
+	claims, ok := ctx.Value("claims").(*auth.AuthClaims)
+	if !ok {
+		return nil, error2.NewGeneralServiceError("EarningUseCase", "GetDriverEarnings", nil)
+	}
+
+	// 1. Determinar el repartidor a consultar
+	driverID, err := resolveEarningsDriverID(claims, driverID, "GetDriverEarnings")
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Determinar el periodo a consultar
+	start, end, err := parseReportPeriod(startDate, endDate)
+	if err != nil {
+		return nil, error2.NewGeneralServiceError("EarningUseCase", "GetDriverEarnings", err)
+	}
+
+	// 3. Obtener los movimientos del repartidor
+	earnings, err := uc.earningService.GetDriverEarnings(ctx, driverID, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	return response_mapper.DriverEarningsToResponseDTO(driverID, earnings), nil
+}
+
+// AddAdjustment registra un ajuste en las ganancias de un repartidor, solo para administradores
+func (uc *EarningUseCase) AddAdjustment(ctx context.Context, req *dto.EarningAdjustmentRequest) (*entities.DriverEarning, error) {
+	claims, ok := ctx.Value("claims").(*auth.AuthClaims)
+	if !ok {
+		return nil, error2.NewGeneralServiceError("EarningUseCase", "AddAdjustment", nil)
+	}
+
+	if err := requireEarningsAdmin(ctx, "AddAdjustment"); err != nil {
+		return nil, err
+	}
+
+	return uc.earningService.AddAdjustment(ctx, req.DriverID, req.Amount, req.Reason, claims.UserID)
+}
+
+// GeneratePayoutStatement liquida las ganancias pendientes de un repartidor, por defecto las de la semana anterior
+func (uc *EarningUseCase) GeneratePayoutStatement(ctx context.Context, req *dto.PayoutStatementGenerateRequest) (*entities.PayoutStatement, error) {
+	if err := requireEarningsAdmin(ctx, "GeneratePayoutStatement"); err != nil {
+		return nil, err
+	}
+
+	start, end := previousPayoutWeek(time.Now())
+	if req.PeriodStart != nil && req.PeriodEnd != nil {
+		start, end = *req.PeriodStart, *req.PeriodEnd
+	}
+
+	return uc.earningService.GeneratePayoutStatement(ctx, req.DriverID, start, end)
+}
+
+// GetPayoutStatements obtiene los estados de pago de un repartidor, los repartidores solo pueden consultar los suyos
+func (uc *EarningUseCase) GetPayoutStatements(ctx context.Context, driverID string) ([]entities.PayoutStatement, error) {
+	claims, ok := ctx.Value("claims").(*auth.AuthClaims)
+	if !ok {
+		return nil, error2.NewGeneralServiceError("EarningUseCase", "GetPayoutStatements", nil)
+	}
+
+	driverID, err := resolveEarningsDriverID(claims, driverID, "GetPayoutStatements")
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.earningService.GetPayoutStatementsByDriver(ctx, driverID)
+}
+
+// GetPayoutStatementByID obtiene un estado de pago con sus movimientos verificando el acceso del usuario
+func (uc *EarningUseCase) GetPayoutStatementByID(ctx context.Context, id string) (*entities.PayoutStatement, error) {
+	claims, ok := ctx.Value("claims").(*auth.AuthClaims)
+	if !ok {
+		return nil, error2.NewGeneralServiceError("EarningUseCase", "GetPayoutStatementByID", nil)
+	}
+
+	statement, err := uc.earningService.GetPayoutStatementByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Role != constants.AdminRole && (claims.Role != constants.Driver || statement.DriverID != claims.UserID) {
+		logs.Warn("User does not have access to the payout statement", map[string]interface{}{
+			"user_id":      claims.UserID,
+			"statement_id": id,
+		})
+		return nil, errPackage.NewDomainErrorWithCause("EarningUseCase", "GetPayoutStatementByID", "payout statement not found", errPackage.ErrPayoutStatementNotFound)
+	}
+
+	return statement, nil
+}
+
+// ExportPayoutStatement genera el PDF descargable de un estado de pago
+func (uc *EarningUseCase) ExportPayoutStatement(ctx context.Context, id string) (*dto.PayoutStatementDocument, error) {
+	// 1. Obtener el estado de pago verificando el acceso del usuario
+	statement, err := uc.GetPayoutStatementByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Generar el documento
+	content, err := uc.pdfRenderer.Render(statement)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.PayoutStatementDocument{
+		Content:     content,
+		ContentType: uc.pdfRenderer.ContentType(),
+		FileName:    fmt.Sprintf("%s.%s", statement.StatementNumber, uc.pdfRenderer.FileExtension()),
+	}, nil
+}
+
+// RunPayoutCycle genera los estados de pago de la semana anterior de los repartidores con ganancias pendientes
+func (uc *EarningUseCase) RunPayoutCycle(ctx context.Context) error {
+	start, end := previousPayoutWeek(time.Now())
+
+	generated, err := uc.earningService.GenerateWeeklyStatements(ctx, start, end)
+	if err != nil {
+		return err
+	}
+
+	if generated > 0 {
+		logs.Info("Weekly payout statements generated", map[string]interface{}{
+			"periodStart": start,
+			"periodEnd":   end,
+			"statements":  generated,
+		})
+	}
+
+	return nil
+}
+
+// previousPayoutWeek obtiene la semana anterior de lunes a lunes, el fin del periodo es exclusivo
+func previousPayoutWeek(now time.Time) (time.Time, time.Time) {
+	daysSinceMonday := (int(now.Weekday()) + 6) % 7
+	end := time.Date(now.Year(), now.Month(), now.Day()-daysSinceMonday, 0, 0, 0, 0, now.Location())
+	return end.AddDate(0, 0, -7), end
+}
+
+// resolveEarningsDriverID determina el repartidor a consultar, los repartidores solo pueden consultar sus ganancias
+func resolveEarningsDriverID(claims *auth.AuthClaims, driverID, method string) (string, error) {
+	switch claims.Role {
+	case constants.Driver:
+		return claims.UserID, nil
+	case constants.AdminRole:
+		if driverID == "" {
+			return "", error2.NewGeneralServiceError("EarningUseCase", method, errPackage.ErrDriverIDRequired)
+		}
+		return driverID, nil
+	default:
+		logs.Warn("User does not have permissions to view driver earnings", map[string]interface{}{
+			"user_id": claims.UserID,
+			"role":    claims.Role,
+		})
+		return "", errPackage.NewDomainError("EarningUseCase", method, "User does not have sufficient permissions")
+	}
+}
+
+func requireEarningsAdmin(ctx context.Context, method string) error {
+	claims, ok := ctx.Value("claims").(*auth.AuthClaims)
+	if !ok {
+		return error2.NewGeneralServiceError("EarningUseCase", method, nil)
+	}
+
+	if claims.Role != constants.AdminRole {
+		logs.Warn("User does not have permissions to manage driver earnings", map[string]interface{}{
+			"user_id": claims.UserID,
+			"role":    claims.Role,
+		})
+		return errPackage.NewDomainError("EarningUseCase", method, "User does not have sufficient permissions")
+	}
+
+	return nil
+}
@@ -0,0 +1,236 @@
+package order
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/auth"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+	error2 "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/mappers/request_mapper"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/mappers/response_mapper"
+)
+
+type ImportUseCase struct {
+	importService  interfaces.OrderImporter
+	orderService   interfaces.Orderer
+	companyService interfaces.Companyrer
+}
+
+func NewImportUseCase(importService interfaces.OrderImporter, orderService interfaces.Orderer, companyService interfaces.Companyrer) *ImportUseCase {
+	return &ImportUseCase{
+		importService:  importService,
+		orderService:   orderService,
+		companyService: companyService,
+	}
+}
+
+// CreateImport lee el archivo de importación y lo deja pendiente para procesarse en segundo plano
+func (uc *ImportUseCase) CreateImport(ctx context.Context, format string, content []byte) (*entities.ImportJob, error) {
+	claims, ok := ctx.Value("claims").(*auth.AuthClaims)
+	if !ok {
+		return nil, error2.NewGeneralServiceError("ImportUseCase", "CreateImport", nil)
+	}
+
+	// 1. Convertir el archivo en filas de pedidos
+	rows, err := request_mapper.ImportFileToRows(format, content)
+	if err != nil {
+		return nil, error2.NewGeneralServiceError("ImportUseCase", "CreateImport", err)
+	}
+
+	// 2. Usar el mapper para crear el trabajo de importación
+	job, err := request_mapper.ImportRowsToImportJob(format, rows)
+	if err != nil {
+		return nil, error2.NewGeneralServiceError("ImportUseCase", "CreateImport", err)
+	}
+
+	// 3. Obtener el branch y company ID del usuario
+	job.CompanyID, job.BranchID, err = uc.companyService.GetCompanyAndBranchForUser(ctx, claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+	job.CreatedByID = claims.UserID
+
+	// 4. Registrar el trabajo
+	if err = uc.importService.CreateImportJob(ctx, job); err != nil {
+		return nil, err
+	}
+
+	return job, nil
+}
+
+// GetImportJobs obtiene las importaciones de la empresa del usuario
+func (uc *ImportUseCase) GetImportJobs(ctx context.Context) ([]entities.ImportJob, error) {
+	claims, ok := ctx.Value("claims").(*auth.AuthClaims)
+	if !ok {
+		return nil, error2.NewGeneralServiceError("ImportUseCase", "GetImportJobs", nil)
+	}
+
+	companyID, _, err := uc.companyService.GetCompanyAndBranchForUser(ctx, claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.importService.GetImportJobsByCompany(ctx, companyID)
+}
+
+// GetImportJobByID obtiene una importación de la empresa del usuario
+func (uc *ImportUseCase) GetImportJobByID(ctx context.Context, id string) (*entities.ImportJob, error) {
+	claims, ok := ctx.Value("claims").(*auth.AuthClaims)
+	if !ok {
+		return nil, error2.NewGeneralServiceError("ImportUseCase", "GetImportJobByID", nil)
+	}
+
+	job, err := uc.importService.GetImportJobByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Role != constants.AdminRole {
+		companyID, _, err := uc.companyService.GetCompanyAndBranchForUser(ctx, claims.UserID)
+		if err != nil {
+			return nil, err
+		}
+
+		if job.CompanyID != companyID {
+			return nil, errPackage.NewDomainErrorWithCause("ImportUseCase", "GetImportJobByID", "import job not found", errPackage.ErrImportJobNotFound)
+		}
+	}
+
+	return job, nil
+}
+
+// GetImportReport obtiene el resultado por fila de una importación finalizada
+func (uc *ImportUseCase) GetImportReport(ctx context.Context, id string) ([]dto.OrderImportRowResult, error) {
+	job, err := uc.GetImportJobByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !job.IsFinished() {
+		return nil, errPackage.NewDomainErrorWithCause("ImportUseCase", "GetImportReport", "import not finished", errPackage.ErrImportReportNotReady)
+	}
+
+	results, err := response_mapper.ImportReportToResponseDTO(job)
+	if err != nil {
+		return nil, error2.NewGeneralServiceError("ImportUseCase", "GetImportReport", err)
+	}
+
+	return results, nil
+}
+
+// ProcessPendingImports procesa las importaciones pendientes creando un pedido por fila válida
+func (uc *ImportUseCase) ProcessPendingImports(ctx context.Context) error {
+	// 1. Obtener las importaciones pendientes
+	jobs, err := uc.importService.GetPendingImportJobs(ctx)
+	if err != nil {
+		return err
+	}
+
+	for i := range jobs {
+		job := &jobs[i]
+
+		// 2. Reservar la importación para que no se procese en otra instancia
+		claimed, err := uc.importService.ClaimImportJob(ctx, job.ID)
+		if err != nil || !claimed {
+			continue
+		}
+
+		// 3. Procesar las filas y guardar el reporte
+		jobErr := uc.processImportJob(ctx, job)
+		if jobErr != nil {
+			logs.Error("Import job interrupted", map[string]interface{}{
+				"jobID": job.ID,
+				"error": jobErr.Error(),
+			})
+		}
+
+		// El reporte parcial se guarda aunque el contexto se haya cancelado
+		_ = uc.importService.FinishImportJob(context.WithoutCancel(ctx), job, jobErr)
+	}
+
+	return nil
+}
+
+// processImportJob crea los pedidos de cada fila y deja el resultado en el reporte del trabajo
+func (uc *ImportUseCase) processImportJob(ctx context.Context, job *entities.ImportJob) error {
+	rows, err := request_mapper.ImportJobToRows(job)
+	if err != nil {
+		return err
+	}
+
+	addresses := make(map[string]*entities.CompanyAddress)
+	results := make([]dto.OrderImportRowResult, 0, len(rows))
+
+	var jobErr error
+	for _, row := range rows {
+		if jobErr = ctx.Err(); jobErr != nil {
+			break
+		}
+
+		result := dto.OrderImportRowResult{Row: row.Row, Status: constants.ImportRowCreated}
+		order, err := uc.importRow(ctx, job, row, addresses)
+		if err != nil {
+			result.Status = constants.ImportRowFailed
+			result.Error = err.Error()
+			job.FailedRows++
+		} else {
+			result.OrderID = order.ID
+			result.TrackingNumber = order.TrackingNumber
+			job.CreatedRows++
+		}
+
+		results = append(results, result)
+	}
+
+	report, err := json.Marshal(results)
+	if err != nil {
+		return errPackage.ErrFailedToParseJSON
+	}
+	job.Report = string(report)
+
+	return jobErr
+}
+
+// importRow valida una fila con las mismas reglas de la creación individual y crea el pedido
+func (uc *ImportUseCase) importRow(ctx context.Context, job *entities.ImportJob, row dto.OrderImportRow, addresses map[string]*entities.CompanyAddress) (*entities.Order, error) {
+	// 1. Verificar que la fila se haya podido leer
+	if row.Error != "" {
+		return nil, errPackage.NewDomainError("ImportUseCase", "importRow", row.Error)
+	}
+
+	// 2. Verificar si la solicitud es válida
+	if err := row.Order.Validate(); err != nil {
+		return nil, err
+	}
+
+	// 3. Obtener la dirección de la empresa, reutilizándola entre filas
+	companyAddress, ok := addresses[row.Order.CompanyPickUpID]
+	if !ok {
+		var err error
+		companyAddress, err = uc.companyService.GetAddressByID(ctx, row.Order.CompanyPickUpID, job.CreatedByID)
+		if err != nil {
+			return nil, err
+		}
+		addresses[row.Order.CompanyPickUpID] = companyAddress
+	}
+
+	// 4. Usar el mapper para convertir el dto a entidad
+	order, err := request_mapper.OrderRequestToOrder(row.Order, companyAddress)
+	if err != nil {
+		return nil, err
+	}
+	order.CompanyID = job.CompanyID
+	order.BranchID = job.BranchID
+
+	// 5. Crear el pedido
+	if err = uc.orderService.CreateOrder(ctx, order); err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
@@ -0,0 +1,245 @@
+package order
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/auth"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+	error2 "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/mappers/response_mapper"
+)
+
+// companyVisibleInvoiceStatuses estados de factura que pueden ver los usuarios de la empresa, los borradores son internos
+var companyVisibleInvoiceStatuses = []string{
+	constants.InvoiceStatusIssued,
+	constants.InvoiceStatusPaid,
+	constants.InvoiceStatusVoid,
+}
+
+type InvoiceUseCase struct {
+	invoiceService interfaces.Invoicer
+	pdfRenderer    ports.InvoiceRenderer
+}
+
+func NewInvoiceUseCase(invoiceService interfaces.Invoicer, pdfRenderer ports.InvoiceRenderer) *InvoiceUseCase {
+	return &InvoiceUseCase{
+		invoiceService: invoiceService,
+		pdfRenderer:    pdfRenderer,
+	}
+}
+
+// GenerateInvoice genera en borrador la factura de una empresa, por defecto la del mes anterior
+func (uc *InvoiceUseCase) GenerateInvoice(ctx context.Context, req *dto.InvoiceGenerateRequest) (*entities.Invoice, error) {
+	claims, ok := ctx.Value("claims").(*auth.AuthClaims)
+	if !ok {
+		return nil, error2.NewGeneralServiceError("InvoiceUseCase", "GenerateInvoice", nil)
+	}
+
+	// 1. Solo los administradores pueden facturar
+	if claims.Role != constants.AdminRole {
+		logs.Warn("User does not have permissions to generate invoices", map[string]interface{}{
+			"user_id": claims.UserID,
+			"role":    claims.Role,
+		})
+		return nil, errPackage.NewDomainError("InvoiceUseCase", "GenerateInvoice", "User does not have sufficient permissions")
+	}
+
+	// 2. Determinar el periodo y el impuesto
+	start, end := previousBillingPeriod(time.Now())
+	if req.PeriodStart != nil && req.PeriodEnd != nil {
+		start, end = *req.PeriodStart, *req.PeriodEnd
+	}
+
+	taxRate := constants.DefaultInvoiceTaxRate
+	if req.TaxRate != nil {
+		taxRate = *req.TaxRate
+	}
+
+	// 3. Generar la factura
+	return uc.invoiceService.GenerateInvoice(ctx, req.CompanyID, start, end, taxRate)
+}
+
+// GetInvoices obtiene las facturas de la empresa del usuario, los administradores pueden indicar la empresa
+func (uc *InvoiceUseCase) GetInvoices(ctx context.Context, companyID, status string) ([]entities.Invoice, error) {
+	claims, ok := ctx.Value("claims").(*auth.AuthClaims)
+	if !ok {
+		return nil, error2.NewGeneralServiceError("InvoiceUseCase", "GetInvoices", nil)
+	}
+
+	// 1. Verificar el acceso y determinar la empresa
+	if claims.Role != constants.AdminRole && claims.Role != constants.CompanyUser {
+		return nil, errPackage.NewDomainError("InvoiceUseCase", "GetInvoices", "User does not have sufficient permissions")
+	}
+
+	if claims.Role != constants.AdminRole || companyID == "" {
+		companyID = claims.CompanyID
+	}
+
+	if companyID == "" {
+		return nil, error2.NewGeneralServiceError("InvoiceUseCase", "GetInvoices", errPackage.ErrCompanyIDRequired)
+	}
+
+	// 2. Determinar los estados visibles para el usuario
+	var statuses []string
+	if status != "" {
+		statuses = []string{strings.ToUpper(status)}
+	}
+
+	if claims.Role != constants.AdminRole {
+		statuses = visibleInvoiceStatuses(statuses)
+		if len(statuses) == 0 {
+			return []entities.Invoice{}, nil
+		}
+	}
+
+	return uc.invoiceService.GetInvoicesByCompany(ctx, companyID, statuses)
+}
+
+// GetInvoiceByID obtiene una factura con sus líneas verificando el acceso del usuario
+func (uc *InvoiceUseCase) GetInvoiceByID(ctx context.Context, id string) (*entities.Invoice, error) {
+	claims, ok := ctx.Value("claims").(*auth.AuthClaims)
+	if !ok {
+		return nil, error2.NewGeneralServiceError("InvoiceUseCase", "GetInvoiceByID", nil)
+	}
+
+	invoice, err := uc.invoiceService.GetInvoiceByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !canAccessInvoice(claims, invoice) {
+		logs.Warn("User does not have access to the invoice", map[string]interface{}{
+			"user_id":    claims.UserID,
+			"invoice_id": id,
+		})
+		return nil, errPackage.NewDomainErrorWithCause("InvoiceUseCase", "GetInvoiceByID", "invoice not found", errPackage.ErrInvoiceNotFound)
+	}
+
+	return invoice, nil
+}
+
+// ChangeInvoiceStatus emite, marca como pagada o anula una factura
+func (uc *InvoiceUseCase) ChangeInvoiceStatus(ctx context.Context, id string, req *dto.InvoiceStatusRequest) (*entities.Invoice, error) {
+	claims, ok := ctx.Value("claims").(*auth.AuthClaims)
+	if !ok {
+		return nil, error2.NewGeneralServiceError("InvoiceUseCase", "ChangeInvoiceStatus", nil)
+	}
+
+	if claims.Role != constants.AdminRole {
+		logs.Warn("User does not have permissions to change invoice status", map[string]interface{}{
+			"user_id": claims.UserID,
+			"role":    claims.Role,
+		})
+		return nil, errPackage.NewDomainError("InvoiceUseCase", "ChangeInvoiceStatus", "User does not have sufficient permissions")
+	}
+
+	return uc.invoiceService.ChangeInvoiceStatus(ctx, id, req.Status, req.Reason)
+}
+
+// ExportInvoice genera el archivo descargable de una factura en PDF o JSON
+func (uc *InvoiceUseCase) ExportInvoice(ctx context.Context, id, format string) (*dto.InvoiceDocument, error) {
+	// 1. Validar el formato solicitado
+	format = strings.ToUpper(format)
+	if format == "" {
+		format = constants.InvoiceFormatPDF
+	}
+
+	if format != constants.InvoiceFormatPDF && format != constants.InvoiceFormatJSON {
+		return nil, error2.NewGeneralServiceError("InvoiceUseCase", "ExportInvoice", errPackage.ErrInvalidInvoiceFormat)
+	}
+
+	// 2. Obtener la factura verificando el acceso del usuario
+	invoice, err := uc.GetInvoiceByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Generar el documento
+	if format == constants.InvoiceFormatJSON {
+		content, err := json.MarshalIndent(response_mapper.InvoiceToResponseDTO(invoice), "", "  ")
+		if err != nil {
+			return nil, error2.NewGeneralServiceError("InvoiceUseCase", "ExportInvoice", errPackage.ErrFailedToParseJSON)
+		}
+
+		return &dto.InvoiceDocument{
+			Content:     content,
+			ContentType: "application/json",
+			FileName:    fmt.Sprintf("%s.json", invoice.InvoiceNumber),
+		}, nil
+	}
+
+	content, err := uc.pdfRenderer.Render(invoice)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.InvoiceDocument{
+		Content:     content,
+		ContentType: uc.pdfRenderer.ContentType(),
+		FileName:    fmt.Sprintf("%s.%s", invoice.InvoiceNumber, uc.pdfRenderer.FileExtension()),
+	}, nil
+}
+
+// RunBillingCycle genera en borrador las facturas del mes anterior de las empresas que aún no la tienen
+func (uc *InvoiceUseCase) RunBillingCycle(ctx context.Context) error {
+	start, end := previousBillingPeriod(time.Now())
+
+	generated, err := uc.invoiceService.GenerateCycleInvoices(ctx, start, end)
+	if err != nil {
+		return err
+	}
+
+	if generated > 0 {
+		logs.Info("Billing cycle invoices generated", map[string]interface{}{
+			"periodStart": start,
+			"periodEnd":   end,
+			"invoices":    generated,
+		})
+	}
+
+	return nil
+}
+
+// previousBillingPeriod obtiene el mes calendario anterior, el fin del periodo es exclusivo
+func previousBillingPeriod(now time.Time) (time.Time, time.Time) {
+	end := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	return end.AddDate(0, -1, 0), end
+}
+
+// visibleInvoiceStatuses limita los estados solicitados a los visibles para los usuarios de la empresa
+func visibleInvoiceStatuses(requested []string) []string {
+	if len(requested) == 0 {
+		return companyVisibleInvoiceStatuses
+	}
+
+	visible := make([]string, 0, len(requested))
+	for _, status := range requested {
+		if containsStatus(companyVisibleInvoiceStatuses, status) {
+			visible = append(visible, status)
+		}
+	}
+
+	return visible
+}
+
+// canAccessInvoice verifica si el usuario puede ver la factura, las empresas no ven los borradores
+func canAccessInvoice(claims *auth.AuthClaims, invoice *entities.Invoice) bool {
+	switch claims.Role {
+	case constants.AdminRole:
+		return true
+	case constants.CompanyUser:
+		return invoice.CompanyID == claims.CompanyID && invoice.Status != constants.InvoiceStatusDraft
+	default:
+		return false
+	}
+}
@@ -0,0 +1,141 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/auth"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+	error2 "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+type LabelUseCase struct {
+	orderService interfaces.Orderer
+	renderers    map[string]ports.LabelRenderer
+}
+
+func NewLabelUseCase(orderService interfaces.Orderer, pdfRenderer, zplRenderer ports.LabelRenderer) *LabelUseCase {
+	return &LabelUseCase{
+		orderService: orderService,
+		renderers: map[string]ports.LabelRenderer{
+			constants.LabelFormatPDF: pdfRenderer,
+			constants.LabelFormatZPL: zplRenderer,
+		},
+	}
+}
+
+// GenerateLabel genera la etiqueta de envío de un pedido
+func (uc *LabelUseCase) GenerateLabel(ctx context.Context, orderID, format string) (*dto.LabelDocument, error) {
+	// 1. Obtener el generador del formato solicitado
+	renderer, err := uc.rendererFor(format)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Obtener el pedido verificando el acceso del usuario
+	order, err := uc.getAccessibleOrder(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Generar el documento
+	content, err := renderer.Render([]entities.Order{*order})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.LabelDocument{
+		Content:     content,
+		ContentType: renderer.ContentType(),
+		FileName:    fmt.Sprintf("label-%s.%s", order.TrackingNumber, renderer.FileExtension()),
+	}, nil
+}
+
+// GenerateLabels genera en un solo documento las etiquetas de varios pedidos
+func (uc *LabelUseCase) GenerateLabels(ctx context.Context, req *dto.LabelBatchRequest) (*dto.LabelDocument, error) {
+	// 1. Obtener el generador del formato solicitado
+	renderer, err := uc.rendererFor(req.Format)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Obtener los pedidos en el orden solicitado, ignorando identificadores repetidos
+	seen := make(map[string]bool, len(req.OrderIDs))
+	orders := make([]entities.Order, 0, len(req.OrderIDs))
+	for _, orderID := range req.OrderIDs {
+		if seen[orderID] {
+			continue
+		}
+		seen[orderID] = true
+
+		order, err := uc.getAccessibleOrder(ctx, orderID)
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, *order)
+	}
+
+	// 3. Generar el documento con una etiqueta por pedido
+	content, err := renderer.Render(orders)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.LabelDocument{
+		Content:     content,
+		ContentType: renderer.ContentType(),
+		FileName:    fmt.Sprintf("labels-%d.%s", len(orders), renderer.FileExtension()),
+	}, nil
+}
+
+func (uc *LabelUseCase) rendererFor(format string) (ports.LabelRenderer, error) {
+	if format == "" {
+		format = constants.LabelFormatPDF
+	}
+
+	renderer, ok := uc.renderers[strings.ToUpper(format)]
+	if !ok {
+		return nil, error2.NewGeneralServiceError("LabelUseCase", "rendererFor", errPackage.ErrInvalidLabelFormat)
+	}
+
+	return renderer, nil
+}
+
+// getAccessibleOrder obtiene un pedido no eliminado al que el usuario tiene acceso
+func (uc *LabelUseCase) getAccessibleOrder(ctx context.Context, orderID string) (*entities.Order, error) {
+	claims, ok := ctx.Value("claims").(*auth.AuthClaims)
+	if !ok {
+		return nil, error2.NewGeneralServiceError("LabelUseCase", "getAccessibleOrder", nil)
+	}
+
+	if uc.orderService.OrderIsDeleted(ctx, orderID) {
+		return nil, error2.NewGeneralServiceError("LabelUseCase", "getAccessibleOrder", errPackage.ErrOrderDeleted)
+	}
+
+	order, err := uc.orderService.GetOrderByID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case claims.Role == constants.AdminRole:
+	case claims.Role == constants.Driver && order.DriverID != nil && *order.DriverID == claims.UserID:
+	case claims.Role != constants.Driver && claims.CompanyID != "" && order.CompanyID == claims.CompanyID:
+	default:
+		logs.Warn("User does not have permissions to print the order label", map[string]interface{}{
+			"user_id":  claims.UserID,
+			"role":     claims.Role,
+			"order_id": orderID,
+		})
+		return nil, errPackage.NewDomainError("LabelUseCase", "getAccessibleOrder", "User does not have sufficient permissions")
+	}
+
+	return order, nil
+}
@@ -0,0 +1,291 @@
+package order
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/auth"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+	error2 "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/mappers/request_mapper"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/mappers/response_mapper"
+)
+
+// notificationSortFields campos permitidos para ordenar la bandeja
+var notificationSortFields = map[string]bool{
+	"created_at": true,
+	"read_at":    true,
+	"type":       true,
+}
+
+type NotificationUseCase struct {
+	orderNotifier interfaces.OrderNotifier
+	inbox         interfaces.NotificationInbox
+	stream        ports.InboxStream
+	preferences   interfaces.NotificationPreferencer
+}
+
+func NewNotificationUseCase(orderNotifier interfaces.OrderNotifier, inbox interfaces.NotificationInbox, stream ports.InboxStream, preferences interfaces.NotificationPreferencer) *NotificationUseCase {
+	return &NotificationUseCase{
+		orderNotifier: orderNotifier,
+		inbox:         inbox,
+		stream:        stream,
+		preferences:   preferences,
+	}
+}
+
+// GetCompanySettings obtiene qué eventos del pedido notifica la empresa del usuario, los administradores
+// pueden indicar la empresa
+func (uc *NotificationUseCase) GetCompanySettings(ctx context.Context, companyID string) (*dto.CompanyNotificationSettingsResponse, error) {
+	companyID, err := resolveNotificationCompanyID(ctx, companyID, "GetCompanySettings")
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := uc.orderNotifier.GetCompanySettings(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return response_mapper.CompanyNotificationSettingsToResponseDTO(companyID, settings), nil
+}
+
+// UpdateCompanySettings configura qué eventos del pedido se notifican al destinatario y a la empresa
+func (uc *NotificationUseCase) UpdateCompanySettings(ctx context.Context, req *dto.UpdateCompanyNotificationSettingsRequest) (*dto.CompanyNotificationSettingsResponse, error) {
+	companyID, err := resolveNotificationCompanyID(ctx, req.CompanyID, "UpdateCompanySettings")
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := uc.orderNotifier.UpdateCompanySettings(ctx, companyID, request_mapper.CompanyNotificationSettingsRequestToEntities(companyID, req))
+	if err != nil {
+		return nil, err
+	}
+
+	return response_mapper.CompanyNotificationSettingsToResponseDTO(companyID, settings), nil
+}
+
+// GetNotifications obtiene la bandeja paginada del usuario autenticado
+func (uc *NotificationUseCase) GetNotifications(ctx context.Context, request *http.Request) (*dto.PaginatedResponse, error) {
+	claims, ok := ctx.Value("claims").(*auth.AuthClaims)
+	if !ok {
+		return nil, error2.NewGeneralServiceError("NotificationUseCase", "GetNotifications", nil)
+	}
+
+	// 1. Extraer los filtros de la request
+	params := parseNotificationQueryParams(request)
+
+	// 2. Obtener las notificaciones
+	notifications, total, err := uc.inbox.GetNotifications(ctx, claims.UserID, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return response_mapper.MapNotificationsToResponse(notifications, params, total), nil
+}
+
+// GetUnreadCounts obtiene las notificaciones sin leer del usuario autenticado
+func (uc *NotificationUseCase) GetUnreadCounts(ctx context.Context) (*dto.UnreadNotificationsResponse, error) {
+	claims, ok := ctx.Value("claims").(*auth.AuthClaims)
+	if !ok {
+		return nil, error2.NewGeneralServiceError("NotificationUseCase", "GetUnreadCounts", nil)
+	}
+
+	counts, total, err := uc.inbox.GetUnreadCounts(ctx, claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.UnreadNotificationsResponse{
+		Total:  total,
+		ByType: counts,
+	}, nil
+}
+
+// MarkAsRead marca como leída una notificación del usuario autenticado
+func (uc *NotificationUseCase) MarkAsRead(ctx context.Context, notificationID string) error {
+	claims, ok := ctx.Value("claims").(*auth.AuthClaims)
+	if !ok {
+		return error2.NewGeneralServiceError("NotificationUseCase", "MarkAsRead", nil)
+	}
+
+	return uc.inbox.MarkAsRead(ctx, claims.UserID, notificationID)
+}
+
+// MarkAllAsRead marca como leídas todas las notificaciones del usuario autenticado
+func (uc *NotificationUseCase) MarkAllAsRead(ctx context.Context) (*dto.MarkAllNotificationsReadResponse, error) {
+	claims, ok := ctx.Value("claims").(*auth.AuthClaims)
+	if !ok {
+		return nil, error2.NewGeneralServiceError("NotificationUseCase", "MarkAllAsRead", nil)
+	}
+
+	updated, err := uc.inbox.MarkAllAsRead(ctx, claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.MarkAllNotificationsReadResponse{Updated: updated}, nil
+}
+
+// SubscribeInbox conecta al usuario autenticado al stream de su bandeja, devuelve los eventos, la función para
+// desconectarse y el contador actual de no leídas
+func (uc *NotificationUseCase) SubscribeInbox(ctx context.Context) (<-chan entities.InboxEvent, func(), int64, error) {
+	claims, ok := ctx.Value("claims").(*auth.AuthClaims)
+	if !ok {
+		return nil, nil, 0, error2.NewGeneralServiceError("NotificationUseCase", "SubscribeInbox", nil)
+	}
+
+	// 1. Suscribirse antes de leer el contador para no perder eventos publicados entre ambos pasos
+	events, unsubscribe := uc.stream.Subscribe(claims.UserID)
+
+	// 2. Obtener el contador actual
+	_, total, err := uc.inbox.GetUnreadCounts(ctx, claims.UserID)
+	if err != nil {
+		unsubscribe()
+		return nil, nil, 0, err
+	}
+
+	return events, unsubscribe, total, nil
+}
+
+// GetPreferences obtiene los canales de cada tipo de notificación del usuario autenticado
+func (uc *NotificationUseCase) GetPreferences(ctx context.Context) (*dto.NotificationPreferencesResponse, error) {
+	claims, ok := ctx.Value("claims").(*auth.AuthClaims)
+	if !ok {
+		return nil, error2.NewGeneralServiceError("NotificationUseCase", "GetPreferences", nil)
+	}
+
+	preferences, err := uc.preferences.GetPreferences(ctx, claims.UserID, claims.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	return response_mapper.NotificationPreferencesToResponseDTO(preferences), nil
+}
+
+// UpdatePreferences configura los canales de los tipos de notificación indicados del usuario autenticado
+func (uc *NotificationUseCase) UpdatePreferences(ctx context.Context, req *dto.UpdateNotificationPreferencesRequest) (*dto.NotificationPreferencesResponse, error) {
+	claims, ok := ctx.Value("claims").(*auth.AuthClaims)
+	if !ok {
+		return nil, error2.NewGeneralServiceError("NotificationUseCase", "UpdatePreferences", nil)
+	}
+
+	preferences, err := uc.preferences.UpdatePreferences(ctx, claims.UserID, claims.Role, request_mapper.NotificationPreferencesRequestToEntities(claims.UserID, req))
+	if err != nil {
+		return nil, err
+	}
+
+	return response_mapper.NotificationPreferencesToResponseDTO(preferences), nil
+}
+
+// GetDevices obtiene los dispositivos activos del usuario autenticado
+func (uc *NotificationUseCase) GetDevices(ctx context.Context) ([]dto.NotificationDeviceResponse, error) {
+	claims, ok := ctx.Value("claims").(*auth.AuthClaims)
+	if !ok {
+		return nil, error2.NewGeneralServiceError("NotificationUseCase", "GetDevices", nil)
+	}
+
+	devices, err := uc.preferences.GetDevices(ctx, claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return response_mapper.NotificationDevicesToResponseDTO(devices), nil
+}
+
+// RegisterDevice registra o renueva el token push de un dispositivo del usuario autenticado
+func (uc *NotificationUseCase) RegisterDevice(ctx context.Context, req *dto.RegisterNotificationDeviceRequest) (*dto.NotificationDeviceResponse, error) {
+	claims, ok := ctx.Value("claims").(*auth.AuthClaims)
+	if !ok {
+		return nil, error2.NewGeneralServiceError("NotificationUseCase", "RegisterDevice", nil)
+	}
+
+	device := request_mapper.RegisterNotificationDeviceRequestToEntity(claims.UserID, req)
+	if err := uc.preferences.RegisterDevice(ctx, device, req.PreviousToken); err != nil {
+		return nil, err
+	}
+
+	return response_mapper.NotificationDeviceToResponseDTO(device), nil
+}
+
+// DeregisterDevice desactiva un dispositivo del usuario autenticado
+func (uc *NotificationUseCase) DeregisterDevice(ctx context.Context, deviceID string) error {
+	claims, ok := ctx.Value("claims").(*auth.AuthClaims)
+	if !ok {
+		return error2.NewGeneralServiceError("NotificationUseCase", "DeregisterDevice", nil)
+	}
+
+	return uc.preferences.DeregisterDevice(ctx, claims.UserID, deviceID)
+}
+
+// parseNotificationQueryParams extrae los parámetros de consulta de la bandeja de la request
+func parseNotificationQueryParams(r *http.Request) *entities.NotificationQueryParams {
+	params := &entities.NotificationQueryParams{}
+
+	// Filtros
+	params.Type = r.URL.Query().Get("type")
+	if isReadStr := r.URL.Query().Get("is_read"); isReadStr != "" {
+		if isRead, err := strconv.ParseBool(isReadStr); err == nil {
+			params.IsRead = &isRead
+		}
+	}
+
+	// Paginación
+	params.Page = 1 // Default
+	if page, err := strconv.Atoi(r.URL.Query().Get("page")); err == nil && page > 0 {
+		params.Page = page
+	}
+
+	params.PageSize = 10 // Default
+	if pageSize, err := strconv.Atoi(r.URL.Query().Get("page_size")); err == nil && pageSize > 0 {
+		params.PageSize = pageSize
+	}
+
+	// Ordenamiento, solo por campos permitidos
+	if sortBy := r.URL.Query().Get("sort_by"); notificationSortFields[sortBy] {
+		params.SortBy = sortBy
+	}
+	params.SortDirection = r.URL.Query().Get("sort_direction")
+	if params.SortDirection != "asc" && params.SortDirection != "desc" {
+		params.SortDirection = "desc" // Default
+	}
+
+	return params
+}
+
+// resolveNotificationCompanyID determina la empresa a configurar, solo los administradores pueden indicar otra empresa
+func resolveNotificationCompanyID(ctx context.Context, companyID, method string) (string, error) {
+	claims, ok := ctx.Value("claims").(*auth.AuthClaims)
+	if !ok {
+		return "", error2.NewGeneralServiceError("NotificationUseCase", method, nil)
+	}
+
+	switch claims.Role {
+	case constants.AdminRole:
+		if companyID == "" {
+			companyID = claims.CompanyID
+		}
+	case constants.CompanyUser:
+		companyID = claims.CompanyID
+	default:
+		logs.Warn("User does not have permissions to manage company notifications", map[string]interface{}{
+			"user_id": claims.UserID,
+			"role":    claims.Role,
+		})
+		return "", errPackage.NewDomainError("NotificationUseCase", method, "User does not have sufficient permissions")
+	}
+
+	if companyID == "" {
+		return "", error2.NewGeneralServiceError("NotificationUseCase", method, errPackage.ErrCompanyIDRequired)
+	}
+
+	return companyID, nil
+}
@@ -2,6 +2,7 @@ package order
 
 import (
 	"context"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
 	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
 	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/auth"
 	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
@@ -12,6 +13,7 @@ import (
 	"github.com/MarlonG1/delivery-backend/pkg/shared/mappers/request_mapper"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -107,6 +109,69 @@ func (uc *OrderUseCase) ChangeStatus(ctx context.Context, id, status string) err
 	return nil
 }
 
+// DeliverOrder completa la entrega de un pedido verificando el PIN del destinatario y el cobro contra entrega
+func (uc *OrderUseCase) DeliverOrder(ctx context.Context, orderID string, req *dto.OrderDeliverRequest) error {
+	// 1. Obtener los claims del contexto
+	claims, ok := ctx.Value("claims").(*auth.AuthClaims)
+	if !ok {
+		return error2.NewGeneralServiceError("OrderUseCase", "DeliverOrder", nil)
+	}
+
+	// 2. Solo repartidores y administradores pueden completar entregas
+	if claims.Role != constants.Driver && claims.Role != constants.AdminRole {
+		logs.Warn("User does not have permissions to deliver orders", map[string]interface{}{
+			"user_id": claims.UserID,
+			"role":    claims.Role,
+		})
+		return errPackage.NewDomainError("OrderUseCase", "DeliverOrder", "User does not have sufficient permissions")
+	}
+
+	// 3. Un repartidor solo puede entregar los pedidos que tiene asignados
+	driverID := ""
+	if claims.Role == constants.Driver {
+		driverID = claims.UserID
+	}
+
+	// 4. Completar la entrega
+	return uc.orderService.DeliverOrder(ctx, orderID, driverID, req.PIN, req.CollectedAmount)
+}
+
+// RegisterDeliveryAttempt registra un intento de entrega fallido
+func (uc *OrderUseCase) RegisterDeliveryAttempt(ctx context.Context, orderID string, req *dto.DeliveryAttemptRequest) (*entities.DeliveryAttempt, error) {
+	// 1. Obtener los claims del contexto
+	claims, ok := ctx.Value("claims").(*auth.AuthClaims)
+	if !ok {
+		return nil, error2.NewGeneralServiceError("OrderUseCase", "RegisterDeliveryAttempt", nil)
+	}
+
+	// 2. Solo repartidores y administradores pueden registrar intentos de entrega
+	if claims.Role != constants.Driver && claims.Role != constants.AdminRole {
+		logs.Warn("User does not have permissions to register delivery attempts", map[string]interface{}{
+			"user_id": claims.UserID,
+			"role":    claims.Role,
+		})
+		return nil, errPackage.NewDomainError("OrderUseCase", "RegisterDeliveryAttempt", "User does not have sufficient permissions")
+	}
+
+	driverID := ""
+	if claims.Role == constants.Driver {
+		driverID = claims.UserID
+	}
+
+	// 3. Registrar el intento
+	return uc.orderService.RegisterDeliveryAttempt(ctx, orderID, driverID, strings.ToUpper(req.ReasonCode), req.Notes)
+}
+
+// GetDeliveryAttempts obtiene los intentos de entrega de un pedido
+func (uc *OrderUseCase) GetDeliveryAttempts(ctx context.Context, orderID string) ([]entities.DeliveryAttempt, error) {
+	// 1. Verificar si el pedido no está eliminado
+	if uc.orderService.OrderIsDeleted(ctx, orderID) {
+		return nil, error2.NewGeneralServiceError("OrderUseCase", "GetDeliveryAttempts", errPackage.ErrOrderDeleted)
+	}
+
+	return uc.orderService.GetDeliveryAttempts(ctx, orderID)
+}
+
 // GetOrdersByCompany obtiene los pedidos de una empresa
 func (uc *OrderUseCase) GetOrdersByCompany(ctx context.Context, userID string, request *http.Request) ([]entities.Order, *entities.OrderQueryParams, int64, error) {
 	// 1. Parsear los parámetros de consulta
@@ -0,0 +1,58 @@
+package order
+
+import (
+	"context"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/auth"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	error2 "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+type PaymentUseCase struct {
+	paymentService interfaces.PaymentProcessor
+}
+
+func NewPaymentUseCase(paymentService interfaces.PaymentProcessor) *PaymentUseCase {
+	return &PaymentUseCase{
+		paymentService: paymentService,
+	}
+}
+
+// GetOrderPayment obtiene el pago anticipado de un pedido verificando el acceso del usuario
+func (uc *PaymentUseCase) GetOrderPayment(ctx context.Context, orderID string) (*entities.OrderPayment, error) {
+	claims, ok := ctx.Value("claims").(*auth.AuthClaims)
+	if !ok {
+		return nil, error2.NewGeneralServiceError("PaymentUseCase", "GetOrderPayment", nil)
+	}
+
+	// 1. Obtener el pago del pedido
+	payment, err := uc.paymentService.GetOrderPayment(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Verificar que el usuario tenga acceso al pedido
+	order := payment.Order
+	switch {
+	case claims.Role == constants.AdminRole,
+		order != nil && claims.Role == constants.CompanyUser && order.CompanyID == claims.CompanyID,
+		order != nil && claims.Role == constants.FinalUser && order.ClientID == claims.UserID:
+		return payment, nil
+	default:
+		logs.Warn("User does not have permissions to view the order payment", map[string]interface{}{
+			"user_id":  claims.UserID,
+			"role":     claims.Role,
+			"order_id": orderID,
+		})
+		return nil, errPackage.NewDomainError("PaymentUseCase", "GetOrderPayment", "User does not have sufficient permissions")
+	}
+}
+
+// HandleWebhook procesa un evento firmado enviado por el proveedor de pagos
+func (uc *PaymentUseCase) HandleWebhook(ctx context.Context, payload []byte, signature string) error {
+	return uc.paymentService.HandleWebhook(ctx, payload, signature)
+}
@@ -0,0 +1,166 @@
+package order
+
+import (
+	"context"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/auth"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+	error2 "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/mappers/request_mapper"
+)
+
+type ReturnUseCase struct {
+	returnService interfaces.Returner
+	orderService  interfaces.Orderer
+}
+
+func NewReturnUseCase(returnService interfaces.Returner, orderService interfaces.Orderer) *ReturnUseCase {
+	return &ReturnUseCase{
+		returnService: returnService,
+		orderService:  orderService,
+	}
+}
+
+// CreateReturn crea la devolución de un pedido entregado, iniciada por la empresa o por el destinatario del pedido
+func (uc *ReturnUseCase) CreateReturn(ctx context.Context, orderID string, req *dto.ReturnCreateRequest) (*entities.OrderReturn, error) {
+	// 1. Obtener los claims del contexto
+	claims, ok := ctx.Value("claims").(*auth.AuthClaims)
+	if !ok {
+		return nil, error2.NewGeneralServiceError("ReturnUseCase", "CreateReturn", nil)
+	}
+
+	// 2. Obtener el pedido original
+	order, err := uc.orderService.GetOrderByID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Determinar quién inicia la devolución y si tiene acceso al pedido
+	var initiatedBy string
+	switch {
+	case claims.Role == constants.FinalUser && order.ClientID == claims.UserID:
+		initiatedBy = constants.ReturnInitiatedByRecipient
+	case claims.Role == constants.AdminRole,
+		claims.Role == constants.CompanyUser && order.CompanyID == claims.CompanyID:
+		initiatedBy = constants.ReturnInitiatedByCompany
+	default:
+		logs.Warn("User does not have permissions to return the order", map[string]interface{}{
+			"user_id":  claims.UserID,
+			"role":     claims.Role,
+			"order_id": orderID,
+		})
+		return nil, errPackage.NewDomainError("ReturnUseCase", "CreateReturn", "User does not have sufficient permissions")
+	}
+
+	// 4. Crear la devolución
+	orderReturn := request_mapper.ReturnRequestToReturn(orderID, claims.UserID, initiatedBy, req)
+	if err = uc.returnService.CreateReturn(ctx, orderReturn); err != nil {
+		return nil, err
+	}
+
+	return orderReturn, nil
+}
+
+// GetReturnByID obtiene una devolución verificando el acceso del usuario
+func (uc *ReturnUseCase) GetReturnByID(ctx context.Context, id string) (*entities.OrderReturn, error) {
+	claims, ok := ctx.Value("claims").(*auth.AuthClaims)
+	if !ok {
+		return nil, error2.NewGeneralServiceError("ReturnUseCase", "GetReturnByID", nil)
+	}
+
+	orderReturn, err := uc.returnService.GetReturnByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !canAccessReturn(claims, orderReturn) {
+		return nil, errPackage.NewDomainError("ReturnUseCase", "GetReturnByID", "User does not have sufficient permissions")
+	}
+
+	return orderReturn, nil
+}
+
+// GetReturnsByOrder obtiene las devoluciones de un pedido
+func (uc *ReturnUseCase) GetReturnsByOrder(ctx context.Context, orderID string) ([]entities.OrderReturn, error) {
+	claims, ok := ctx.Value("claims").(*auth.AuthClaims)
+	if !ok {
+		return nil, error2.NewGeneralServiceError("ReturnUseCase", "GetReturnsByOrder", nil)
+	}
+
+	order, err := uc.orderService.GetOrderByID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !canAccessReturn(claims, &entities.OrderReturn{CompanyID: order.CompanyID, Order: order}) {
+		return nil, errPackage.NewDomainError("ReturnUseCase", "GetReturnsByOrder", "User does not have sufficient permissions")
+	}
+
+	return uc.returnService.GetReturnsByOrder(ctx, orderID)
+}
+
+// GetReturnsByCompany obtiene las devoluciones de la empresa del usuario, los administradores pueden indicar la empresa
+func (uc *ReturnUseCase) GetReturnsByCompany(ctx context.Context, companyID, status string) ([]entities.OrderReturn, error) {
+	claims, ok := ctx.Value("claims").(*auth.AuthClaims)
+	if !ok {
+		return nil, error2.NewGeneralServiceError("ReturnUseCase", "GetReturnsByCompany", nil)
+	}
+
+	if claims.Role != constants.AdminRole || companyID == "" {
+		companyID = claims.CompanyID
+	}
+
+	if companyID == "" {
+		return nil, error2.NewGeneralServiceError("ReturnUseCase", "GetReturnsByCompany", errPackage.ErrCompanyIDRequired)
+	}
+
+	return uc.returnService.GetReturnsByCompany(ctx, companyID, status)
+}
+
+// ChangeReturnStatus avanza el estado de una devolución
+func (uc *ReturnUseCase) ChangeReturnStatus(ctx context.Context, id, status string) error {
+	claims, ok := ctx.Value("claims").(*auth.AuthClaims)
+	if !ok {
+		return error2.NewGeneralServiceError("ReturnUseCase", "ChangeReturnStatus", nil)
+	}
+
+	// 1. Verificar acceso a la devolución
+	orderReturn, err := uc.returnService.GetReturnByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	switch claims.Role {
+	case constants.AdminRole, constants.Driver, constants.WarehouseStaff:
+	case constants.CompanyUser:
+		if orderReturn.CompanyID != claims.CompanyID {
+			return errPackage.NewDomainError("ReturnUseCase", "ChangeReturnStatus", "User does not have sufficient permissions")
+		}
+	default:
+		return errPackage.NewDomainError("ReturnUseCase", "ChangeReturnStatus", "User does not have sufficient permissions")
+	}
+
+	// 2. El repartidor que recoge la devolución queda asignado a ella
+	var driverID *string
+	if claims.Role == constants.Driver {
+		driverID = &claims.UserID
+	}
+
+	return uc.returnService.ChangeReturnStatus(ctx, id, status, driverID)
+}
+
+// canAccessReturn verifica si el usuario puede consultar una devolución
+func canAccessReturn(claims *auth.AuthClaims, orderReturn *entities.OrderReturn) bool {
+	switch claims.Role {
+	case constants.AdminRole, constants.Driver, constants.WarehouseStaff:
+		return true
+	case constants.FinalUser:
+		return orderReturn.Order != nil && orderReturn.Order.ClientID == claims.UserID
+	default:
+		return orderReturn.CompanyID == claims.CompanyID
+	}
+}
@@ -0,0 +1,236 @@
+package order
+
+import (
+	"context"
+	"strings"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/auth"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/value_objects"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+	error2 "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/mappers/response_mapper"
+)
+
+type ScanUseCase struct {
+	orderService interfaces.Orderer
+}
+
+func NewScanUseCase(orderService interfaces.Orderer) *ScanUseCase {
+	return &ScanUseCase{
+		orderService: orderService,
+	}
+}
+
+// ScanOrder obtiene el pedido de un código QR escaneado con las acciones que el rol puede realizar,
+// aplicando en la misma llamada el cambio de estado solicitado
+func (uc *ScanUseCase) ScanOrder(ctx context.Context, req *dto.ScanRequest) (*dto.ScanResponse, error) {
+	// 1. Obtener los claims del contexto y verificar que el rol pueda escanear
+	claims, ok := ctx.Value("claims").(*auth.AuthClaims)
+	if !ok {
+		return nil, error2.NewGeneralServiceError("ScanUseCase", "ScanOrder", nil)
+	}
+
+	roleStatuses, ok := constants.ScanStatusesByRole[claims.Role]
+	if !ok {
+		logs.Warn("User does not have permissions to scan orders", map[string]interface{}{
+			"user_id": claims.UserID,
+			"role":    claims.Role,
+		})
+		return nil, errPackage.NewDomainErrorWithCause("ScanUseCase", "ScanOrder", "User does not have sufficient permissions", errPackage.ErrScanNotAllowed)
+	}
+
+	// 2. Los códigos de los bultos se escanean de forma individual
+	parcel, err := uc.orderService.GetParcelByQRData(ctx, req.QRData)
+	if err != nil {
+		return nil, err
+	}
+
+	if parcel != nil {
+		return uc.scanParcel(ctx, claims, roleStatuses, parcel, req)
+	}
+
+	// 3. Obtener el pedido del código escaneado
+	order, err := uc.orderService.GetOrderByQRData(ctx, req.QRData)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = authorizeScannedOrder(claims, order); err != nil {
+		return nil, err
+	}
+
+	// 4. Solo consultar si no se solicitó un cambio de estado
+	status := strings.ToUpper(strings.TrimSpace(req.Status))
+	if status == "" {
+		return response_mapper.ScanToResponseDTO(order, "", scanActions(order.Status, roleStatuses)), nil
+	}
+
+	// 5. Validar que el estado solicitado sea una de las acciones disponibles
+	if !containsStatus(scanActions(order.Status, roleStatuses), status) {
+		logs.Warn("Scan action not allowed", map[string]interface{}{
+			"order_id": order.ID,
+			"role":     claims.Role,
+			"from":     order.Status,
+			"to":       status,
+		})
+		return nil, errPackage.NewDomainErrorWithCause("ScanUseCase", "ScanOrder", "scan action not allowed", errPackage.ErrScanActionNotAllowed)
+	}
+
+	// 6. Aplicar el cambio de estado, las entregas y los intentos fallidos tienen su propio flujo
+	driverID := ""
+	if claims.Role == constants.Driver {
+		driverID = claims.UserID
+	}
+
+	switch status {
+	case constants.OrderStatusDelivered:
+		err = uc.orderService.DeliverOrder(ctx, order.ID, driverID, req.PIN, req.CollectedAmount)
+	case constants.OrderStatusFailed:
+		_, err = uc.orderService.RegisterDeliveryAttempt(ctx, order.ID, driverID, strings.ToUpper(req.ReasonCode), req.Notes)
+	default:
+		err = uc.orderService.ChangeStatus(ctx, order.ID, status)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	logs.Info("Order status changed by scan", map[string]interface{}{
+		"order_id": order.ID,
+		"user_id":  claims.UserID,
+		"role":     claims.Role,
+		"from":     order.Status,
+		"to":       status,
+	})
+
+	// 7. Obtener el pedido actualizado y sus siguientes acciones
+	order, err = uc.orderService.GetOrderByID(ctx, order.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return response_mapper.ScanToResponseDTO(order, status, scanActions(order.Status, roleStatuses)), nil
+}
+
+// scanParcel aplica el escaneo a un solo bulto, el pedido avanza cuando todos sus bultos lo hacen
+func (uc *ScanUseCase) scanParcel(ctx context.Context, claims *auth.AuthClaims, roleStatuses []string, parcel *entities.Parcel, req *dto.ScanRequest) (*dto.ScanResponse, error) {
+	// 1. Obtener el pedido del bulto y verificar el acceso
+	order, err := uc.orderService.GetOrderByID(ctx, parcel.OrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = authorizeScannedOrder(claims, order); err != nil {
+		return nil, err
+	}
+
+	// 2. Solo consultar si no se solicitó un cambio de estado
+	status := strings.ToUpper(strings.TrimSpace(req.Status))
+	if status == "" {
+		return scanParcelResponse(order, parcel.ID, "", roleStatuses), nil
+	}
+
+	// 3. Validar que el estado solicitado sea una de las acciones disponibles para el bulto
+	if !containsStatus(parcelScanActions(order, parcel, roleStatuses), status) {
+		logs.Warn("Parcel scan action not allowed", map[string]interface{}{
+			"parcel_id": parcel.ID,
+			"role":      claims.Role,
+			"from":      parcel.Status,
+			"to":        status,
+		})
+		return nil, errPackage.NewDomainErrorWithCause("ScanUseCase", "scanParcel", "scan action not allowed", errPackage.ErrScanActionNotAllowed)
+	}
+
+	// 4. Cambiar el estado del bulto
+	if err = uc.orderService.ChangeParcelStatus(ctx, parcel, status); err != nil {
+		return nil, err
+	}
+
+	logs.Info("Parcel status changed by scan", map[string]interface{}{
+		"parcel_id": parcel.ID,
+		"order_id":  order.ID,
+		"user_id":   claims.UserID,
+		"from":      parcel.Status,
+		"to":        status,
+	})
+
+	// 5. Obtener el pedido actualizado con el estado derivado de sus bultos
+	order, err = uc.orderService.GetOrderByID(ctx, parcel.OrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanParcelResponse(order, parcel.ID, status, roleStatuses), nil
+}
+
+// authorizeScannedOrder verifica que el pedido no esté eliminado y que un repartidor
+// solo escanee los pedidos que tiene asignados
+func authorizeScannedOrder(claims *auth.AuthClaims, order *entities.Order) error {
+	if order.DeletedAt != nil {
+		return error2.NewGeneralServiceError("ScanUseCase", "authorizeScannedOrder", errPackage.ErrOrderDeleted)
+	}
+
+	if claims.Role == constants.Driver && (order.DriverID == nil || *order.DriverID != claims.UserID) {
+		logs.Warn("Driver scanned an order not assigned to them", map[string]interface{}{
+			"order_id":  order.ID,
+			"driver_id": claims.UserID,
+		})
+		return errPackage.NewDomainErrorWithCause("ScanUseCase", "authorizeScannedOrder", "order is not assigned to the driver", errPackage.ErrOrderNotAssignedToDriver)
+	}
+
+	return nil
+}
+
+func scanParcelResponse(order *entities.Order, parcelID, appliedStatus string, roleStatuses []string) *dto.ScanResponse {
+	for i := range order.Parcels {
+		if order.Parcels[i].ID == parcelID {
+			response := response_mapper.ScanToResponseDTO(order, appliedStatus, parcelScanActions(order, &order.Parcels[i], roleStatuses))
+			response.Parcel = response_mapper.ParcelToResponseDTO(&order.Parcels[i])
+			return response
+		}
+	}
+
+	return response_mapper.ScanToResponseDTO(order, appliedStatus, nil)
+}
+
+// parcelScanActions devuelve las transiciones válidas del bulto que el rol puede aplicar
+func parcelScanActions(order *entities.Order, parcel *entities.Parcel, roleStatuses []string) []string {
+	if !constants.ParcelScanOrderStatuses[order.Status] {
+		return nil
+	}
+
+	var actions []string
+	for _, next := range value_objects.NewParcelStatus(parcel.Status).NextStatuses() {
+		if containsStatus(roleStatuses, next) {
+			actions = append(actions, next)
+		}
+	}
+
+	return actions
+}
+
+// scanActions devuelve las transiciones válidas del estado actual que el rol puede aplicar
+func scanActions(currentStatus string, roleStatuses []string) []string {
+	var actions []string
+	for _, next := range value_objects.NewOrderStatus(currentStatus).NextStatuses() {
+		if containsStatus(roleStatuses, next) {
+			actions = append(actions, next)
+		}
+	}
+
+	return actions
+}
+
+func containsStatus(statuses []string, status string) bool {
+	for _, s := range statuses {
+		if s == status {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,231 @@
+package order
+
+import (
+	"context"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/auth"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+	error2 "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/mappers/request_mapper"
+	"time"
+)
+
+type ScheduleUseCase struct {
+	scheduleService interfaces.OrderScheduler
+	orderService    interfaces.Orderer
+	companyService  interfaces.Companyrer
+}
+
+func NewScheduleUseCase(scheduleService interfaces.OrderScheduler, orderService interfaces.Orderer, companyService interfaces.Companyrer) *ScheduleUseCase {
+	return &ScheduleUseCase{
+		scheduleService: scheduleService,
+		orderService:    orderService,
+		companyService:  companyService,
+	}
+}
+
+// CreateSchedule crea una programación de pedidos para la sucursal del usuario
+func (uc *ScheduleUseCase) CreateSchedule(ctx context.Context, req *dto.ScheduleRequest) (*entities.OrderSchedule, error) {
+	claims, ok := ctx.Value("claims").(*auth.AuthClaims)
+	if !ok {
+		return nil, error2.NewGeneralServiceError("ScheduleUseCase", "CreateSchedule", nil)
+	}
+
+	// 1. Verificar que la dirección de recogida de la plantilla exista
+	if _, err := uc.companyService.GetAddressByID(ctx, req.Order.CompanyPickUpID, claims.UserID); err != nil {
+		return nil, err
+	}
+
+	// 2. Usar el mapper para convertir el dto a entidad
+	schedule, err := request_mapper.ScheduleRequestToSchedule(req)
+	if err != nil {
+		return nil, error2.NewGeneralServiceError("ScheduleUseCase", "CreateSchedule", err)
+	}
+
+	// 3. Obtener el branch y company ID del usuario
+	schedule.CompanyID, schedule.BranchID, err = uc.companyService.GetCompanyAndBranchForUser(ctx, claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+	schedule.CreatedByID = claims.UserID
+
+	// 4. Crear la programación
+	if err = uc.scheduleService.CreateSchedule(ctx, schedule); err != nil {
+		return nil, err
+	}
+
+	return schedule, nil
+}
+
+// UpdateSchedule reemplaza la recurrencia y la plantilla de una programación
+func (uc *ScheduleUseCase) UpdateSchedule(ctx context.Context, id string, req *dto.ScheduleRequest) (*entities.OrderSchedule, error) {
+	// 1. Verificar el acceso a la programación
+	existing, err := uc.GetScheduleByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Verificar que la dirección de recogida de la plantilla exista para quien creó la programación, que es
+	// con quien se materializan sus pedidos
+	if _, err = uc.companyService.GetAddressByID(ctx, req.Order.CompanyPickUpID, existing.CreatedByID); err != nil {
+		return nil, err
+	}
+
+	// 3. Usar el mapper para convertir el dto a entidad
+	schedule, err := request_mapper.ScheduleRequestToSchedule(req)
+	if err != nil {
+		return nil, error2.NewGeneralServiceError("ScheduleUseCase", "UpdateSchedule", err)
+	}
+	schedule.ID = id
+
+	// 4. Actualizar la programación
+	if err = uc.scheduleService.UpdateSchedule(ctx, schedule); err != nil {
+		return nil, err
+	}
+
+	return uc.scheduleService.GetScheduleByID(ctx, id)
+}
+
+// GetScheduleByID obtiene una programación de la empresa del usuario
+func (uc *ScheduleUseCase) GetScheduleByID(ctx context.Context, id string) (*entities.OrderSchedule, error) {
+	claims, ok := ctx.Value("claims").(*auth.AuthClaims)
+	if !ok {
+		return nil, error2.NewGeneralServiceError("ScheduleUseCase", "GetScheduleByID", nil)
+	}
+
+	schedule, err := uc.scheduleService.GetScheduleByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Role != constants.AdminRole {
+		companyID, _, err := uc.companyService.GetCompanyAndBranchForUser(ctx, claims.UserID)
+		if err != nil {
+			return nil, err
+		}
+
+		if schedule.CompanyID != companyID {
+			return nil, errPackage.NewDomainErrorWithCause("ScheduleUseCase", "GetScheduleByID", "schedule not found", errPackage.ErrScheduleNotFound)
+		}
+	}
+
+	return schedule, nil
+}
+
+// GetSchedules obtiene las programaciones de la empresa del usuario
+func (uc *ScheduleUseCase) GetSchedules(ctx context.Context, status string) ([]entities.OrderSchedule, error) {
+	claims, ok := ctx.Value("claims").(*auth.AuthClaims)
+	if !ok {
+		return nil, error2.NewGeneralServiceError("ScheduleUseCase", "GetSchedules", nil)
+	}
+
+	companyID, _, err := uc.companyService.GetCompanyAndBranchForUser(ctx, claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.scheduleService.GetSchedulesByCompany(ctx, companyID, status)
+}
+
+func (uc *ScheduleUseCase) PauseSchedule(ctx context.Context, id string) error {
+	if _, err := uc.GetScheduleByID(ctx, id); err != nil {
+		return err
+	}
+
+	return uc.scheduleService.PauseSchedule(ctx, id)
+}
+
+func (uc *ScheduleUseCase) ResumeSchedule(ctx context.Context, id string) error {
+	if _, err := uc.GetScheduleByID(ctx, id); err != nil {
+		return err
+	}
+
+	return uc.scheduleService.ResumeSchedule(ctx, id)
+}
+
+func (uc *ScheduleUseCase) CancelSchedule(ctx context.Context, id string) error {
+	if _, err := uc.GetScheduleByID(ctx, id); err != nil {
+		return err
+	}
+
+	return uc.scheduleService.CancelSchedule(ctx, id)
+}
+
+// RunDueSchedules materializa un pedido por cada programación cuya ejecución ya llegó
+func (uc *ScheduleUseCase) RunDueSchedules(ctx context.Context) error {
+	// 1. Obtener las programaciones pendientes
+	schedules, err := uc.scheduleService.GetDueSchedules(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+
+	for i := range schedules {
+		schedule := &schedules[i]
+		runAt := *schedule.NextRunAt
+
+		// 2. Reservar la ejecución para que no se repita en otra instancia
+		claimed, err := uc.scheduleService.ClaimScheduleRun(ctx, schedule)
+		if err != nil || !claimed {
+			continue
+		}
+
+		// 3. Crear el pedido y registrar el resultado
+		orderID, runErr := uc.materializeOrder(ctx, schedule, runAt)
+		if runErr != nil {
+			logs.Error("Failed to materialize scheduled order", map[string]interface{}{
+				"scheduleID": schedule.ID,
+				"runAt":      runAt,
+				"error":      runErr.Error(),
+			})
+		}
+
+		_ = uc.scheduleService.RecordScheduleRun(ctx, schedule.ID, orderID, runErr)
+	}
+
+	return nil
+}
+
+// materializeOrder crea el pedido de una ejecución a través del servicio de pedidos
+func (uc *ScheduleUseCase) materializeOrder(ctx context.Context, schedule *entities.OrderSchedule, runAt time.Time) (*string, error) {
+	// 1. Validar que la sucursal esté abierta a la hora de recogida
+	if err := uc.scheduleService.ValidatePickupWindow(schedule.Branch, runAt); err != nil {
+		return nil, err
+	}
+
+	// 2. Reconstruir la solicitud desde la plantilla
+	reqOrder, err := request_mapper.ScheduleToOrderRequest(schedule, runAt)
+	if err != nil {
+		return nil, error2.NewGeneralServiceError("ScheduleUseCase", "RunDueSchedules", err)
+	}
+
+	// 3. Obtener la dirección de recogida
+	companyAddress, err := uc.companyService.GetAddressByID(ctx, reqOrder.CompanyPickUpID, schedule.CreatedByID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 4. Usar el mapper para convertir el dto a entidad
+	order, err := request_mapper.OrderRequestToOrder(reqOrder, companyAddress)
+	if err != nil {
+		return nil, err
+	}
+	order.CompanyID = schedule.CompanyID
+	order.BranchID = schedule.BranchID
+
+	// 5. Crear el pedido
+	if err = uc.orderService.CreateOrder(ctx, order); err != nil {
+		return nil, err
+	}
+
+	logs.Info("Scheduled order created", map[string]interface{}{
+		"scheduleID": schedule.ID,
+		"orderID":    order.ID,
+		"pickupTime": runAt,
+	})
+
+	return &order.ID, nil
+}
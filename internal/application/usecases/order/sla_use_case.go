@@ -0,0 +1,80 @@
+package order
+
+import (
+	"context"
+	"strings"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/auth"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+	error2 "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/mappers/response_mapper"
+)
+
+type SLAUseCase struct {
+	slaMonitor interfaces.SLAMonitor
+}
+
+func NewSLAUseCase(slaMonitor interfaces.SLAMonitor) *SLAUseCase {
+	return &SLAUseCase{
+		slaMonitor: slaMonitor,
+	}
+}
+
+// GetOrdersSLA obtiene el estado del SLA de los pedidos activos de la empresa del usuario, los administradores
+// pueden indicar la empresa o consultar todas
+func (uc *SLAUseCase) GetOrdersSLA(ctx context.Context, companyID, branchID, slaStatus string) (*dto.OrdersSLAResponse, error) {
+	claims, ok := ctx.Value("claims").(*auth.AuthClaims)
+	if !ok {
+		return nil, error2.NewGeneralServiceError("SLAUseCase", "GetOrdersSLA", nil)
+	}
+
+	// 1. Determinar la empresa a consultar
+	switch claims.Role {
+	case constants.AdminRole:
+	case constants.CompanyUser:
+		companyID = claims.CompanyID
+		if companyID == "" {
+			return nil, error2.NewGeneralServiceError("SLAUseCase", "GetOrdersSLA", errPackage.ErrCompanyIDRequired)
+		}
+	default:
+		logs.Warn("User does not have permissions to view the orders SLA", map[string]interface{}{
+			"user_id": claims.UserID,
+			"role":    claims.Role,
+		})
+		return nil, errPackage.NewDomainError("SLAUseCase", "GetOrdersSLA", "User does not have sufficient permissions")
+	}
+
+	// 2. Validar el estado de SLA solicitado
+	slaStatus = strings.ToUpper(slaStatus)
+	if slaStatus != "" && !constants.ValidSLAStatuses[slaStatus] {
+		return nil, error2.NewGeneralServiceError("SLAUseCase", "GetOrdersSLA", errPackage.ErrInvalidSLAStatus)
+	}
+
+	// 3. Evaluar los pedidos activos
+	evaluations, err := uc.slaMonitor.GetOrdersSLA(ctx, companyID, branchID)
+	if err != nil {
+		return nil, err
+	}
+
+	return response_mapper.OrdersSLAToResponseDTO(companyID, branchID, slaStatus, evaluations), nil
+}
+
+// RunSLAMonitor reevalúa el SLA de los pedidos activos y alerta sobre los que pasan a estar en riesgo o incumplidos
+func (uc *SLAUseCase) RunSLAMonitor(ctx context.Context) error {
+	alerts, err := uc.slaMonitor.MonitorActiveOrders(ctx)
+	if err != nil {
+		return err
+	}
+
+	if alerts > 0 {
+		logs.Info("SLA alerts sent", map[string]interface{}{
+			"alerts": alerts,
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,245 @@
+package order
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/auth"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+	error2 "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/mappers/request_mapper"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/mappers/response_mapper"
+)
+
+type WebhookUseCase struct {
+	webhookService interfaces.WebhookManager
+}
+
+func NewWebhookUseCase(webhookService interfaces.WebhookManager) *WebhookUseCase {
+	return &WebhookUseCase{
+		webhookService: webhookService,
+	}
+}
+
+// CreateWebhook registra un webhook de la empresa, la respuesta incluye el secreto con el que se firman los eventos
+func (uc *WebhookUseCase) CreateWebhook(ctx context.Context, req *dto.CreateWebhookRequest) (*dto.WebhookEndpointResponse, error) {
+	claims, ok := ctx.Value("claims").(*auth.AuthClaims)
+	if !ok {
+		return nil, error2.NewGeneralServiceError("WebhookUseCase", "CreateWebhook", nil)
+	}
+
+	// 1. Determinar la empresa del webhook
+	companyID, err := resolveWebhookCompanyID(claims, req.CompanyID, "CreateWebhook")
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Registrar el webhook
+	endpoint := request_mapper.CreateWebhookRequestToEntity(companyID, claims.UserID, req)
+	if err = uc.webhookService.CreateEndpoint(ctx, endpoint); err != nil {
+		return nil, err
+	}
+
+	return response_mapper.WebhookEndpointToResponseDTO(endpoint, true), nil
+}
+
+// GetWebhooks obtiene los webhooks de la empresa del usuario, los administradores pueden indicar la empresa
+func (uc *WebhookUseCase) GetWebhooks(ctx context.Context, companyID string) ([]dto.WebhookEndpointResponse, error) {
+	claims, ok := ctx.Value("claims").(*auth.AuthClaims)
+	if !ok {
+		return nil, error2.NewGeneralServiceError("WebhookUseCase", "GetWebhooks", nil)
+	}
+
+	companyID, err := resolveWebhookCompanyID(claims, companyID, "GetWebhooks")
+	if err != nil {
+		return nil, err
+	}
+
+	endpoints, err := uc.webhookService.GetEndpoints(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return response_mapper.WebhookEndpointsToResponseDTO(endpoints), nil
+}
+
+func (uc *WebhookUseCase) GetWebhook(ctx context.Context, id string) (*dto.WebhookEndpointResponse, error) {
+	endpoint, err := uc.getAuthorizedEndpoint(ctx, id, "GetWebhook")
+	if err != nil {
+		return nil, err
+	}
+
+	return response_mapper.WebhookEndpointToResponseDTO(endpoint, false), nil
+}
+
+// UpdateWebhook actualiza la dirección, los eventos o el estado de un webhook
+func (uc *WebhookUseCase) UpdateWebhook(ctx context.Context, id string, req *dto.UpdateWebhookRequest) (*dto.WebhookEndpointResponse, error) {
+	// 1. Obtener el webhook verificando el acceso del usuario
+	endpoint, err := uc.getAuthorizedEndpoint(ctx, id, "UpdateWebhook")
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Aplicar los cambios
+	request_mapper.ApplyWebhookUpdateRequest(endpoint, req)
+	if err = uc.webhookService.UpdateEndpoint(ctx, endpoint); err != nil {
+		return nil, err
+	}
+
+	return response_mapper.WebhookEndpointToResponseDTO(endpoint, false), nil
+}
+
+func (uc *WebhookUseCase) DeleteWebhook(ctx context.Context, id string) error {
+	if _, err := uc.getAuthorizedEndpoint(ctx, id, "DeleteWebhook"); err != nil {
+		return err
+	}
+
+	return uc.webhookService.DeleteEndpoint(ctx, id)
+}
+
+// RotateWebhookSecret genera un nuevo secreto para el webhook, la respuesta lo incluye
+func (uc *WebhookUseCase) RotateWebhookSecret(ctx context.Context, id string) (*dto.WebhookEndpointResponse, error) {
+	if _, err := uc.getAuthorizedEndpoint(ctx, id, "RotateWebhookSecret"); err != nil {
+		return nil, err
+	}
+
+	endpoint, err := uc.webhookService.RotateSecret(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return response_mapper.WebhookEndpointToResponseDTO(endpoint, true), nil
+}
+
+// GetWebhookDeliveries obtiene el registro paginado de entregas de un webhook
+func (uc *WebhookUseCase) GetWebhookDeliveries(ctx context.Context, id string, request *http.Request) (*dto.PaginatedResponse, error) {
+	// 1. Verificar el acceso del usuario al webhook
+	if _, err := uc.getAuthorizedEndpoint(ctx, id, "GetWebhookDeliveries"); err != nil {
+		return nil, err
+	}
+
+	// 2. Obtener las entregas
+	params := parseWebhookDeliveryParams(request)
+	deliveries, total, err := uc.webhookService.GetDeliveries(ctx, id, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return response_mapper.MapWebhookDeliveriesToResponse(deliveries, params, total), nil
+}
+
+// RedeliverWebhook vuelve a enviar el evento de una entrega y devuelve la nueva entrega con su resultado
+func (uc *WebhookUseCase) RedeliverWebhook(ctx context.Context, deliveryID string) (*dto.WebhookDeliveryResponse, error) {
+	// 1. Obtener la entrega verificando el acceso del usuario a su webhook
+	delivery, err := uc.webhookService.GetDeliveryByID(ctx, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = uc.getAuthorizedEndpoint(ctx, delivery.EndpointID, "RedeliverWebhook"); err != nil {
+		return nil, errPackage.NewDomainErrorWithCause("WebhookUseCase", "RedeliverWebhook", "webhook delivery not found", errPackage.ErrWebhookDeliveryNotFound)
+	}
+
+	// 2. Reenviar el evento
+	redelivery, err := uc.webhookService.Redeliver(ctx, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+
+	return response_mapper.WebhookDeliveryToResponseDTO(redelivery), nil
+}
+
+// RunWebhookDeliveries envía los eventos pendientes y los reintentos que ya corresponden
+func (uc *WebhookUseCase) RunWebhookDeliveries(ctx context.Context) error {
+	processed, err := uc.webhookService.ProcessDueDeliveries(ctx)
+	if err != nil {
+		return err
+	}
+
+	if processed > 0 {
+		logs.Info("Webhook deliveries processed", map[string]interface{}{
+			"attempts": processed,
+		})
+	}
+
+	return nil
+}
+
+// getAuthorizedEndpoint obtiene el webhook verificando que pertenezca a la empresa del usuario, los administradores
+// pueden acceder a cualquier webhook
+func (uc *WebhookUseCase) getAuthorizedEndpoint(ctx context.Context, id, method string) (*entities.WebhookEndpoint, error) {
+	claims, ok := ctx.Value("claims").(*auth.AuthClaims)
+	if !ok {
+		return nil, error2.NewGeneralServiceError("WebhookUseCase", method, nil)
+	}
+
+	if claims.Role != constants.AdminRole && claims.Role != constants.CompanyUser {
+		return nil, webhookPermissionError(claims, method)
+	}
+
+	endpoint, err := uc.webhookService.GetEndpointByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Role != constants.AdminRole && endpoint.CompanyID != claims.CompanyID {
+		logs.Warn("User does not have access to the webhook endpoint", map[string]interface{}{
+			"user_id":     claims.UserID,
+			"endpoint_id": id,
+		})
+		return nil, errPackage.NewDomainErrorWithCause("WebhookUseCase", method, "webhook endpoint not found", errPackage.ErrWebhookEndpointNotFound)
+	}
+
+	return endpoint, nil
+}
+
+// resolveWebhookCompanyID determina la empresa de los webhooks, solo los administradores pueden indicar otra empresa
+func resolveWebhookCompanyID(claims *auth.AuthClaims, companyID, method string) (string, error) {
+	switch claims.Role {
+	case constants.AdminRole:
+		if companyID == "" {
+			companyID = claims.CompanyID
+		}
+	case constants.CompanyUser:
+		companyID = claims.CompanyID
+	default:
+		return "", webhookPermissionError(claims, method)
+	}
+
+	if companyID == "" {
+		return "", error2.NewGeneralServiceError("WebhookUseCase", method, errPackage.ErrCompanyIDRequired)
+	}
+
+	return companyID, nil
+}
+
+func webhookPermissionError(claims *auth.AuthClaims, method string) error {
+	logs.Warn("User does not have permissions to manage webhooks", map[string]interface{}{
+		"user_id": claims.UserID,
+		"role":    claims.Role,
+	})
+	return errPackage.NewDomainError("WebhookUseCase", method, "User does not have sufficient permissions")
+}
+
+// parseWebhookDeliveryParams extrae la paginación del registro de entregas de la request
+func parseWebhookDeliveryParams(r *http.Request) *entities.PaginationQueryParams {
+	params := &entities.PaginationQueryParams{
+		Page:     1,  // Default
+		PageSize: 10, // Default
+	}
+
+	if page, err := strconv.Atoi(r.URL.Query().Get("page")); err == nil && page > 0 {
+		params.Page = page
+	}
+	if pageSize, err := strconv.Atoi(r.URL.Query().Get("page_size")); err == nil && pageSize > 0 {
+		params.PageSize = pageSize
+	}
+
+	return params
+}
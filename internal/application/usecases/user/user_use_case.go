@@ -6,6 +6,7 @@ import (
 	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
 	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/auth"
 	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
 	"net/http"
 	"strconv"
 	"strings"
@@ -17,14 +18,16 @@ type UsererUseCase struct {
 	rolesService interfaces.Roler
 	compService  interfaces.Companyrer
 	tokenService appPorts.TokenProvider
+	txManager    ports.TransactionManager
 }
 
-func NewUserProfileUseCase(userService interfaces.Userer, rolesService interfaces.Roler, compService interfaces.Companyrer, tokenService appPorts.TokenProvider) appPorts.UserUseCase {
+func NewUserProfileUseCase(userService interfaces.Userer, rolesService interfaces.Roler, compService interfaces.Companyrer, tokenService appPorts.TokenProvider, txManager ports.TransactionManager) appPorts.UserUseCase {
 	return &UsererUseCase{
 		userService:  userService,
 		rolesService: rolesService,
 		compService:  compService,
 		tokenService: tokenService,
+		txManager:    txManager,
 	}
 }
 
@@ -64,21 +67,20 @@ func (uc *UsererUseCase) CreateUser(ctx context.Context, user *entities.User) er
 		user.Roles = nil
 	}
 
-	// 4. Crear el usuario
-	err = uc.userService.CreateUser(ctx, user)
-	if err != nil {
-		return err
-	}
-
-	// 5. Asignar roles al usuario
-	for _, role := range roles {
-		err = uc.userService.AssignRoleToUser(ctx, user.ID, role.ID, claims.UserID)
-		if err != nil {
+	// 4. Crear el usuario y asignarle sus roles en una sola transacción, un rol fallido no deja el usuario creado
+	return uc.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.userService.CreateUser(ctx, user); err != nil {
 			return err
 		}
-	}
 
-	return nil
+		for _, role := range roles {
+			if err := uc.userService.AssignRoleToUser(ctx, user.ID, role.ID, claims.UserID); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
 }
 
 func (uc *UsererUseCase) UpdateUser(ctx context.Context, userID string, user *entities.User) error {
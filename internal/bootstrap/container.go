@@ -19,6 +19,7 @@ type Container struct {
 	useCases     *UseCaseContainer
 	handlers     *HandlerContainer
 	middleware   *MiddlewareContainer
+	workers      *WorkerContainer
 
 	mu sync.RWMutex
 }
@@ -32,7 +33,7 @@ func NewContainer(db *gorm.DB, config *config.EnvConfig) *Container {
 
 // Initialize Inicializa todos los contenedores de la aplicación en orden de dependencia
 // El orden de inicialización es importante para evitar errores de dependencia
-// 1 - Repositories, 2 - Services, 3 - UseCases, 4 - Middleware, 5 - Handlers
+// 1 - Repositories, 2 - Services, 3 - UseCases, 4 - Middleware, 5 - Handlers, 6 - Workers
 // Especificamente en ese orde
 func (c *Container) Initialize() error {
 	c.mu.Lock()
@@ -63,6 +64,11 @@ func (c *Container) Initialize() error {
 		return err
 	}
 
+	c.workers = NewWorkerContainer(c.useCases, c.services)
+	if err := c.workers.Initialize(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -85,3 +91,7 @@ func (c *Container) GetHandlerContainer() *HandlerContainer {
 func (c *Container) GetMiddlewareContainer() *MiddlewareContainer {
 	return c.middleware
 }
+
+func (c *Container) GetWorkerContainer() *WorkerContainer {
+	return c.workers
+}
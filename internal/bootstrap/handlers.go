@@ -6,12 +6,25 @@ type HandlerContainer struct {
 	usesCases *UseCaseContainer
 	services  *ServiceContainer
 
-	authHandler    *handlers.AuthHandler
-	userHandler    *handlers.UserHandler
-	orderHandler   *handlers.OrderHandler
-	roleHandler    *handlers.RoleHandler
-	companyHandler *handlers.CompanyHandler
-	branchHandler  *handlers.BranchHandler
+	authHandler     *handlers.AuthHandler
+	userHandler     *handlers.UserHandler
+	orderHandler    *handlers.OrderHandler
+	roleHandler     *handlers.RoleHandler
+	companyHandler  *handlers.CompanyHandler
+	branchHandler   *handlers.BranchHandler
+	returnHandler   *handlers.ReturnHandler
+	scheduleHandler *handlers.ScheduleHandler
+	importHandler   *handlers.ImportHandler
+	labelHandler    *handlers.LabelHandler
+	scanHandler     *handlers.ScanHandler
+	codHandler      *handlers.CODHandler
+	invoiceHandler  *handlers.InvoiceHandler
+	paymentHandler  *handlers.PaymentHandler
+	earningHandler  *handlers.EarningHandler
+	slaHandler      *handlers.SLAHandler
+	notifHandler    *handlers.NotificationHandler
+	webhookHandler  *handlers.WebhookHandler
+	jobHandler      *handlers.JobHandler
 }
 
 func NewHandlerContainer(userCases *UseCaseContainer, services *ServiceContainer) *HandlerContainer {
@@ -28,6 +41,19 @@ func (c *HandlerContainer) Initialize() error {
 	c.roleHandler = handlers.NewRoleHandler(c.usesCases.GetRoleUseCase())
 	c.companyHandler = handlers.NewCompanyHandler(c.usesCases.GetCompanyUseCase())
 	c.branchHandler = handlers.NewBranchHandler(c.usesCases.GetBranchUseCase())
+	c.returnHandler = handlers.NewReturnHandler(c.usesCases.GetReturnUseCase())
+	c.scheduleHandler = handlers.NewScheduleHandler(c.usesCases.GetScheduleUseCase())
+	c.importHandler = handlers.NewImportHandler(c.usesCases.GetImportUseCase())
+	c.labelHandler = handlers.NewLabelHandler(c.usesCases.GetLabelUseCase())
+	c.scanHandler = handlers.NewScanHandler(c.usesCases.GetScanUseCase())
+	c.codHandler = handlers.NewCODHandler(c.usesCases.GetCODUseCase())
+	c.invoiceHandler = handlers.NewInvoiceHandler(c.usesCases.GetInvoiceUseCase())
+	c.paymentHandler = handlers.NewPaymentHandler(c.usesCases.GetPaymentUseCase())
+	c.earningHandler = handlers.NewEarningHandler(c.usesCases.GetEarningUseCase())
+	c.slaHandler = handlers.NewSLAHandler(c.usesCases.GetSLAUseCase())
+	c.notifHandler = handlers.NewNotificationHandler(c.usesCases.GetNotificationUseCase())
+	c.webhookHandler = handlers.NewWebhookHandler(c.usesCases.GetWebhookUseCase())
+	c.jobHandler = handlers.NewJobHandler(c.usesCases.GetJobUseCase())
 
 	return nil
 }
@@ -55,3 +81,55 @@ func (c *HandlerContainer) GetOrderHandler() *handlers.OrderHandler {
 func (c *HandlerContainer) GetRoleHandler() *handlers.RoleHandler {
 	return c.roleHandler
 }
+
+func (c *HandlerContainer) GetReturnHandler() *handlers.ReturnHandler {
+	return c.returnHandler
+}
+
+func (c *HandlerContainer) GetScheduleHandler() *handlers.ScheduleHandler {
+	return c.scheduleHandler
+}
+
+func (c *HandlerContainer) GetImportHandler() *handlers.ImportHandler {
+	return c.importHandler
+}
+
+func (c *HandlerContainer) GetLabelHandler() *handlers.LabelHandler {
+	return c.labelHandler
+}
+
+func (c *HandlerContainer) GetScanHandler() *handlers.ScanHandler {
+	return c.scanHandler
+}
+
+func (c *HandlerContainer) GetCODHandler() *handlers.CODHandler {
+	return c.codHandler
+}
+
+func (c *HandlerContainer) GetInvoiceHandler() *handlers.InvoiceHandler {
+	return c.invoiceHandler
+}
+
+func (c *HandlerContainer) GetPaymentHandler() *handlers.PaymentHandler {
+	return c.paymentHandler
+}
+
+func (c *HandlerContainer) GetEarningHandler() *handlers.EarningHandler {
+	return c.earningHandler
+}
+
+func (c *HandlerContainer) GetSLAHandler() *handlers.SLAHandler {
+	return c.slaHandler
+}
+
+func (c *HandlerContainer) GetNotificationHandler() *handlers.NotificationHandler {
+	return c.notifHandler
+}
+
+func (c *HandlerContainer) GetWebhookHandler() *handlers.WebhookHandler {
+	return c.webhookHandler
+}
+
+func (c *HandlerContainer) GetJobHandler() *handlers.JobHandler {
+	return c.jobHandler
+}
@@ -1,6 +1,11 @@
 package bootstrap
 
-import "github.com/MarlonG1/delivery-backend/internal/infrastructure/api/middleware"
+import (
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/middleware"
+	"time"
+)
+
+const idempotencyKeyTTL = 24 * time.Hour
 
 type MiddlewareContainer struct {
 	services *ServiceContainer
@@ -9,6 +14,7 @@ type MiddlewareContainer struct {
 	authMiddleware *middleware.AuthMiddleware
 	tokenExtractor *middleware.TokenExtractor
 	corsMiddleware *middleware.CorsMiddleware
+	idempotency    *middleware.IdempotencyMiddleware
 }
 
 func NewMiddlewareContainer(services *ServiceContainer) *MiddlewareContainer {
@@ -26,6 +32,7 @@ func (c *MiddlewareContainer) Initialize() error {
 		nil,
 		nil,
 	)
+	c.idempotency = middleware.NewIdempotencyMiddleware(c.services.GetIdempotencyStore(), idempotencyKeyTTL)
 
 	return nil
 }
@@ -45,3 +52,7 @@ func (c *MiddlewareContainer) GetTokenExtractor() *middleware.TokenExtractor {
 func (c *MiddlewareContainer) GetCorsMiddleware() *middleware.CorsMiddleware {
 	return c.corsMiddleware
 }
+
+func (c *MiddlewareContainer) GetIdempotencyMiddleware() *middleware.IdempotencyMiddleware {
+	return c.idempotency
+}
@@ -9,11 +9,24 @@ import (
 type RepositoryContainer struct {
 	db *gorm.DB
 
-	roleRepo    ports.RolerRepository
-	userRepo    ports.UserRepository
-	orderRepo   ports.OrdererRepository
-	companyRepo ports.CompanyRepository
-	metricsRepo ports.MetricsRepository
+	roleRepo     ports.RolerRepository
+	userRepo     ports.UserRepository
+	orderRepo    ports.OrdererRepository
+	companyRepo  ports.CompanyRepository
+	metricsRepo  ports.MetricsRepository
+	returnRepo   ports.ReturnRepository
+	scheduleRepo ports.ScheduleRepository
+	importRepo   ports.ImportRepository
+	cashRepo     ports.CashRepository
+	invoiceRepo  ports.InvoiceRepository
+	paymentRepo  ports.PaymentRepository
+	earningRepo  ports.EarningRepository
+	slaRepo      ports.SLARepository
+	notifRepo    ports.NotificationRepository
+	webhookRepo  ports.WebhookRepository
+	outboxRepo   ports.OutboxRepository
+	jobRepo      ports.JobRepository
+	txManager    ports.TransactionManager
 }
 
 func NewRepositoryContainer(db *gorm.DB) *RepositoryContainer {
@@ -28,6 +41,19 @@ func (c *RepositoryContainer) Initialize() error {
 	c.orderRepo = repositories.NewOrderRepository(c.db)
 	c.companyRepo = repositories.NewCompanyRepository(c.db)
 	c.metricsRepo = repositories.NewMetricsRepository(c.db)
+	c.returnRepo = repositories.NewReturnRepository(c.db)
+	c.scheduleRepo = repositories.NewScheduleRepository(c.db)
+	c.importRepo = repositories.NewImportRepository(c.db)
+	c.cashRepo = repositories.NewCashRepository(c.db)
+	c.invoiceRepo = repositories.NewInvoiceRepository(c.db)
+	c.paymentRepo = repositories.NewPaymentRepository(c.db)
+	c.earningRepo = repositories.NewEarningRepository(c.db)
+	c.slaRepo = repositories.NewSLARepository(c.db)
+	c.notifRepo = repositories.NewNotificationRepository(c.db)
+	c.webhookRepo = repositories.NewWebhookRepository(c.db)
+	c.outboxRepo = repositories.NewOutboxRepository(c.db)
+	c.jobRepo = repositories.NewJobRepository(c.db)
+	c.txManager = repositories.NewTransactionManager(c.db)
 
 	return nil
 }
@@ -51,3 +77,55 @@ func (c *RepositoryContainer) GetOrderRepository() ports.OrdererRepository {
 func (c *RepositoryContainer) GetCompanyRepository() ports.CompanyRepository {
 	return c.companyRepo
 }
+
+func (c *RepositoryContainer) GetReturnRepository() ports.ReturnRepository {
+	return c.returnRepo
+}
+
+func (c *RepositoryContainer) GetScheduleRepository() ports.ScheduleRepository {
+	return c.scheduleRepo
+}
+
+func (c *RepositoryContainer) GetImportRepository() ports.ImportRepository {
+	return c.importRepo
+}
+
+func (c *RepositoryContainer) GetCashRepository() ports.CashRepository {
+	return c.cashRepo
+}
+
+func (c *RepositoryContainer) GetInvoiceRepository() ports.InvoiceRepository {
+	return c.invoiceRepo
+}
+
+func (c *RepositoryContainer) GetPaymentRepository() ports.PaymentRepository {
+	return c.paymentRepo
+}
+
+func (c *RepositoryContainer) GetEarningRepository() ports.EarningRepository {
+	return c.earningRepo
+}
+
+func (c *RepositoryContainer) GetSLARepository() ports.SLARepository {
+	return c.slaRepo
+}
+
+func (c *RepositoryContainer) GetNotificationRepository() ports.NotificationRepository {
+	return c.notifRepo
+}
+
+func (c *RepositoryContainer) GetWebhookRepository() ports.WebhookRepository {
+	return c.webhookRepo
+}
+
+func (c *RepositoryContainer) GetOutboxRepository() ports.OutboxRepository {
+	return c.outboxRepo
+}
+
+func (c *RepositoryContainer) GetTransactionManager() ports.TransactionManager {
+	return c.txManager
+}
+
+func (c *RepositoryContainer) GetJobRepository() ports.JobRepository {
+	return c.jobRepo
+}
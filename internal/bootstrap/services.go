@@ -1,27 +1,60 @@
 package bootstrap
 
 import (
+	"fmt"
+	"os"
+
 	"github.com/MarlonG1/delivery-backend/configs"
 	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
 	domainPorts "github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	repoPorts "github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
 	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/services"
 	"github.com/MarlonG1/delivery-backend/internal/infrastructure/adapters/auth"
 	"github.com/MarlonG1/delivery-backend/internal/infrastructure/adapters/cache"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/adapters/eventbus"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/adapters/notification"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/adapters/payment"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/adapters/realtime"
 	"github.com/MarlonG1/delivery-backend/internal/infrastructure/adapters/token"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/adapters/webhook"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
 )
 
 type ServiceContainer struct {
 	repositories *RepositoryContainer
 	config       *config.EnvConfig
 
-	jwtService     ports.TokenProvider
-	cacheService   ports.Cacher
-	authService    ports.Authenticator
-	userService    domainPorts.Userer
-	orderService   domainPorts.Orderer
-	companyService domainPorts.Companyrer
-	metricsService domainPorts.MetricsService
-	roleService    domainPorts.Roler
+	jwtService      ports.TokenProvider
+	cacheService    ports.Cacher
+	idempotency     ports.IdempotencyStore
+	authService     ports.Authenticator
+	userService     domainPorts.Userer
+	trackingService domainPorts.TrackingNumberGenerator
+	orderService    domainPorts.Orderer
+	companyService  domainPorts.Companyrer
+	metricsService  domainPorts.MetricsService
+	roleService     domainPorts.Roler
+	returnService   domainPorts.Returner
+	scheduleService domainPorts.OrderScheduler
+	importService   domainPorts.OrderImporter
+	cashService     domainPorts.CashLedger
+	invoiceService  domainPorts.Invoicer
+	paymentService  domainPorts.PaymentProcessor
+	earningService  domainPorts.DriverEarner
+	slaService      domainPorts.SLAMonitor
+	notifier        domainPorts.Notifier
+	orderNotifier   domainPorts.OrderNotifier
+	inboxService    domainPorts.NotificationInbox
+	inboxHub        *realtime.InboxHub
+	notifPrefs      domainPorts.NotificationPreferencer
+	webhookService  domainPorts.WebhookManager
+	outboxRelay     domainPorts.OutboxRelayer
+	eventBus        domainPorts.EventBus
+	eventConsumers  []domainPorts.EventSubscriber
+	jobRecorder     domainPorts.JobRecorder
+	jobLocker       ports.JobLocker
+	maintainer      domainPorts.Maintainer
 }
 
 func NewServiceContainer(repositories *RepositoryContainer, config *config.EnvConfig) *ServiceContainer {
@@ -39,13 +72,65 @@ func (c *ServiceContainer) Initialize() error {
 		return err
 	}
 
+	c.idempotency = cache.NewRedisIdempotencyStore(c.cacheService.GetRedisClient())
 	c.jwtService = token.NewJWTService(c.config.Server.JWTSecret, c.cacheService)
 	c.authService = auth.NewAuthService(c.repositories.GetUserRepository(), c.jwtService)
 	c.userService = services.NewUserService(c.repositories.GetUserRepository())
-	c.orderService = services.NewOrderService(c.repositories.GetOrderRepository())
+	c.trackingService = services.NewTrackingNumberService(c.repositories.GetCompanyRepository())
+	c.paymentService = services.NewPaymentService(c.repositories.GetPaymentRepository(), payment.NewFakePaymentGateway(c.config.Payment.WebhookSecret))
+	c.earningService = services.NewEarningService(c.repositories.GetEarningRepository(), c.repositories.GetCompanyRepository())
+	c.inboxHub = realtime.NewInboxHub()
+	c.notifier = services.NewNotificationService(c.repositories.GetNotificationRepository(), notification.NewLogEmailSender(), notification.NewLogSMSSender(), notification.NewLogPushSender(), c.inboxHub)
+	c.inboxService = services.NewNotificationInboxService(c.repositories.GetNotificationRepository(), c.inboxHub)
+	c.notifPrefs = services.NewNotificationPreferenceService(c.repositories.GetNotificationRepository())
+	c.webhookService = services.NewWebhookService(c.repositories.GetWebhookRepository(), webhook.NewHTTPWebhookSender(), !c.config.Server.Debug)
+	c.orderNotifier = services.NewOrderNotificationService(c.repositories.GetNotificationRepository(), c.notifier, c.config.Notification.TrackingURL)
+	c.slaService = services.NewSLAService(c.repositories.GetSLARepository(), c.orderNotifier)
+	c.orderService = services.NewOrderService(c.repositories.GetOrderRepository(), notification.NewRecipientNotifier(c.notifier), c.trackingService, c.paymentService, c.earningService, c.repositories.GetTransactionManager())
 	c.metricsService = services.NewCompanyMetricsService(c.repositories.GetCompanyRepository(), c.repositories.GetMetricsRepository())
-	c.companyService = services.NewCompanyService(c.repositories.GetCompanyRepository(), c.metricsService)
+	c.companyService = services.NewCompanyService(c.repositories.GetCompanyRepository(), c.metricsService, c.repositories.GetTransactionManager())
 	c.roleService = services.NewRoleService(c.repositories.GetRoleRepository())
+	c.returnService = services.NewReturnService(c.repositories.GetReturnRepository(), c.repositories.GetOrderRepository(), c.trackingService)
+	c.scheduleService = services.NewScheduleService(c.repositories.GetScheduleRepository(), c.repositories.GetCompanyRepository())
+	c.importService = services.NewImportService(c.repositories.GetImportRepository())
+	c.cashService = services.NewCashService(c.repositories.GetCashRepository(), c.repositories.GetTransactionManager())
+	c.invoiceService = services.NewInvoiceService(c.repositories.GetInvoiceRepository(), c.repositories.GetCompanyRepository(), c.repositories.GetTransactionManager())
+
+	// Bus de eventos donde el outbox publica los eventos de dominio para los grupos de consumidores, el bus en
+	// memoria no se comparte entre procesos y se rechaza si los procesos en segundo plano corren aparte de la API
+	switch c.config.EventBus.Driver {
+	case constants.EventBusDriverRedis, "":
+		c.eventBus = eventbus.NewRedisEventBus(c.cacheService.GetRedisClient(), constants.EventBusAckTimeout)
+	case constants.EventBusDriverMemory:
+		if c.config.Server.DisableWorkers {
+			return errPackage.NewGeneralServiceError("ServiceContainer", "Initialize", errPackage.ErrMemoryEventBusShared)
+		}
+		c.eventBus = eventbus.NewMemoryEventBus(constants.EventBusAckTimeout)
+	default:
+		return errPackage.NewGeneralServiceError("ServiceContainer", "Initialize", errPackage.ErrUnknownEventBusDriver)
+	}
+
+	c.outboxRelay = services.NewOutboxRelayService(c.repositories.GetOutboxRepository())
+	c.outboxRelay.Subscribe(eventbus.NewOutboxPublisher(c.eventBus))
+
+	// Grupos de consumidores que procesan los eventos del bus en segundo plano, el despacho, las notificaciones,
+	// los webhooks y las métricas de los pedidos se procesan aquí y no en la solicitud que generó el evento
+	c.eventConsumers = []domainPorts.EventSubscriber{
+		auth.NewSessionRevocationSubscriber(c.repositories.GetUserRepository(), c.jwtService),
+		services.NewDriverDispatchSubscriber(c.repositories.GetOrderRepository(), c.orderNotifier),
+		services.NewOrderNotificationSubscriber(c.repositories.GetOrderRepository(), c.orderNotifier),
+		services.NewWebhookEventSubscriber(c.repositories.GetOrderRepository(), c.webhookService, c.config.Notification.TrackingURL),
+		services.NewCompanyMetricsSubscriber(c.metricsService),
+	}
+
+	// Trabajos programados, cada ejecución queda registrada con la instancia que la tomó
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "delivery-backend"
+	}
+	c.jobRecorder = services.NewJobService(c.repositories.GetJobRepository(), fmt.Sprintf("%s-%d", hostname, os.Getpid()))
+	c.jobLocker = cache.NewRedisJobLocker(c.cacheService.GetRedisClient())
+	c.maintainer = services.NewMaintenanceService(c.repositories.GetUserRepository(), c.repositories.GetOrderRepository(), c.repositories.GetWebhookRepository())
 
 	return nil
 }
@@ -81,3 +166,95 @@ func (c *ServiceContainer) GetCompanyService() domainPorts.Companyrer {
 func (c *ServiceContainer) GetRoleService() domainPorts.Roler {
 	return c.roleService
 }
+
+func (c *ServiceContainer) GetReturnService() domainPorts.Returner {
+	return c.returnService
+}
+
+func (c *ServiceContainer) GetScheduleService() domainPorts.OrderScheduler {
+	return c.scheduleService
+}
+
+func (c *ServiceContainer) GetImportService() domainPorts.OrderImporter {
+	return c.importService
+}
+
+func (c *ServiceContainer) GetIdempotencyStore() ports.IdempotencyStore {
+	return c.idempotency
+}
+
+func (c *ServiceContainer) GetTrackingNumberService() domainPorts.TrackingNumberGenerator {
+	return c.trackingService
+}
+
+func (c *ServiceContainer) GetCashService() domainPorts.CashLedger {
+	return c.cashService
+}
+
+func (c *ServiceContainer) GetInvoiceService() domainPorts.Invoicer {
+	return c.invoiceService
+}
+
+func (c *ServiceContainer) GetPaymentService() domainPorts.PaymentProcessor {
+	return c.paymentService
+}
+
+func (c *ServiceContainer) GetEarningService() domainPorts.DriverEarner {
+	return c.earningService
+}
+
+func (c *ServiceContainer) GetSLAService() domainPorts.SLAMonitor {
+	return c.slaService
+}
+
+func (c *ServiceContainer) GetNotifier() domainPorts.Notifier {
+	return c.notifier
+}
+
+func (c *ServiceContainer) GetOrderNotifier() domainPorts.OrderNotifier {
+	return c.orderNotifier
+}
+
+func (c *ServiceContainer) GetNotificationInbox() domainPorts.NotificationInbox {
+	return c.inboxService
+}
+
+func (c *ServiceContainer) GetInboxHub() *realtime.InboxHub {
+	return c.inboxHub
+}
+
+func (c *ServiceContainer) GetNotificationPreferencer() domainPorts.NotificationPreferencer {
+	return c.notifPrefs
+}
+
+func (c *ServiceContainer) GetWebhookService() domainPorts.WebhookManager {
+	return c.webhookService
+}
+
+func (c *ServiceContainer) GetOutboxRelay() domainPorts.OutboxRelayer {
+	return c.outboxRelay
+}
+
+func (c *ServiceContainer) GetTransactionManager() repoPorts.TransactionManager {
+	return c.repositories.GetTransactionManager()
+}
+
+func (c *ServiceContainer) GetEventBus() domainPorts.EventBus {
+	return c.eventBus
+}
+
+func (c *ServiceContainer) GetEventConsumers() []domainPorts.EventSubscriber {
+	return c.eventConsumers
+}
+
+func (c *ServiceContainer) GetJobRecorder() domainPorts.JobRecorder {
+	return c.jobRecorder
+}
+
+func (c *ServiceContainer) GetJobLocker() ports.JobLocker {
+	return c.jobLocker
+}
+
+func (c *ServiceContainer) GetMaintainer() domainPorts.Maintainer {
+	return c.maintainer
+}
@@ -4,20 +4,40 @@ import (
 	"github.com/MarlonG1/delivery-backend/internal/application/ports"
 	"github.com/MarlonG1/delivery-backend/internal/application/usecases/auth"
 	"github.com/MarlonG1/delivery-backend/internal/application/usecases/company"
+	"github.com/MarlonG1/delivery-backend/internal/application/usecases/events"
+	"github.com/MarlonG1/delivery-backend/internal/application/usecases/jobs"
 	"github.com/MarlonG1/delivery-backend/internal/application/usecases/order"
 	"github.com/MarlonG1/delivery-backend/internal/application/usecases/role"
 	"github.com/MarlonG1/delivery-backend/internal/application/usecases/user"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/adapters/invoice"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/adapters/label"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/adapters/payout"
 )
 
 type UseCaseContainer struct {
 	services *ServiceContainer
 
-	authUseCase    ports.AuthenticatorUseCase
-	userUseCase    ports.UserUseCase
-	orderUseCase   ports.OrdererUseCase
-	roleUseCase    ports.RolerUseCase
-	companyUseCase ports.CompanyUseCase
-	branchUseCase  ports.BranchUseCase
+	authUseCase     ports.AuthenticatorUseCase
+	userUseCase     ports.UserUseCase
+	orderUseCase    ports.OrdererUseCase
+	roleUseCase     ports.RolerUseCase
+	companyUseCase  ports.CompanyUseCase
+	branchUseCase   ports.BranchUseCase
+	returnUseCase   ports.ReturnUseCase
+	scheduleUseCase ports.ScheduleUseCase
+	importUseCase   ports.ImportUseCase
+	labelUseCase    ports.LabelUseCase
+	scanUseCase     ports.ScanUseCase
+	codUseCase      ports.CODUseCase
+	invoiceUseCase  ports.InvoiceUseCase
+	paymentUseCase  ports.PaymentUseCase
+	earningUseCase  ports.EarningUseCase
+	slaUseCase      ports.SLAUseCase
+	notifUseCase    ports.NotificationUseCase
+	webhookUseCase  ports.WebhookUseCase
+	outboxUseCase   ports.OutboxUseCase
+	eventBusUseCase ports.EventBusUseCase
+	jobUseCase      ports.JobUseCase
 }
 
 func NewUseCaseContainer(services *ServiceContainer) *UseCaseContainer {
@@ -32,11 +52,27 @@ func (c *UseCaseContainer) Initialize() error {
 		c.services.GetRoleService(),
 		c.services.GetCompanyService(),
 		c.services.GetTokenService(),
+		c.services.GetTransactionManager(),
 	)
 	c.orderUseCase = order.NewOrderUseCase(c.services.GetOrderService(), c.services.GetCompanyService())
 	c.roleUseCase = role.NewRolerUseCase(c.services.GetRoleService())
 	c.companyUseCase = company.NewCompanyUseCase(c.services.GetCompanyService())
 	c.branchUseCase = company.NewBranchUseCase(c.services.GetCompanyService())
+	c.returnUseCase = order.NewReturnUseCase(c.services.GetReturnService(), c.services.GetOrderService())
+	c.scheduleUseCase = order.NewScheduleUseCase(c.services.GetScheduleService(), c.services.GetOrderService(), c.services.GetCompanyService())
+	c.importUseCase = order.NewImportUseCase(c.services.GetImportService(), c.services.GetOrderService(), c.services.GetCompanyService())
+	c.labelUseCase = order.NewLabelUseCase(c.services.GetOrderService(), label.NewPDFLabelRenderer(), label.NewZPLLabelRenderer())
+	c.scanUseCase = order.NewScanUseCase(c.services.GetOrderService())
+	c.codUseCase = order.NewCODUseCase(c.services.GetCashService())
+	c.invoiceUseCase = order.NewInvoiceUseCase(c.services.GetInvoiceService(), invoice.NewPDFInvoiceRenderer())
+	c.paymentUseCase = order.NewPaymentUseCase(c.services.GetPaymentService())
+	c.earningUseCase = order.NewEarningUseCase(c.services.GetEarningService(), payout.NewPDFStatementRenderer())
+	c.slaUseCase = order.NewSLAUseCase(c.services.GetSLAService())
+	c.notifUseCase = order.NewNotificationUseCase(c.services.GetOrderNotifier(), c.services.GetNotificationInbox(), c.services.GetInboxHub(), c.services.GetNotificationPreferencer())
+	c.webhookUseCase = order.NewWebhookUseCase(c.services.GetWebhookService())
+	c.outboxUseCase = events.NewOutboxUseCase(c.services.GetOutboxRelay())
+	c.eventBusUseCase = events.NewEventBusUseCase(c.services.GetEventBus(), c.services.GetEventConsumers()...)
+	c.jobUseCase = jobs.NewJobUseCase(c.services.GetJobRecorder(), c.services.GetJobLocker(), c.services.GetMaintainer(), c.services.GetMetricsService(), c.services.GetOrderService(), c.services.GetPaymentService())
 
 	return nil
 }
@@ -64,3 +100,63 @@ func (c *UseCaseContainer) GetOrderUseCase() ports.OrdererUseCase {
 func (c *UseCaseContainer) GetRoleUseCase() ports.RolerUseCase {
 	return c.roleUseCase
 }
+
+func (c *UseCaseContainer) GetReturnUseCase() ports.ReturnUseCase {
+	return c.returnUseCase
+}
+
+func (c *UseCaseContainer) GetScheduleUseCase() ports.ScheduleUseCase {
+	return c.scheduleUseCase
+}
+
+func (c *UseCaseContainer) GetImportUseCase() ports.ImportUseCase {
+	return c.importUseCase
+}
+
+func (c *UseCaseContainer) GetLabelUseCase() ports.LabelUseCase {
+	return c.labelUseCase
+}
+
+func (c *UseCaseContainer) GetScanUseCase() ports.ScanUseCase {
+	return c.scanUseCase
+}
+
+func (c *UseCaseContainer) GetCODUseCase() ports.CODUseCase {
+	return c.codUseCase
+}
+
+func (c *UseCaseContainer) GetInvoiceUseCase() ports.InvoiceUseCase {
+	return c.invoiceUseCase
+}
+
+func (c *UseCaseContainer) GetPaymentUseCase() ports.PaymentUseCase {
+	return c.paymentUseCase
+}
+
+func (c *UseCaseContainer) GetEarningUseCase() ports.EarningUseCase {
+	return c.earningUseCase
+}
+
+func (c *UseCaseContainer) GetSLAUseCase() ports.SLAUseCase {
+	return c.slaUseCase
+}
+
+func (c *UseCaseContainer) GetNotificationUseCase() ports.NotificationUseCase {
+	return c.notifUseCase
+}
+
+func (c *UseCaseContainer) GetWebhookUseCase() ports.WebhookUseCase {
+	return c.webhookUseCase
+}
+
+func (c *UseCaseContainer) GetOutboxUseCase() ports.OutboxUseCase {
+	return c.outboxUseCase
+}
+
+func (c *UseCaseContainer) GetEventBusUseCase() ports.EventBusUseCase {
+	return c.eventBusUseCase
+}
+
+func (c *UseCaseContainer) GetJobUseCase() ports.JobUseCase {
+	return c.jobUseCase
+}
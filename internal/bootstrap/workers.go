@@ -0,0 +1,83 @@
+package bootstrap
+
+import (
+	"context"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/workers"
+	"time"
+)
+
+const (
+	scheduleRunnerInterval = time.Minute
+	importRunnerInterval   = 10 * time.Second
+	billingRunnerInterval  = time.Hour
+	payoutRunnerInterval   = time.Hour
+	slaRunnerInterval      = time.Minute
+	webhookRunnerInterval  = 10 * time.Second
+	outboxRunnerInterval   = 5 * time.Second
+	eventBusRunnerInterval = 2 * time.Second
+)
+
+type WorkerContainer struct {
+	useCases *UseCaseContainer
+	services *ServiceContainer
+
+	scheduleRunner *workers.ScheduleRunner
+	importRunner   *workers.ImportRunner
+	billingRunner  *workers.BillingRunner
+	payoutRunner   *workers.PayoutRunner
+	slaRunner      *workers.SLARunner
+	webhookRunner  *workers.WebhookRunner
+	outboxRunner   *workers.OutboxRunner
+	eventBusRunner *workers.EventBusRunner
+	jobScheduler   *workers.JobScheduler
+}
+
+func NewWorkerContainer(useCases *UseCaseContainer, services *ServiceContainer) *WorkerContainer {
+	return &WorkerContainer{
+		useCases: useCases,
+		services: services,
+	}
+}
+
+// Initialize crea los runners, cada ciclo toma el bloqueo de trabajos para que solo una instancia lo ejecute salvo
+// el del bus de eventos, cuyos grupos de consumidores ya reparten los mensajes entre las instancias
+func (c *WorkerContainer) Initialize() error {
+	locker := c.services.GetJobLocker()
+	c.scheduleRunner = workers.NewScheduleRunner(c.useCases.GetScheduleUseCase(), locker, scheduleRunnerInterval)
+	c.importRunner = workers.NewImportRunner(c.useCases.GetImportUseCase(), locker, importRunnerInterval)
+	c.billingRunner = workers.NewBillingRunner(c.useCases.GetInvoiceUseCase(), locker, billingRunnerInterval)
+	c.payoutRunner = workers.NewPayoutRunner(c.useCases.GetEarningUseCase(), locker, payoutRunnerInterval)
+	c.slaRunner = workers.NewSLARunner(c.useCases.GetSLAUseCase(), locker, slaRunnerInterval)
+	c.webhookRunner = workers.NewWebhookRunner(c.useCases.GetWebhookUseCase(), locker, webhookRunnerInterval)
+	c.outboxRunner = workers.NewOutboxRunner(c.useCases.GetOutboxUseCase(), locker, outboxRunnerInterval)
+	c.eventBusRunner = workers.NewEventBusRunner(c.useCases.GetEventBusUseCase(), eventBusRunnerInterval)
+	c.jobScheduler = workers.NewJobScheduler(c.useCases.GetJobUseCase())
+
+	return nil
+}
+
+// Start inicia todos los procesos en segundo plano
+func (c *WorkerContainer) Start(ctx context.Context) {
+	c.scheduleRunner.Start(ctx)
+	c.importRunner.Start(ctx)
+	c.billingRunner.Start(ctx)
+	c.payoutRunner.Start(ctx)
+	c.slaRunner.Start(ctx)
+	c.webhookRunner.Start(ctx)
+	c.outboxRunner.Start(ctx)
+	c.eventBusRunner.Start(ctx)
+	c.jobScheduler.Start(ctx)
+}
+
+// Stop detiene todos los procesos en segundo plano
+func (c *WorkerContainer) Stop() {
+	c.scheduleRunner.Stop()
+	c.importRunner.Stop()
+	c.billingRunner.Stop()
+	c.payoutRunner.Stop()
+	c.slaRunner.Stop()
+	c.webhookRunner.Stop()
+	c.outboxRunner.Stop()
+	c.eventBusRunner.Stop()
+	c.jobScheduler.Stop()
+}
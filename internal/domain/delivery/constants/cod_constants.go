@@ -0,0 +1,14 @@
+package constants
+
+// Tipos de movimiento del libro de efectivo de los repartidores
+var (
+	CashEntryCollection = "COLLECTION"
+	CashEntryHandIn     = "HAND_IN"
+)
+
+// Resultado de la conciliación del efectivo entregado por un repartidor
+var (
+	ReconciliationBalanced = "BALANCED"
+	ReconciliationShort    = "SHORT"
+	ReconciliationOver     = "OVER"
+)
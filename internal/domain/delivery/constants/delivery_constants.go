@@ -0,0 +1,48 @@
+package constants
+
+import "time"
+
+var (
+	// MaxDeliveryPINAttempts cantidad de intentos fallidos permitidos antes de bloquear el PIN de entrega
+	MaxDeliveryPINAttempts = 5
+
+	// DefaultMaxDeliveryAttempts intentos de entrega permitidos cuando la empresa no define un límite
+	DefaultMaxDeliveryAttempts = 3
+
+	// MaxAllowedDeliveryAttempts límite superior configurable de intentos de entrega por empresa
+	MaxAllowedDeliveryAttempts = 10
+
+	OrderFlagDeliveryPINLocked = "DELIVERY_PIN_LOCKED"
+
+	// Prefijos de los números de seguimiento, las empresas pueden configurar el suyo para los pedidos
+	TrackingPrefixOrder  = "DEL"
+	TrackingPrefixReturn = "RET"
+
+	// MaxTrackingNumberRetries intentos de guardado con un nuevo número de seguimiento si este ya existe
+	MaxTrackingNumberRetries = 3
+
+	// RedeliveryDeadlineWindow plazo para completar una entrega reprogramada desde el inicio de su ventana
+	RedeliveryDeadlineWindow = 8 * time.Hour
+
+	// RedeliveryBatchSize máximo de pedidos que se vuelven a poner en camino en cada ejecución
+	RedeliveryBatchSize = 200
+)
+
+// Códigos de motivo para un intento de entrega fallido
+var (
+	DeliveryFailureRecipientAbsent  = "RECIPIENT_ABSENT"
+	DeliveryFailureWrongAddress     = "WRONG_ADDRESS"
+	DeliveryFailureRecipientRefused = "RECIPIENT_REFUSED"
+	DeliveryFailureAccessDenied     = "ACCESS_DENIED"
+	DeliveryFailureUnsafeLocation   = "UNSAFE_LOCATION"
+	DeliveryFailureOther            = "OTHER"
+)
+
+var ValidDeliveryFailureReasons = map[string]bool{
+	DeliveryFailureRecipientAbsent:  true,
+	DeliveryFailureWrongAddress:     true,
+	DeliveryFailureRecipientRefused: true,
+	DeliveryFailureAccessDenied:     true,
+	DeliveryFailureUnsafeLocation:   true,
+	DeliveryFailureOther:            true,
+}
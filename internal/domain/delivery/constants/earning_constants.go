@@ -0,0 +1,24 @@
+package constants
+
+// Tipos de movimiento del libro de ganancias de un repartidor
+var (
+	EarningTypeDelivery   = "DELIVERY"
+	EarningTypeAdjustment = "ADJUSTMENT"
+)
+
+var (
+	// EarningsCurrency moneda en la que se calculan y pagan las ganancias de los repartidores
+	EarningsCurrency = "USD"
+
+	// PayoutStatementNumberPrefix prefijo de los números de estado de pago
+	PayoutStatementNumberPrefix = "PAY"
+
+	// Tarifas aplicadas cuando no existe una regla de ganancias configurada
+	DefaultBasePerDelivery = 2.50
+	DefaultPerKmRate       = 0.35
+	DefaultUrgentBonus     = 1.50
+	DefaultZoneMultiplier  = 1.0
+
+	// MaxZoneMultiplier multiplicador máximo permitido para una zona
+	MaxZoneMultiplier = 5.0
+)
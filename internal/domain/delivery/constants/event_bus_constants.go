@@ -0,0 +1,24 @@
+package constants
+
+import "time"
+
+// Adaptadores disponibles para el bus de eventos, se elige con EVENT_BUS_DRIVER
+var (
+	EventBusDriverMemory = "memory"
+	EventBusDriverRedis  = "redis"
+)
+
+const (
+	// EventBusStream stream donde el outbox publica los eventos de dominio
+	EventBusStream = "events:system"
+	// EventBusDeadLetterStream prefijo del stream de mensajes descartados de cada grupo de consumidores
+	EventBusDeadLetterStream = "events:dead_letter:"
+	// EventBusMaxLen máximo aproximado de mensajes que se conservan en el stream
+	EventBusMaxLen = 10000
+	// EventBusBatchSize máximo de mensajes que cada grupo procesa por ejecución
+	EventBusBatchSize = 50
+	// EventBusAckTimeout tiempo tras el cual un mensaje sin confirmar se vuelve a entregar
+	EventBusAckTimeout = time.Minute
+	// EventBusMaxDeliveries entregas fallidas tras las cuales el mensaje pasa al dead letter de su grupo
+	EventBusMaxDeliveries = 5
+)
@@ -0,0 +1,32 @@
+package constants
+
+// Estados de un trabajo de importación masiva de pedidos
+var (
+	ImportStatusPending    = "PENDING"
+	ImportStatusProcessing = "PROCESSING"
+	ImportStatusCompleted  = "COMPLETED"
+	ImportStatusFailed     = "FAILED"
+)
+
+// Formatos aceptados para la importación masiva
+var (
+	ImportFormatCSV  = "CSV"
+	ImportFormatJSON = "JSON"
+)
+
+// Resultado de cada fila dentro del reporte de importación
+var (
+	ImportRowCreated = "CREATED"
+	ImportRowFailed  = "FAILED"
+)
+
+var (
+	// MaxImportRows cantidad máxima de pedidos por archivo de importación
+	MaxImportRows = 1000
+
+	// MaxImportFileSize tamaño máximo del archivo de importación en bytes
+	MaxImportFileSize int64 = 5 << 20
+
+	// MaxPendingImportsPerRun cantidad máxima de importaciones procesadas en cada ejecución
+	MaxPendingImportsPerRun = 5
+)
@@ -0,0 +1,51 @@
+package constants
+
+// Estados de una factura
+var (
+	InvoiceStatusDraft  = "DRAFT"
+	InvoiceStatusIssued = "ISSUED"
+	InvoiceStatusPaid   = "PAID"
+	InvoiceStatusVoid   = "VOID"
+)
+
+var ValidInvoiceStatuses = []string{
+	InvoiceStatusDraft,
+	InvoiceStatusIssued,
+	InvoiceStatusPaid,
+	InvoiceStatusVoid,
+}
+
+// Tipos de línea de una factura
+var (
+	InvoiceLineDelivery  = "DELIVERY"
+	InvoiceLineSurcharge = "SURCHARGE"
+	InvoiceLineCredit    = "CREDIT"
+)
+
+// Formatos de exportación de una factura
+var (
+	InvoiceFormatPDF  = "PDF"
+	InvoiceFormatJSON = "JSON"
+)
+
+var (
+	// InvoiceCurrency moneda en la que se facturan las tarifas de entrega
+	InvoiceCurrency = "USD"
+
+	// InvoiceNumberPrefix prefijo de los números de factura
+	InvoiceNumberPrefix = "INV"
+
+	// DefaultInvoiceTaxRate impuesto aplicado cuando no se indica otro al generar la factura
+	DefaultInvoiceTaxRate = 0.13
+
+	// DefaultPaymentTermDays días de crédito cuando el contrato no define condiciones de pago
+	DefaultPaymentTermDays = 30
+
+	// Recargos sobre la tarifa de entrega de la empresa
+	UrgentSurchargeRate      = 0.25
+	FragileSurchargeRate     = 0.10
+	ExtraParcelSurchargeRate = 0.50
+
+	// LostOrderCreditRate compensación por pedido perdido sobre la tarifa de entrega
+	LostOrderCreditRate = 1.0
+)
@@ -0,0 +1,32 @@
+package constants
+
+import "time"
+
+// Trabajos periódicos ejecutados por el planificador
+var (
+	JobCleanExpiredSessions = "clean_expired_sessions"
+	JobPurgeDeletedRecords  = "purge_deleted_records"
+	JobRecomputeMetrics     = "recompute_metrics"
+	JobRescheduleDeliveries = "reschedule_failed_deliveries"
+	JobRetryPendingPayments = "retry_pending_payments"
+)
+
+// Estados de cada ejecución de un trabajo
+var (
+	JobRunStatusRunning   = "RUNNING"
+	JobRunStatusSucceeded = "SUCCEEDED"
+	JobRunStatusFailed    = "FAILED"
+)
+
+const (
+	// JobLockTTL tiempo que una instancia reserva una ejecución programada, las demás instancias la omiten
+	JobLockTTL = 30 * time.Minute
+	// JobRetryBaseDelay espera antes del primer reintento de un trabajo, se duplica en cada intento fallido
+	JobRetryBaseDelay = 30 * time.Second
+	// DeletedRecordsRetention tiempo que se conservan los registros eliminados lógicamente antes de purgarlos
+	DeletedRecordsRetention = 90 * 24 * time.Hour
+	// PurgeBatchSize máximo de pedidos que se purgan en cada transacción
+	PurgeBatchSize = 200
+	// MetricsSnapshotMaxAge antigüedad máxima de las métricas precalculadas, si se supera se calculan en la consulta
+	MetricsSnapshotMaxAge = 30 * time.Minute
+)
@@ -0,0 +1,12 @@
+package constants
+
+// Formatos de etiqueta de envío
+var (
+	LabelFormatPDF = "PDF"
+	LabelFormatZPL = "ZPL"
+)
+
+var (
+	// MaxLabelsPerBatch cantidad máxima de etiquetas que se pueden imprimir en una sola solicitud
+	MaxLabelsPerBatch = 100
+)
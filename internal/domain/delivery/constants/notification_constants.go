@@ -0,0 +1,141 @@
+package constants
+
+// Canales por los que se despachan las notificaciones, además de guardarse en la bandeja del usuario
+var (
+	NotificationChannelEmail = "EMAIL"
+	NotificationChannelSMS   = "SMS"
+	NotificationChannelPush  = "PUSH"
+)
+
+// Tipos de notificación, cada tipo tiene su plantilla y preferencias por usuario
+var (
+	NotificationTypeDeliveryPIN = "DELIVERY_PIN"
+	NotificationTypeSLAAtRisk   = "SLA_AT_RISK"
+	NotificationTypeSLABreached = "SLA_BREACHED"
+
+	// Eventos del ciclo de vida del pedido, cada evento usa la plantilla de su mismo tipo
+	NotificationTypeOrderDriverAssigned = "ORDER_DRIVER_ASSIGNED"
+	NotificationTypeOrderOutForDelivery = "ORDER_OUT_FOR_DELIVERY"
+	NotificationTypeOrderDelivered      = "ORDER_DELIVERED"
+	NotificationTypeOrderAttemptFailed  = "ORDER_DELIVERY_ATTEMPT_FAILED"
+	NotificationTypeOrderReturned       = "ORDER_RETURNED"
+	NotificationTypeOrderCancelled      = "ORDER_CANCELLED"
+	NotificationTypeOrderLost           = "ORDER_LOST"
+
+	// NotificationTypeDriverOrderAssigned aviso al repartidor del pedido que se le asignó, no es configurable
+	NotificationTypeDriverOrderAssigned = "DRIVER_ORDER_ASSIGNED"
+)
+
+// OrderEventAudience destinatarios por defecto de un evento del pedido cuando la empresa no lo configuró
+type OrderEventAudience struct {
+	Recipient bool
+	Company   bool
+}
+
+// DefaultOrderEventAudiences eventos que las empresas pueden configurar y a quién se notifican por defecto
+var DefaultOrderEventAudiences = map[string]OrderEventAudience{
+	NotificationTypeOrderDriverAssigned: {Recipient: false, Company: false},
+	NotificationTypeOrderOutForDelivery: {Recipient: true, Company: false},
+	NotificationTypeOrderDelivered:      {Recipient: true, Company: false},
+	NotificationTypeOrderAttemptFailed:  {Recipient: true, Company: false},
+	NotificationTypeOrderReturned:       {Recipient: true, Company: true},
+	NotificationTypeOrderCancelled:      {Recipient: false, Company: true},
+	NotificationTypeOrderLost:           {Recipient: false, Company: true},
+	NotificationTypeSLAAtRisk:           {Recipient: false, Company: true},
+	NotificationTypeSLABreached:         {Recipient: false, Company: true},
+}
+
+// ConfigurableOrderEvents eventos configurables en el orden en que se muestran a la empresa
+var ConfigurableOrderEvents = []string{
+	NotificationTypeOrderDriverAssigned,
+	NotificationTypeOrderOutForDelivery,
+	NotificationTypeOrderDelivered,
+	NotificationTypeOrderAttemptFailed,
+	NotificationTypeOrderReturned,
+	NotificationTypeOrderCancelled,
+	NotificationTypeOrderLost,
+	NotificationTypeSLAAtRisk,
+	NotificationTypeSLABreached,
+}
+
+// OrderEventsByStatus evento que genera el cambio de un pedido a cada estado
+var OrderEventsByStatus = map[string]string{
+	OrderStatusInTransit: NotificationTypeOrderOutForDelivery,
+	OrderStatusDelivered: NotificationTypeOrderDelivered,
+	OrderStatusReturned:  NotificationTypeOrderReturned,
+	OrderStatusCancelled: NotificationTypeOrderCancelled,
+	OrderStatusLost:      NotificationTypeOrderLost,
+}
+
+// NotificationTypes tipos de notificación en el orden en que se muestran en las preferencias del usuario
+var NotificationTypes = []string{
+	NotificationTypeDeliveryPIN,
+	NotificationTypeOrderDriverAssigned,
+	NotificationTypeOrderOutForDelivery,
+	NotificationTypeOrderDelivered,
+	NotificationTypeOrderAttemptFailed,
+	NotificationTypeOrderReturned,
+	NotificationTypeOrderCancelled,
+	NotificationTypeOrderLost,
+	NotificationTypeDriverOrderAssigned,
+	NotificationTypeSLAAtRisk,
+	NotificationTypeSLABreached,
+}
+
+// NotificationChannelDefaults canales habilitados por defecto para un rol
+type NotificationChannelDefaults struct {
+	Email bool
+	Push  bool
+}
+
+// DefaultNotificationChannelsByRole canales por defecto de cada rol cuando el usuario no configuró sus preferencias,
+// el SMS solo se habilita por defecto en los tipos de NotificationTypesWithSMSByDefault
+var DefaultNotificationChannelsByRole = map[string]NotificationChannelDefaults{
+	AdminRole:      {Email: true, Push: false},
+	CompanyUser:    {Email: true, Push: true},
+	Driver:         {Email: false, Push: true},
+	WarehouseStaff: {Email: true, Push: true},
+	Collector:      {Email: false, Push: true},
+	FinalUser:      {Email: true, Push: true},
+}
+
+// Tipos de dispositivo que pueden registrarse para recibir notificaciones push
+var (
+	DeviceTypeIOS     = "IOS"
+	DeviceTypeAndroid = "ANDROID"
+	DeviceTypeWeb     = "WEB"
+)
+
+var ValidDeviceTypes = map[string]bool{
+	DeviceTypeIOS:     true,
+	DeviceTypeAndroid: true,
+	DeviceTypeWeb:     true,
+}
+
+// Estados del despacho de una notificación y de cada uno de sus canales
+var (
+	NotificationStatusPending = "PENDING"
+	NotificationStatusSent    = "SENT"
+	NotificationStatusPartial = "PARTIALLY_SENT"
+	NotificationStatusFailed  = "FAILED"
+	NotificationStatusSkipped = "SKIPPED"
+)
+
+// Eventos enviados en tiempo real a la bandeja del usuario
+var (
+	InboxEventNotification = "notification"
+	InboxEventUnreadCount  = "unread_count"
+)
+
+var (
+	// DefaultTrackingURL dirección pública de seguimiento usada cuando no se configura una
+	DefaultTrackingURL = "https://tracking.delivery.local"
+
+	// NotificationSecretMask texto con el que se guardan los datos sensibles en el contenido de la notificación
+	NotificationSecretMask = "******"
+
+	// NotificationTypesWithSMSByDefault tipos que se envían por SMS aunque el usuario no haya configurado sus preferencias
+	NotificationTypesWithSMSByDefault = map[string]bool{
+		NotificationTypeDeliveryPIN: true,
+	}
+)
@@ -8,6 +8,7 @@ var (
 	OrderStatusPickedUp    = "PICKED_UP"
 	OrderStatusInWarehouse = "IN_WAREHOUSE"
 	OrderStatusInTransit   = "IN_TRANSIT"
+	OrderStatusFailed      = "DELIVERY_FAILED"
 	OrderStatusReturned    = "RETURNED"
 	OrderStatusCompleted   = "COMPLETED"
 	OrderStatusLost        = "LOST"
@@ -25,6 +26,7 @@ var ValidOrderStatuses = []string{
 	OrderStatusInWarehouse,
 	OrderStatusReturned,
 	OrderStatusInTransit,
+	OrderStatusFailed,
 	OrderStatusLost,
 }
 
@@ -34,10 +36,16 @@ var AllowedStatesToDelete = map[string]bool{
 	OrderStatusRestored:  true,
 }
 
+// DeliverableOrderStatuses estados desde los que un pedido puede marcarse como entregado
+var DeliverableOrderStatuses = []string{
+	OrderStatusInTransit,
+}
+
 var AllowedStatesToUpdate = map[string]bool{
 	OrderStatusPending:     true,
 	OrderStatusAccepted:    true,
 	OrderStatusPickedUp:    true,
 	OrderStatusInWarehouse: true,
 	OrderStatusInTransit:   true,
+	OrderStatusFailed:      true,
 }
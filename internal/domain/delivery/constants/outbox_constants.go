@@ -0,0 +1,44 @@
+package constants
+
+import "time"
+
+// Eventos de dominio que se guardan en el outbox junto con el cambio de estado que los genera
+var (
+	SystemEventOrderCreated        = "order.created"
+	SystemEventOrderStatusChanged  = "order.status_changed"
+	SystemEventOrderDriverAssigned = "order.driver_assigned"
+	SystemEventUserActivated       = "user.activated"
+	SystemEventUserDeactivated     = "user.deactivated"
+	SystemEventCompanyDeactivated  = "company.deactivated"
+	SystemEventCompanyReactivated  = "company.reactivated"
+)
+
+// Entidades que originan los eventos de dominio
+var (
+	EventSourceOrder   = "ORDER"
+	EventSourceUser    = "USER"
+	EventSourceCompany = "COMPANY"
+)
+
+// Severidad de los eventos de dominio
+var (
+	EventSeverityInfo    = "INFO"
+	EventSeverityWarning = "WARNING"
+)
+
+// Niveles del registro de entrega de un evento a cada suscriptor
+var (
+	EventLogLevelInfo  = "INFO"
+	EventLogLevelError = "ERROR"
+)
+
+const (
+	// OutboxBatchSize máximo de eventos que el relay publica en cada ejecución
+	OutboxBatchSize = 100
+	// OutboxLease tiempo que un relay reserva un evento mientras lo publica, evita que otra instancia lo publique a la vez
+	OutboxLease = time.Minute
+	// OutboxMaxAttempts intentos de publicación tras los cuales el evento queda pendiente de revisión manual
+	OutboxMaxAttempts = 10
+	// OutboxRetryBaseDelay espera antes del primer reintento, se duplica en cada intento fallido
+	OutboxRetryBaseDelay = 15 * time.Second
+)
@@ -0,0 +1,30 @@
+package constants
+
+var (
+	// MaxParcelsPerOrder cantidad máxima de bultos que puede contener un pedido
+	MaxParcelsPerOrder = 50
+)
+
+// ParcelStatusProgress orden de avance de los estados de un bulto, el estado del pedido
+// se deriva del bulto menos avanzado
+var ParcelStatusProgress = map[string]int{
+	OrderStatusPending:     0,
+	OrderStatusPickedUp:    1,
+	OrderStatusInWarehouse: 2,
+	OrderStatusInTransit:   3,
+	OrderStatusDelivered:   4,
+}
+
+// ParcelTerminalStatuses estados finales del pedido que se aplican a todos los bultos no entregados
+var ParcelTerminalStatuses = map[string]bool{
+	OrderStatusCancelled: true,
+	OrderStatusReturned:  true,
+	OrderStatusLost:      true,
+}
+
+// ParcelScanOrderStatuses estados del pedido en los que se pueden escanear sus bultos de forma individual
+var ParcelScanOrderStatuses = map[string]bool{
+	OrderStatusAccepted:    true,
+	OrderStatusPickedUp:    true,
+	OrderStatusInWarehouse: true,
+}
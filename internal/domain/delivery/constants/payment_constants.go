@@ -0,0 +1,45 @@
+package constants
+
+// Estados del pago anticipado de un pedido
+var (
+	PaymentStatusPending    = "PENDING"
+	PaymentStatusAuthorized = "AUTHORIZED"
+	PaymentStatusCaptured   = "CAPTURED"
+	PaymentStatusFailed     = "FAILED"
+	PaymentStatusRefunded   = "REFUNDED"
+
+	// Estados de un cobro o una devolución que el proveedor no confirmó, el trabajo de pagos los reintenta
+	PaymentStatusCapturePending = "CAPTURE_PENDING"
+	PaymentStatusRefundPending  = "REFUND_PENDING"
+)
+
+// PaymentRetryBatchSize máximo de pagos pendientes que se reintentan en cada ejecución
+var PaymentRetryBatchSize = 100
+
+// Eventos que notifica el proveedor de pagos a través del webhook
+var (
+	PaymentEventAuthorized = "payment.authorized"
+	PaymentEventCaptured   = "payment.captured"
+	PaymentEventFailed     = "payment.failed"
+	PaymentEventRefunded   = "payment.refunded"
+)
+
+// PaymentStatusByEvent estado del pago que corresponde a cada evento del proveedor
+var PaymentStatusByEvent = map[string]string{
+	PaymentEventAuthorized: PaymentStatusAuthorized,
+	PaymentEventCaptured:   PaymentStatusCaptured,
+	PaymentEventFailed:     PaymentStatusFailed,
+	PaymentEventRefunded:   PaymentStatusRefunded,
+}
+
+var (
+	// PaymentProviderFake proveedor local que simula las respuestas de una pasarela de pagos
+	PaymentProviderFake = "FAKE"
+
+	// Tokens de prueba del proveedor local para simular pagos rechazados o pendientes de confirmación
+	FakePaymentTokenDeclined = "tok_declined"
+	FakePaymentTokenPending  = "tok_pending"
+
+	// PaymentSignatureHeader cabecera con la firma HMAC del cuerpo del webhook
+	PaymentSignatureHeader = "X-Payment-Signature"
+)
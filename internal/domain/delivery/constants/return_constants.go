@@ -0,0 +1,55 @@
+package constants
+
+// Estados de una devolución
+var (
+	ReturnStatusRequested = "REQUESTED"
+	ReturnStatusPickedUp  = "PICKED_UP"
+	ReturnStatusInTransit = "IN_TRANSIT"
+	ReturnStatusReceived  = "RECEIVED"
+	ReturnStatusCancelled = "CANCELLED"
+)
+
+var ValidReturnStatuses = []string{
+	ReturnStatusRequested,
+	ReturnStatusPickedUp,
+	ReturnStatusInTransit,
+	ReturnStatusReceived,
+	ReturnStatusCancelled,
+}
+
+// Origen de la solicitud de devolución
+var (
+	ReturnInitiatedByCompany   = "COMPANY"
+	ReturnInitiatedByRecipient = "RECIPIENT"
+)
+
+// Destino al que se enruta la devolución
+var (
+	ReturnDestinationBranch    = "BRANCH"
+	ReturnDestinationWarehouse = "WAREHOUSE"
+)
+
+// Códigos de motivo de devolución
+var (
+	ReturnReasonDamaged          = "DAMAGED"
+	ReturnReasonWrongItem        = "WRONG_ITEM"
+	ReturnReasonNotAsDescribed   = "NOT_AS_DESCRIBED"
+	ReturnReasonRecipientRefused = "RECIPIENT_REFUSED"
+	ReturnReasonUndeliverable    = "UNDELIVERABLE"
+	ReturnReasonOther            = "OTHER"
+)
+
+var ValidReturnReasons = map[string]bool{
+	ReturnReasonDamaged:          true,
+	ReturnReasonWrongItem:        true,
+	ReturnReasonNotAsDescribed:   true,
+	ReturnReasonRecipientRefused: true,
+	ReturnReasonUndeliverable:    true,
+	ReturnReasonOther:            true,
+}
+
+// Estados del pedido original desde los que se puede iniciar una devolución, los pedidos que no se entregaron
+// vuelven al remitente mediante los intentos de entrega y no mediante una devolución
+var AllowedStatesToReturn = map[string]bool{
+	OrderStatusDelivered: true,
+}
@@ -0,0 +1,22 @@
+package constants
+
+// ScanStatusesByRole estados que cada rol puede asignar a un pedido al escanear su código QR,
+// las acciones disponibles son la intersección con las transiciones válidas del estado actual
+var ScanStatusesByRole = map[string][]string{
+	AdminRole: ValidOrderStatuses,
+	Driver: {
+		OrderStatusPickedUp,
+		OrderStatusInTransit,
+		OrderStatusDelivered,
+		OrderStatusFailed,
+	},
+	Collector: {
+		OrderStatusPickedUp,
+		OrderStatusInWarehouse,
+	},
+	WarehouseStaff: {
+		OrderStatusInWarehouse,
+		OrderStatusInTransit,
+		OrderStatusReturned,
+	},
+}
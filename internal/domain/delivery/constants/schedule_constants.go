@@ -0,0 +1,17 @@
+package constants
+
+// Estados de una programación de pedidos
+var (
+	ScheduleStatusActive    = "ACTIVE"
+	ScheduleStatusPaused    = "PAUSED"
+	ScheduleStatusCancelled = "CANCELLED"
+	ScheduleStatusCompleted = "COMPLETED"
+)
+
+var (
+	// DefaultScheduleDeliveryWindow minutos entre la recogida y el límite de entrega si no se especifican
+	DefaultScheduleDeliveryWindow = 240
+
+	// MaxDueSchedulesPerRun cantidad máxima de programaciones que se materializan en cada ejecución
+	MaxDueSchedulesPerRun = 100
+)
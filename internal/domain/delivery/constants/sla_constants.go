@@ -0,0 +1,41 @@
+package constants
+
+// Estados del acuerdo de nivel de servicio (SLA) de entrega de un pedido
+var (
+	SLAStatusOnTrack  = "ON_TRACK"
+	SLAStatusAtRisk   = "AT_RISK"
+	SLAStatusBreached = "BREACHED"
+)
+
+var ValidSLAStatuses = map[string]bool{
+	SLAStatusOnTrack:  true,
+	SLAStatusAtRisk:   true,
+	SLAStatusBreached: true,
+}
+
+var (
+	// DefaultZoneDeliveryMinutes tiempo de entrega estimado cuando la zona de la sucursal no define uno
+	DefaultZoneDeliveryMinutes = 120
+
+	// Motivos con los que se marca un pedido cuyo SLA está en riesgo o fue incumplido
+	OrderFlagSLAAtRisk   = "SLA_AT_RISK"
+	OrderFlagSLABreached = "SLA_BREACHED"
+
+	// SLAMonitoredStatuses estados de los pedidos que aún no se entregan y cuyo SLA se supervisa
+	SLAMonitoredStatuses = []string{
+		OrderStatusPending,
+		OrderStatusAccepted,
+		OrderStatusPickedUp,
+		OrderStatusInWarehouse,
+		OrderStatusInTransit,
+		OrderStatusFailed,
+	}
+
+	// SLAPickedUpStatuses estados en los que el pedido ya fue recogido y el tiempo de entrega ya está corriendo
+	SLAPickedUpStatuses = map[string]bool{
+		OrderStatusPickedUp:    true,
+		OrderStatusInWarehouse: true,
+		OrderStatusInTransit:   true,
+		OrderStatusFailed:      true,
+	}
+)
@@ -0,0 +1,61 @@
+package constants
+
+import "time"
+
+// Eventos del pedido a los que las empresas pueden suscribir sus webhooks
+var (
+	WebhookEventOrderCreated        = "order.created"
+	WebhookEventOrderStatusChanged  = "order.status_changed"
+	WebhookEventOrderDriverAssigned = "order.driver_assigned"
+	WebhookEventOrderDelivered      = "order.delivered"
+	WebhookEventOrderAttemptFailed  = "order.delivery_attempt_failed"
+	WebhookEventOrderCancelled      = "order.cancelled"
+)
+
+// WebhookEvents eventos disponibles en el orden en que se muestran a la empresa
+var WebhookEvents = []string{
+	WebhookEventOrderCreated,
+	WebhookEventOrderStatusChanged,
+	WebhookEventOrderDriverAssigned,
+	WebhookEventOrderDelivered,
+	WebhookEventOrderAttemptFailed,
+	WebhookEventOrderCancelled,
+}
+
+// WebhookEventsByStatus evento específico que genera el cambio de un pedido a cada estado, además de order.status_changed
+var WebhookEventsByStatus = map[string]string{
+	OrderStatusDelivered: WebhookEventOrderDelivered,
+	OrderStatusCancelled: WebhookEventOrderCancelled,
+}
+
+// Estados de la entrega de un evento a un webhook
+var (
+	WebhookDeliveryStatusPending   = "PENDING"
+	WebhookDeliveryStatusSucceeded = "SUCCEEDED"
+	WebhookDeliveryStatusFailed    = "FAILED"
+)
+
+var (
+	// WebhookMaxAttempts intentos de entrega de un evento antes de darlo por fallido
+	WebhookMaxAttempts = 6
+
+	// WebhookRetryBaseDelay espera antes del primer reintento, cada reintento duplica la espera anterior
+	WebhookRetryBaseDelay = 30 * time.Second
+
+	// WebhookDisableAfterFailures intentos fallidos consecutivos tras los cuales se desactiva el webhook
+	WebhookDisableAfterFailures = 15
+
+	// WebhookDeliveryLease tiempo que un proceso reserva una entrega para enviarla, evita envíos duplicados
+	WebhookDeliveryLease = 2 * time.Minute
+
+	// WebhookDeliveryBatchSize entregas pendientes procesadas en cada ciclo
+	WebhookDeliveryBatchSize = 50
+
+	// WebhookSecretPrefix prefijo de los secretos generados para firmar los eventos
+	WebhookSecretPrefix = "whsec_"
+
+	// Cabeceras enviadas con cada evento
+	WebhookSignatureHeader = "X-Webhook-Signature"
+	WebhookEventHeader     = "X-Webhook-Event"
+	WebhookDeliveryHeader  = "X-Webhook-Delivery"
+)
@@ -0,0 +1,15 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+type CashLedger interface {
+	GetDriverLedger(ctx context.Context, driverID string, start, end time.Time) ([]entities.CashLedgerEntry, []entities.CashBalance, error)
+	ReconcileDriverCash(ctx context.Context, driverID, currency string, handedIn float64, reconciledByID, notes string) (*entities.CashReconciliation, error)
+	GetReconciliations(ctx context.Context, driverID string) ([]entities.CashReconciliation, error)
+	GetSettlementReport(ctx context.Context, companyID string, start, end time.Time) ([]entities.CODSettlement, error)
+}
@@ -0,0 +1,22 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+type DriverEarner interface {
+	CalculateDeliveryEarning(ctx context.Context, order *entities.Order) (*entities.DriverEarning, error)
+	GetEarningRules(ctx context.Context) ([]entities.EarningRule, error)
+	GetEarningRuleByID(ctx context.Context, id string) (*entities.EarningRule, error)
+	CreateEarningRule(ctx context.Context, rule *entities.EarningRule) error
+	UpdateEarningRule(ctx context.Context, rule *entities.EarningRule) error
+	AddAdjustment(ctx context.Context, driverID string, amount float64, reason, createdBy string) (*entities.DriverEarning, error)
+	GetDriverEarnings(ctx context.Context, driverID string, start, end time.Time) ([]entities.DriverEarning, error)
+	GeneratePayoutStatement(ctx context.Context, driverID string, start, end time.Time) (*entities.PayoutStatement, error)
+	GenerateWeeklyStatements(ctx context.Context, start, end time.Time) (int, error)
+	GetPayoutStatementByID(ctx context.Context, id string) (*entities.PayoutStatement, error)
+	GetPayoutStatementsByDriver(ctx context.Context, driverID string) ([]entities.PayoutStatement, error)
+}
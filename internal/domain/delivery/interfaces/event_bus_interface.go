@@ -0,0 +1,15 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+// EventBus transporta los eventos de dominio a los grupos de consumidores. Cada grupo, identificado por el nombre
+// del suscriptor, recibe todos los eventos y los reparte entre sus instancias. Un mensaje se confirma cuando el
+// suscriptor lo procesa sin error, si no se vuelve a entregar y tras varias entregas fallidas pasa al dead letter
+type EventBus interface {
+	Publish(ctx context.Context, event *entities.SystemEvent) error
+	Consume(ctx context.Context, subscriber EventSubscriber, limit int) (int, error)
+}
@@ -0,0 +1,18 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+type OrderImporter interface {
+	CreateImportJob(ctx context.Context, job *entities.ImportJob) error
+	GetImportJobByID(ctx context.Context, id string) (*entities.ImportJob, error)
+	GetImportJobsByCompany(ctx context.Context, companyID string) ([]entities.ImportJob, error)
+
+	// Procesamiento asíncrono
+	GetPendingImportJobs(ctx context.Context) ([]entities.ImportJob, error)
+	ClaimImportJob(ctx context.Context, id string) (bool, error)
+	FinishImportJob(ctx context.Context, job *entities.ImportJob, jobErr error) error
+}
@@ -0,0 +1,16 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+type Invoicer interface {
+	GenerateInvoice(ctx context.Context, companyID string, start, end time.Time, taxRate float64) (*entities.Invoice, error)
+	GenerateCycleInvoices(ctx context.Context, start, end time.Time) (int, error)
+	GetInvoiceByID(ctx context.Context, id string) (*entities.Invoice, error)
+	GetInvoicesByCompany(ctx context.Context, companyID string, statuses []string) ([]entities.Invoice, error)
+	ChangeInvoiceStatus(ctx context.Context, id, status, reason string) (*entities.Invoice, error)
+}
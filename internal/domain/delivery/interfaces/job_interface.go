@@ -0,0 +1,19 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+type JobRecorder interface {
+	StartRun(ctx context.Context, jobName string, attempt int, scheduledAt time.Time) (*entities.JobRun, error)
+	FinishRun(ctx context.Context, run *entities.JobRun, result string, runErr error) error
+	GetRuns(ctx context.Context, jobName string, params *entities.PaginationQueryParams) ([]entities.JobRun, int64, error)
+}
+
+type Maintainer interface {
+	CleanExpiredSessions(ctx context.Context) (int64, error)
+	PurgeDeletedRecords(ctx context.Context) (map[string]int64, error)
+}
@@ -2,6 +2,8 @@ package interfaces
 
 import (
 	"context"
+	"time"
+
 	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
 )
 
@@ -12,4 +14,10 @@ type MetricsService interface {
 
 	// Métricas de sucursal
 	GetBranchMetrics(ctx context.Context, branchID, companyID string) (*entities.BranchMetrics, error)
+
+	// RecomputeCompanyMetrics precalcula las métricas de las empresas activas
+	RecomputeCompanyMetrics(ctx context.Context) (int, error)
+
+	// RefreshCompanyMetrics precalcula las métricas de la empresa si las guardadas son anteriores a since
+	RefreshCompanyMetrics(ctx context.Context, companyID string, since time.Time) error
 }
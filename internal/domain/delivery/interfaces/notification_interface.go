@@ -0,0 +1,37 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+type Notifier interface {
+	Notify(ctx context.Context, request *entities.NotificationRequest) (*entities.Notification, error)
+	NotifyRole(ctx context.Context, role, companyID string, request *entities.NotificationRequest) (int, error)
+}
+
+type OrderNotifier interface {
+	NotifyStatusChange(ctx context.Context, order *entities.Order, status string)
+	NotifyDriverAssigned(ctx context.Context, order *entities.Order)
+	NotifyDriverDispatch(ctx context.Context, order *entities.Order) error
+	NotifyDeliveryAttempt(ctx context.Context, order *entities.Order, attempt *entities.DeliveryAttempt, returnToSender bool)
+	NotifySLAAlert(ctx context.Context, evaluation *entities.SLAEvaluation) error
+	GetCompanySettings(ctx context.Context, companyID string) ([]entities.CompanyNotificationSetting, error)
+	UpdateCompanySettings(ctx context.Context, companyID string, settings []entities.CompanyNotificationSetting) ([]entities.CompanyNotificationSetting, error)
+}
+
+type NotificationInbox interface {
+	GetNotifications(ctx context.Context, userID string, params *entities.NotificationQueryParams) ([]entities.Notification, int64, error)
+	GetUnreadCounts(ctx context.Context, userID string) (map[string]int64, int64, error)
+	MarkAsRead(ctx context.Context, userID, notificationID string) error
+	MarkAllAsRead(ctx context.Context, userID string) (int64, error)
+}
+
+type NotificationPreferencer interface {
+	GetPreferences(ctx context.Context, userID, role string) ([]entities.NotificationPreference, error)
+	UpdatePreferences(ctx context.Context, userID, role string, preferences []entities.NotificationPreference) ([]entities.NotificationPreference, error)
+	GetDevices(ctx context.Context, userID string) ([]entities.NotificationDevice, error)
+	RegisterDevice(ctx context.Context, device *entities.NotificationDevice, previousToken string) error
+	DeregisterDevice(ctx context.Context, userID, deviceID string) error
+}
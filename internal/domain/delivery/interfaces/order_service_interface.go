@@ -14,10 +14,17 @@ type Orderer interface {
 	GetOrdersByCompany(ctx context.Context, companyID string, params *entities.OrderQueryParams) ([]entities.Order, int64, error)
 	UpdateOrder(ctx context.Context, orderID string, order *entities.Order) error
 	GetOrderByTrackingNumber(ctx context.Context, trackingNumber string) (*entities.Order, error)
+	GetOrderByQRData(ctx context.Context, qrData string) (*entities.Order, error)
+	GetParcelByQRData(ctx context.Context, qrData string) (*entities.Parcel, error)
+	ChangeParcelStatus(ctx context.Context, parcel *entities.Parcel, status string) error
 	GetOrdersByClientID(ctx context.Context, clientID string) ([]entities.Order, error)
 	AssignDriverToOrder(ctx context.Context, orderID, driverID string) error
 	SoftDeleteOrder(ctx context.Context, id string) error
 	OrderIsDeleted(ctx context.Context, orderID string) bool
 	RestoreOrder(ctx context.Context, id string) error
 	IsAvailableForDelete(ctx context.Context, orderID string) error
+	DeliverOrder(ctx context.Context, orderID, driverID, pin string, collectedAmount *float64) error
+	RegisterDeliveryAttempt(ctx context.Context, orderID, driverID, reasonCode, notes string) (*entities.DeliveryAttempt, error)
+	GetDeliveryAttempts(ctx context.Context, orderID string) ([]entities.DeliveryAttempt, error)
+	RescheduleDueDeliveries(ctx context.Context) (int, error)
 }
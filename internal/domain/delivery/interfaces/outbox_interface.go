@@ -0,0 +1,22 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+// EventSubscriber recibe los eventos de dominio publicados desde el outbox o consumidos del bus de eventos, puede
+// ser un suscriptor del proceso o un destino externo. Un evento se entrega una sola vez a cada suscriptor,
+// identificado por su nombre
+type EventSubscriber interface {
+	Name() string
+	// EventTypes eventos que recibe el suscriptor, vacío para recibir todos
+	EventTypes() []string
+	Handle(ctx context.Context, event *entities.SystemEvent) error
+}
+
+type OutboxRelayer interface {
+	Subscribe(subscriber EventSubscriber)
+	RelayPendingEvents(ctx context.Context) (int, error)
+}
@@ -0,0 +1,17 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+type PaymentProcessor interface {
+	AuthorizePayment(ctx context.Context, payment *entities.OrderPayment) error
+	CapturePayment(ctx context.Context, payment *entities.OrderPayment) error
+	ReleasePayment(ctx context.Context, payment *entities.OrderPayment)
+	RefundOrderPayment(ctx context.Context, orderID string) error
+	RetryPendingPayments(ctx context.Context) (int, error)
+	GetOrderPayment(ctx context.Context, orderID string) (*entities.OrderPayment, error)
+	HandleWebhook(ctx context.Context, payload []byte, signature string) error
+}
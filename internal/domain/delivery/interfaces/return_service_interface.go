@@ -0,0 +1,15 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+type Returner interface {
+	CreateReturn(ctx context.Context, orderReturn *entities.OrderReturn) error
+	GetReturnByID(ctx context.Context, id string) (*entities.OrderReturn, error)
+	GetReturnsByOrder(ctx context.Context, orderID string) ([]entities.OrderReturn, error)
+	GetReturnsByCompany(ctx context.Context, companyID, status string) ([]entities.OrderReturn, error)
+	ChangeReturnStatus(ctx context.Context, id, status string, driverID *string) error
+}
@@ -0,0 +1,24 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+type OrderScheduler interface {
+	CreateSchedule(ctx context.Context, schedule *entities.OrderSchedule) error
+	UpdateSchedule(ctx context.Context, schedule *entities.OrderSchedule) error
+	GetScheduleByID(ctx context.Context, id string) (*entities.OrderSchedule, error)
+	GetSchedulesByCompany(ctx context.Context, companyID, status string) ([]entities.OrderSchedule, error)
+	PauseSchedule(ctx context.Context, id string) error
+	ResumeSchedule(ctx context.Context, id string) error
+	CancelSchedule(ctx context.Context, id string) error
+
+	// Materialización de pedidos
+	GetDueSchedules(ctx context.Context, now time.Time) ([]entities.OrderSchedule, error)
+	ClaimScheduleRun(ctx context.Context, schedule *entities.OrderSchedule) (bool, error)
+	RecordScheduleRun(ctx context.Context, id string, orderID *string, runErr error) error
+	ValidatePickupWindow(branch *entities.Branch, pickupTime time.Time) error
+}
@@ -0,0 +1,14 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+type SLAMonitor interface {
+	EvaluateOrder(order *entities.Order, now time.Time) *entities.SLAEvaluation
+	GetOrdersSLA(ctx context.Context, companyID, branchID string) ([]entities.SLAEvaluation, error)
+	MonitorActiveOrders(ctx context.Context) (int, error)
+}
@@ -0,0 +1,8 @@
+package interfaces
+
+import "context"
+
+type TrackingNumberGenerator interface {
+	GenerateForCompany(ctx context.Context, companyID string) (string, error)
+	Generate(prefix string) (string, error)
+}
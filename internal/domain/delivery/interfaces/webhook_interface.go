@@ -0,0 +1,25 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+type WebhookPublisher interface {
+	Publish(ctx context.Context, eventID, companyID, event string, data map[string]interface{}) error
+}
+
+type WebhookManager interface {
+	WebhookPublisher
+	CreateEndpoint(ctx context.Context, endpoint *entities.WebhookEndpoint) error
+	GetEndpoints(ctx context.Context, companyID string) ([]entities.WebhookEndpoint, error)
+	GetEndpointByID(ctx context.Context, id string) (*entities.WebhookEndpoint, error)
+	UpdateEndpoint(ctx context.Context, endpoint *entities.WebhookEndpoint) error
+	DeleteEndpoint(ctx context.Context, id string) error
+	RotateSecret(ctx context.Context, id string) (*entities.WebhookEndpoint, error)
+	GetDeliveries(ctx context.Context, endpointID string, params *entities.PaginationQueryParams) ([]entities.WebhookDelivery, int64, error)
+	GetDeliveryByID(ctx context.Context, id string) (*entities.WebhookDelivery, error)
+	Redeliver(ctx context.Context, deliveryID string) (*entities.WebhookDelivery, error)
+	ProcessDueDeliveries(ctx context.Context) (int, error)
+}
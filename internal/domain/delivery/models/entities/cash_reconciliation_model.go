@@ -0,0 +1,32 @@
+package entities
+
+import "time"
+
+type CashReconciliation struct {
+	ID             string    `gorm:"column:id;type:char(36);primaryKey"`
+	DriverID       string    `gorm:"column:driver_id;type:char(36);not null;index"`
+	Currency       string    `gorm:"column:currency;type:char(3);not null"`
+	ExpectedAmount float64   `gorm:"column:expected_amount;type:decimal(12,2);not null"`
+	HandedInAmount float64   `gorm:"column:handed_in_amount;type:decimal(12,2);not null"`
+	Difference     float64   `gorm:"column:difference;type:decimal(12,2);not null"`
+	Status         string    `gorm:"column:status;type:varchar(20);not null"`
+	Collections    int       `gorm:"column:collections;type:int;not null;default:0"`
+	ReconciledByID string    `gorm:"column:reconciled_by_id;type:char(36);not null"`
+	Notes          string    `gorm:"column:notes;type:varchar(255)"`
+	CreatedAt      time.Time `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP"`
+}
+
+func (CashReconciliation) TableName() string {
+	return "cash_reconciliations"
+}
+
+// CODSettlement resumen por moneda de los cobros contra entrega de una empresa en un periodo
+type CODSettlement struct {
+	CompanyID       string
+	Currency        string
+	Orders          int64
+	CollectedAmount float64
+	HandedInAmount  float64
+	PendingAmount   float64
+	Collections     []CashLedgerEntry
+}
@@ -17,6 +17,9 @@ type CompanyMetrics struct {
 	// Tiempo promedio de entrega (en minutos)
 	AverageDeliveryTime float64 `json:"average_delivery_time"`
 
+	// Tasa de entregas realizadas antes del plazo de entrega (porcentaje)
+	OnTimeDeliveryRate float64 `json:"on_time_delivery_rate"`
+
 	// Ingresos totales generados
 	TotalRevenue float64 `json:"total_revenue"`
 
@@ -25,6 +28,15 @@ type CompanyMetrics struct {
 
 	// Número de clientes únicos
 	UniqueCustomers int `json:"unique_customers"`
+
+	// Total de devoluciones solicitadas
+	ReturnedOrders int64 `json:"returned_orders"`
+
+	// Tasa de devolución sobre el total de órdenes (porcentaje)
+	ReturnRate float64 `json:"return_rate"`
+
+	// Devoluciones agrupadas por código de motivo
+	ReturnsByReason map[string]int64 `json:"returns_by_reason"`
 }
 
 // BranchMetrics contiene las métricas de una sucursal
@@ -44,6 +56,9 @@ type BranchMetrics struct {
 	// Tiempo promedio de entrega (en minutos)
 	AverageDeliveryTime float64 `json:"average_delivery_time"`
 
+	// Tasa de entregas realizadas antes del plazo de entrega (porcentaje)
+	OnTimeDeliveryRate float64 `json:"on_time_delivery_rate"`
+
 	// Ingresos totales generados
 	TotalRevenue float64 `json:"total_revenue"`
 
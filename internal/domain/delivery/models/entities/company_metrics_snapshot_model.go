@@ -0,0 +1,14 @@
+package entities
+
+import "time"
+
+// CompanyMetricsSnapshot métricas de una empresa precalculadas por el trabajo de métricas
+type CompanyMetricsSnapshot struct {
+	CompanyID  string    `gorm:"column:company_id;type:char(36);primaryKey"`
+	Metrics    string    `gorm:"column:metrics;type:json;not null"`
+	ComputedAt time.Time `gorm:"column:computed_at;type:timestamp;not null"`
+}
+
+func (CompanyMetricsSnapshot) TableName() string {
+	return "company_metrics_snapshots"
+}
@@ -0,0 +1,35 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+)
+
+// CompanyNotificationSetting indica si un evento del pedido se notifica al destinatario y a la empresa
+type CompanyNotificationSetting struct {
+	CompanyID       string    `gorm:"column:company_id;type:char(36);primaryKey"`
+	Event           string    `gorm:"column:event;type:varchar(50);primaryKey"`
+	NotifyRecipient bool      `gorm:"column:notify_recipient;type:boolean;default:false"`
+	NotifyCompany   bool      `gorm:"column:notify_company;type:boolean;default:false"`
+	CreatedAt       time.Time `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP"`
+	UpdatedAt       time.Time `gorm:"column:updated_at;type:timestamp;default:CURRENT_TIMESTAMP"`
+
+	// Inverse Relationships
+	Company *Company `gorm:"foreignKey:CompanyID;references:ID"`
+}
+
+func (CompanyNotificationSetting) TableName() string {
+	return "company_notification_settings"
+}
+
+// DefaultCompanyNotificationSetting configuración de un evento cuando la empresa no la ha personalizado
+func DefaultCompanyNotificationSetting(companyID, event string) *CompanyNotificationSetting {
+	audience := constants.DefaultOrderEventAudiences[event]
+	return &CompanyNotificationSetting{
+		CompanyID:       companyID,
+		Event:           event,
+		NotifyRecipient: audience.Recipient,
+		NotifyCompany:   audience.Company,
+	}
+}
@@ -0,0 +1,30 @@
+package entities
+
+import "time"
+
+// CashLedgerEntry movimiento del efectivo en poder de un repartidor, los cobros contra entrega suman
+// y las entregas de efectivo en la conciliación restan
+type CashLedgerEntry struct {
+	ID               string    `gorm:"column:id;type:char(36);primaryKey"`
+	DriverID         string    `gorm:"column:driver_id;type:char(36);not null;index"`
+	CompanyID        *string   `gorm:"column:company_id;type:char(36);index"`
+	OrderID          *string   `gorm:"column:order_id;type:char(36);uniqueIndex"`
+	ReconciliationID *string   `gorm:"column:reconciliation_id;type:char(36);index"`
+	Type             string    `gorm:"column:type;type:varchar(20);not null"`
+	Amount           float64   `gorm:"column:amount;type:decimal(12,2);not null"`
+	Currency         string    `gorm:"column:currency;type:char(3);not null"`
+	CreatedAt        time.Time `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP"`
+
+	// Inverse Relationships
+	Order *Order `gorm:"foreignKey:OrderID;references:ID"`
+}
+
+func (CashLedgerEntry) TableName() string {
+	return "driver_cash_ledger"
+}
+
+// CashBalance efectivo pendiente de entregar por un repartidor en una moneda
+type CashBalance struct {
+	Currency string  `json:"currency"`
+	Amount   float64 `json:"amount"`
+}
@@ -0,0 +1,109 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+)
+
+// EarningRule tarifas con las que se calcula la ganancia de un repartidor por entrega, la regla sin zona
+// aplica por defecto a las zonas que no tienen una propia
+type EarningRule struct {
+	ID              string    `gorm:"column:id;type:char(36);primaryKey"`
+	ZoneID          *string   `gorm:"column:zone_id;type:char(36);uniqueIndex"`
+	BasePerDelivery float64   `gorm:"column:base_per_delivery;type:decimal(10,2);not null"`
+	PerKmRate       float64   `gorm:"column:per_km_rate;type:decimal(10,2);not null"`
+	UrgentBonus     float64   `gorm:"column:urgent_bonus;type:decimal(10,2);not null"`
+	ZoneMultiplier  float64   `gorm:"column:zone_multiplier;type:decimal(5,2);not null;default:1.00"`
+	IsActive        bool      `gorm:"column:is_active;type:boolean;default:true"`
+	CreatedAt       time.Time `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP"`
+	UpdatedAt       time.Time `gorm:"column:updated_at;type:timestamp;default:CURRENT_TIMESTAMP"`
+
+	// Inverse Relationships
+	Zone *Zone `gorm:"foreignKey:ZoneID;references:ID"`
+}
+
+func (EarningRule) TableName() string {
+	return "driver_earning_rules"
+}
+
+// DefaultEarningRule regla usada cuando no hay ninguna configurada
+func DefaultEarningRule() *EarningRule {
+	return &EarningRule{
+		BasePerDelivery: constants.DefaultBasePerDelivery,
+		PerKmRate:       constants.DefaultPerKmRate,
+		UrgentBonus:     constants.DefaultUrgentBonus,
+		ZoneMultiplier:  constants.DefaultZoneMultiplier,
+		IsActive:        true,
+	}
+}
+
+// DriverEarning movimiento del libro de ganancias de un repartidor, las entregas guardan el desglose
+// de la regla aplicada y los ajustes el motivo y quién los registró
+type DriverEarning struct {
+	ID             string    `gorm:"column:id;type:char(36);primaryKey"`
+	DriverID       string    `gorm:"column:driver_id;type:char(36);not null;index"`
+	OrderID        *string   `gorm:"column:order_id;type:char(36);uniqueIndex"`
+	StatementID    *string   `gorm:"column:statement_id;type:char(36);index"`
+	Type           string    `gorm:"column:type;type:varchar(20);not null"`
+	BaseAmount     float64   `gorm:"column:base_amount;type:decimal(10,2);default:0"`
+	Distance       float64   `gorm:"column:distance;type:decimal(10,2);default:0"`
+	DistanceAmount float64   `gorm:"column:distance_amount;type:decimal(10,2);default:0"`
+	UrgentBonus    float64   `gorm:"column:urgent_bonus;type:decimal(10,2);default:0"`
+	ZoneMultiplier float64   `gorm:"column:zone_multiplier;type:decimal(5,2);default:1.00"`
+	Amount         float64   `gorm:"column:amount;type:decimal(12,2);not null"`
+	Currency       string    `gorm:"column:currency;type:char(3);not null"`
+	Reason         string    `gorm:"column:reason;type:varchar(255)"`
+	CreatedBy      *string   `gorm:"column:created_by;type:char(36)"`
+	CreatedAt      time.Time `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP"`
+
+	// Inverse Relationships
+	Order *Order `gorm:"foreignKey:OrderID;references:ID"`
+}
+
+func (DriverEarning) TableName() string {
+	return "driver_earnings"
+}
+
+// PayoutStatement estado de pago semanal de un repartidor con los movimientos que liquida
+type PayoutStatement struct {
+	ID              string    `gorm:"column:id;type:char(36);primaryKey"`
+	DriverID        string    `gorm:"column:driver_id;type:char(36);not null;uniqueIndex:idx_payout_driver_period"`
+	StatementNumber string    `gorm:"column:statement_number;type:varchar(40);not null;uniqueIndex"`
+	PeriodStart     time.Time `gorm:"column:period_start;type:timestamp;not null;uniqueIndex:idx_payout_driver_period"`
+	PeriodEnd       time.Time `gorm:"column:period_end;type:timestamp;not null"`
+	DeliveryCount   int       `gorm:"column:delivery_count;type:int;not null"`
+	DeliveryTotal   float64   `gorm:"column:delivery_total;type:decimal(12,2);not null"`
+	AdjustmentTotal float64   `gorm:"column:adjustment_total;type:decimal(12,2);not null"`
+	Total           float64   `gorm:"column:total;type:decimal(12,2);not null"`
+	Currency        string    `gorm:"column:currency;type:char(3);not null"`
+	CreatedAt       time.Time `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP"`
+
+	// Inverse Relationships
+	Driver *Driver `gorm:"foreignKey:DriverID;references:UserID"`
+
+	// Relationships one to many
+	Entries []DriverEarning `gorm:"foreignKey:StatementID"`
+}
+
+func (PayoutStatement) TableName() string {
+	return "driver_payout_statements"
+}
+
+// CalculateTotals recalcula los totales del estado de pago a partir de sus movimientos
+func (s *PayoutStatement) CalculateTotals() {
+	s.DeliveryCount, s.DeliveryTotal, s.AdjustmentTotal = 0, 0, 0
+	for _, entry := range s.Entries {
+		switch entry.Type {
+		case constants.EarningTypeDelivery:
+			s.DeliveryCount++
+			s.DeliveryTotal += entry.Amount
+		case constants.EarningTypeAdjustment:
+			s.AdjustmentTotal += entry.Amount
+		}
+	}
+
+	s.DeliveryTotal = roundAmount(s.DeliveryTotal)
+	s.AdjustmentTotal = roundAmount(s.AdjustmentTotal)
+	s.Total = roundAmount(s.DeliveryTotal + s.AdjustmentTotal)
+}
@@ -4,9 +4,11 @@ import (
 	"time"
 )
 
+// EventLog registro de la entrega de un evento de dominio a un suscriptor
 type EventLog struct {
 	ID          string    `gorm:"column:id;type:char(36);primaryKey"`
-	EventID     string    `gorm:"column:event_id;type:char(36);not null"`
+	EventID     string    `gorm:"column:event_id;type:char(36);not null;index"`
+	Subscriber  string    `gorm:"column:subscriber;type:varchar(100)"`
 	LogLevel    string    `gorm:"column:log_level;type:varchar(20);not null"`
 	Description string    `gorm:"column:description;type:text;not null"`
 	Metadata    string    `gorm:"column:metadata;type:json"`
@@ -0,0 +1,90 @@
+package entities
+
+import (
+	"math"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+)
+
+type Invoice struct {
+	ID             string     `gorm:"column:id;type:char(36);primaryKey"`
+	CompanyID      string     `gorm:"column:company_id;type:char(36);not null;index"`
+	InvoiceNumber  string     `gorm:"column:invoice_number;type:varchar(30);not null;uniqueIndex"`
+	Status         string     `gorm:"column:status;type:varchar(20);not null"`
+	Currency       string     `gorm:"column:currency;type:char(3);not null"`
+	PeriodStart    time.Time  `gorm:"column:period_start;type:timestamp;not null"`
+	PeriodEnd      time.Time  `gorm:"column:period_end;type:timestamp;not null"`
+	Subtotal       float64    `gorm:"column:subtotal;type:decimal(12,2);not null"`
+	SurchargeTotal float64    `gorm:"column:surcharge_total;type:decimal(12,2);not null"`
+	CreditTotal    float64    `gorm:"column:credit_total;type:decimal(12,2);not null"`
+	TaxRate        float64    `gorm:"column:tax_rate;type:decimal(5,4);not null"`
+	TaxAmount      float64    `gorm:"column:tax_amount;type:decimal(12,2);not null"`
+	Total          float64    `gorm:"column:total;type:decimal(12,2);not null"`
+	PaymentTerms   string     `gorm:"column:payment_terms;type:varchar(50)"`
+	IssuedAt       *time.Time `gorm:"column:issued_at;type:timestamp"`
+	DueDate        *time.Time `gorm:"column:due_date;type:timestamp"`
+	PaidAt         *time.Time `gorm:"column:paid_at;type:timestamp"`
+	VoidedAt       *time.Time `gorm:"column:voided_at;type:timestamp"`
+	VoidReason     string     `gorm:"column:void_reason;type:varchar(255)"`
+	CreatedAt      time.Time  `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP"`
+	UpdatedAt      time.Time  `gorm:"column:updated_at;type:timestamp;default:CURRENT_TIMESTAMP"`
+
+	// Inverse Relationships
+	Company *Company `gorm:"foreignKey:CompanyID;references:ID"`
+
+	// Relationships one to many
+	Lines []InvoiceLine `gorm:"foreignKey:InvoiceID"`
+}
+
+func (Invoice) TableName() string {
+	return "invoices"
+}
+
+// CalculateTotals recalcula los totales de la factura a partir de sus líneas, los créditos
+// nunca dejan la base imponible en negativo
+func (i *Invoice) CalculateTotals() {
+	i.Subtotal, i.SurchargeTotal, i.CreditTotal = 0, 0, 0
+	for _, line := range i.Lines {
+		switch line.Type {
+		case constants.InvoiceLineDelivery:
+			i.Subtotal += line.Amount
+		case constants.InvoiceLineSurcharge:
+			i.SurchargeTotal += line.Amount
+		case constants.InvoiceLineCredit:
+			i.CreditTotal += line.Amount
+		}
+	}
+
+	i.Subtotal = roundAmount(i.Subtotal)
+	i.SurchargeTotal = roundAmount(i.SurchargeTotal)
+	i.CreditTotal = roundAmount(i.CreditTotal)
+
+	taxable := math.Max(i.Subtotal+i.SurchargeTotal+i.CreditTotal, 0)
+	i.TaxAmount = roundAmount(taxable * i.TaxRate)
+	i.Total = roundAmount(taxable + i.TaxAmount)
+}
+
+type InvoiceLine struct {
+	ID          string    `gorm:"column:id;type:char(36);primaryKey"`
+	InvoiceID   string    `gorm:"column:invoice_id;type:char(36);not null;index"`
+	LineNumber  int       `gorm:"column:line_number;type:int;not null"`
+	OrderID     *string   `gorm:"column:order_id;type:char(36);index"`
+	Type        string    `gorm:"column:type;type:varchar(20);not null"`
+	Description string    `gorm:"column:description;type:varchar(255);not null"`
+	Quantity    int       `gorm:"column:quantity;type:int;not null;default:1"`
+	UnitPrice   float64   `gorm:"column:unit_price;type:decimal(12,2);not null"`
+	Amount      float64   `gorm:"column:amount;type:decimal(12,2);not null"`
+	CreatedAt   time.Time `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP"`
+
+	// Inverse Relationships
+	Order *Order `gorm:"foreignKey:OrderID;references:ID"`
+}
+
+func (InvoiceLine) TableName() string {
+	return "invoice_lines"
+}
+
+func roundAmount(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
@@ -0,0 +1,21 @@
+package entities
+
+import "time"
+
+// JobRun registro de cada intento de ejecución de un trabajo periódico
+type JobRun struct {
+	ID          string     `gorm:"column:id;type:char(36);primaryKey"`
+	JobName     string     `gorm:"column:job_name;type:varchar(100);not null;index"`
+	Status      string     `gorm:"column:status;type:varchar(20);not null"`
+	Attempt     int        `gorm:"column:attempt;type:int;not null;default:1"`
+	Instance    string     `gorm:"column:instance;type:varchar(255)"`
+	ScheduledAt time.Time  `gorm:"column:scheduled_at;type:timestamp;not null"`
+	StartedAt   time.Time  `gorm:"column:started_at;type:timestamp;not null"`
+	FinishedAt  *time.Time `gorm:"column:finished_at;type:timestamp"`
+	Result      string     `gorm:"column:result;type:text"`
+	Error       string     `gorm:"column:error;type:text"`
+}
+
+func (JobRun) TableName() string {
+	return "job_runs"
+}
@@ -12,6 +12,8 @@ type Notification struct {
 	Content    string     `gorm:"column:content;type:text;not null"`
 	Type       string     `gorm:"column:type;type:varchar(50);not null"`
 	Metadata   string     `gorm:"column:metadata;type:json"`
+	Channels   string     `gorm:"column:channels;type:varchar(50)"`
+	Status     string     `gorm:"column:status;type:varchar(20);not null;default:'PENDING'"`
 	IsRead     bool       `gorm:"column:is_read;type:boolean;default:false"`
 	ReadAt     *time.Time `gorm:"column:read_at;type:timestamp"`
 	SentAt     *time.Time `gorm:"column:sent_at;type:timestamp"`
@@ -20,8 +22,56 @@ type Notification struct {
 	// Relationships
 	User     *User                 `gorm:"foreignKey:UserID;references:ID"`
 	Template *NotificationTemplate `gorm:"foreignKey:TemplateID;references:ID"`
+
+	// Relationships one to many
+	Deliveries []NotificationDelivery `gorm:"foreignKey:NotificationID"`
 }
 
 func (Notification) TableName() string {
 	return "notifications"
 }
+
+// NotificationDelivery resultado del despacho de una notificación por uno de sus canales
+type NotificationDelivery struct {
+	ID             string     `gorm:"column:id;type:char(36);primaryKey"`
+	NotificationID string     `gorm:"column:notification_id;type:char(36);not null;index"`
+	Channel        string     `gorm:"column:channel;type:varchar(10);not null"`
+	Recipient      string     `gorm:"column:recipient;type:varchar(255)"`
+	Status         string     `gorm:"column:status;type:varchar(20);not null"`
+	FailureReason  string     `gorm:"column:failure_reason;type:varchar(255)"`
+	SentAt         *time.Time `gorm:"column:sent_at;type:timestamp"`
+	CreatedAt      time.Time  `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP"`
+
+	// Inverse Relationships
+	Notification *Notification `gorm:"foreignKey:NotificationID;references:ID"`
+}
+
+func (NotificationDelivery) TableName() string {
+	return "notification_deliveries"
+}
+
+// NotificationRequest datos para generar una notificación a partir de la plantilla de su tipo, no se persiste
+type NotificationRequest struct {
+	UserID string
+	Type   string
+
+	// Variables disponibles para las plantillas
+	Data map[string]string
+
+	// Variables sensibles, se envían por los canales pero se guardan enmascaradas
+	Secrets map[string]string
+
+	// Información adicional guardada con la notificación, por ejemplo el pedido relacionado
+	Metadata map[string]interface{}
+
+	// Destinos que reemplazan el correo o teléfono del usuario, por ejemplo el teléfono del destinatario del pedido
+	Email string
+	Phone string
+}
+
+// InboxEvent evento enviado en tiempo real a los clientes conectados a la bandeja de un usuario, no se persiste
+type InboxEvent struct {
+	Type         string
+	Notification *Notification
+	UnreadCount  int64
+}
@@ -2,6 +2,8 @@ package entities
 
 import (
 	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
 )
 
 type NotificationPreference struct {
@@ -20,3 +22,36 @@ type NotificationPreference struct {
 func (NotificationPreference) TableName() string {
 	return "notification_preferences"
 }
+
+// DefaultNotificationPreference preferencias aplicadas cuando el usuario no configuró las del tipo de notificación,
+// los canales dependen del rol del usuario y sin un rol conocido se habilitan correo y push
+func DefaultNotificationPreference(userID, role, notificationType string) *NotificationPreference {
+	channels, ok := constants.DefaultNotificationChannelsByRole[role]
+	if !ok {
+		channels = constants.NotificationChannelDefaults{Email: true, Push: true}
+	}
+
+	return &NotificationPreference{
+		UserID:           userID,
+		NotificationType: notificationType,
+		EmailEnabled:     channels.Email,
+		PushEnabled:      channels.Push,
+		SMSEnabled:       constants.NotificationTypesWithSMSByDefault[notificationType],
+	}
+}
+
+// EnabledChannels obtiene los canales habilitados en las preferencias
+func (p *NotificationPreference) EnabledChannels() []string {
+	channels := make([]string, 0, 3)
+	if p.EmailEnabled {
+		channels = append(channels, constants.NotificationChannelEmail)
+	}
+	if p.SMSEnabled {
+		channels = append(channels, constants.NotificationChannelSMS)
+	}
+	if p.PushEnabled {
+		channels = append(channels, constants.NotificationChannelPush)
+	}
+
+	return channels
+}
@@ -2,6 +2,8 @@ package entities
 
 import (
 	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
 )
 
 type NotificationTemplate struct {
@@ -22,3 +24,85 @@ type NotificationTemplate struct {
 func (NotificationTemplate) TableName() string {
 	return "notification_templates"
 }
+
+// defaultNotificationTemplates plantillas con las que se crea cada tipo de notificación la primera vez que se usa
+var defaultNotificationTemplates = map[string]NotificationTemplate{
+	constants.NotificationTypeDeliveryPIN: {
+		Name:            "PIN de entrega",
+		TitleTemplate:   "PIN de entrega del pedido {{.tracking_number}}",
+		ContentTemplate: "Hola {{.recipient_name}}, tu pedido {{.tracking_number}} está en camino. Comparte el PIN {{.pin}} con el repartidor al recibirlo.",
+		Variables:       `["tracking_number","recipient_name","pin"]`,
+	},
+	constants.NotificationTypeSLAAtRisk: {
+		Name:            "Pedido en riesgo de retraso",
+		TitleTemplate:   "El pedido {{.tracking_number}} podría entregarse tarde",
+		ContentTemplate: "La entrega estimada del pedido {{.tracking_number}} es {{.estimated_delivery}}, {{.delay_minutes}} minutos después del plazo de entrega ({{.delivery_deadline}}).",
+		Variables:       `["tracking_number","estimated_delivery","delivery_deadline","delay_minutes"]`,
+	},
+	constants.NotificationTypeSLABreached: {
+		Name:            "Plazo de entrega incumplido",
+		TitleTemplate:   "El pedido {{.tracking_number}} superó su plazo de entrega",
+		ContentTemplate: "El plazo de entrega del pedido {{.tracking_number}} venció el {{.delivery_deadline}} y el pedido aún no se ha entregado. Entrega estimada: {{.estimated_delivery}}.",
+		Variables:       `["tracking_number","estimated_delivery","delivery_deadline","delay_minutes"]`,
+	},
+	constants.NotificationTypeOrderDriverAssigned: {
+		Name:            "Repartidor asignado",
+		TitleTemplate:   "Tu pedido {{.tracking_number}} ya tiene repartidor",
+		ContentTemplate: "{{.driver_name}} será el encargado de entregar tu pedido {{.tracking_number}}. Sigue tu pedido en {{.tracking_url}}",
+		Variables:       `["tracking_number","driver_name","tracking_url"]`,
+	},
+	constants.NotificationTypeDriverOrderAssigned: {
+		Name:            "Pedido asignado al repartidor",
+		TitleTemplate:   "Se te asignó el pedido {{.tracking_number}}",
+		ContentTemplate: "Tienes un nuevo pedido asignado, {{.tracking_number}}, con entrega en {{.delivery_address}} antes del {{.delivery_deadline}}.",
+		Variables:       `["tracking_number","delivery_address","delivery_deadline"]`,
+	},
+	constants.NotificationTypeOrderOutForDelivery: {
+		Name:            "Pedido en camino",
+		TitleTemplate:   "Tu pedido {{.tracking_number}} va en camino",
+		ContentTemplate: "Hola {{.recipient_name}}, tu pedido {{.tracking_number}} salió a entrega. Síguelo en {{.tracking_url}}",
+		Variables:       `["tracking_number","recipient_name","tracking_url"]`,
+	},
+	constants.NotificationTypeOrderDelivered: {
+		Name:            "Pedido entregado",
+		TitleTemplate:   "Tu pedido {{.tracking_number}} fue entregado",
+		ContentTemplate: "Hola {{.recipient_name}}, tu pedido {{.tracking_number}} fue entregado. Consulta el detalle en {{.tracking_url}}",
+		Variables:       `["tracking_number","recipient_name","tracking_url"]`,
+	},
+	constants.NotificationTypeOrderAttemptFailed: {
+		Name:            "Intento de entrega fallido",
+		TitleTemplate:   "No pudimos entregar el pedido {{.tracking_number}}",
+		ContentTemplate: "El intento de entrega {{.attempt_number}} del pedido {{.tracking_number}} no se completó ({{.reason}}). {{.next_attempt}} Sigue el pedido en {{.tracking_url}}",
+		Variables:       `["tracking_number","attempt_number","reason","next_attempt","tracking_url"]`,
+	},
+	constants.NotificationTypeOrderReturned: {
+		Name:            "Pedido devuelto al remitente",
+		TitleTemplate:   "El pedido {{.tracking_number}} será devuelto al remitente",
+		ContentTemplate: "El pedido {{.tracking_number}} no pudo entregarse y será devuelto al remitente. Consulta el detalle en {{.tracking_url}}",
+		Variables:       `["tracking_number","tracking_url"]`,
+	},
+	constants.NotificationTypeOrderCancelled: {
+		Name:            "Pedido cancelado",
+		TitleTemplate:   "El pedido {{.tracking_number}} fue cancelado",
+		ContentTemplate: "El pedido {{.tracking_number}} fue cancelado y no será entregado.",
+		Variables:       `["tracking_number"]`,
+	},
+	constants.NotificationTypeOrderLost: {
+		Name:            "Pedido extraviado",
+		TitleTemplate:   "El pedido {{.tracking_number}} fue reportado como extraviado",
+		ContentTemplate: "El pedido {{.tracking_number}} de la sucursal {{.branch_name}} fue marcado como extraviado. Nuestro equipo de operaciones dará seguimiento al caso.",
+		Variables:       `["tracking_number","branch_name"]`,
+	},
+}
+
+// DefaultNotificationTemplate obtiene la plantilla por defecto de un tipo de notificación, nil si el tipo no tiene una
+func DefaultNotificationTemplate(notificationType string) *NotificationTemplate {
+	template, ok := defaultNotificationTemplates[notificationType]
+	if !ok {
+		return nil
+	}
+
+	template.Type = notificationType
+	template.IsActive = true
+	return &template
+}
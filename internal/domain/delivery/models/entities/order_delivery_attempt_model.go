@@ -0,0 +1,29 @@
+package entities
+
+import (
+	"time"
+)
+
+type DeliveryAttempt struct {
+	ID            string     `gorm:"column:id;type:char(36);primaryKey"`
+	OrderID       string     `gorm:"column:order_id;type:char(36);not null;index;uniqueIndex:idx_delivery_attempts_order_attempt,priority:1"`
+	DriverID      *string    `gorm:"column:driver_id;type:char(36)"`
+	AttemptNumber int        `gorm:"column:attempt_number;type:int;not null;uniqueIndex:idx_delivery_attempts_order_attempt,priority:2"`
+	ReasonCode    string     `gorm:"column:reason_code;type:varchar(30);not null"`
+	Notes         string     `gorm:"column:notes;type:text"`
+	AttemptedAt   time.Time  `gorm:"column:attempted_at;type:timestamp;not null"`
+	NextAttemptAt *time.Time `gorm:"column:next_attempt_at;type:timestamp null"`
+	CreatedAt     time.Time  `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP"`
+
+	// Inverse Relationships
+	Order *Order `gorm:"foreignKey:OrderID;references:ID"`
+}
+
+func (DeliveryAttempt) TableName() string {
+	return "order_delivery_attempts"
+}
+
+// IsFinal indica si el intento agotó los reintentos y el pedido se devuelve al remitente
+func (a *DeliveryAttempt) IsFinal() bool {
+	return a.NextAttemptAt == nil
+}
@@ -0,0 +1,23 @@
+package entities
+
+import (
+	"time"
+)
+
+type DeliveryPIN struct {
+	OrderID        string     `gorm:"column:order_id;type:char(36);primaryKey"`
+	PINHash        string     `gorm:"column:pin_hash;type:varchar(255);not null"`
+	FailedAttempts int        `gorm:"column:failed_attempts;type:int;default:0"`
+	IsLocked       bool       `gorm:"column:is_locked;type:boolean;default:false"`
+	LockedAt       *time.Time `gorm:"column:locked_at;type:timestamp null"`
+	VerifiedAt     *time.Time `gorm:"column:verified_at;type:timestamp null"`
+	CreatedAt      time.Time  `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP"`
+	UpdatedAt      time.Time  `gorm:"column:updated_at;type:timestamp;default:CURRENT_TIMESTAMP"`
+
+	// Inverse Relationships
+	Order *Order `gorm:"foreignKey:OrderID;references:ID"`
+}
+
+func (DeliveryPIN) TableName() string {
+	return "order_delivery_pins"
+}
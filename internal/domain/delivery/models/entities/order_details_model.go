@@ -5,16 +5,21 @@ import (
 )
 
 type Details struct {
-	OrderID           string     `gorm:"column:order_id;type:char(36);primaryKey"`
-	Price             float64    `gorm:"column:price;type:decimal(10,2);not null"`
-	Distance          float64    `gorm:"column:distance;type:decimal(10,2);not null"`
-	PickupTime        time.Time  `gorm:"column:pickup_time;type:timestamp;not null"`
-	DeliveryDeadline  time.Time  `gorm:"column:delivery_deadline;type:timestamp;not null"`
-	DeliveredAt       *time.Time `gorm:"column:delivered_at;type:timestamp"`
-	RequiresSignature bool       `gorm:"column:requires_signature;type:boolean;default:false"`
-	DeliveryNotes     string     `gorm:"column:delivery_notes;type:varchar(200)"`
-	CreatedAt         time.Time  `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP"`
-	UpdatedAt         time.Time  `gorm:"column:updated_at;type:timestamp;default:CURRENT_TIMESTAMP"`
+	OrderID             string     `gorm:"column:order_id;type:char(36);primaryKey"`
+	Price               float64    `gorm:"column:price;type:decimal(10,2);not null"`
+	Distance            float64    `gorm:"column:distance;type:decimal(10,2);not null"`
+	PickupTime          time.Time  `gorm:"column:pickup_time;type:timestamp;not null"`
+	DeliveryDeadline    time.Time  `gorm:"column:delivery_deadline;type:timestamp;not null"`
+	DeliveredAt         *time.Time `gorm:"column:delivered_at;type:timestamp"`
+	RequiresSignature   bool       `gorm:"column:requires_signature;type:boolean;default:false"`
+	RequiresDeliveryPIN bool       `gorm:"column:requires_delivery_pin;type:boolean;default:false"`
+	DeliveryNotes       string     `gorm:"column:delivery_notes;type:varchar(200)"`
+	CODAmount           float64    `gorm:"column:cod_amount;type:decimal(10,2);default:0"`
+	CODCurrency         string     `gorm:"column:cod_currency;type:char(3)"`
+	SLAStatus           string     `gorm:"column:sla_status;type:varchar(20);default:'ON_TRACK'"`
+	SLAEvaluatedAt      *time.Time `gorm:"column:sla_evaluated_at;type:timestamp"`
+	CreatedAt           time.Time  `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP"`
+	UpdatedAt           time.Time  `gorm:"column:updated_at;type:timestamp;default:CURRENT_TIMESTAMP"`
 
 	// Inverse Relationships
 	Order *Order `gorm:"foreignKey:OrderID;references:ID"`
@@ -23,3 +28,8 @@ type Details struct {
 func (Details) TableName() string {
 	return "order_details"
 }
+
+// HasCashOnDelivery indica si el repartidor debe cobrar el pedido al entregarlo
+func (d *Details) HasCashOnDelivery() bool {
+	return d.CODAmount > 0
+}
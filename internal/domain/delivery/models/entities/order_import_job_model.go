@@ -0,0 +1,38 @@
+package entities
+
+import (
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"time"
+)
+
+type ImportJob struct {
+	ID           string     `gorm:"column:id;type:char(36);primaryKey"`
+	CompanyID    string     `gorm:"column:company_id;type:char(36);not null;index"`
+	BranchID     string     `gorm:"column:branch_id;type:char(36);not null"`
+	CreatedByID  string     `gorm:"column:created_by_id;type:char(36);not null"`
+	Format       string     `gorm:"column:format;type:varchar(10);not null"`
+	Status       string     `gorm:"column:status;type:varchar(20);not null;index"`
+	Payload      string     `gorm:"column:payload;type:longtext;not null"`
+	Report       string     `gorm:"column:report;type:longtext"`
+	TotalRows    int        `gorm:"column:total_rows;type:int;not null"`
+	CreatedRows  int        `gorm:"column:created_rows;type:int;default:0"`
+	FailedRows   int        `gorm:"column:failed_rows;type:int;default:0"`
+	ErrorMessage string     `gorm:"column:error_message;type:text"`
+	StartedAt    *time.Time `gorm:"column:started_at;type:timestamp null"`
+	FinishedAt   *time.Time `gorm:"column:finished_at;type:timestamp null"`
+	CreatedAt    time.Time  `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP"`
+	UpdatedAt    time.Time  `gorm:"column:updated_at;type:timestamp;default:CURRENT_TIMESTAMP"`
+
+	// Inverse Relationships
+	Company *Company `gorm:"foreignKey:CompanyID;references:ID"`
+	Branch  *Branch  `gorm:"foreignKey:BranchID;references:ID"`
+}
+
+func (ImportJob) TableName() string {
+	return "order_import_jobs"
+}
+
+// IsFinished indica si la importación ya terminó y su reporte está disponible
+func (j *ImportJob) IsFinished() bool {
+	return j.Status == constants.ImportStatusCompleted || j.Status == constants.ImportStatusFailed
+}
@@ -1,6 +1,7 @@
 package entities
 
 import (
+	"fmt"
 	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
 	"time"
 )
@@ -11,8 +12,10 @@ type Order struct {
 	BranchID       string     `gorm:"column:branch_id;type:char(36);not null"`
 	ClientID       string     `gorm:"column:client_id;type:char(36);not null"`
 	DriverID       *string    `gorm:"column:driver_id;type:char(36)"`
-	TrackingNumber string     `gorm:"column:tracking_number;type:varchar(50);not null"`
+	TrackingNumber string     `gorm:"column:tracking_number;type:varchar(50);not null;uniqueIndex"`
 	Status         string     `gorm:"column:status;type:varchar(20);not null"`
+	IsFlagged      bool       `gorm:"column:is_flagged;type:boolean;default:false"`
+	FlagReason     string     `gorm:"column:flag_reason;type:varchar(50)"`
 	CreatedAt      time.Time  `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP"`
 	UpdatedAt      time.Time  `gorm:"column:updated_at;type:timestamp;default:CURRENT_TIMESTAMP"`
 	DeletedAt      *time.Time `gorm:"column:deleted_at;type:timestamp;index"`
@@ -30,9 +33,13 @@ type Order struct {
 	PickupAddress   *PickupAddress   `gorm:"foreignKey:OrderID"`
 	Tracking        *Tracking        `gorm:"foreignKey:OrderID"`
 	QRCode          *QRCode          `gorm:"foreignKey:OrderID"`
+	DeliveryPIN     *DeliveryPIN     `gorm:"foreignKey:OrderID"`
+	Payment         *OrderPayment    `gorm:"foreignKey:OrderID"`
 
 	// Relationships one to many
+	Parcels            []Parcel          `gorm:"foreignKey:OrderID"`
 	StatusHistory      []StatusHistory   `gorm:"foreignKey:OrderID"`
+	DeliveryAttempts   []DeliveryAttempt `gorm:"foreignKey:OrderID"`
 	WarehouseTrackings []PackageTracking `gorm:"foreignKey:OrderID"`
 	WarehouseInventory []Inventory       `gorm:"foreignKey:OrderID"`
 }
@@ -72,3 +79,11 @@ func (o *Order) Validate() error {
 
 	return nil
 }
+
+// AssignParcelTrackingNumbers asigna a cada bulto el número de seguimiento del pedido con su secuencia
+func (o *Order) AssignParcelTrackingNumbers() {
+	for i := range o.Parcels {
+		o.Parcels[i].OrderID = o.ID
+		o.Parcels[i].TrackingNumber = fmt.Sprintf("%s-%02d", o.TrackingNumber, o.Parcels[i].Sequence)
+	}
+}
@@ -0,0 +1,24 @@
+package entities
+
+import "time"
+
+type Parcel struct {
+	ID             string    `gorm:"column:id;type:char(36);primaryKey"`
+	OrderID        string    `gorm:"column:order_id;type:char(36);not null;index"`
+	Sequence       int       `gorm:"column:sequence;type:int;not null"`
+	TrackingNumber string    `gorm:"column:tracking_number;type:varchar(60);not null;uniqueIndex"`
+	Status         string    `gorm:"column:status;type:varchar(20);not null"`
+	IsFragile      bool      `gorm:"column:is_fragile;type:boolean;default:false"`
+	Weight         float64   `gorm:"column:weight;type:decimal(10,2)"`
+	Dimensions     string    `gorm:"column:dimensions;type:json"`
+	Description    string    `gorm:"column:description;type:varchar(200)"`
+	CreatedAt      time.Time `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP"`
+	UpdatedAt      time.Time `gorm:"column:updated_at;type:timestamp;default:CURRENT_TIMESTAMP"`
+
+	// Inverse Relationships
+	Order *Order `gorm:"foreignKey:OrderID;references:ID"`
+}
+
+func (Parcel) TableName() string {
+	return "order_parcels"
+}
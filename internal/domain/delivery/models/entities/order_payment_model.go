@@ -0,0 +1,54 @@
+package entities
+
+import "time"
+
+// OrderPayment pago anticipado de un pedido a través del proveedor de pagos
+type OrderPayment struct {
+	ID                string     `gorm:"column:id;type:char(36);primaryKey"`
+	OrderID           string     `gorm:"column:order_id;type:char(36);not null;uniqueIndex"`
+	Provider          string     `gorm:"column:provider;type:varchar(30);not null"`
+	ProviderPaymentID string     `gorm:"column:provider_payment_id;type:varchar(100);index"`
+	Status            string     `gorm:"column:status;type:varchar(20);not null"`
+	Amount            float64    `gorm:"column:amount;type:decimal(10,2);not null"`
+	Currency          string     `gorm:"column:currency;type:char(3);not null"`
+	RefundedAmount    float64    `gorm:"column:refunded_amount;type:decimal(10,2);default:0"`
+	FailureReason     string     `gorm:"column:failure_reason;type:varchar(255)"`
+	AuthorizedAt      *time.Time `gorm:"column:authorized_at;type:timestamp"`
+	CapturedAt        *time.Time `gorm:"column:captured_at;type:timestamp"`
+	RefundedAt        *time.Time `gorm:"column:refunded_at;type:timestamp"`
+	CreatedAt         time.Time  `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP"`
+	UpdatedAt         time.Time  `gorm:"column:updated_at;type:timestamp;default:CURRENT_TIMESTAMP"`
+
+	// Token de un solo uso del medio de pago, nunca se persiste
+	Token string `gorm:"-"`
+
+	// Inverse Relationships
+	Order *Order `gorm:"foreignKey:OrderID;references:ID"`
+}
+
+func (OrderPayment) TableName() string {
+	return "order_payments"
+}
+
+// PaymentWebhookEvent evento recibido del proveedor de pagos, su ID evita procesarlo más de una vez
+type PaymentWebhookEvent struct {
+	ID                string    `gorm:"column:id;type:varchar(100);primaryKey"`
+	Provider          string    `gorm:"column:provider;type:varchar(30);not null"`
+	Type              string    `gorm:"column:type;type:varchar(50);not null"`
+	ProviderPaymentID string    `gorm:"column:provider_payment_id;type:varchar(100);not null;index"`
+	Amount            float64   `gorm:"column:amount;type:decimal(10,2)"`
+	FailureReason     string    `gorm:"column:failure_reason;type:varchar(255)"`
+	ReceivedAt        time.Time `gorm:"column:received_at;type:timestamp;default:CURRENT_TIMESTAMP"`
+}
+
+func (PaymentWebhookEvent) TableName() string {
+	return "payment_webhook_events"
+}
+
+// GatewayResult respuesta del proveedor de pagos a una operación
+type GatewayResult struct {
+	ProviderPaymentID string
+	Status            string
+	Amount            float64
+	FailureReason     string
+}
@@ -0,0 +1,41 @@
+package entities
+
+import (
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"time"
+)
+
+type OrderReturn struct {
+	ID              string     `gorm:"column:id;type:char(36);primaryKey"`
+	OrderID         string     `gorm:"column:order_id;type:char(36);not null;index"`
+	CompanyID       string     `gorm:"column:company_id;type:char(36);not null;index"`
+	TrackingNumber  string     `gorm:"column:tracking_number;type:varchar(50);not null;uniqueIndex"`
+	Status          string     `gorm:"column:status;type:varchar(20);not null"`
+	InitiatedBy     string     `gorm:"column:initiated_by;type:varchar(20);not null"`
+	RequestedByID   string     `gorm:"column:requested_by_id;type:char(36);not null"`
+	ReasonCode      string     `gorm:"column:reason_code;type:varchar(30);not null"`
+	Notes           string     `gorm:"column:notes;type:text"`
+	DriverID        *string    `gorm:"column:driver_id;type:char(36)"`
+	DestinationType string     `gorm:"column:destination_type;type:varchar(20);not null"`
+	DestinationID   string     `gorm:"column:destination_id;type:char(36);not null"`
+	PickupName      string     `gorm:"column:pickup_name;type:varchar(255);not null"`
+	PickupPhone     string     `gorm:"column:pickup_phone;type:varchar(20);not null"`
+	PickupAddress   string     `gorm:"column:pickup_address;type:varchar(500);not null"`
+	PickedUpAt      *time.Time `gorm:"column:picked_up_at;type:timestamp null"`
+	ReceivedAt      *time.Time `gorm:"column:received_at;type:timestamp null"`
+	CreatedAt       time.Time  `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP"`
+	UpdatedAt       time.Time  `gorm:"column:updated_at;type:timestamp;default:CURRENT_TIMESTAMP"`
+
+	// Inverse Relationships
+	Order   *Order   `gorm:"foreignKey:OrderID;references:ID"`
+	Company *Company `gorm:"foreignKey:CompanyID;references:ID"`
+}
+
+func (OrderReturn) TableName() string {
+	return "order_returns"
+}
+
+// IsActive indica si la devolución sigue en curso
+func (r *OrderReturn) IsActive() bool {
+	return r.Status != constants.ReturnStatusReceived && r.Status != constants.ReturnStatusCancelled
+}
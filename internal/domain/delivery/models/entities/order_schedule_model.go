@@ -0,0 +1,44 @@
+package entities
+
+import (
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"time"
+)
+
+type OrderSchedule struct {
+	ID                    string     `gorm:"column:id;type:char(36);primaryKey"`
+	CompanyID             string     `gorm:"column:company_id;type:char(36);not null;index"`
+	BranchID              string     `gorm:"column:branch_id;type:char(36);not null"`
+	CreatedByID           string     `gorm:"column:created_by_id;type:char(36);not null"`
+	Name                  string     `gorm:"column:name;type:varchar(100);not null"`
+	OrderTemplate         string     `gorm:"column:order_template;type:json;not null"`
+	RunAt                 *time.Time `gorm:"column:run_at;type:timestamp null"`
+	DaysOfWeek            string     `gorm:"column:days_of_week;type:varchar(20)"`
+	TimeOfDay             string     `gorm:"column:time_of_day;type:varchar(5)"`
+	DeliveryWindowMinutes int        `gorm:"column:delivery_window_minutes;type:int;not null"`
+	Status                string     `gorm:"column:status;type:varchar(20);not null;index"`
+	NextRunAt             *time.Time `gorm:"column:next_run_at;type:timestamp null;index"`
+	LastRunAt             *time.Time `gorm:"column:last_run_at;type:timestamp null"`
+	LastOrderID           *string    `gorm:"column:last_order_id;type:char(36)"`
+	LastError             string     `gorm:"column:last_error;type:text"`
+	CreatedAt             time.Time  `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP"`
+	UpdatedAt             time.Time  `gorm:"column:updated_at;type:timestamp;default:CURRENT_TIMESTAMP"`
+
+	// Inverse Relationships
+	Company *Company `gorm:"foreignKey:CompanyID;references:ID"`
+	Branch  *Branch  `gorm:"foreignKey:BranchID;references:ID"`
+}
+
+func (OrderSchedule) TableName() string {
+	return "order_schedules"
+}
+
+// IsRecurring indica si la programación se repite semanalmente o es de una sola ejecución
+func (s *OrderSchedule) IsRecurring() bool {
+	return s.DaysOfWeek != ""
+}
+
+// IsEditable indica si la programación aún puede modificarse
+func (s *OrderSchedule) IsEditable() bool {
+	return s.Status == constants.ScheduleStatusActive || s.Status == constants.ScheduleStatusPaused
+}
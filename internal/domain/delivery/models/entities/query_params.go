@@ -25,3 +25,11 @@ type UserQueryParams struct {
 
 	PaginationQueryParams
 }
+
+type NotificationQueryParams struct {
+	// Filtros
+	Type   string `json:"type,omitempty"`
+	IsRead *bool  `json:"is_read,omitempty"`
+
+	PaginationQueryParams
+}
@@ -0,0 +1,26 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+)
+
+// SLAEvaluation resultado de evaluar el plazo de entrega de un pedido activo, no se persiste
+type SLAEvaluation struct {
+	Order *Order
+
+	// Fecha estimada de entrega según el tiempo máximo de entrega de la zona
+	EstimatedDelivery time.Time
+
+	// Estado del SLA: ON_TRACK, AT_RISK o BREACHED
+	Status string
+
+	// Minutos que la entrega estimada excede el plazo de entrega
+	DelayMinutes int
+}
+
+// IsAlert indica si el pedido requiere atención por estar en riesgo o haber incumplido su plazo
+func (e *SLAEvaluation) IsAlert() bool {
+	return e.Status == constants.SLAStatusAtRisk || e.Status == constants.SLAStatusBreached
+}
@@ -1,17 +1,26 @@
 package entities
 
 import (
+	"encoding/json"
 	"time"
+
+	"github.com/google/uuid"
 )
 
+// SystemEvent evento de dominio guardado en el outbox en la misma transacción que el cambio que lo genera,
+// el relay lo publica a los suscriptores y lo marca como publicado
 type SystemEvent struct {
-	ID         string    `gorm:"column:id;type:char(36);primaryKey"`
-	EventType  string    `gorm:"column:event_type;type:varchar(50);not null"`
-	Source     string    `gorm:"column:source;type:varchar(50);not null"`
-	SourceID   string    `gorm:"column:source_id;type:char(36);not null"`
-	EventData  string    `gorm:"column:event_data;type:json;not null"`
-	Severity   string    `gorm:"column:severity;type:varchar(20);not null;default:INFO"`
-	OccurredAt time.Time `gorm:"column:occurred_at;type:timestamp;default:CURRENT_TIMESTAMP"`
+	ID            string     `gorm:"column:id;type:char(36);primaryKey"`
+	EventType     string     `gorm:"column:event_type;type:varchar(50);not null"`
+	Source        string     `gorm:"column:source;type:varchar(50);not null"`
+	SourceID      string     `gorm:"column:source_id;type:char(36);not null"`
+	EventData     string     `gorm:"column:event_data;type:json;not null"`
+	Severity      string     `gorm:"column:severity;type:varchar(20);not null;default:INFO"`
+	OccurredAt    time.Time  `gorm:"column:occurred_at;type:timestamp;default:CURRENT_TIMESTAMP"`
+	PublishedAt   *time.Time `gorm:"column:published_at;type:timestamp;index"`
+	Attempts      int        `gorm:"column:attempts;type:int;default:0"`
+	NextAttemptAt *time.Time `gorm:"column:next_attempt_at;type:timestamp"`
+	LastError     string     `gorm:"column:last_error;type:text"`
 
 	// Relationships
 	Logs []EventLog `gorm:"foreignKey:EventID"`
@@ -20,3 +29,28 @@ type SystemEvent struct {
 func (SystemEvent) TableName() string {
 	return "system_events"
 }
+
+// NewSystemEvent crea un evento de dominio pendiente de publicar con los datos serializados
+func NewSystemEvent(eventType, source, sourceID, severity string, data map[string]interface{}) *SystemEvent {
+	eventData, err := json.Marshal(data)
+	if err != nil || data == nil {
+		eventData = []byte("{}")
+	}
+
+	return &SystemEvent{
+		ID:         uuid.NewString(),
+		EventType:  eventType,
+		Source:     source,
+		SourceID:   sourceID,
+		EventData:  string(eventData),
+		Severity:   severity,
+		OccurredAt: time.Now(),
+	}
+}
+
+// Data obtiene los datos del evento
+func (e *SystemEvent) Data() map[string]interface{} {
+	data := make(map[string]interface{})
+	_ = json.Unmarshal([]byte(e.EventData), &data)
+	return data
+}
@@ -0,0 +1,102 @@
+package entities
+
+import (
+	"strings"
+	"time"
+)
+
+// WebhookEndpoint dirección de una empresa que recibe los eventos de sus pedidos firmados con su secreto
+type WebhookEndpoint struct {
+	ID                  string     `gorm:"column:id;type:char(36);primaryKey"`
+	CompanyID           string     `gorm:"column:company_id;type:char(36);not null;index"`
+	URL                 string     `gorm:"column:url;type:varchar(500);not null"`
+	Secret              string     `gorm:"column:secret;type:varchar(100);not null"`
+	Events              string     `gorm:"column:events;type:varchar(500);not null"`
+	Description         string     `gorm:"column:description;type:varchar(255)"`
+	IsActive            bool       `gorm:"column:is_active;type:boolean;default:true"`
+	ConsecutiveFailures int        `gorm:"column:consecutive_failures;type:int;default:0"`
+	DisabledAt          *time.Time `gorm:"column:disabled_at;type:timestamp"`
+	DisabledReason      string     `gorm:"column:disabled_reason;type:varchar(255)"`
+	CreatedBy           string     `gorm:"column:created_by;type:char(36)"`
+	CreatedAt           time.Time  `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP"`
+	UpdatedAt           time.Time  `gorm:"column:updated_at;type:timestamp;default:CURRENT_TIMESTAMP"`
+	DeletedAt           *time.Time `gorm:"column:deleted_at;type:timestamp"`
+
+	// Inverse Relationships
+	Company *Company `gorm:"foreignKey:CompanyID;references:ID"`
+}
+
+func (WebhookEndpoint) TableName() string {
+	return "webhook_endpoints"
+}
+
+// EventList obtiene los eventos a los que está suscrito el webhook
+func (w *WebhookEndpoint) EventList() []string {
+	if w.Events == "" {
+		return nil
+	}
+
+	return strings.Split(w.Events, ",")
+}
+
+// Subscribes indica si el webhook recibe el evento
+func (w *WebhookEndpoint) Subscribes(event string) bool {
+	for _, subscribed := range w.EventList() {
+		if subscribed == event {
+			return true
+		}
+	}
+
+	return false
+}
+
+// WebhookDelivery entrega de un evento a un webhook, registra cada intento con la respuesta obtenida
+type WebhookDelivery struct {
+	ID            string     `gorm:"column:id;type:char(36);primaryKey"`
+	EndpointID    string     `gorm:"column:endpoint_id;type:char(36);not null;index"`
+	EventID       string     `gorm:"column:event_id;type:char(36);not null;index"`
+	Event         string     `gorm:"column:event;type:varchar(50);not null"`
+	Payload       string     `gorm:"column:payload;type:text;not null"`
+	Status        string     `gorm:"column:status;type:varchar(20);not null;index"`
+	Attempts      int        `gorm:"column:attempts;type:int;default:0"`
+	ResponseCode  *int       `gorm:"column:response_code;type:int"`
+	ResponseBody  string     `gorm:"column:response_body;type:text"`
+	ErrorMessage  string     `gorm:"column:error_message;type:varchar(255)"`
+	DurationMs    int64      `gorm:"column:duration_ms;type:bigint"`
+	NextAttemptAt *time.Time `gorm:"column:next_attempt_at;type:timestamp;index"`
+	LastAttemptAt *time.Time `gorm:"column:last_attempt_at;type:timestamp"`
+	DeliveredAt   *time.Time `gorm:"column:delivered_at;type:timestamp"`
+	RedeliveryOf  *string    `gorm:"column:redelivery_of;type:char(36)"`
+	CreatedAt     time.Time  `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP"`
+	UpdatedAt     time.Time  `gorm:"column:updated_at;type:timestamp;default:CURRENT_TIMESTAMP"`
+
+	// Inverse Relationships
+	Endpoint *WebhookEndpoint `gorm:"foreignKey:EndpointID;references:ID"`
+}
+
+func (WebhookDelivery) TableName() string {
+	return "webhook_deliveries"
+}
+
+// WebhookEvent evento de un pedido publicado a los webhooks de su empresa, no se persiste
+type WebhookEvent struct {
+	ID        string                 `json:"id"`
+	Type      string                 `json:"event"`
+	CompanyID string                 `json:"company_id"`
+	CreatedAt time.Time              `json:"created_at"`
+	Data      map[string]interface{} `json:"data"`
+}
+
+// WebhookRequest solicitud HTTP firmada que se envía al webhook, no se persiste
+type WebhookRequest struct {
+	URL     string
+	Headers map[string]string
+	Body    []byte
+}
+
+// WebhookResponse respuesta del webhook a un intento de entrega, no se persiste
+type WebhookResponse struct {
+	StatusCode int
+	Body       string
+	Duration   time.Duration
+}
@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+type CashRepository interface {
+	GetLedgerEntries(ctx context.Context, driverID string, start, end time.Time) ([]entities.CashLedgerEntry, error)
+	GetBalances(ctx context.Context, driverID string) ([]entities.CashBalance, error)
+	GetPendingBalance(ctx context.Context, driverID, currency string, until time.Time) (float64, error)
+	CreateReconciliation(ctx context.Context, reconciliation *entities.CashReconciliation, handIn *entities.CashLedgerEntry) error
+	GetReconciliations(ctx context.Context, driverID string) ([]entities.CashReconciliation, error)
+	GetCompanyCollections(ctx context.Context, companyID string, start, end time.Time) ([]entities.CashLedgerEntry, error)
+}
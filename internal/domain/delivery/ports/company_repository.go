@@ -16,12 +16,15 @@ type CompanyRepository interface {
 	GetCompanyByID(ctx context.Context, id string) (*entities.Company, error)
 	CreateCompany(ctx context.Context, company *entities.Company) error
 	UpdateCompany(ctx context.Context, company *entities.Company) error
-	DeactivateCompany(ctx context.Context, id string) error
-	ReactivateCompany(ctx context.Context, id string) error
+	DeactivateCompany(ctx context.Context, id string, event *entities.SystemEvent) error
+	ReactivateCompany(ctx context.Context, id string, event *entities.SystemEvent) error
 	AddCompanyAddress(ctx context.Context, address *entities.CompanyAddress) error
 	UpdateCompanyAddress(ctx context.Context, address *entities.CompanyAddress) error
+	UnsetMainAddresses(ctx context.Context, companyID, exceptID string) error
 	DeleteCompanyAddress(ctx context.Context, addressID string) error
 	GetCompanies(ctx context.Context, params *entities.CompanyQueryParams) ([]entities.Company, int64, error)
+	GetActiveCompanyIDs(ctx context.Context) ([]string, error)
+	GetTrackingPrefix(ctx context.Context, companyID string) (string, error)
 
 	// Métodos para verificaciones
 	ExistsTaxID(ctx context.Context, taxID string, excludeID string) (bool, error)
@@ -0,0 +1,24 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+type EarningRepository interface {
+	GetEarningRules(ctx context.Context) ([]entities.EarningRule, error)
+	GetEarningRuleByID(ctx context.Context, id string) (*entities.EarningRule, error)
+	GetEarningRuleForZone(ctx context.Context, zoneID string) (*entities.EarningRule, error)
+	CreateEarningRule(ctx context.Context, rule *entities.EarningRule) error
+	UpdateEarningRule(ctx context.Context, rule *entities.EarningRule) error
+	ExistsDriver(ctx context.Context, driverID string) (bool, error)
+	CreateEarning(ctx context.Context, earning *entities.DriverEarning) error
+	GetEarnings(ctx context.Context, driverID string, start, end time.Time) ([]entities.DriverEarning, error)
+	GetUnsettledEarnings(ctx context.Context, driverID string, end time.Time) ([]entities.DriverEarning, error)
+	GetDriversWithUnsettledEarnings(ctx context.Context, end time.Time) ([]string, error)
+	CreatePayoutStatement(ctx context.Context, statement *entities.PayoutStatement) error
+	GetPayoutStatementByID(ctx context.Context, id string) (*entities.PayoutStatement, error)
+	GetPayoutStatementsByDriver(ctx context.Context, driverID string) ([]entities.PayoutStatement, error)
+}
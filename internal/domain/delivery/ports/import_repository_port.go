@@ -0,0 +1,15 @@
+package ports
+
+import (
+	"context"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+type ImportRepository interface {
+	CreateImportJob(ctx context.Context, job *entities.ImportJob) error
+	GetImportJobByID(ctx context.Context, id string) (*entities.ImportJob, error)
+	GetImportJobsByCompany(ctx context.Context, companyID string) ([]entities.ImportJob, error)
+	GetPendingImportJobs(ctx context.Context, limit int) ([]entities.ImportJob, error)
+	ClaimImportJob(ctx context.Context, id string) (bool, error)
+	FinishImportJob(ctx context.Context, job *entities.ImportJob) error
+}
@@ -0,0 +1,20 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+type InvoiceRepository interface {
+	CreateInvoice(ctx context.Context, invoice *entities.Invoice) error
+	GetInvoiceByID(ctx context.Context, id string) (*entities.Invoice, error)
+	GetInvoicesByCompany(ctx context.Context, companyID string, statuses []string) ([]entities.Invoice, error)
+	UpdateInvoiceStatus(ctx context.Context, invoice *entities.Invoice) error
+	ExistsInvoiceForPeriod(ctx context.Context, companyID string, start, end time.Time) (bool, error)
+	LockCompanyBilling(ctx context.Context, companyID string) error
+	GetBillableOrders(ctx context.Context, companyID string, start, end time.Time) ([]entities.Order, error)
+	GetLostOrders(ctx context.Context, companyID string, start, end time.Time) ([]entities.Order, error)
+	GetBillableCompanies(ctx context.Context) ([]entities.Company, error)
+}
@@ -0,0 +1,13 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+type JobRepository interface {
+	CreateJobRun(ctx context.Context, run *entities.JobRun) error
+	UpdateJobRun(ctx context.Context, run *entities.JobRun) error
+	GetJobRuns(ctx context.Context, jobName string, params *entities.PaginationQueryParams) ([]entities.JobRun, int64, error)
+}
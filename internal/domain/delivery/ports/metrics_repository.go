@@ -3,6 +3,8 @@ package ports
 import (
 	"context"
 	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
 )
 
 // MetricsRepository define los métodos para obtener métricas empresariales y de sucursales
@@ -10,15 +12,22 @@ type MetricsRepository interface {
 	// Métricas de Empresa
 	GetOrderCountByCompany(ctx context.Context, companyID string, startDate, endDate time.Time) (total, completed, cancelled int64, err error)
 	GetAverageDeliveryTimeByCompany(ctx context.Context, companyID string, startDate, endDate time.Time) (float64, error)
+	GetOnTimeDeliveriesByCompany(ctx context.Context, companyID string, startDate, endDate time.Time) (delivered, onTime int64, err error)
 	GetTotalRevenueByCompany(ctx context.Context, companyID string, startDate, endDate time.Time) (float64, error)
 	GetActiveBranchesCountByCompany(ctx context.Context, companyID string) (int, error)
 	GetUniqueCustomersByCompany(ctx context.Context, companyID string, startDate, endDate time.Time) (int, error)
+	GetReturnsByReasonByCompany(ctx context.Context, companyID string, startDate, endDate time.Time) (map[string]int64, error)
 
 	// Métricas de Sucursal
 	GetOrderCountByBranch(ctx context.Context, branchID string, startDate, endDate time.Time) (total, completed, cancelled int64, err error)
 	GetAverageDeliveryTimeByBranch(ctx context.Context, branchID string, startDate, endDate time.Time) (float64, error)
+	GetOnTimeDeliveriesByBranch(ctx context.Context, branchID string, startDate, endDate time.Time) (delivered, onTime int64, err error)
 	GetTotalRevenueByBranch(ctx context.Context, branchID string, startDate, endDate time.Time) (float64, error)
 	GetActiveDriversCountByBranch(ctx context.Context, branchID string) (int, error)
 	GetUniqueCustomersByBranch(ctx context.Context, branchID string, startDate, endDate time.Time) (int, error)
 	GetPeakHourOrderRateByBranch(ctx context.Context, branchID string, date time.Time) (float64, error)
+
+	// Métricas precalculadas
+	SaveCompanyMetricsSnapshot(ctx context.Context, snapshot *entities.CompanyMetricsSnapshot) error
+	GetCompanyMetricsSnapshot(ctx context.Context, companyID string) (*entities.CompanyMetricsSnapshot, error)
 }
@@ -0,0 +1,19 @@
+package ports
+
+import "context"
+
+// EmailSender define el proveedor por el cual se envían las notificaciones por correo
+type EmailSender interface {
+	SendEmail(ctx context.Context, to, subject, body string) error
+}
+
+// SMSSender define el proveedor por el cual se envían las notificaciones por SMS
+type SMSSender interface {
+	SendSMS(ctx context.Context, phone, message string) error
+}
+
+// PushSender define el proveedor por el cual se envían las notificaciones push a los dispositivos del usuario,
+// cuando el proveedor rechaza el token por no estar registrado devuelve un error que envuelve ErrPushTokenUnregistered
+type PushSender interface {
+	SendPush(ctx context.Context, deviceToken, title, body string, data map[string]string) error
+}
@@ -0,0 +1,9 @@
+package ports
+
+import "github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+
+// NotificationPublisher define el canal por el cual se envían en tiempo real los eventos de la bandeja
+// a los clientes conectados del usuario
+type NotificationPublisher interface {
+	PublishInboxEvent(userID string, event *entities.InboxEvent)
+}
@@ -0,0 +1,35 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+type NotificationRepository interface {
+	GetActiveTemplateByType(ctx context.Context, notificationType string) (*entities.NotificationTemplate, error)
+	CreateTemplate(ctx context.Context, template *entities.NotificationTemplate) error
+	GetPreference(ctx context.Context, userID, notificationType string) (*entities.NotificationPreference, error)
+	GetRecipientUser(ctx context.Context, userID string) (*entities.User, error)
+	GetActiveDevices(ctx context.Context, userID string) ([]entities.NotificationDevice, error)
+	GetPreferences(ctx context.Context, userID string) ([]entities.NotificationPreference, error)
+	SeedPreferences(ctx context.Context, preferences []entities.NotificationPreference) error
+	SavePreferences(ctx context.Context, preferences []entities.NotificationPreference) error
+	GetDeviceByToken(ctx context.Context, deviceToken string) (*entities.NotificationDevice, error)
+	GetUserDevice(ctx context.Context, userID, deviceID string) (*entities.NotificationDevice, error)
+	SaveDevice(ctx context.Context, device *entities.NotificationDevice) error
+	DeactivateDevice(ctx context.Context, deviceID string) error
+	DeactivateUserDeviceToken(ctx context.Context, userID, deviceToken string) error
+	TouchDevice(ctx context.Context, deviceID string, usedAt time.Time) error
+	GetUserIDsByRole(ctx context.Context, role, companyID string) ([]string, error)
+	CreateNotification(ctx context.Context, notification *entities.Notification) error
+	SaveDeliveryResult(ctx context.Context, notification *entities.Notification) error
+	GetUserNotifications(ctx context.Context, userID string, params *entities.NotificationQueryParams) ([]entities.Notification, int64, error)
+	GetUnreadCounts(ctx context.Context, userID string) (map[string]int64, error)
+	MarkAsRead(ctx context.Context, userID, notificationID string, readAt time.Time) error
+	MarkAllAsRead(ctx context.Context, userID string, readAt time.Time) (int64, error)
+	GetCompanyNotificationSettings(ctx context.Context, companyID string) ([]entities.CompanyNotificationSetting, error)
+	GetCompanyNotificationSetting(ctx context.Context, companyID, event string) (*entities.CompanyNotificationSetting, error)
+	SaveCompanyNotificationSettings(ctx context.Context, settings []entities.CompanyNotificationSetting) error
+}
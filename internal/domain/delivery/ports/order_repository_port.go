@@ -2,11 +2,13 @@ package ports
 
 import (
 	"context"
+	"time"
+
 	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
 )
 
 type OrdererRepository interface {
-	CreateOrder(ctx context.Context, order *entities.Order) error
+	CreateOrder(ctx context.Context, order *entities.Order, event *entities.SystemEvent) error
 	CreateQRData(ctx context.Context, qr *entities.QRCode) error
 	GetOrderByID(ctx context.Context, id string) (*entities.Order, error)
 	GetOrderByQR(ctx context.Context, qr *entities.QRCode) (*entities.Order, error)
@@ -17,8 +19,25 @@ type OrdererRepository interface {
 	GetLocationCoordinates(ctx context.Context, orderID string, addressType string) (float64, float64, error)
 	UpdateOrder(ctx context.Context, orderID string, order *entities.Order) error
 	DeleteOrder(ctx context.Context, id string) error
-	ChangeStatus(ctx context.Context, id string, status string) error
-	AssignDriverToOrder(ctx context.Context, orderID, driverID string) error
+	ChangeStatus(ctx context.Context, id string, status string, event *entities.SystemEvent) error
+	AssignDriverToOrder(ctx context.Context, orderID, driverID string, event *entities.SystemEvent) error
 	SoftDeleteOrder(ctx context.Context, id string) error
 	RestoreOrder(ctx context.Context, id string) error
+	MarkOrderDelivered(ctx context.Context, orderID string, collection *entities.CashLedgerEntry, earning *entities.DriverEarning, event *entities.SystemEvent) error
+	PurgeDeletedOrders(ctx context.Context, deletedBefore time.Time, limit int) (int64, error)
+
+	// Operaciones de PIN de entrega
+	CreateDeliveryPIN(ctx context.Context, pin *entities.DeliveryPIN) error
+	GetDeliveryPIN(ctx context.Context, orderID string) (*entities.DeliveryPIN, error)
+	RegisterFailedPINAttempt(ctx context.Context, orderID string, maxAttempts int) (*entities.DeliveryPIN, error)
+
+	// Operaciones de bultos
+	GetParcelByTrackingNumber(ctx context.Context, trackingNumber string) (*entities.Parcel, error)
+	ChangeParcelStatus(ctx context.Context, parcel *entities.Parcel, status, orderStatus string, event *entities.SystemEvent) error
+
+	// Operaciones de intentos de entrega
+	RegisterDeliveryAttempt(ctx context.Context, attempt *entities.DeliveryAttempt, returnToSender bool, event *entities.SystemEvent) error
+	GetDeliveryAttempts(ctx context.Context, orderID string) ([]entities.DeliveryAttempt, error)
+	GetDueRedeliveries(ctx context.Context, now time.Time, limit int) ([]entities.DeliveryAttempt, error)
+	RescheduleDelivery(ctx context.Context, orderID string, deadline time.Time, event *entities.SystemEvent) error
 }
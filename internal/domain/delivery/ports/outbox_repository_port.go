@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+type OutboxRepository interface {
+	GetPendingEvents(ctx context.Context, now time.Time, maxAttempts, limit int) ([]entities.SystemEvent, error)
+	ClaimEvent(ctx context.Context, id string, now, leaseUntil time.Time) (bool, error)
+	GetHandledSubscribers(ctx context.Context, eventID string) (map[string]bool, error)
+	SaveEventLog(ctx context.Context, log *entities.EventLog) error
+	MarkEventPublished(ctx context.Context, id string, publishedAt time.Time) error
+	MarkEventFailed(ctx context.Context, event *entities.SystemEvent) error
+}
@@ -0,0 +1,15 @@
+package ports
+
+import (
+	"context"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+// PaymentGateway define el proveedor externo que procesa los pagos anticipados de los pedidos
+type PaymentGateway interface {
+	Name() string
+	Authorize(ctx context.Context, payment *entities.OrderPayment) (*entities.GatewayResult, error)
+	Capture(ctx context.Context, providerPaymentID string, amount float64) (*entities.GatewayResult, error)
+	Refund(ctx context.Context, providerPaymentID string, amount float64) (*entities.GatewayResult, error)
+	VerifyWebhook(payload []byte, signature string) (*entities.PaymentWebhookEvent, error)
+}
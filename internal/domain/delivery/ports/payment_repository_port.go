@@ -0,0 +1,15 @@
+package ports
+
+import (
+	"context"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+type PaymentRepository interface {
+	GetPaymentByOrderID(ctx context.Context, orderID string) (*entities.OrderPayment, error)
+	GetPaymentByProviderID(ctx context.Context, provider, providerPaymentID string) (*entities.OrderPayment, error)
+	UpdatePayment(ctx context.Context, payment *entities.OrderPayment) error
+	GetPaymentsByStatus(ctx context.Context, statuses []string, limit int) ([]entities.OrderPayment, error)
+	ExistsWebhookEvent(ctx context.Context, eventID string) (bool, error)
+	ApplyWebhookEvent(ctx context.Context, event *entities.PaymentWebhookEvent, payment *entities.OrderPayment) error
+}
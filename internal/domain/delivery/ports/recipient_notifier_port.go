@@ -0,0 +1,11 @@
+package ports
+
+import (
+	"context"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+// RecipientNotifier define el canal por el cual se envían mensajes al destinatario de un pedido
+type RecipientNotifier interface {
+	SendDeliveryPIN(ctx context.Context, order *entities.Order, pin string) error
+}
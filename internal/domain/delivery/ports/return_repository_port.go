@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"time"
+)
+
+type ReturnRepository interface {
+	CreateReturn(ctx context.Context, orderReturn *entities.OrderReturn) error
+	GetReturnByID(ctx context.Context, id string) (*entities.OrderReturn, error)
+	GetReturnsByOrder(ctx context.Context, orderID string) ([]entities.OrderReturn, error)
+	GetReturnsByCompany(ctx context.Context, companyID string, status string) ([]entities.OrderReturn, error)
+	UpdateReturnStatus(ctx context.Context, orderReturn *entities.OrderReturn, status string, driverID *string, changedAt time.Time, event *entities.SystemEvent) error
+	ExistsActiveBranch(ctx context.Context, companyID, branchID string) (bool, error)
+	ExistsActiveWarehouse(ctx context.Context, warehouseID string) (bool, error)
+}
@@ -0,0 +1,18 @@
+package ports
+
+import (
+	"context"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"time"
+)
+
+type ScheduleRepository interface {
+	CreateSchedule(ctx context.Context, schedule *entities.OrderSchedule) error
+	UpdateSchedule(ctx context.Context, schedule *entities.OrderSchedule, currentStatus string, currentRunAt *time.Time) error
+	UpdateScheduleStatus(ctx context.Context, id, currentStatus string, currentRunAt *time.Time, status string, nextRunAt *time.Time) error
+	GetScheduleByID(ctx context.Context, id string) (*entities.OrderSchedule, error)
+	GetSchedulesByCompany(ctx context.Context, companyID, status string) ([]entities.OrderSchedule, error)
+	GetDueSchedules(ctx context.Context, now time.Time, limit int) ([]entities.OrderSchedule, error)
+	ClaimScheduleRun(ctx context.Context, id string, currentRunAt time.Time, nextRunAt *time.Time, status string) (bool, error)
+	RecordScheduleRun(ctx context.Context, id string, orderID *string, lastError string, runAt time.Time) error
+}
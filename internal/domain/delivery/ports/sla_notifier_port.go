@@ -0,0 +1,13 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+// SLANotifier define el canal por el cual se alerta a la empresa y a operaciones sobre los pedidos
+// en riesgo o que incumplieron su plazo de entrega
+type SLANotifier interface {
+	NotifySLAAlert(ctx context.Context, evaluation *entities.SLAEvaluation) error
+}
@@ -0,0 +1,13 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+type SLARepository interface {
+	GetSLAActiveOrders(ctx context.Context, companyID, branchID string) ([]entities.Order, error)
+	UpdateOrderSLAStatus(ctx context.Context, orderID, status, flagReason string, evaluatedAt time.Time) error
+}
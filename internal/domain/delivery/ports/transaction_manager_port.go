@@ -0,0 +1,9 @@
+package ports
+
+import "context"
+
+// TransactionManager ejecuta varias operaciones de repositorio en una sola transacción. Los repositorios
+// obtienen la transacción del contexto recibido por fn, si fn devuelve un error se revierten todos los cambios
+type TransactionManager interface {
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -2,6 +2,8 @@ package ports
 
 import (
 	"context"
+	"time"
+
 	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
 )
 
@@ -15,7 +17,7 @@ type UserRepository interface {
 	Update(ctx context.Context, id string, user *entities.User) error
 	UpdateRolesToUser(ctx context.Context, userID string, loggedUserID string, roles []entities.Role) error
 	Delete(ctx context.Context, id string) error
-	ActivateOrDeactivate(ctx context.Context, id string, active bool) error
+	ActivateOrDeactivate(ctx context.Context, id string, active bool, event *entities.SystemEvent) error
 
 	// Operaciones de Perfil
 	GetProfileByUserID(ctx context.Context, userID string) (*entities.Profile, error)
@@ -32,6 +34,7 @@ type UserRepository interface {
 	GetActiveSessionsByUserID(ctx context.Context, userID string) ([]entities.UserSession, error)
 	DeleteSession(ctx context.Context, sessionID string) error
 	CleanExpiredSessions(ctx context.Context, id string) error
+	DeleteExpiredSessions(ctx context.Context, before time.Time) (int64, error)
 
 	// Operaciones de Roles y Permisos
 	AssignRoleToUser(ctx context.Context, userID string, roleID string, assignedBy string) error
@@ -0,0 +1,24 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+type WebhookRepository interface {
+	CreateEndpoint(ctx context.Context, endpoint *entities.WebhookEndpoint) error
+	GetEndpointByID(ctx context.Context, id string) (*entities.WebhookEndpoint, error)
+	GetEndpointsByCompany(ctx context.Context, companyID string) ([]entities.WebhookEndpoint, error)
+	GetActiveEndpointsByCompany(ctx context.Context, companyID string) ([]entities.WebhookEndpoint, error)
+	UpdateEndpoint(ctx context.Context, endpoint *entities.WebhookEndpoint) error
+	DeleteEndpoint(ctx context.Context, id string, deletedAt time.Time) error
+	PurgeDeletedEndpoints(ctx context.Context, deletedBefore time.Time) (int64, error)
+	CreateDeliveries(ctx context.Context, deliveries []entities.WebhookDelivery) error
+	GetDeliveryByID(ctx context.Context, id string) (*entities.WebhookDelivery, error)
+	GetDeliveriesByEndpoint(ctx context.Context, endpointID string, params *entities.PaginationQueryParams) ([]entities.WebhookDelivery, int64, error)
+	GetDueDeliveries(ctx context.Context, now time.Time, limit int) ([]entities.WebhookDelivery, error)
+	ClaimDelivery(ctx context.Context, id string, now, leaseUntil time.Time) (bool, error)
+	SaveDeliveryAttempt(ctx context.Context, delivery *entities.WebhookDelivery, trackFailures bool, disableAfter int) (bool, error)
+}
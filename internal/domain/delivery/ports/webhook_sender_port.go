@@ -0,0 +1,13 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+// WebhookSender define el cliente por el cual se envían los eventos firmados a los webhooks de las empresas,
+// devuelve error solo cuando no se obtuvo una respuesta del webhook
+type WebhookSender interface {
+	Send(ctx context.Context, request *entities.WebhookRequest) (*entities.WebhookResponse, error)
+}
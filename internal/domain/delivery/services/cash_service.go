@@ -0,0 +1,206 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+type CashService struct {
+	repo      ports.CashRepository
+	txManager ports.TransactionManager
+}
+
+func NewCashService(repo ports.CashRepository, txManager ports.TransactionManager) interfaces.CashLedger {
+	return &CashService{
+		repo:      repo,
+		txManager: txManager,
+	}
+}
+
+// GetDriverLedger obtiene los movimientos de efectivo del repartidor en el periodo y su saldo pendiente por moneda
+func (s *CashService) GetDriverLedger(ctx context.Context, driverID string, start, end time.Time) ([]entities.CashLedgerEntry, []entities.CashBalance, error) {
+	entries, err := s.repo.GetLedgerEntries(ctx, driverID, start, end)
+	if err != nil {
+		logs.Error("Failed to get driver cash ledger", map[string]interface{}{
+			"driverID": driverID,
+			"error":    err.Error(),
+		})
+		return nil, nil, errPackage.NewDomainErrorWithCause("CashService", "GetDriverLedger", "failed to get driver cash ledger", err)
+	}
+
+	balances, err := s.repo.GetBalances(ctx, driverID)
+	if err != nil {
+		logs.Error("Failed to get driver cash balances", map[string]interface{}{
+			"driverID": driverID,
+			"error":    err.Error(),
+		})
+		return nil, nil, errPackage.NewDomainErrorWithCause("CashService", "GetDriverLedger", "failed to get driver cash balances", err)
+	}
+
+	return entries, balances, nil
+}
+
+// ReconcileDriverCash concilia el efectivo entregado por el repartidor contra los cobros pendientes en una moneda
+func (s *CashService) ReconcileDriverCash(ctx context.Context, driverID, currency string, handedIn float64, reconciledByID, notes string) (*entities.CashReconciliation, error) {
+	currency = strings.ToUpper(currency)
+
+	// 1. Validar el monto entregado
+	if handedIn < 0 {
+		return nil, errPackage.NewDomainErrorWithCause("CashService", "ReconcileDriverCash", "invalid hand-in amount", errPackage.ErrInvalidHandInAmount)
+	}
+
+	// 2. Leer el saldo pendiente y registrar la conciliación en la misma transacción, con la misma fecha de corte
+	// con la que se marcan los cobros conciliados
+	now := time.Now()
+	var reconciliation *entities.CashReconciliation
+	err := s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		balance, err := s.repo.GetPendingBalance(ctx, driverID, currency, now)
+		if err != nil {
+			return err
+		}
+
+		reconciliation, err = newCashReconciliation(driverID, currency, roundMoney(balance), handedIn, reconciledByID, notes, now)
+		if err != nil {
+			return err
+		}
+
+		// 3. Registrar la entrega de efectivo por lo recibido, la diferencia queda como saldo pendiente del repartidor
+		handIn := &entities.CashLedgerEntry{
+			ID:               uuid.NewString(),
+			DriverID:         driverID,
+			ReconciliationID: &reconciliation.ID,
+			Type:             constants.CashEntryHandIn,
+			Amount:           -reconciliation.HandedInAmount,
+			Currency:         currency,
+			CreatedAt:        now,
+		}
+
+		return s.repo.CreateReconciliation(ctx, reconciliation, handIn)
+	})
+	if err != nil {
+		if errors.Is(err, errPackage.ErrNothingToReconcile) {
+			return nil, errPackage.NewDomainErrorWithCause("CashService", "ReconcileDriverCash", "nothing to reconcile", errPackage.ErrNothingToReconcile)
+		}
+
+		logs.Error("Failed to create cash reconciliation", map[string]interface{}{
+			"driverID": driverID,
+			"currency": currency,
+			"error":    err.Error(),
+		})
+		return nil, errPackage.NewDomainErrorWithCause("CashService", "ReconcileDriverCash", "failed to create cash reconciliation", err)
+	}
+
+	if reconciliation.Status != constants.ReconciliationBalanced {
+		logs.Warn("Driver cash reconciliation with difference", map[string]interface{}{
+			"driverID":   driverID,
+			"currency":   currency,
+			"expected":   reconciliation.ExpectedAmount,
+			"handedIn":   reconciliation.HandedInAmount,
+			"difference": reconciliation.Difference,
+		})
+	}
+
+	return reconciliation, nil
+}
+
+// newCashReconciliation calcula la diferencia entre lo esperado y lo entregado y el resultado de la conciliación
+func newCashReconciliation(driverID, currency string, expected, handedIn float64, reconciledByID, notes string, now time.Time) (*entities.CashReconciliation, error) {
+	if expected == 0 && handedIn == 0 {
+		return nil, errPackage.ErrNothingToReconcile
+	}
+
+	difference := roundMoney(handedIn - expected)
+	status := constants.ReconciliationBalanced
+	switch {
+	case difference < 0:
+		status = constants.ReconciliationShort
+	case difference > 0:
+		status = constants.ReconciliationOver
+	}
+
+	return &entities.CashReconciliation{
+		ID:             uuid.NewString(),
+		DriverID:       driverID,
+		Currency:       currency,
+		ExpectedAmount: expected,
+		HandedInAmount: roundMoney(handedIn),
+		Difference:     difference,
+		Status:         status,
+		ReconciledByID: reconciledByID,
+		Notes:          notes,
+		CreatedAt:      now,
+	}, nil
+}
+
+func (s *CashService) GetReconciliations(ctx context.Context, driverID string) ([]entities.CashReconciliation, error) {
+	reconciliations, err := s.repo.GetReconciliations(ctx, driverID)
+	if err != nil {
+		logs.Error("Failed to get cash reconciliations", map[string]interface{}{
+			"driverID": driverID,
+			"error":    err.Error(),
+		})
+		return nil, errPackage.NewDomainErrorWithCause("CashService", "GetReconciliations", "failed to get cash reconciliations", err)
+	}
+
+	return reconciliations, nil
+}
+
+// GetSettlementReport resume por moneda los cobros contra entrega que se le deben a la empresa en el periodo
+func (s *CashService) GetSettlementReport(ctx context.Context, companyID string, start, end time.Time) ([]entities.CODSettlement, error) {
+	collections, err := s.repo.GetCompanyCollections(ctx, companyID, start, end)
+	if err != nil {
+		logs.Error("Failed to get company COD collections", map[string]interface{}{
+			"companyID": companyID,
+			"error":     err.Error(),
+		})
+		return nil, errPackage.NewDomainErrorWithCause("CashService", "GetSettlementReport", "failed to get company COD collections", err)
+	}
+
+	// 1. Agrupar los cobros por moneda conservando el orden de aparición
+	settlements := make([]entities.CODSettlement, 0)
+	indexByCurrency := make(map[string]int)
+	for _, collection := range collections {
+		idx, ok := indexByCurrency[collection.Currency]
+		if !ok {
+			settlements = append(settlements, entities.CODSettlement{
+				CompanyID: companyID,
+				Currency:  collection.Currency,
+			})
+			idx = len(settlements) - 1
+			indexByCurrency[collection.Currency] = idx
+		}
+
+		// 2. Acumular lo cobrado y lo que ya fue entregado por los repartidores
+		settlement := &settlements[idx]
+		settlement.Orders++
+		settlement.CollectedAmount += collection.Amount
+		if collection.ReconciliationID != nil {
+			settlement.HandedInAmount += collection.Amount
+		}
+		settlement.Collections = append(settlement.Collections, collection)
+	}
+
+	for i := range settlements {
+		settlements[i].CollectedAmount = roundMoney(settlements[i].CollectedAmount)
+		settlements[i].HandedInAmount = roundMoney(settlements[i].HandedInAmount)
+		settlements[i].PendingAmount = roundMoney(settlements[i].CollectedAmount - settlements[i].HandedInAmount)
+	}
+
+	return settlements, nil
+}
+
+func roundMoney(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
@@ -2,7 +2,9 @@ package services
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
 	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
 	"gorm.io/gorm"
 	"time"
@@ -43,15 +45,91 @@ func (s *CompanyMetricsService) GetCompanyMetrics(ctx context.Context, companyID
 		return nil, errPackage.NewDomainErrorWithCause("CompanyMetricsService", "GetCompanyMetrics", "Error getting company by ID", err)
 	}
 
-	// 2. Definir el rango de fechas para las métricas (último mes)
+	// 2. Usar las métricas precalculadas si son recientes, si no calcularlas en la consulta
+	snapshot, err := s.metricsRepo.GetCompanyMetricsSnapshot(ctx, companyID)
+	if err == nil && time.Since(snapshot.ComputedAt) <= constants.MetricsSnapshotMaxAge {
+		var metrics entities.CompanyMetrics
+		if err = json.Unmarshal([]byte(snapshot.Metrics), &metrics); err == nil {
+			return &metrics, nil
+		}
+	}
+
+	return s.computeCompanyMetrics(ctx, companyID), nil
+}
+
+// RecomputeCompanyMetrics precalcula y guarda las métricas de cada empresa activa, una empresa con error no
+// detiene al resto. Devuelve la cantidad de empresas actualizadas
+func (s *CompanyMetricsService) RecomputeCompanyMetrics(ctx context.Context) (int, error) {
+	// 1. Obtener las empresas activas
+	companyIDs, err := s.companyRepo.GetActiveCompanyIDs(ctx)
+	if err != nil {
+		logs.Error("Failed to get active companies", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return 0, errPackage.NewDomainErrorWithCause("CompanyMetricsService", "RecomputeCompanyMetrics", "failed to get active companies", err)
+	}
+
+	// 2. Calcular y guardar las métricas de cada empresa
+	updated := 0
+	for _, companyID := range companyIDs {
+		if err = s.saveCompanyMetrics(ctx, companyID); err != nil {
+			continue
+		}
+		updated++
+	}
+
+	return updated, nil
+}
+
+// RefreshCompanyMetrics precalcula las métricas de la empresa salvo que las guardadas ya incluyan los cambios
+// hasta since, así una ráfaga de eventos de la misma empresa las calcula una sola vez
+func (s *CompanyMetricsService) RefreshCompanyMetrics(ctx context.Context, companyID string, since time.Time) error {
+	snapshot, err := s.metricsRepo.GetCompanyMetricsSnapshot(ctx, companyID)
+	if err == nil && !snapshot.ComputedAt.Before(since) {
+		return nil
+	}
+
+	if err = s.saveCompanyMetrics(ctx, companyID); err != nil {
+		return errPackage.NewDomainErrorWithCause("CompanyMetricsService", "RefreshCompanyMetrics", "failed to save company metrics", err)
+	}
+
+	return nil
+}
+
+// saveCompanyMetrics calcula y guarda las métricas precalculadas de la empresa
+func (s *CompanyMetricsService) saveCompanyMetrics(ctx context.Context, companyID string) error {
+	metrics, err := json.Marshal(s.computeCompanyMetrics(ctx, companyID))
+	if err != nil {
+		return err
+	}
+
+	snapshot := &entities.CompanyMetricsSnapshot{
+		CompanyID:  companyID,
+		Metrics:    string(metrics),
+		ComputedAt: time.Now(),
+	}
+	if err = s.metricsRepo.SaveCompanyMetricsSnapshot(ctx, snapshot); err != nil {
+		logs.Error("Failed to save company metrics snapshot", map[string]interface{}{
+			"error":      err.Error(),
+			"company_id": companyID,
+		})
+		return err
+	}
+
+	return nil
+}
+
+// computeCompanyMetrics calcula las métricas de la empresa del último mes, una métrica con error queda en cero
+func (s *CompanyMetricsService) computeCompanyMetrics(ctx context.Context, companyID string) *entities.CompanyMetrics {
+	// 1. Definir el rango de fechas para las métricas (último mes)
 	endDate := time.Now()
 	startDate := endDate.AddDate(0, -1, 0) // Un mes atrás
 
-	// 3. Inicializar el objeto de métricas
+	// 2. Inicializar el objeto de métricas
 	metrics := &entities.CompanyMetrics{}
 
-	// 4. Obtener métricas reales usando el repositorio de métricas
-	// 4.1 Conteos de órdenes
+	// 3. Obtener métricas reales usando el repositorio de métricas
+	// 3.1 Conteos de órdenes
 	total, completed, cancelled, err := s.metricsRepo.GetOrderCountByCompany(ctx, companyID, startDate, endDate)
 	if err != nil {
 		logs.Error("Failed to get order counts", map[string]interface{}{
@@ -71,7 +149,7 @@ func (s *CompanyMetricsService) GetCompanyMetrics(ctx context.Context, companyID
 		}
 	}
 
-	// 4.2 Tiempo promedio de entrega
+	// 3.2 Tiempo promedio de entrega
 	avgTime, err := s.metricsRepo.GetAverageDeliveryTimeByCompany(ctx, companyID, startDate, endDate)
 	if err != nil {
 		logs.Error("Failed to get average delivery time", map[string]interface{}{
@@ -82,7 +160,18 @@ func (s *CompanyMetricsService) GetCompanyMetrics(ctx context.Context, companyID
 		metrics.AverageDeliveryTime = avgTime
 	}
 
-	// 4.3 Ingresos totales
+	// 3.3 Tasa de entregas a tiempo
+	delivered, onTime, err := s.metricsRepo.GetOnTimeDeliveriesByCompany(ctx, companyID, startDate, endDate)
+	if err != nil {
+		logs.Error("Failed to get on-time deliveries", map[string]interface{}{
+			"error":      err,
+			"company_id": companyID,
+		})
+	} else if delivered > 0 {
+		metrics.OnTimeDeliveryRate = float64(onTime) / float64(delivered) * 100
+	}
+
+	// 3.4 Ingresos totales
 	revenue, err := s.metricsRepo.GetTotalRevenueByCompany(ctx, companyID, startDate, endDate)
 	if err != nil {
 		logs.Error("Failed to get total revenue", map[string]interface{}{
@@ -93,7 +182,7 @@ func (s *CompanyMetricsService) GetCompanyMetrics(ctx context.Context, companyID
 		metrics.TotalRevenue = revenue
 	}
 
-	// 4.4 Sucursales activas
+	// 3.5 Sucursales activas
 	activeBranches, err := s.metricsRepo.GetActiveBranchesCountByCompany(ctx, companyID)
 	if err != nil {
 		logs.Error("Failed to get active branches count", map[string]interface{}{
@@ -104,7 +193,7 @@ func (s *CompanyMetricsService) GetCompanyMetrics(ctx context.Context, companyID
 		metrics.ActiveBranches = activeBranches
 	}
 
-	// 4.5 Clientes únicos
+	// 3.6 Clientes únicos
 	uniqueCustomers, err := s.metricsRepo.GetUniqueCustomersByCompany(ctx, companyID, startDate, endDate)
 	if err != nil {
 		logs.Error("Failed to get unique customers", map[string]interface{}{
@@ -115,7 +204,25 @@ func (s *CompanyMetricsService) GetCompanyMetrics(ctx context.Context, companyID
 		metrics.UniqueCustomers = uniqueCustomers
 	}
 
-	return metrics, nil
+	// 3.7 Devoluciones por motivo
+	returnsByReason, err := s.metricsRepo.GetReturnsByReasonByCompany(ctx, companyID, startDate, endDate)
+	if err != nil {
+		logs.Error("Failed to get returns by reason", map[string]interface{}{
+			"error":      err,
+			"company_id": companyID,
+		})
+	} else {
+		metrics.ReturnsByReason = returnsByReason
+		for _, count := range returnsByReason {
+			metrics.ReturnedOrders += count
+		}
+
+		if metrics.TotalOrders > 0 {
+			metrics.ReturnRate = float64(metrics.ReturnedOrders) / float64(metrics.TotalOrders) * 100
+		}
+	}
+
+	return metrics
 }
 
 // GetBranchMetrics obtiene las métricas reales de una sucursal
@@ -173,7 +280,18 @@ func (s *CompanyMetricsService) GetBranchMetrics(ctx context.Context, branchID,
 		metrics.AverageDeliveryTime = avgTime
 	}
 
-	// 4.3 Ingresos totales
+	// 4.3 Tasa de entregas a tiempo
+	delivered, onTime, err := s.metricsRepo.GetOnTimeDeliveriesByBranch(ctx, branchID, startDate, endDate)
+	if err != nil {
+		logs.Error("Failed to get on-time deliveries", map[string]interface{}{
+			"error":     err,
+			"branch_id": branchID,
+		})
+	} else if delivered > 0 {
+		metrics.OnTimeDeliveryRate = float64(onTime) / float64(delivered) * 100
+	}
+
+	// 4.4 Ingresos totales
 	revenue, err := s.metricsRepo.GetTotalRevenueByBranch(ctx, branchID, startDate, endDate)
 	if err != nil {
 		logs.Error("Failed to get total revenue", map[string]interface{}{
@@ -184,7 +302,7 @@ func (s *CompanyMetricsService) GetBranchMetrics(ctx context.Context, branchID,
 		metrics.TotalRevenue = revenue
 	}
 
-	// 4.4 Repartidores activos
+	// 4.5 Repartidores activos
 	activeDrivers, err := s.metricsRepo.GetActiveDriversCountByBranch(ctx, branchID)
 	if err != nil {
 		logs.Error("Failed to get active drivers count", map[string]interface{}{
@@ -195,7 +313,7 @@ func (s *CompanyMetricsService) GetBranchMetrics(ctx context.Context, branchID,
 		metrics.ActiveDrivers = activeDrivers
 	}
 
-	// 4.5 Clientes únicos
+	// 4.6 Clientes únicos
 	uniqueCustomers, err := s.metricsRepo.GetUniqueCustomersByBranch(ctx, branchID, startDate, endDate)
 	if err != nil {
 		logs.Error("Failed to get unique customers", map[string]interface{}{
@@ -206,7 +324,7 @@ func (s *CompanyMetricsService) GetBranchMetrics(ctx context.Context, branchID,
 		metrics.UniqueCustomers = uniqueCustomers
 	}
 
-	// 4.6 Tasa de pedidos en hora pico
+	// 4.7 Tasa de pedidos en hora pico
 	peakRate, err := s.metricsRepo.GetPeakHourOrderRateByBranch(ctx, branchID, endDate)
 	if err != nil {
 		logs.Error("Failed to get peak hour order rate", map[string]interface{}{
@@ -0,0 +1,41 @@
+package services
+
+import (
+	"context"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+// CompanyMetricsSubscriber actualiza las métricas precalculadas de la empresa cuando se crea un pedido o cambia su
+// estado, el trabajo de métricas las sigue recalculando para las empresas sin movimiento
+type CompanyMetricsSubscriber struct {
+	metrics interfaces.MetricsService
+}
+
+func NewCompanyMetricsSubscriber(metrics interfaces.MetricsService) interfaces.EventSubscriber {
+	return &CompanyMetricsSubscriber{
+		metrics: metrics,
+	}
+}
+
+func (s *CompanyMetricsSubscriber) Name() string {
+	return "metrics.company_snapshots"
+}
+
+func (s *CompanyMetricsSubscriber) EventTypes() []string {
+	return []string{
+		constants.SystemEventOrderCreated,
+		constants.SystemEventOrderStatusChanged,
+	}
+}
+
+func (s *CompanyMetricsSubscriber) Handle(ctx context.Context, event *entities.SystemEvent) error {
+	companyID, _ := event.Data()["company_id"].(string)
+	if companyID == "" {
+		return nil
+	}
+
+	return s.metrics.RefreshCompanyMetrics(ctx, companyID, event.OccurredAt)
+}
@@ -8,6 +8,7 @@ import (
 	"gorm.io/gorm"
 	"time"
 
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
 	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
 	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
 	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
@@ -19,12 +20,14 @@ import (
 type CompanyService struct {
 	repo           ports.CompanyRepository
 	metricsService interfaces.MetricsService
+	txManager      ports.TransactionManager
 }
 
-func NewCompanyService(repo ports.CompanyRepository, metricsService interfaces.MetricsService) interfaces.Companyrer {
+func NewCompanyService(repo ports.CompanyRepository, metricsService interfaces.MetricsService, txManager ports.TransactionManager) interfaces.Companyrer {
 	return &CompanyService{
 		repo:           repo,
 		metricsService: metricsService,
+		txManager:      txManager,
 	}
 }
 
@@ -166,7 +169,12 @@ func (c *CompanyService) DeactivateCompany(ctx context.Context, id string) error
 	}
 
 	// 3. Desactivar la empresa
-	err = c.repo.DeactivateCompany(ctx, id)
+	event := entities.NewSystemEvent(constants.SystemEventCompanyDeactivated, constants.EventSourceCompany, id, constants.EventSeverityWarning, map[string]interface{}{
+		"company_id": id,
+		"name":       company.Name,
+	})
+
+	err = c.repo.DeactivateCompany(ctx, id, event)
 	if err != nil {
 		logs.Error("Failed to deactivate company", map[string]interface{}{
 			"error":      err,
@@ -208,7 +216,12 @@ func (c *CompanyService) ReactivateCompany(ctx context.Context, id string) error
 	}
 
 	// 3. Reactivar la empresa
-	err = c.repo.ReactivateCompany(ctx, id)
+	event := entities.NewSystemEvent(constants.SystemEventCompanyReactivated, constants.EventSourceCompany, id, constants.EventSeverityInfo, map[string]interface{}{
+		"company_id": id,
+		"name":       company.Name,
+	})
+
+	err = c.repo.ReactivateCompany(ctx, id, event)
 	if err != nil {
 		logs.Error("Failed to reactivate company", map[string]interface{}{
 			"error":      err,
@@ -289,7 +302,23 @@ func (c *CompanyService) ValidateCompany(ctx context.Context, company *entities.
 		return errPackage.NewDomainErrorWithCause("CompanyService", "ValidateCompany", "Contract end date cannot be before start date", errPackage.ErrInvalidContractDates)
 	}
 
-	// 5. Validar detalles de contrato si existen
+	// 5. Validar el máximo de intentos de entrega
+	if company.MaxDeliveryAttempts < 1 || company.MaxDeliveryAttempts > constants.MaxAllowedDeliveryAttempts {
+		logs.Error("Invalid max delivery attempts", map[string]interface{}{
+			"max_delivery_attempts": company.MaxDeliveryAttempts,
+		})
+		return errPackage.NewDomainErrorWithCause("CompanyService", "ValidateCompany", "Invalid max delivery attempts", errPackage.ErrInvalidMaxDeliveryAttempts)
+	}
+
+	// 5.1 Validar el prefijo de los números de seguimiento
+	if !isValidCompanyTrackingPrefix(company.TrackingPrefix) {
+		logs.Error("Invalid tracking prefix", map[string]interface{}{
+			"tracking_prefix": company.TrackingPrefix,
+		})
+		return errPackage.NewDomainErrorWithCause("CompanyService", "ValidateCompany", "Invalid tracking prefix", errPackage.ErrInvalidTrackingPrefix)
+	}
+
+	// 6. Validar detalles de contrato si existen
 	if company.ContractDetails != "" {
 		var contractDetails map[string]interface{}
 		if err := json.Unmarshal([]byte(company.ContractDetails), &contractDetails); err != nil {
@@ -348,7 +377,24 @@ func (c *CompanyService) ValidateCompanyUpdate(ctx context.Context, company *ent
 		}
 	}
 
-	// 4. Validar detalles de contrato si se actualizan
+	// 4. Validar el máximo de intentos de entrega si se actualiza
+	if company.MaxDeliveryAttempts != 0 &&
+		(company.MaxDeliveryAttempts < 1 || company.MaxDeliveryAttempts > constants.MaxAllowedDeliveryAttempts) {
+		logs.Error("Invalid max delivery attempts", map[string]interface{}{
+			"max_delivery_attempts": company.MaxDeliveryAttempts,
+		})
+		return errPackage.NewDomainErrorWithCause("CompanyService", "ValidateCompanyUpdate", "Invalid max delivery attempts", errPackage.ErrInvalidMaxDeliveryAttempts)
+	}
+
+	// 4.1 Validar el prefijo de los números de seguimiento si se actualiza
+	if company.TrackingPrefix != "" && !isValidCompanyTrackingPrefix(company.TrackingPrefix) {
+		logs.Error("Invalid tracking prefix", map[string]interface{}{
+			"tracking_prefix": company.TrackingPrefix,
+		})
+		return errPackage.NewDomainErrorWithCause("CompanyService", "ValidateCompanyUpdate", "Invalid tracking prefix", errPackage.ErrInvalidTrackingPrefix)
+	}
+
+	// 5. Validar detalles de contrato si se actualizan
 	if company.ContractDetails != "" {
 		var contractDetails map[string]interface{}
 		if err := json.Unmarshal([]byte(company.ContractDetails), &contractDetails); err != nil {
@@ -927,9 +973,15 @@ func (c *CompanyService) AddCompanyAddress(ctx context.Context, companyID string
 		return errPackage.NewDomainError("CompanyService", "AddCompanyAddress", "Invalid address data")
 	}
 
-	// 3. Guardar la dirección en el repositorio
+	// 3. Guardar la dirección en el repositorio, si es la principal las demás dejan de serlo en la misma transacción
 	address.CompanyID = companyID
-	err = c.repo.AddCompanyAddress(ctx, address)
+	err = c.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := c.repo.AddCompanyAddress(ctx, address); err != nil {
+			return err
+		}
+
+		return c.unsetOtherMainAddresses(ctx, address)
+	})
 	if err != nil {
 		logs.Error("Failed to create company address", map[string]interface{}{
 			"error":      err,
@@ -975,8 +1027,15 @@ func (c *CompanyService) UpdateCompanyAddress(ctx context.Context, companyID str
 		return errPackage.NewDomainError("CompanyService", "UpdateCompanyAddress", "Address is not associated with a company")
 	}
 
-	// 4. Actualizar la dirección en el repositorio
-	err = c.repo.UpdateCompanyAddress(ctx, address)
+	// 4. Actualizar la dirección en el repositorio, si es la principal las demás dejan de serlo en la misma transacción
+	address.CompanyID = existingAddress.CompanyID
+	err = c.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := c.repo.UpdateCompanyAddress(ctx, address); err != nil {
+			return err
+		}
+
+		return c.unsetOtherMainAddresses(ctx, address)
+	})
 	if err != nil {
 		logs.Error("Failed to update company address", map[string]interface{}{
 			"error":      err,
@@ -989,6 +1048,15 @@ func (c *CompanyService) UpdateCompanyAddress(ctx context.Context, companyID str
 	return nil
 }
 
+// unsetOtherMainAddresses mantiene una sola dirección principal por empresa
+func (c *CompanyService) unsetOtherMainAddresses(ctx context.Context, address *entities.CompanyAddress) error {
+	if !address.IsMain {
+		return nil
+	}
+
+	return c.repo.UnsetMainAddresses(ctx, address.CompanyID, address.ID)
+}
+
 func (c *CompanyService) DeleteCompanyAddress(ctx context.Context, addressID, companyID string) error {
 	// 1.  Verificar que la dirección existe en la base de datos
 	_, err := c.repo.GetCompanyAddressByID(ctx, addressID, companyID)
@@ -1041,3 +1109,8 @@ func (c *CompanyService) GetCompanies(ctx context.Context, params *entities.Comp
 
 	return companies, total, nil
 }
+
+// isValidCompanyTrackingPrefix valida el formato del prefijo, reservando el de las devoluciones
+func isValidCompanyTrackingPrefix(prefix string) bool {
+	return value_objects.IsValidTrackingPrefix(prefix) && prefix != constants.TrackingPrefixReturn
+}
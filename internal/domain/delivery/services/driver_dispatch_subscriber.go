@@ -0,0 +1,47 @@
+package services
+
+import (
+	"context"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+)
+
+// DriverDispatchSubscriber despacha al repartidor los pedidos que se le asignan. Es un grupo aparte de las
+// notificaciones al destinatario para que un fallo de uno no repita el aviso del otro
+type DriverDispatchSubscriber struct {
+	orderRepo ports.OrdererRepository
+	notifier  interfaces.OrderNotifier
+}
+
+func NewDriverDispatchSubscriber(orderRepo ports.OrdererRepository, notifier interfaces.OrderNotifier) interfaces.EventSubscriber {
+	return &DriverDispatchSubscriber{
+		orderRepo: orderRepo,
+		notifier:  notifier,
+	}
+}
+
+func (s *DriverDispatchSubscriber) Name() string {
+	return "dispatch.driver_assignments"
+}
+
+func (s *DriverDispatchSubscriber) EventTypes() []string {
+	return []string{constants.SystemEventOrderDriverAssigned}
+}
+
+func (s *DriverDispatchSubscriber) Handle(ctx context.Context, event *entities.SystemEvent) error {
+	// 1. Obtener el pedido con el repartidor asignado
+	order, err := eventOrder(ctx, s.orderRepo, event)
+	if err != nil || order == nil {
+		return err
+	}
+
+	// 2. Avisar al repartidor solo si el evento corresponde a su asignación vigente
+	if driverID, _ := event.Data()["driver_id"].(string); order.DriverID == nil || *order.DriverID != driverID {
+		return nil
+	}
+
+	return s.notifier.NotifyDriverDispatch(ctx, order)
+}
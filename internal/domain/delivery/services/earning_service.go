@@ -0,0 +1,367 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+type EarningService struct {
+	repo        ports.EarningRepository
+	companyRepo ports.CompanyRepository
+}
+
+func NewEarningService(repo ports.EarningRepository, companyRepo ports.CompanyRepository) interfaces.DriverEarner {
+	return &EarningService{
+		repo:        repo,
+		companyRepo: companyRepo,
+	}
+}
+
+// CalculateDeliveryEarning calcula la ganancia del repartidor por la entrega de un pedido con la regla de la zona
+// de la sucursal, los pedidos sin repartidor no generan ganancia
+func (s *EarningService) CalculateDeliveryEarning(ctx context.Context, order *entities.Order) (*entities.DriverEarning, error) {
+	if order == nil || order.DriverID == nil {
+		return nil, nil
+	}
+
+	// 1. Obtener la regla de la zona de la sucursal o la regla por defecto
+	var zoneID string
+	if order.Branch != nil {
+		zoneID = order.Branch.ZoneID
+	}
+
+	rule, err := s.repo.GetEarningRuleForZone(ctx, zoneID)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			logs.Error("Failed to get earning rule", map[string]interface{}{
+				"orderID": order.ID,
+				"zoneID":  zoneID,
+				"error":   err.Error(),
+			})
+			return nil, errPackage.NewDomainErrorWithCause("EarningService", "CalculateDeliveryEarning", "failed to get earning rule", err)
+		}
+		rule = entities.DefaultEarningRule()
+	}
+
+	// 2. Calcular el desglose de la ganancia
+	earning := &entities.DriverEarning{
+		ID:             uuid.NewString(),
+		DriverID:       *order.DriverID,
+		OrderID:        &order.ID,
+		Type:           constants.EarningTypeDelivery,
+		BaseAmount:     rule.BasePerDelivery,
+		ZoneMultiplier: rule.ZoneMultiplier,
+		Currency:       constants.EarningsCurrency,
+		CreatedAt:      time.Now(),
+	}
+
+	if order.Detail != nil {
+		earning.Distance = order.Detail.Distance
+		earning.DistanceAmount = roundMoney(order.Detail.Distance * rule.PerKmRate)
+	}
+
+	if order.PackageDetail != nil && order.PackageDetail.IsUrgent {
+		earning.UrgentBonus = rule.UrgentBonus
+	}
+
+	earning.Amount = roundMoney((earning.BaseAmount + earning.DistanceAmount + earning.UrgentBonus) * earning.ZoneMultiplier)
+
+	return earning, nil
+}
+
+func (s *EarningService) GetEarningRules(ctx context.Context) ([]entities.EarningRule, error) {
+	rules, err := s.repo.GetEarningRules(ctx)
+	if err != nil {
+		logs.Error("Failed to get earning rules", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, errPackage.NewDomainErrorWithCause("EarningService", "GetEarningRules", "failed to get earning rules", err)
+	}
+
+	return rules, nil
+}
+
+func (s *EarningService) GetEarningRuleByID(ctx context.Context, id string) (*entities.EarningRule, error) {
+	rule, err := s.repo.GetEarningRuleByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errPackage.NewDomainErrorWithCause("EarningService", "GetEarningRuleByID", "earning rule not found", errPackage.ErrEarningRuleNotFound)
+		}
+
+		logs.Error("Failed to get earning rule by id", map[string]interface{}{
+			"ruleID": id,
+			"error":  err.Error(),
+		})
+		return nil, errPackage.NewDomainErrorWithCause("EarningService", "GetEarningRuleByID", "failed to get earning rule by id", err)
+	}
+
+	return rule, nil
+}
+
+// CreateEarningRule crea la regla por defecto o la regla de una zona, cada zona solo puede tener una
+func (s *EarningService) CreateEarningRule(ctx context.Context, rule *entities.EarningRule) error {
+	// 1. Validar las tarifas
+	if !isValidEarningRule(rule) {
+		return errPackage.NewDomainErrorWithCause("EarningService", "CreateEarningRule", "invalid earning rule", errPackage.ErrInvalidEarningRule)
+	}
+
+	// 2. Validar que la zona exista, la regla sin zona es la regla por defecto
+	if rule.ZoneID != nil {
+		if _, err := s.companyRepo.GetZoneByID(ctx, *rule.ZoneID); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errPackage.NewDomainErrorWithCause("EarningService", "CreateEarningRule", "zone not found", errPackage.ErrZoneNotFound)
+			}
+			return errPackage.NewDomainErrorWithCause("EarningService", "CreateEarningRule", "failed to get zone", err)
+		}
+	} else {
+		rules, err := s.GetEarningRules(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, existing := range rules {
+			if existing.ZoneID == nil {
+				return errPackage.NewDomainErrorWithCause("EarningService", "CreateEarningRule", "default earning rule already exists", errPackage.ErrEarningRuleAlreadyExists)
+			}
+		}
+	}
+
+	// 3. Guardar la regla
+	if err := s.repo.CreateEarningRule(ctx, rule); err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return errPackage.NewDomainErrorWithCause("EarningService", "CreateEarningRule", "earning rule already exists", errPackage.ErrEarningRuleAlreadyExists)
+		}
+
+		logs.Error("Failed to create earning rule", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return errPackage.NewDomainErrorWithCause("EarningService", "CreateEarningRule", "failed to create earning rule", err)
+	}
+
+	return nil
+}
+
+// UpdateEarningRule actualiza las tarifas de una regla, las entregas ya registradas conservan su desglose
+func (s *EarningService) UpdateEarningRule(ctx context.Context, rule *entities.EarningRule) error {
+	if !isValidEarningRule(rule) {
+		return errPackage.NewDomainErrorWithCause("EarningService", "UpdateEarningRule", "invalid earning rule", errPackage.ErrInvalidEarningRule)
+	}
+
+	if err := s.repo.UpdateEarningRule(ctx, rule); err != nil {
+		logs.Error("Failed to update earning rule", map[string]interface{}{
+			"ruleID": rule.ID,
+			"error":  err.Error(),
+		})
+		return errPackage.NewDomainErrorWithCause("EarningService", "UpdateEarningRule", "failed to update earning rule", err)
+	}
+
+	return nil
+}
+
+// AddAdjustment registra un ajuste positivo o negativo en el libro de ganancias de un repartidor
+func (s *EarningService) AddAdjustment(ctx context.Context, driverID string, amount float64, reason, createdBy string) (*entities.DriverEarning, error) {
+	// 1. Validar el ajuste
+	reason = strings.TrimSpace(reason)
+	amount = roundMoney(amount)
+	if driverID == "" || amount == 0 || reason == "" {
+		return nil, errPackage.NewDomainErrorWithCause("EarningService", "AddAdjustment", "invalid adjustment", errPackage.ErrInvalidAdjustment)
+	}
+
+	// 2. Validar que el repartidor exista
+	exists, err := s.repo.ExistsDriver(ctx, driverID)
+	if err != nil {
+		logs.Error("Failed to check driver", map[string]interface{}{
+			"driverID": driverID,
+			"error":    err.Error(),
+		})
+		return nil, errPackage.NewDomainErrorWithCause("EarningService", "AddAdjustment", "failed to check driver", err)
+	}
+
+	if !exists {
+		return nil, errPackage.NewDomainErrorWithCause("EarningService", "AddAdjustment", "driver not found", errPackage.ErrDriverNotFound)
+	}
+
+	// 3. Registrar el ajuste
+	adjustment := &entities.DriverEarning{
+		ID:             uuid.NewString(),
+		DriverID:       driverID,
+		Type:           constants.EarningTypeAdjustment,
+		ZoneMultiplier: constants.DefaultZoneMultiplier,
+		Amount:         amount,
+		Currency:       constants.EarningsCurrency,
+		Reason:         reason,
+		CreatedBy:      &createdBy,
+		CreatedAt:      time.Now(),
+	}
+
+	if err = s.repo.CreateEarning(ctx, adjustment); err != nil {
+		logs.Error("Failed to create earning adjustment", map[string]interface{}{
+			"driverID": driverID,
+			"error":    err.Error(),
+		})
+		return nil, errPackage.NewDomainErrorWithCause("EarningService", "AddAdjustment", "failed to create adjustment", err)
+	}
+
+	logs.Info("Driver earning adjustment added", map[string]interface{}{
+		"driverID":  driverID,
+		"amount":    amount,
+		"createdBy": createdBy,
+	})
+
+	return adjustment, nil
+}
+
+func (s *EarningService) GetDriverEarnings(ctx context.Context, driverID string, start, end time.Time) ([]entities.DriverEarning, error) {
+	earnings, err := s.repo.GetEarnings(ctx, driverID, start, end)
+	if err != nil {
+		logs.Error("Failed to get driver earnings", map[string]interface{}{
+			"driverID": driverID,
+			"error":    err.Error(),
+		})
+		return nil, errPackage.NewDomainErrorWithCause("EarningService", "GetDriverEarnings", "failed to get driver earnings", err)
+	}
+
+	return earnings, nil
+}
+
+// GeneratePayoutStatement liquida en un estado de pago los movimientos del repartidor anteriores al fin del periodo,
+// los movimientos de periodos anteriores aún no liquidados se incluyen en el siguiente estado de pago
+func (s *EarningService) GeneratePayoutStatement(ctx context.Context, driverID string, start, end time.Time) (*entities.PayoutStatement, error) {
+	// 1. Validar el periodo
+	if !start.Before(end) || start.After(time.Now()) {
+		return nil, errPackage.NewDomainErrorWithCause("EarningService", "GeneratePayoutStatement", "invalid payout period", errPackage.ErrInvalidPayoutPeriod)
+	}
+
+	// 2. Obtener los movimientos pendientes de pago
+	earnings, err := s.repo.GetUnsettledEarnings(ctx, driverID, end)
+	if err != nil {
+		logs.Error("Failed to get unsettled earnings", map[string]interface{}{
+			"driverID": driverID,
+			"error":    err.Error(),
+		})
+		return nil, errPackage.NewDomainErrorWithCause("EarningService", "GeneratePayoutStatement", "failed to get unsettled earnings", err)
+	}
+
+	if len(earnings) == 0 {
+		return nil, errPackage.NewDomainErrorWithCause("EarningService", "GeneratePayoutStatement", "nothing to pay", errPackage.ErrNothingToPay)
+	}
+
+	// 3. Construir el estado de pago y calcular sus totales
+	statement := &entities.PayoutStatement{
+		ID:              uuid.NewString(),
+		DriverID:        driverID,
+		StatementNumber: newPayoutStatementNumber(start),
+		PeriodStart:     start,
+		PeriodEnd:       end,
+		Currency:        constants.EarningsCurrency,
+		Entries:         earnings,
+	}
+	statement.CalculateTotals()
+
+	// 4. Guardar el estado de pago asignándole sus movimientos
+	if err = s.repo.CreatePayoutStatement(ctx, statement); err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrDuplicatedKey):
+			return nil, errPackage.NewDomainErrorWithCause("EarningService", "GeneratePayoutStatement", "payout statement already exists", errPackage.ErrPayoutStatementExists)
+		case errors.Is(err, errPackage.ErrPayoutStatementConflict):
+			return nil, errPackage.NewDomainErrorWithCause("EarningService", "GeneratePayoutStatement", "earnings already settled", errPackage.ErrPayoutStatementConflict)
+		}
+
+		logs.Error("Failed to create payout statement", map[string]interface{}{
+			"driverID": driverID,
+			"error":    err.Error(),
+		})
+		return nil, errPackage.NewDomainErrorWithCause("EarningService", "GeneratePayoutStatement", "failed to create payout statement", err)
+	}
+
+	logs.Info("Payout statement generated", map[string]interface{}{
+		"statementID": statement.ID,
+		"driverID":    driverID,
+		"entries":     len(earnings),
+		"total":       statement.Total,
+	})
+
+	return statement, nil
+}
+
+// GenerateWeeklyStatements genera los estados de pago de la semana para todos los repartidores con ganancias pendientes
+func (s *EarningService) GenerateWeeklyStatements(ctx context.Context, start, end time.Time) (int, error) {
+	driverIDs, err := s.repo.GetDriversWithUnsettledEarnings(ctx, end)
+	if err != nil {
+		logs.Error("Failed to get drivers with unsettled earnings", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return 0, errPackage.NewDomainErrorWithCause("EarningService", "GenerateWeeklyStatements", "failed to get drivers with unsettled earnings", err)
+	}
+
+	generated := 0
+	for _, driverID := range driverIDs {
+		// 1. Los repartidores ya liquidados en el periodo se omiten
+		_, err = s.GeneratePayoutStatement(ctx, driverID, start, end)
+		if err != nil {
+			if !hasDomainCause(err, errPackage.ErrPayoutStatementExists) && !hasDomainCause(err, errPackage.ErrNothingToPay) {
+				logs.Error("Failed to generate weekly payout statement", map[string]interface{}{
+					"driverID": driverID,
+					"error":    err.Error(),
+				})
+			}
+			continue
+		}
+		generated++
+	}
+
+	return generated, nil
+}
+
+func (s *EarningService) GetPayoutStatementByID(ctx context.Context, id string) (*entities.PayoutStatement, error) {
+	statement, err := s.repo.GetPayoutStatementByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errPackage.NewDomainErrorWithCause("EarningService", "GetPayoutStatementByID", "payout statement not found", errPackage.ErrPayoutStatementNotFound)
+		}
+
+		logs.Error("Failed to get payout statement by id", map[string]interface{}{
+			"statementID": id,
+			"error":       err.Error(),
+		})
+		return nil, errPackage.NewDomainErrorWithCause("EarningService", "GetPayoutStatementByID", "failed to get payout statement by id", err)
+	}
+
+	return statement, nil
+}
+
+func (s *EarningService) GetPayoutStatementsByDriver(ctx context.Context, driverID string) ([]entities.PayoutStatement, error) {
+	statements, err := s.repo.GetPayoutStatementsByDriver(ctx, driverID)
+	if err != nil {
+		logs.Error("Failed to get payout statements by driver", map[string]interface{}{
+			"driverID": driverID,
+			"error":    err.Error(),
+		})
+		return nil, errPackage.NewDomainErrorWithCause("EarningService", "GetPayoutStatementsByDriver", "failed to get payout statements", err)
+	}
+
+	return statements, nil
+}
+
+func isValidEarningRule(rule *entities.EarningRule) bool {
+	return rule.BasePerDelivery >= 0 && rule.PerKmRate >= 0 && rule.UrgentBonus >= 0 &&
+		rule.ZoneMultiplier > 0 && rule.ZoneMultiplier <= constants.MaxZoneMultiplier
+}
+
+func newPayoutStatementNumber(periodStart time.Time) string {
+	suffix := strings.ToUpper(strings.ReplaceAll(uuid.NewString(), "-", "")[:8])
+	return fmt.Sprintf("%s-%s-%s", constants.PayoutStatementNumberPrefix, periodStart.Format("20060102"), suffix)
+}
@@ -0,0 +1,148 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+type ImportService struct {
+	repo ports.ImportRepository
+}
+
+func NewImportService(repo ports.ImportRepository) interfaces.OrderImporter {
+	return &ImportService{
+		repo: repo,
+	}
+}
+
+// CreateImportJob registra un archivo de importación para ser procesado en segundo plano
+func (s *ImportService) CreateImportJob(ctx context.Context, job *entities.ImportJob) error {
+	// 1. Validar el contenido del trabajo
+	if job.TotalRows == 0 {
+		return errPackage.NewDomainErrorWithCause("ImportService", "CreateImportJob", "empty import", errPackage.ErrEmptyImport)
+	}
+
+	if job.TotalRows > constants.MaxImportRows {
+		return errPackage.NewDomainErrorWithCause("ImportService", "CreateImportJob", "too many rows", errPackage.ErrTooManyImportRows)
+	}
+
+	// 2. Completar los datos del trabajo
+	now := time.Now()
+	job.ID = uuid.NewString()
+	job.Status = constants.ImportStatusPending
+	job.CreatedAt = now
+	job.UpdatedAt = now
+
+	// 3. Guardar el trabajo
+	if err := s.repo.CreateImportJob(ctx, job); err != nil {
+		logs.Error("Failed to create import job", map[string]interface{}{
+			"companyID": job.CompanyID,
+			"error":     err.Error(),
+		})
+		return errPackage.NewDomainErrorWithCause("ImportService", "CreateImportJob", "failed to create import job", err)
+	}
+
+	logs.Info("Import job created successfully", map[string]interface{}{
+		"jobID":     job.ID,
+		"companyID": job.CompanyID,
+		"format":    job.Format,
+		"totalRows": job.TotalRows,
+	})
+
+	return nil
+}
+
+func (s *ImportService) GetImportJobByID(ctx context.Context, id string) (*entities.ImportJob, error) {
+	job, err := s.repo.GetImportJobByID(ctx, id)
+	if err != nil {
+		logs.Error("Failed to get import job by id", map[string]interface{}{
+			"jobID": id,
+			"error": err.Error(),
+		})
+
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errPackage.NewDomainErrorWithCause("ImportService", "GetImportJobByID", "import job not found", errPackage.ErrImportJobNotFound)
+		}
+
+		return nil, errPackage.NewDomainErrorWithCause("ImportService", "GetImportJobByID", "failed to get import job by id", err)
+	}
+
+	return job, nil
+}
+
+func (s *ImportService) GetImportJobsByCompany(ctx context.Context, companyID string) ([]entities.ImportJob, error) {
+	jobs, err := s.repo.GetImportJobsByCompany(ctx, companyID)
+	if err != nil {
+		logs.Error("Failed to get import jobs by company", map[string]interface{}{
+			"companyID": companyID,
+			"error":     err.Error(),
+		})
+		return nil, errPackage.NewDomainErrorWithCause("ImportService", "GetImportJobsByCompany", "failed to get import jobs by company", err)
+	}
+
+	return jobs, nil
+}
+
+func (s *ImportService) GetPendingImportJobs(ctx context.Context) ([]entities.ImportJob, error) {
+	jobs, err := s.repo.GetPendingImportJobs(ctx, constants.MaxPendingImportsPerRun)
+	if err != nil {
+		logs.Error("Failed to get pending import jobs", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, errPackage.NewDomainErrorWithCause("ImportService", "GetPendingImportJobs", "failed to get pending import jobs", err)
+	}
+
+	return jobs, nil
+}
+
+// ClaimImportJob reserva una importación pendiente para procesarla
+func (s *ImportService) ClaimImportJob(ctx context.Context, id string) (bool, error) {
+	claimed, err := s.repo.ClaimImportJob(ctx, id)
+	if err != nil {
+		logs.Error("Failed to claim import job", map[string]interface{}{
+			"jobID": id,
+			"error": err.Error(),
+		})
+		return false, errPackage.NewDomainErrorWithCause("ImportService", "ClaimImportJob", "failed to claim import job", err)
+	}
+
+	return claimed, nil
+}
+
+// FinishImportJob guarda el reporte y los totales de la importación, marcándola como fallida si hubo un error general
+func (s *ImportService) FinishImportJob(ctx context.Context, job *entities.ImportJob, jobErr error) error {
+	now := time.Now()
+	job.FinishedAt = &now
+	job.Status = constants.ImportStatusCompleted
+	if jobErr != nil {
+		job.Status = constants.ImportStatusFailed
+		job.ErrorMessage = jobErr.Error()
+	}
+
+	if err := s.repo.FinishImportJob(ctx, job); err != nil {
+		logs.Error("Failed to finish import job", map[string]interface{}{
+			"jobID": job.ID,
+			"error": err.Error(),
+		})
+		return errPackage.NewDomainErrorWithCause("ImportService", "FinishImportJob", "failed to finish import job", err)
+	}
+
+	logs.Info("Import job finished", map[string]interface{}{
+		"jobID":       job.ID,
+		"status":      job.Status,
+		"createdRows": job.CreatedRows,
+		"failedRows":  job.FailedRows,
+	})
+
+	return nil
+}
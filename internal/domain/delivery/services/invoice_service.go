@@ -0,0 +1,333 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/value_objects"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+type InvoiceService struct {
+	repo        ports.InvoiceRepository
+	companyRepo ports.CompanyRepository
+	txManager   ports.TransactionManager
+}
+
+func NewInvoiceService(repo ports.InvoiceRepository, companyRepo ports.CompanyRepository, txManager ports.TransactionManager) interfaces.Invoicer {
+	return &InvoiceService{
+		repo:        repo,
+		companyRepo: companyRepo,
+		txManager:   txManager,
+	}
+}
+
+// GenerateInvoice genera en borrador la factura de una empresa con los pedidos entregados en el periodo,
+// sus recargos y los créditos por pedidos perdidos
+func (s *InvoiceService) GenerateInvoice(ctx context.Context, companyID string, start, end time.Time, taxRate float64) (*entities.Invoice, error) {
+	// 1. Validar el periodo y el impuesto
+	if !start.Before(end) || start.After(time.Now()) {
+		return nil, errPackage.NewDomainErrorWithCause("InvoiceService", "GenerateInvoice", "invalid billing period", errPackage.ErrInvalidBillingPeriod)
+	}
+
+	if taxRate < 0 || taxRate > 1 {
+		return nil, errPackage.NewDomainErrorWithCause("InvoiceService", "GenerateInvoice", "invalid tax rate", errPackage.ErrInvalidTaxRate)
+	}
+
+	// 2. Obtener la empresa con su tarifa de entrega
+	company, err := s.companyRepo.GetCompanyByID(ctx, companyID)
+	if err != nil {
+		logs.Error("Failed to get company by id", map[string]interface{}{
+			"companyID": companyID,
+			"error":     err.Error(),
+		})
+		return nil, errPackage.NewDomainErrorWithCause("InvoiceService", "GenerateInvoice", "failed to get company by id", err)
+	}
+
+	// 3. Generar la factura con la empresa bloqueada, así dos procesos no facturan el mismo periodo a la vez
+	var invoice *entities.Invoice
+	var delivered, lost []entities.Order
+	err = s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.LockCompanyBilling(ctx, companyID); err != nil {
+			logs.Error("Failed to lock company billing", map[string]interface{}{
+				"companyID": companyID,
+				"error":     err.Error(),
+			})
+			return errPackage.NewDomainErrorWithCause("InvoiceService", "GenerateInvoice", "failed to lock company billing", err)
+		}
+
+		// 4. Evitar facturar dos veces el mismo periodo
+		exists, err := s.repo.ExistsInvoiceForPeriod(ctx, companyID, start, end)
+		if err != nil {
+			logs.Error("Failed to check invoice period", map[string]interface{}{
+				"companyID": companyID,
+				"error":     err.Error(),
+			})
+			return errPackage.NewDomainErrorWithCause("InvoiceService", "GenerateInvoice", "failed to check invoice period", err)
+		}
+
+		if exists {
+			return errPackage.NewDomainErrorWithCause("InvoiceService", "GenerateInvoice", "invoice already exists", errPackage.ErrInvoiceAlreadyExists)
+		}
+
+		// 5. Obtener los pedidos a facturar y a acreditar
+		delivered, err = s.repo.GetBillableOrders(ctx, companyID, start, end)
+		if err != nil {
+			logs.Error("Failed to get billable orders", map[string]interface{}{
+				"companyID": companyID,
+				"error":     err.Error(),
+			})
+			return errPackage.NewDomainErrorWithCause("InvoiceService", "GenerateInvoice", "failed to get billable orders", err)
+		}
+
+		lost, err = s.repo.GetLostOrders(ctx, companyID, start, end)
+		if err != nil {
+			logs.Error("Failed to get lost orders", map[string]interface{}{
+				"companyID": companyID,
+				"error":     err.Error(),
+			})
+			return errPackage.NewDomainErrorWithCause("InvoiceService", "GenerateInvoice", "failed to get lost orders", err)
+		}
+
+		if len(delivered) == 0 && len(lost) == 0 {
+			return errPackage.NewDomainErrorWithCause("InvoiceService", "GenerateInvoice", "nothing to invoice", errPackage.ErrNothingToInvoice)
+		}
+
+		// 6. Construir la factura y calcular sus totales
+		invoice = &entities.Invoice{
+			ID:            uuid.NewString(),
+			CompanyID:     companyID,
+			InvoiceNumber: newInvoiceNumber(start),
+			Status:        constants.InvoiceStatusDraft,
+			Currency:      constants.InvoiceCurrency,
+			PeriodStart:   start,
+			PeriodEnd:     end,
+			TaxRate:       taxRate,
+			PaymentTerms:  paymentTermsOf(company),
+		}
+		invoice.Lines = newInvoiceLines(invoice.ID, company.DeliveryRate, delivered, lost)
+		invoice.CalculateTotals()
+
+		// 7. Guardar la factura
+		if err = s.repo.CreateInvoice(ctx, invoice); err != nil {
+			logs.Error("Failed to create invoice", map[string]interface{}{
+				"companyID": companyID,
+				"error":     err.Error(),
+			})
+			return errPackage.NewDomainErrorWithCause("InvoiceService", "GenerateInvoice", "failed to create invoice", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logs.Info("Invoice generated", map[string]interface{}{
+		"invoiceID": invoice.ID,
+		"companyID": companyID,
+		"orders":    len(delivered),
+		"credits":   len(lost),
+		"total":     invoice.Total,
+	})
+
+	return invoice, nil
+}
+
+// GenerateCycleInvoices genera las facturas del ciclo para todas las empresas activas que aún no la tienen
+func (s *InvoiceService) GenerateCycleInvoices(ctx context.Context, start, end time.Time) (int, error) {
+	companies, err := s.repo.GetBillableCompanies(ctx)
+	if err != nil {
+		logs.Error("Failed to get billable companies", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return 0, errPackage.NewDomainErrorWithCause("InvoiceService", "GenerateCycleInvoices", "failed to get billable companies", err)
+	}
+
+	generated := 0
+	for _, company := range companies {
+		// 1. Las empresas ya facturadas o sin movimientos en el periodo se omiten
+		_, err = s.GenerateInvoice(ctx, company.ID, start, end, constants.DefaultInvoiceTaxRate)
+		if err != nil {
+			if !hasDomainCause(err, errPackage.ErrInvoiceAlreadyExists) && !hasDomainCause(err, errPackage.ErrNothingToInvoice) {
+				logs.Error("Failed to generate cycle invoice", map[string]interface{}{
+					"companyID": company.ID,
+					"error":     err.Error(),
+				})
+			}
+			continue
+		}
+		generated++
+	}
+
+	return generated, nil
+}
+
+func (s *InvoiceService) GetInvoiceByID(ctx context.Context, id string) (*entities.Invoice, error) {
+	invoice, err := s.repo.GetInvoiceByID(ctx, id)
+	if err != nil {
+		logs.Error("Failed to get invoice by id", map[string]interface{}{
+			"invoiceID": id,
+			"error":     err.Error(),
+		})
+
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errPackage.NewDomainErrorWithCause("InvoiceService", "GetInvoiceByID", "invoice not found", errPackage.ErrInvoiceNotFound)
+		}
+
+		return nil, errPackage.NewDomainErrorWithCause("InvoiceService", "GetInvoiceByID", "failed to get invoice by id", err)
+	}
+
+	return invoice, nil
+}
+
+func (s *InvoiceService) GetInvoicesByCompany(ctx context.Context, companyID string, statuses []string) ([]entities.Invoice, error) {
+	invoices, err := s.repo.GetInvoicesByCompany(ctx, companyID, statuses)
+	if err != nil {
+		logs.Error("Failed to get invoices by company", map[string]interface{}{
+			"companyID": companyID,
+			"error":     err.Error(),
+		})
+		return nil, errPackage.NewDomainErrorWithCause("InvoiceService", "GetInvoicesByCompany", "failed to get invoices by company", err)
+	}
+
+	return invoices, nil
+}
+
+// ChangeInvoiceStatus emite, marca como pagada o anula una factura
+func (s *InvoiceService) ChangeInvoiceStatus(ctx context.Context, id, status, reason string) (*entities.Invoice, error) {
+	// 1. Validar el estado solicitado
+	nextStatus := value_objects.NewInvoiceStatus(status)
+	if !nextStatus.IsValid() {
+		return nil, errPackage.NewDomainErrorWithCause("InvoiceService", "ChangeInvoiceStatus", "invalid invoice status", errPackage.ErrInvalidInvoiceStatus)
+	}
+
+	// 2. Obtener la factura
+	invoice, err := s.GetInvoiceByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Validar la transición
+	if !value_objects.NewInvoiceStatus(invoice.Status).CanTransitionTo(nextStatus) {
+		logs.Warn("Invalid invoice status transition", map[string]interface{}{
+			"invoiceID": id,
+			"from":      invoice.Status,
+			"to":        nextStatus.GetValue(),
+		})
+		return nil, errPackage.NewDomainErrorWithCause("InvoiceService", "ChangeInvoiceStatus",
+			fmt.Sprintf("cannot change invoice status from %s to %s", invoice.Status, nextStatus.GetValue()),
+			errPackage.ErrInvalidInvoiceTransition)
+	}
+
+	// 4. Registrar las fechas del nuevo estado
+	now := time.Now()
+	switch nextStatus.GetValue() {
+	case constants.InvoiceStatusIssued:
+		dueDate := now.AddDate(0, 0, paymentTermDaysOf(invoice.Company))
+		invoice.IssuedAt = &now
+		invoice.DueDate = &dueDate
+	case constants.InvoiceStatusPaid:
+		invoice.PaidAt = &now
+	case constants.InvoiceStatusVoid:
+		if strings.TrimSpace(reason) == "" {
+			return nil, errPackage.NewDomainErrorWithCause("InvoiceService", "ChangeInvoiceStatus", "void reason required", errPackage.ErrVoidReasonRequired)
+		}
+		invoice.VoidedAt = &now
+		invoice.VoidReason = reason
+	}
+	invoice.Status = nextStatus.GetValue()
+
+	// 5. Actualizar la factura
+	if err = s.repo.UpdateInvoiceStatus(ctx, invoice); err != nil {
+		logs.Error("Failed to update invoice status", map[string]interface{}{
+			"invoiceID": id,
+			"status":    invoice.Status,
+			"error":     err.Error(),
+		})
+		return nil, errPackage.NewDomainErrorWithCause("InvoiceService", "ChangeInvoiceStatus", "failed to update invoice status", err)
+	}
+
+	return invoice, nil
+}
+
+// newInvoiceLines genera una línea por entrega con sus recargos y un crédito por cada pedido perdido
+func newInvoiceLines(invoiceID string, rate float64, delivered, lost []entities.Order) []entities.InvoiceLine {
+	lines := make([]entities.InvoiceLine, 0, len(delivered)+len(lost))
+	addLine := func(orderID, lineType, description string, quantity int, unitPrice float64) {
+		id := orderID
+		lines = append(lines, entities.InvoiceLine{
+			ID:          uuid.NewString(),
+			InvoiceID:   invoiceID,
+			LineNumber:  len(lines) + 1,
+			OrderID:     &id,
+			Type:        lineType,
+			Description: description,
+			Quantity:    quantity,
+			UnitPrice:   roundMoney(unitPrice),
+			Amount:      roundMoney(unitPrice * float64(quantity)),
+		})
+	}
+
+	for _, order := range delivered {
+		addLine(order.ID, constants.InvoiceLineDelivery, "Entrega "+order.TrackingNumber, 1, rate)
+
+		if order.PackageDetail != nil && order.PackageDetail.IsUrgent {
+			addLine(order.ID, constants.InvoiceLineSurcharge, "Recargo urgente "+order.TrackingNumber, 1, rate*constants.UrgentSurchargeRate)
+		}
+
+		if order.PackageDetail != nil && order.PackageDetail.IsFragile {
+			addLine(order.ID, constants.InvoiceLineSurcharge, "Recargo frágil "+order.TrackingNumber, 1, rate*constants.FragileSurchargeRate)
+		}
+
+		if extra := len(order.Parcels) - 1; extra > 0 {
+			addLine(order.ID, constants.InvoiceLineSurcharge, "Bultos adicionales "+order.TrackingNumber, extra, rate*constants.ExtraParcelSurchargeRate)
+		}
+	}
+
+	for _, order := range lost {
+		addLine(order.ID, constants.InvoiceLineCredit, "Crédito por pedido perdido "+order.TrackingNumber, 1, -rate*constants.LostOrderCreditRate)
+	}
+
+	return lines
+}
+
+// newInvoiceNumber genera un número de factura con el mes facturado y un sufijo aleatorio
+func newInvoiceNumber(periodStart time.Time) string {
+	suffix := strings.ToUpper(strings.ReplaceAll(uuid.NewString(), "-", "")[:8])
+	return fmt.Sprintf("%s-%s-%s", constants.InvoiceNumberPrefix, periodStart.Format("200601"), suffix)
+}
+
+func paymentTermsOf(company *entities.Company) string {
+	if company == nil || company.ContractDetails == "" {
+		return ""
+	}
+
+	details, err := value_objects.ContractDetailsFromJSON(company.ContractDetails)
+	if err != nil {
+		return ""
+	}
+
+	return details.PaymentTerms
+}
+
+func paymentTermDaysOf(company *entities.Company) int {
+	return value_objects.NewContractDetails("", paymentTermsOf(company), "", 0).PaymentTermDays()
+}
+
+// hasDomainCause indica si el error de dominio fue causado por el error indicado
+func hasDomainCause(err, cause error) bool {
+	var domainErr *errPackage.DomainError
+	return errors.As(err, &domainErr) && domainErr.Err == cause
+}
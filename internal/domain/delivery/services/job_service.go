@@ -0,0 +1,87 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+type JobService struct {
+	repo     ports.JobRepository
+	instance string
+}
+
+// NewJobService crea el registro de ejecuciones de los trabajos, instance identifica a la instancia que los ejecuta
+func NewJobService(repo ports.JobRepository, instance string) interfaces.JobRecorder {
+	return &JobService{
+		repo:     repo,
+		instance: instance,
+	}
+}
+
+// StartRun registra el inicio de un intento de ejecución del trabajo
+func (s *JobService) StartRun(ctx context.Context, jobName string, attempt int, scheduledAt time.Time) (*entities.JobRun, error) {
+	run := &entities.JobRun{
+		ID:          uuid.NewString(),
+		JobName:     jobName,
+		Status:      constants.JobRunStatusRunning,
+		Attempt:     attempt,
+		Instance:    s.instance,
+		ScheduledAt: scheduledAt,
+		StartedAt:   time.Now(),
+	}
+
+	if err := s.repo.CreateJobRun(ctx, run); err != nil {
+		logs.Error("Failed to create job run", map[string]interface{}{
+			"job":   jobName,
+			"error": err.Error(),
+		})
+		return nil, errPackage.NewDomainErrorWithCause("JobService", "StartRun", "failed to create job run", err)
+	}
+
+	return run, nil
+}
+
+// FinishRun registra el resultado del intento, runErr indica que el intento falló
+func (s *JobService) FinishRun(ctx context.Context, run *entities.JobRun, result string, runErr error) error {
+	finishedAt := time.Now()
+	run.FinishedAt = &finishedAt
+	run.Result = result
+	run.Status = constants.JobRunStatusSucceeded
+	if runErr != nil {
+		run.Status = constants.JobRunStatusFailed
+		run.Error = truncateText(runErr.Error(), 1000)
+	}
+
+	if err := s.repo.UpdateJobRun(ctx, run); err != nil {
+		logs.Error("Failed to update job run", map[string]interface{}{
+			"job":   run.JobName,
+			"runID": run.ID,
+			"error": err.Error(),
+		})
+		return errPackage.NewDomainErrorWithCause("JobService", "FinishRun", "failed to update job run", err)
+	}
+
+	return nil
+}
+
+func (s *JobService) GetRuns(ctx context.Context, jobName string, params *entities.PaginationQueryParams) ([]entities.JobRun, int64, error) {
+	runs, total, err := s.repo.GetJobRuns(ctx, jobName, params)
+	if err != nil {
+		logs.Error("Failed to get job runs", map[string]interface{}{
+			"job":   jobName,
+			"error": err.Error(),
+		})
+		return nil, 0, errPackage.NewDomainErrorWithCause("JobService", "GetRuns", "failed to get job runs", err)
+	}
+
+	return runs, total, nil
+}
@@ -0,0 +1,75 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+// MaintenanceService limpia los registros que ya no se usan, lo ejecutan los trabajos periódicos
+type MaintenanceService struct {
+	userRepo    ports.UserRepository
+	orderRepo   ports.OrdererRepository
+	webhookRepo ports.WebhookRepository
+}
+
+func NewMaintenanceService(userRepo ports.UserRepository, orderRepo ports.OrdererRepository, webhookRepo ports.WebhookRepository) interfaces.Maintainer {
+	return &MaintenanceService{
+		userRepo:    userRepo,
+		orderRepo:   orderRepo,
+		webhookRepo: webhookRepo,
+	}
+}
+
+// CleanExpiredSessions elimina las sesiones expiradas de todos los usuarios
+func (s *MaintenanceService) CleanExpiredSessions(ctx context.Context) (int64, error) {
+	deleted, err := s.userRepo.DeleteExpiredSessions(ctx, time.Now())
+	if err != nil {
+		logs.Error("Failed to delete expired sessions", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return 0, errPackage.NewDomainErrorWithCause("MaintenanceService", "CleanExpiredSessions", "failed to delete expired sessions", err)
+	}
+
+	return deleted, nil
+}
+
+// PurgeDeletedRecords elimina definitivamente los pedidos y webhooks eliminados lógicamente que superaron el
+// periodo de retención, devuelve la cantidad purgada de cada tipo de registro
+func (s *MaintenanceService) PurgeDeletedRecords(ctx context.Context) (map[string]int64, error) {
+	deletedBefore := time.Now().Add(-constants.DeletedRecordsRetention)
+	purged := map[string]int64{"orders": 0, "webhook_endpoints": 0}
+
+	// 1. Purgar los pedidos por lotes para no bloquear las tablas en una sola transacción
+	for {
+		orders, err := s.orderRepo.PurgeDeletedOrders(ctx, deletedBefore, constants.PurgeBatchSize)
+		if err != nil {
+			logs.Error("Failed to purge deleted orders", map[string]interface{}{
+				"error": err.Error(),
+			})
+			return purged, errPackage.NewDomainErrorWithCause("MaintenanceService", "PurgeDeletedRecords", "failed to purge deleted orders", err)
+		}
+
+		purged["orders"] += orders
+		if orders < int64(constants.PurgeBatchSize) {
+			break
+		}
+	}
+
+	// 2. Purgar los webhooks y su registro de entregas
+	endpoints, err := s.webhookRepo.PurgeDeletedEndpoints(ctx, deletedBefore)
+	if err != nil {
+		logs.Error("Failed to purge deleted webhook endpoints", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return purged, errPackage.NewDomainErrorWithCause("MaintenanceService", "PurgeDeletedRecords", "failed to purge deleted webhook endpoints", err)
+	}
+	purged["webhook_endpoints"] = endpoints
+
+	return purged, nil
+}
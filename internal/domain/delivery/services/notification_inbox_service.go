@@ -0,0 +1,129 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+type NotificationInboxService struct {
+	repo      ports.NotificationRepository
+	publisher ports.NotificationPublisher
+}
+
+func NewNotificationInboxService(repo ports.NotificationRepository, publisher ports.NotificationPublisher) interfaces.NotificationInbox {
+	return &NotificationInboxService{
+		repo:      repo,
+		publisher: publisher,
+	}
+}
+
+// GetNotifications obtiene la bandeja del usuario paginada y filtrada
+func (s *NotificationInboxService) GetNotifications(ctx context.Context, userID string, params *entities.NotificationQueryParams) ([]entities.Notification, int64, error) {
+	notifications, total, err := s.repo.GetUserNotifications(ctx, userID, params)
+	if err != nil {
+		logs.Error("Failed to get user notifications", map[string]interface{}{
+			"userID": userID,
+			"error":  err.Error(),
+		})
+		return nil, 0, errPackage.NewDomainErrorWithCause("NotificationInboxService", "GetNotifications", "failed to get notifications", err)
+	}
+
+	return notifications, total, nil
+}
+
+// GetUnreadCounts obtiene la cantidad de notificaciones sin leer por tipo y el total
+func (s *NotificationInboxService) GetUnreadCounts(ctx context.Context, userID string) (map[string]int64, int64, error) {
+	counts, err := s.repo.GetUnreadCounts(ctx, userID)
+	if err != nil {
+		logs.Error("Failed to get unread notification counts", map[string]interface{}{
+			"userID": userID,
+			"error":  err.Error(),
+		})
+		return nil, 0, errPackage.NewDomainErrorWithCause("NotificationInboxService", "GetUnreadCounts", "failed to get unread notification counts", err)
+	}
+
+	var total int64
+	for _, count := range counts {
+		total += count
+	}
+
+	return counts, total, nil
+}
+
+// MarkAsRead marca como leída una notificación del usuario y actualiza el contador de sus clientes conectados
+func (s *NotificationInboxService) MarkAsRead(ctx context.Context, userID, notificationID string) error {
+	if err := s.repo.MarkAsRead(ctx, userID, notificationID, time.Now()); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errPackage.NewDomainErrorWithCause("NotificationInboxService", "MarkAsRead", "notification not found", errPackage.ErrNotificationNotFound)
+		}
+		logs.Error("Failed to mark notification as read", map[string]interface{}{
+			"userID":         userID,
+			"notificationID": notificationID,
+			"error":          err.Error(),
+		})
+		return errPackage.NewDomainErrorWithCause("NotificationInboxService", "MarkAsRead", "failed to mark notification as read", err)
+	}
+
+	s.publishUnreadCount(ctx, userID)
+	return nil
+}
+
+// MarkAllAsRead marca como leídas todas las notificaciones del usuario, devuelve la cantidad actualizada
+func (s *NotificationInboxService) MarkAllAsRead(ctx context.Context, userID string) (int64, error) {
+	updated, err := s.repo.MarkAllAsRead(ctx, userID, time.Now())
+	if err != nil {
+		logs.Error("Failed to mark all notifications as read", map[string]interface{}{
+			"userID": userID,
+			"error":  err.Error(),
+		})
+		return 0, errPackage.NewDomainErrorWithCause("NotificationInboxService", "MarkAllAsRead", "failed to mark all notifications as read", err)
+	}
+
+	if updated > 0 {
+		s.publishUnreadCount(ctx, userID)
+	}
+
+	return updated, nil
+}
+
+// publishUnreadCount envía el contador actualizado a los clientes conectados, un fallo no afecta la operación
+func (s *NotificationInboxService) publishUnreadCount(ctx context.Context, userID string) {
+	unread, err := countUnreadNotifications(ctx, s.repo, userID)
+	if err != nil {
+		logs.Warn("Failed to count unread notifications", map[string]interface{}{
+			"userID": userID,
+			"error":  err.Error(),
+		})
+		return
+	}
+
+	s.publisher.PublishInboxEvent(userID, &entities.InboxEvent{
+		Type:        constants.InboxEventUnreadCount,
+		UnreadCount: unread,
+	})
+}
+
+// countUnreadNotifications obtiene el total de notificaciones sin leer del usuario
+func countUnreadNotifications(ctx context.Context, repo ports.NotificationRepository, userID string) (int64, error) {
+	counts, err := repo.GetUnreadCounts(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	var total int64
+	for _, count := range counts {
+		total += count
+	}
+
+	return total, nil
+}
@@ -0,0 +1,212 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+type NotificationPreferenceService struct {
+	repo ports.NotificationRepository
+}
+
+func NewNotificationPreferenceService(repo ports.NotificationRepository) interfaces.NotificationPreferencer {
+	return &NotificationPreferenceService{
+		repo: repo,
+	}
+}
+
+// GetPreferences obtiene las preferencias de cada tipo de notificación del usuario, los tipos que el usuario
+// aún no tiene se guardan con los valores por defecto de su rol
+func (s *NotificationPreferenceService) GetPreferences(ctx context.Context, userID, role string) ([]entities.NotificationPreference, error) {
+	// 1. Obtener las preferencias guardadas
+	saved, err := s.repo.GetPreferences(ctx, userID)
+	if err != nil {
+		logs.Error("Failed to get notification preferences", map[string]interface{}{
+			"userID": userID,
+			"error":  err.Error(),
+		})
+		return nil, errPackage.NewDomainErrorWithCause("NotificationPreferenceService", "GetPreferences", "failed to get notification preferences", err)
+	}
+
+	savedByType := make(map[string]entities.NotificationPreference, len(saved))
+	for _, preference := range saved {
+		savedByType[preference.NotificationType] = preference
+	}
+
+	// 2. Completar los tipos faltantes con los valores por defecto del rol
+	preferences := make([]entities.NotificationPreference, 0, len(constants.NotificationTypes))
+	missing := make([]entities.NotificationPreference, 0)
+	for _, notificationType := range constants.NotificationTypes {
+		if preference, ok := savedByType[notificationType]; ok {
+			preferences = append(preferences, preference)
+			continue
+		}
+
+		preference := *entities.DefaultNotificationPreference(userID, role, notificationType)
+		preferences = append(preferences, preference)
+		missing = append(missing, preference)
+	}
+
+	// 3. Guardar los valores por defecto, un fallo no impide responder con ellos
+	if len(missing) > 0 {
+		if err = s.repo.SeedPreferences(ctx, missing); err != nil {
+			logs.Warn("Failed to seed default notification preferences", map[string]interface{}{
+				"userID": userID,
+				"role":   role,
+				"error":  err.Error(),
+			})
+		}
+	}
+
+	return preferences, nil
+}
+
+// UpdatePreferences guarda los canales de los tipos indicados, el resto conserva su configuración
+func (s *NotificationPreferenceService) UpdatePreferences(ctx context.Context, userID, role string, preferences []entities.NotificationPreference) ([]entities.NotificationPreference, error) {
+	// 1. Validar que los tipos existan y no estén repetidos
+	seen := make(map[string]bool, len(preferences))
+	now := time.Now()
+	for i := range preferences {
+		if !isNotificationType(preferences[i].NotificationType) || seen[preferences[i].NotificationType] {
+			return nil, errPackage.NewDomainErrorWithCause("NotificationPreferenceService", "UpdatePreferences", "invalid notification type", errPackage.ErrInvalidNotificationType)
+		}
+		seen[preferences[i].NotificationType] = true
+
+		preferences[i].UserID = userID
+		preferences[i].UpdatedAt = now
+	}
+
+	// 2. Guardar las preferencias
+	if len(preferences) > 0 {
+		if err := s.repo.SavePreferences(ctx, preferences); err != nil {
+			logs.Error("Failed to save notification preferences", map[string]interface{}{
+				"userID": userID,
+				"error":  err.Error(),
+			})
+			return nil, errPackage.NewDomainErrorWithCause("NotificationPreferenceService", "UpdatePreferences", "failed to save notification preferences", err)
+		}
+	}
+
+	return s.GetPreferences(ctx, userID, role)
+}
+
+// GetDevices obtiene los dispositivos activos del usuario
+func (s *NotificationPreferenceService) GetDevices(ctx context.Context, userID string) ([]entities.NotificationDevice, error) {
+	devices, err := s.repo.GetActiveDevices(ctx, userID)
+	if err != nil {
+		logs.Error("Failed to get notification devices", map[string]interface{}{
+			"userID": userID,
+			"error":  err.Error(),
+		})
+		return nil, errPackage.NewDomainErrorWithCause("NotificationPreferenceService", "GetDevices", "failed to get notification devices", err)
+	}
+
+	return devices, nil
+}
+
+// RegisterDevice registra o renueva el token push de un dispositivo, un token ya registrado se reasigna al usuario
+// en lugar de duplicarse y el token anterior del dispositivo, si se indica, se desactiva
+func (s *NotificationPreferenceService) RegisterDevice(ctx context.Context, device *entities.NotificationDevice, previousToken string) error {
+	// 1. Validar el token y el tipo de dispositivo
+	device.DeviceToken = strings.TrimSpace(device.DeviceToken)
+	device.DeviceType = strings.ToUpper(device.DeviceType)
+	if device.DeviceToken == "" {
+		return errPackage.NewDomainErrorWithCause("NotificationPreferenceService", "RegisterDevice", "invalid device token", errPackage.ErrInvalidDeviceToken)
+	}
+	if !constants.ValidDeviceTypes[device.DeviceType] {
+		return errPackage.NewDomainErrorWithCause("NotificationPreferenceService", "RegisterDevice", "invalid device type", errPackage.ErrInvalidDeviceType)
+	}
+
+	// 2. Desactivar el token anterior cuando el proveedor lo renovó
+	previousToken = strings.TrimSpace(previousToken)
+	if previousToken != "" && previousToken != device.DeviceToken {
+		if err := s.repo.DeactivateUserDeviceToken(ctx, device.UserID, previousToken); err != nil {
+			logs.Warn("Failed to deactivate previous device token", map[string]interface{}{
+				"userID": device.UserID,
+				"error":  err.Error(),
+			})
+		}
+	}
+
+	// 3. Reutilizar el registro del token si ya existe
+	now := time.Now()
+	existing, err := s.repo.GetDeviceByToken(ctx, device.DeviceToken)
+	switch {
+	case err == nil:
+		existing.UserID = device.UserID
+		existing.DeviceType = device.DeviceType
+		existing.IsActive = true
+		existing.UpdatedAt = now
+		*device = *existing
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		device.ID = uuid.NewString()
+		device.IsActive = true
+		device.CreatedAt = now
+		device.UpdatedAt = now
+	default:
+		logs.Error("Failed to get notification device", map[string]interface{}{
+			"userID": device.UserID,
+			"error":  err.Error(),
+		})
+		return errPackage.NewDomainErrorWithCause("NotificationPreferenceService", "RegisterDevice", "failed to get notification device", err)
+	}
+
+	// 4. Guardar el dispositivo
+	if err = s.repo.SaveDevice(ctx, device); err != nil {
+		logs.Error("Failed to save notification device", map[string]interface{}{
+			"userID": device.UserID,
+			"error":  err.Error(),
+		})
+		return errPackage.NewDomainErrorWithCause("NotificationPreferenceService", "RegisterDevice", "failed to save notification device", err)
+	}
+
+	return nil
+}
+
+// DeregisterDevice desactiva un dispositivo del usuario, por ejemplo al cerrar sesión en la aplicación
+func (s *NotificationPreferenceService) DeregisterDevice(ctx context.Context, userID, deviceID string) error {
+	if _, err := s.repo.GetUserDevice(ctx, userID, deviceID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errPackage.NewDomainErrorWithCause("NotificationPreferenceService", "DeregisterDevice", "notification device not found", errPackage.ErrNotificationDeviceNotFound)
+		}
+		logs.Error("Failed to get notification device", map[string]interface{}{
+			"userID":   userID,
+			"deviceID": deviceID,
+			"error":    err.Error(),
+		})
+		return errPackage.NewDomainErrorWithCause("NotificationPreferenceService", "DeregisterDevice", "failed to get notification device", err)
+	}
+
+	if err := s.repo.DeactivateDevice(ctx, deviceID); err != nil {
+		logs.Error("Failed to deactivate notification device", map[string]interface{}{
+			"userID":   userID,
+			"deviceID": deviceID,
+			"error":    err.Error(),
+		})
+		return errPackage.NewDomainErrorWithCause("NotificationPreferenceService", "DeregisterDevice", "failed to deactivate notification device", err)
+	}
+
+	return nil
+}
+
+func isNotificationType(notificationType string) bool {
+	for _, known := range constants.NotificationTypes {
+		if known == notificationType {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,410 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+type NotificationService struct {
+	repo      ports.NotificationRepository
+	email     ports.EmailSender
+	sms       ports.SMSSender
+	push      ports.PushSender
+	publisher ports.NotificationPublisher
+}
+
+func NewNotificationService(repo ports.NotificationRepository, email ports.EmailSender, sms ports.SMSSender, push ports.PushSender, publisher ports.NotificationPublisher) interfaces.Notifier {
+	return &NotificationService{
+		repo:      repo,
+		email:     email,
+		sms:       sms,
+		push:      push,
+		publisher: publisher,
+	}
+}
+
+// Notify genera la notificación con la plantilla de su tipo, la guarda en la bandeja del usuario y la despacha
+// por los canales habilitados en sus preferencias, guardando el resultado de cada canal
+func (s *NotificationService) Notify(ctx context.Context, request *entities.NotificationRequest) (*entities.Notification, error) {
+	if request == nil || request.UserID == "" || request.Type == "" {
+		return nil, errPackage.NewDomainErrorWithCause("NotificationService", "Notify", "invalid notification request", errPackage.ErrInvalidNotificationRequest)
+	}
+
+	// 1. Obtener el destinatario
+	user, err := s.repo.GetRecipientUser(ctx, request.UserID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errPackage.NewDomainErrorWithCause("NotificationService", "Notify", "notification recipient not found", errPackage.ErrNotificationRecipientNotFound)
+		}
+		logs.Error("Failed to get notification recipient", map[string]interface{}{
+			"userID": request.UserID,
+			"error":  err.Error(),
+		})
+		return nil, errPackage.NewDomainErrorWithCause("NotificationService", "Notify", "failed to get notification recipient", err)
+	}
+
+	// 2. Obtener la plantilla del tipo de notificación
+	tmpl, err := s.resolveTemplate(ctx, request.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Generar el contenido que se envía y el que se guarda con los datos sensibles enmascarados
+	title, content, err := renderNotification(tmpl, request.Data, request.Secrets, false)
+	if err != nil {
+		return nil, err
+	}
+	storedTitle, storedContent, err := renderNotification(tmpl, request.Data, request.Secrets, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// 4. Determinar los canales a partir de las preferencias del usuario
+	preference, err := s.repo.GetPreference(ctx, request.UserID, request.Type)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			logs.Warn("Failed to get notification preferences, using defaults", map[string]interface{}{
+				"userID": request.UserID,
+				"type":   request.Type,
+				"error":  err.Error(),
+			})
+		}
+		preference = entities.DefaultNotificationPreference(request.UserID, recipientRole(user), request.Type)
+	}
+	channels := preference.EnabledChannels()
+
+	// 5. Guardar la notificación en la bandeja del usuario
+	notification := &entities.Notification{
+		ID:         uuid.NewString(),
+		UserID:     request.UserID,
+		TemplateID: tmpl.ID,
+		Title:      storedTitle,
+		Content:    storedContent,
+		Type:       request.Type,
+		Metadata:   encodeNotificationMetadata(request.Metadata),
+		Channels:   strings.Join(channels, ","),
+		Status:     constants.NotificationStatusPending,
+	}
+
+	if err = s.repo.CreateNotification(ctx, notification); err != nil {
+		logs.Error("Failed to create notification", map[string]interface{}{
+			"userID": request.UserID,
+			"type":   request.Type,
+			"error":  err.Error(),
+		})
+		return nil, errPackage.NewDomainErrorWithCause("NotificationService", "Notify", "failed to create notification", err)
+	}
+
+	// 6. Despachar por cada canal y guardar el resultado
+	for _, channel := range channels {
+		notification.Deliveries = append(notification.Deliveries, s.dispatch(ctx, channel, user, request, title, content)...)
+	}
+
+	notification.Status = summarizeDeliveries(notification.Deliveries)
+	if notification.Status == constants.NotificationStatusSent || notification.Status == constants.NotificationStatusPartial {
+		now := time.Now()
+		notification.SentAt = &now
+	}
+
+	if err = s.repo.SaveDeliveryResult(ctx, notification); err != nil {
+		logs.Error("Failed to save notification delivery result", map[string]interface{}{
+			"notificationID": notification.ID,
+			"status":         notification.Status,
+			"error":          err.Error(),
+		})
+		return nil, errPackage.NewDomainErrorWithCause("NotificationService", "Notify", "failed to save notification delivery result", err)
+	}
+
+	// 7. Avisar a los clientes conectados del usuario junto con su nuevo contador de no leídas
+	unread, err := countUnreadNotifications(ctx, s.repo, request.UserID)
+	if err != nil {
+		logs.Warn("Failed to count unread notifications", map[string]interface{}{
+			"userID": request.UserID,
+			"error":  err.Error(),
+		})
+	}
+	s.publisher.PublishInboxEvent(request.UserID, &entities.InboxEvent{
+		Type:         constants.InboxEventNotification,
+		Notification: notification,
+		UnreadCount:  unread,
+	})
+
+	return notification, nil
+}
+
+// NotifyRole envía la notificación a cada usuario activo con el rol indicado, filtrando por empresa cuando se indica,
+// devuelve la cantidad de usuarios notificados
+func (s *NotificationService) NotifyRole(ctx context.Context, role, companyID string, request *entities.NotificationRequest) (int, error) {
+	if request == nil || request.Type == "" {
+		return 0, errPackage.NewDomainErrorWithCause("NotificationService", "NotifyRole", "invalid notification request", errPackage.ErrInvalidNotificationRequest)
+	}
+
+	// 1. Obtener los usuarios con el rol
+	userIDs, err := s.repo.GetUserIDsByRole(ctx, role, companyID)
+	if err != nil {
+		logs.Error("Failed to get users by role", map[string]interface{}{
+			"role":      role,
+			"companyID": companyID,
+			"error":     err.Error(),
+		})
+		return 0, errPackage.NewDomainErrorWithCause("NotificationService", "NotifyRole", "failed to get users by role", err)
+	}
+
+	// 2. Notificar a cada usuario, un fallo no detiene al resto
+	notified := 0
+	for _, userID := range userIDs {
+		userRequest := *request
+		userRequest.UserID = userID
+
+		if _, err = s.Notify(ctx, &userRequest); err != nil {
+			logs.Warn("Failed to notify user", map[string]interface{}{
+				"userID": userID,
+				"type":   request.Type,
+				"error":  err.Error(),
+			})
+			continue
+		}
+		notified++
+	}
+
+	return notified, nil
+}
+
+// resolveTemplate obtiene la plantilla activa del tipo, si no existe se crea a partir de la plantilla por defecto
+func (s *NotificationService) resolveTemplate(ctx context.Context, notificationType string) (*entities.NotificationTemplate, error) {
+	tmpl, err := s.repo.GetActiveTemplateByType(ctx, notificationType)
+	if err == nil {
+		return tmpl, nil
+	}
+
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		logs.Error("Failed to get notification template", map[string]interface{}{
+			"type":  notificationType,
+			"error": err.Error(),
+		})
+		return nil, errPackage.NewDomainErrorWithCause("NotificationService", "resolveTemplate", "failed to get notification template", err)
+	}
+
+	tmpl = entities.DefaultNotificationTemplate(notificationType)
+	if tmpl == nil {
+		return nil, errPackage.NewDomainErrorWithCause("NotificationService", "resolveTemplate", "notification template not found", errPackage.ErrNotificationTemplateNotFound)
+	}
+
+	tmpl.ID = uuid.NewString()
+	if err = s.repo.CreateTemplate(ctx, tmpl); err != nil {
+		logs.Error("Failed to create default notification template", map[string]interface{}{
+			"type":  notificationType,
+			"error": err.Error(),
+		})
+		return nil, errPackage.NewDomainErrorWithCause("NotificationService", "resolveTemplate", "failed to create notification template", err)
+	}
+
+	return tmpl, nil
+}
+
+// dispatch envía la notificación por un canal, el canal push genera un resultado por cada dispositivo activo
+func (s *NotificationService) dispatch(ctx context.Context, channel string, user *entities.User, request *entities.NotificationRequest, title, content string) []entities.NotificationDelivery {
+	switch channel {
+	case constants.NotificationChannelEmail:
+		to := request.Email
+		if to == "" {
+			to = user.Email
+		}
+		return []entities.NotificationDelivery{deliveryResult(channel, to, func() error {
+			return s.email.SendEmail(ctx, to, title, content)
+		})}
+
+	case constants.NotificationChannelSMS:
+		phone := request.Phone
+		if phone == "" {
+			phone = user.Phone
+		}
+		return []entities.NotificationDelivery{deliveryResult(channel, phone, func() error {
+			return s.sms.SendSMS(ctx, phone, content)
+		})}
+
+	case constants.NotificationChannelPush:
+		devices, err := s.repo.GetActiveDevices(ctx, user.ID)
+		if err != nil {
+			logs.Warn("Failed to get notification devices", map[string]interface{}{
+				"userID": user.ID,
+				"error":  err.Error(),
+			})
+			devices = nil
+		}
+
+		if len(devices) == 0 {
+			return []entities.NotificationDelivery{deliveryResult(channel, "", nil)}
+		}
+
+		deliveries := make([]entities.NotificationDelivery, 0, len(devices))
+		for _, device := range devices {
+			device := device
+			deliveries = append(deliveries, deliveryResult(channel, device.DeviceToken, func() error {
+				return s.sendPush(ctx, &device, title, content, request.Data)
+			}))
+		}
+		return deliveries
+	}
+
+	return nil
+}
+
+// sendPush envía la notificación al dispositivo, desactiva los tokens que el proveedor ya no acepta
+// y registra el último uso de los dispositivos que la recibieron
+func (s *NotificationService) sendPush(ctx context.Context, device *entities.NotificationDevice, title, content string, data map[string]string) error {
+	err := s.push.SendPush(ctx, device.DeviceToken, title, content, data)
+	if err != nil {
+		if errors.Is(err, errPackage.ErrPushTokenUnregistered) {
+			if deactivateErr := s.repo.DeactivateDevice(ctx, device.ID); deactivateErr != nil {
+				logs.Warn("Failed to deactivate stale notification device", map[string]interface{}{
+					"deviceID": device.ID,
+					"error":    deactivateErr.Error(),
+				})
+			}
+		}
+		return err
+	}
+
+	if touchErr := s.repo.TouchDevice(ctx, device.ID, time.Now()); touchErr != nil {
+		logs.Warn("Failed to update notification device last use", map[string]interface{}{
+			"deviceID": device.ID,
+			"error":    touchErr.Error(),
+		})
+	}
+
+	return nil
+}
+
+// deliveryResult ejecuta el envío y registra su resultado, sin destino el canal se omite
+func deliveryResult(channel, recipient string, send func() error) entities.NotificationDelivery {
+	delivery := entities.NotificationDelivery{
+		ID:        uuid.NewString(),
+		Channel:   channel,
+		Recipient: recipient,
+		Status:    constants.NotificationStatusSkipped,
+	}
+
+	if recipient == "" || send == nil {
+		delivery.FailureReason = errPackage.ErrNoRecipientAddress.Error()
+		return delivery
+	}
+
+	if err := send(); err != nil {
+		delivery.Status = constants.NotificationStatusFailed
+		delivery.FailureReason = err.Error()
+		if len(delivery.FailureReason) > 255 {
+			delivery.FailureReason = delivery.FailureReason[:255]
+		}
+		return delivery
+	}
+
+	now := time.Now()
+	delivery.Status = constants.NotificationStatusSent
+	delivery.SentAt = &now
+	return delivery
+}
+
+// summarizeDeliveries obtiene el estado de la notificación a partir del resultado de sus canales
+func summarizeDeliveries(deliveries []entities.NotificationDelivery) string {
+	sent, failed := 0, 0
+	for _, delivery := range deliveries {
+		switch delivery.Status {
+		case constants.NotificationStatusSent:
+			sent++
+		case constants.NotificationStatusFailed:
+			failed++
+		}
+	}
+
+	switch {
+	case sent > 0 && failed == 0:
+		return constants.NotificationStatusSent
+	case sent > 0:
+		return constants.NotificationStatusPartial
+	case failed > 0:
+		return constants.NotificationStatusFailed
+	default:
+		return constants.NotificationStatusSkipped
+	}
+}
+
+// renderNotification genera el título y contenido de la plantilla, con mask los datos sensibles se reemplazan
+// por una máscara para poder guardarlos
+func renderNotification(tmpl *entities.NotificationTemplate, data, secrets map[string]string, mask bool) (string, string, error) {
+	values := make(map[string]string, len(data)+len(secrets))
+	for key, value := range data {
+		values[key] = value
+	}
+	for key, value := range secrets {
+		if mask {
+			value = constants.NotificationSecretMask
+		}
+		values[key] = value
+	}
+
+	title, err := renderTemplateText(tmpl.TitleTemplate, values)
+	if err != nil {
+		return "", "", err
+	}
+
+	content, err := renderTemplateText(tmpl.ContentTemplate, values)
+	if err != nil {
+		return "", "", err
+	}
+
+	return title, content, nil
+}
+
+func renderTemplateText(text string, values map[string]string) (string, error) {
+	parsed, err := template.New("notification").Option("missingkey=zero").Parse(text)
+	if err != nil {
+		return "", errPackage.NewDomainErrorWithCause("NotificationService", "renderTemplateText", "invalid notification template", errPackage.ErrInvalidNotificationTemplate)
+	}
+
+	var buf bytes.Buffer
+	if err = parsed.Execute(&buf, values); err != nil {
+		return "", errPackage.NewDomainErrorWithCause("NotificationService", "renderTemplateText", "failed to render notification template", errPackage.ErrInvalidNotificationTemplate)
+	}
+
+	return buf.String(), nil
+}
+
+func encodeNotificationMetadata(metadata map[string]interface{}) string {
+	if len(metadata) == 0 {
+		return "{}"
+	}
+
+	encoded, err := json.Marshal(metadata)
+	if err != nil {
+		return "{}"
+	}
+
+	return string(encoded)
+}
+
+// recipientRole obtiene el rol del destinatario para resolver sus preferencias por defecto
+func recipientRole(user *entities.User) string {
+	for _, userRole := range user.Roles {
+		if userRole.Role != nil {
+			return userRole.Role.Name
+		}
+	}
+
+	return ""
+}
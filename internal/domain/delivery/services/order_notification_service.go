@@ -0,0 +1,308 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+const orderNotificationTimeFormat = "02/01/2006 15:04"
+
+type OrderNotificationService struct {
+	repo        ports.NotificationRepository
+	notifier    interfaces.Notifier
+	trackingURL string
+}
+
+// NewOrderNotificationService crea el servicio que notifica a los usuarios los eventos de sus pedidos, se invoca
+// desde el suscriptor de eventos del pedido una vez confirmado el cambio que los genera
+func NewOrderNotificationService(repo ports.NotificationRepository, notifier interfaces.Notifier, trackingURL string) interfaces.OrderNotifier {
+	if trackingURL == "" {
+		trackingURL = constants.DefaultTrackingURL
+	}
+
+	return &OrderNotificationService{
+		repo:        repo,
+		notifier:    notifier,
+		trackingURL: strings.TrimRight(trackingURL, "/"),
+	}
+}
+
+// NotifyStatusChange notifica el evento que genera el nuevo estado del pedido, los estados sin evento no se notifican
+func (s *OrderNotificationService) NotifyStatusChange(ctx context.Context, order *entities.Order, status string) {
+	if order == nil {
+		return
+	}
+
+	event, ok := constants.OrderEventsByStatus[status]
+	if !ok {
+		return
+	}
+
+	s.notifyOrderEvent(ctx, order, event, s.orderData(order))
+}
+
+// NotifyDriverDispatch avisa al repartidor del pedido que se le asignó, el aviso no depende de la configuración de
+// la empresa y devuelve el error para que el despacho se reintente
+func (s *OrderNotificationService) NotifyDriverDispatch(ctx context.Context, order *entities.Order) error {
+	if order == nil || order.DriverID == nil {
+		return nil
+	}
+
+	if _, err := s.notifier.Notify(ctx, &entities.NotificationRequest{
+		UserID:   *order.DriverID,
+		Type:     constants.NotificationTypeDriverOrderAssigned,
+		Data:     s.orderData(order),
+		Metadata: orderNotificationMetadata(order),
+	}); err != nil {
+		logs.Warn("Failed to notify driver about assigned order", map[string]interface{}{
+			"orderID":  order.ID,
+			"driverID": *order.DriverID,
+			"error":    err.Error(),
+		})
+		return errPackage.NewDomainErrorWithCause("OrderNotificationService", "NotifyDriverDispatch", "failed to notify the driver", err)
+	}
+
+	return nil
+}
+
+// NotifyDriverAssigned avisa al destinatario el repartidor asignado si la empresa lo habilitó
+func (s *OrderNotificationService) NotifyDriverAssigned(ctx context.Context, order *entities.Order) {
+	if order == nil || order.DriverID == nil {
+		return
+	}
+
+	data := s.orderData(order)
+	if driver, err := s.repo.GetRecipientUser(ctx, *order.DriverID); err == nil {
+		data["driver_name"] = driver.FullName
+	}
+
+	s.notifyOrderEvent(ctx, order, constants.NotificationTypeOrderDriverAssigned, data)
+}
+
+// NotifyDeliveryAttempt notifica un intento de entrega fallido o, si se agotaron los intentos, la devolución al remitente
+func (s *OrderNotificationService) NotifyDeliveryAttempt(ctx context.Context, order *entities.Order, attempt *entities.DeliveryAttempt, returnToSender bool) {
+	if order == nil || attempt == nil {
+		return
+	}
+
+	if returnToSender {
+		order.Status = constants.OrderStatusReturned
+		s.notifyOrderEvent(ctx, order, constants.NotificationTypeOrderReturned, s.orderData(order))
+		return
+	}
+
+	order.Status = constants.OrderStatusFailed
+	data := s.orderData(order)
+	data["attempt_number"] = strconv.Itoa(attempt.AttemptNumber)
+	data["reason"] = attempt.ReasonCode
+	if attempt.NextAttemptAt != nil {
+		data["next_attempt"] = fmt.Sprintf("Intentaremos de nuevo el %s.", attempt.NextAttemptAt.Format(orderNotificationTimeFormat))
+	}
+
+	s.notifyOrderEvent(ctx, order, constants.NotificationTypeOrderAttemptFailed, data)
+}
+
+// NotifySLAAlert alerta a operaciones sobre un pedido en riesgo o que incumplió su plazo de entrega
+// y, si la empresa lo habilitó, a los usuarios de la empresa
+func (s *OrderNotificationService) NotifySLAAlert(ctx context.Context, evaluation *entities.SLAEvaluation) error {
+	if evaluation == nil || evaluation.Order == nil || evaluation.Order.Detail == nil {
+		return errPackage.NewDomainErrorWithCause("OrderNotificationService", "NotifySLAAlert", "invalid notification request", errPackage.ErrInvalidNotificationRequest)
+	}
+
+	order := evaluation.Order
+	event := constants.NotificationTypeSLAAtRisk
+	if evaluation.Status == constants.SLAStatusBreached {
+		event = constants.NotificationTypeSLABreached
+	}
+
+	data := s.orderData(order)
+	data["estimated_delivery"] = evaluation.EstimatedDelivery.Format(orderNotificationTimeFormat)
+	data["delay_minutes"] = strconv.Itoa(evaluation.DelayMinutes)
+
+	metadata := orderNotificationMetadata(order)
+	metadata["sla_status"] = evaluation.Status
+	metadata["estimated_delivery"] = evaluation.EstimatedDelivery.Format(time.RFC3339)
+
+	request := &entities.NotificationRequest{
+		Type:     event,
+		Data:     data,
+		Metadata: metadata,
+	}
+
+	// 1. Alertar a operaciones
+	if _, err := s.notifier.NotifyRole(ctx, constants.AdminRole, "", request); err != nil {
+		return err
+	}
+
+	// 2. Alertar a la empresa si lo tiene habilitado
+	if s.resolveSetting(ctx, order.CompanyID, event).NotifyCompany {
+		if _, err := s.notifier.NotifyRole(ctx, constants.CompanyUser, order.CompanyID, request); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetCompanySettings obtiene la configuración de cada evento configurable, con los valores por defecto
+// de los eventos que la empresa no ha personalizado
+func (s *OrderNotificationService) GetCompanySettings(ctx context.Context, companyID string) ([]entities.CompanyNotificationSetting, error) {
+	saved, err := s.repo.GetCompanyNotificationSettings(ctx, companyID)
+	if err != nil {
+		logs.Error("Failed to get company notification settings", map[string]interface{}{
+			"companyID": companyID,
+			"error":     err.Error(),
+		})
+		return nil, errPackage.NewDomainErrorWithCause("OrderNotificationService", "GetCompanySettings", "failed to get company notification settings", err)
+	}
+
+	savedByEvent := make(map[string]entities.CompanyNotificationSetting, len(saved))
+	for _, setting := range saved {
+		savedByEvent[setting.Event] = setting
+	}
+
+	settings := make([]entities.CompanyNotificationSetting, 0, len(constants.ConfigurableOrderEvents))
+	for _, event := range constants.ConfigurableOrderEvents {
+		if setting, ok := savedByEvent[event]; ok {
+			settings = append(settings, setting)
+			continue
+		}
+		settings = append(settings, *entities.DefaultCompanyNotificationSetting(companyID, event))
+	}
+
+	return settings, nil
+}
+
+// UpdateCompanySettings guarda la configuración de los eventos indicados, el resto conserva su configuración
+func (s *OrderNotificationService) UpdateCompanySettings(ctx context.Context, companyID string, settings []entities.CompanyNotificationSetting) ([]entities.CompanyNotificationSetting, error) {
+	// 1. Validar que los eventos sean configurables y no estén repetidos
+	seen := make(map[string]bool, len(settings))
+	now := time.Now()
+	for i := range settings {
+		if _, ok := constants.DefaultOrderEventAudiences[settings[i].Event]; !ok || seen[settings[i].Event] {
+			return nil, errPackage.NewDomainErrorWithCause("OrderNotificationService", "UpdateCompanySettings", "invalid notification event", errPackage.ErrInvalidNotificationEvent)
+		}
+		seen[settings[i].Event] = true
+
+		settings[i].CompanyID = companyID
+		settings[i].UpdatedAt = now
+	}
+
+	// 2. Guardar la configuración
+	if len(settings) > 0 {
+		if err := s.repo.SaveCompanyNotificationSettings(ctx, settings); err != nil {
+			logs.Error("Failed to save company notification settings", map[string]interface{}{
+				"companyID": companyID,
+				"error":     err.Error(),
+			})
+			return nil, errPackage.NewDomainErrorWithCause("OrderNotificationService", "UpdateCompanySettings", "failed to save company notification settings", err)
+		}
+	}
+
+	return s.GetCompanySettings(ctx, companyID)
+}
+
+// notifyOrderEvent envía el evento al destinatario y a los usuarios de la empresa según la configuración
+// de la empresa, un fallo en el envío no afecta la operación que generó el evento
+func (s *OrderNotificationService) notifyOrderEvent(ctx context.Context, order *entities.Order, event string, data map[string]string) {
+	setting := s.resolveSetting(ctx, order.CompanyID, event)
+	metadata := orderNotificationMetadata(order)
+
+	// 1. Notificar al destinatario, la notificación queda en la bandeja del cliente del pedido
+	if setting.NotifyRecipient && order.ClientID != "" {
+		request := &entities.NotificationRequest{
+			UserID:   order.ClientID,
+			Type:     event,
+			Data:     data,
+			Metadata: metadata,
+		}
+		if order.DeliveryAddress != nil {
+			request.Phone = order.DeliveryAddress.RecipientPhone
+		}
+
+		if _, err := s.notifier.Notify(ctx, request); err != nil {
+			logs.Warn("Failed to notify order recipient", map[string]interface{}{
+				"orderID": order.ID,
+				"event":   event,
+				"error":   err.Error(),
+			})
+		}
+	}
+
+	// 2. Notificar a los usuarios de la empresa
+	if setting.NotifyCompany {
+		if _, err := s.notifier.NotifyRole(ctx, constants.CompanyUser, order.CompanyID, &entities.NotificationRequest{
+			Type:     event,
+			Data:     data,
+			Metadata: metadata,
+		}); err != nil {
+			logs.Warn("Failed to notify order company", map[string]interface{}{
+				"orderID":   order.ID,
+				"companyID": order.CompanyID,
+				"event":     event,
+				"error":     err.Error(),
+			})
+		}
+	}
+}
+
+// resolveSetting obtiene la configuración del evento de la empresa o la configuración por defecto
+func (s *OrderNotificationService) resolveSetting(ctx context.Context, companyID, event string) *entities.CompanyNotificationSetting {
+	setting, err := s.repo.GetCompanyNotificationSetting(ctx, companyID, event)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			logs.Warn("Failed to get company notification setting, using defaults", map[string]interface{}{
+				"companyID": companyID,
+				"event":     event,
+				"error":     err.Error(),
+			})
+		}
+		return entities.DefaultCompanyNotificationSetting(companyID, event)
+	}
+
+	return setting
+}
+
+// orderData variables de las plantillas comunes a todos los eventos del pedido
+func (s *OrderNotificationService) orderData(order *entities.Order) map[string]string {
+	data := map[string]string{
+		"tracking_number": order.TrackingNumber,
+		"tracking_url":    s.trackingURL + "/" + order.TrackingNumber,
+		"order_status":    order.Status,
+	}
+
+	if order.DeliveryAddress != nil {
+		data["recipient_name"] = order.DeliveryAddress.RecipientName
+		data["delivery_address"] = order.DeliveryAddress.AddressLine1
+	}
+	if order.Detail != nil {
+		data["delivery_deadline"] = order.Detail.DeliveryDeadline.Format(orderNotificationTimeFormat)
+	}
+	if order.Branch != nil {
+		data["branch_name"] = order.Branch.Name
+	}
+
+	return data
+}
+
+func orderNotificationMetadata(order *entities.Order) map[string]interface{} {
+	return map[string]interface{}{
+		"order_id":        order.ID,
+		"tracking_number": order.TrackingNumber,
+		"company_id":      order.CompanyID,
+		"branch_id":       order.BranchID,
+	}
+}
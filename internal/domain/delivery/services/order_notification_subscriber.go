@@ -0,0 +1,71 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+)
+
+// OrderNotificationSubscriber notifica a los usuarios los eventos de dominio de los pedidos
+type OrderNotificationSubscriber struct {
+	orderRepo ports.OrdererRepository
+	notifier  interfaces.OrderNotifier
+}
+
+func NewOrderNotificationSubscriber(orderRepo ports.OrdererRepository, notifier interfaces.OrderNotifier) interfaces.EventSubscriber {
+	return &OrderNotificationSubscriber{
+		orderRepo: orderRepo,
+		notifier:  notifier,
+	}
+}
+
+func (s *OrderNotificationSubscriber) Name() string {
+	return "notifications.order_events"
+}
+
+func (s *OrderNotificationSubscriber) EventTypes() []string {
+	return []string{
+		constants.SystemEventOrderStatusChanged,
+		constants.SystemEventOrderDriverAssigned,
+	}
+}
+
+func (s *OrderNotificationSubscriber) Handle(ctx context.Context, event *entities.SystemEvent) error {
+	// 1. Obtener el pedido con el estado del evento
+	order, err := eventOrder(ctx, s.orderRepo, event)
+	if err != nil || order == nil {
+		return err
+	}
+
+	// 2. Notificar según el evento, un intento de entrega fallido tiene su propia notificación
+	data := event.Data()
+	switch event.EventType {
+	case constants.SystemEventOrderDriverAssigned:
+		s.notifier.NotifyDriverAssigned(ctx, order)
+	case constants.SystemEventOrderStatusChanged:
+		returnToSender, isAttempt := data["return_to_sender"].(bool)
+		if !isAttempt {
+			s.notifier.NotifyStatusChange(ctx, order, order.Status)
+			return nil
+		}
+
+		attempt := &entities.DeliveryAttempt{
+			OrderID:       order.ID,
+			AttemptNumber: eventInt(data, "attempt_number"),
+		}
+		attempt.ReasonCode, _ = data["reason"].(string)
+		if value, ok := data["next_attempt_at"].(string); ok {
+			if nextAttemptAt, err := time.Parse(time.RFC3339, value); err == nil {
+				attempt.NextAttemptAt = &nextAttemptAt
+			}
+		}
+
+		s.notifier.NotifyDeliveryAttempt(ctx, order, attempt, returnToSender)
+	}
+
+	return nil
+}
@@ -2,9 +2,12 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/google/uuid"
-	"math/rand"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+	"strings"
 	"time"
 
 	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
@@ -17,16 +20,29 @@ import (
 )
 
 type OrderService struct {
-	repo ports.OrdererRepository
+	repo              ports.OrdererRepository
+	notifier          ports.RecipientNotifier
+	trackingGenerator interfaces.TrackingNumberGenerator
+	payments          interfaces.PaymentProcessor
+	earnings          interfaces.DriverEarner
+	txManager         ports.TransactionManager
 }
 
-func NewOrderService(repo ports.OrdererRepository) interfaces.Orderer {
+func NewOrderService(repo ports.OrdererRepository, notifier ports.RecipientNotifier, trackingGenerator interfaces.TrackingNumberGenerator, payments interfaces.PaymentProcessor, earnings interfaces.DriverEarner, txManager ports.TransactionManager) interfaces.Orderer {
 	return &OrderService{
-		repo: repo,
+		repo:              repo,
+		txManager:         txManager,
+		notifier:          notifier,
+		trackingGenerator: trackingGenerator,
+		payments:          payments,
+		earnings:          earnings,
 	}
 }
 
 func (o OrderService) GetOrderByTrackingNumber(ctx context.Context, trackingNumber string) (*entities.Order, error) {
+	// Los números se generan en mayúsculas, se normaliza lo que escribe el usuario
+	trackingNumber = value_objects.NewTrackingNumber(trackingNumber).GetValue()
+
 	order, err := o.repo.GetOrderByTrackingNumber(ctx, trackingNumber)
 	if err != nil {
 		logs.Error("Failed to get order by tracking number", map[string]interface{}{
@@ -39,6 +55,108 @@ func (o OrderService) GetOrderByTrackingNumber(ctx context.Context, trackingNumb
 	return order, nil
 }
 
+// GetOrderByQRData obtiene el pedido al que pertenece el contenido escaneado de un código QR
+func (o OrderService) GetOrderByQRData(ctx context.Context, qrData string) (*entities.Order, error) {
+	qrData = strings.TrimSpace(qrData)
+	if qrData == "" {
+		return nil, errPackage.NewDomainErrorWithCause("OrderService", "GetOrderByQRData", "qr data is required", errPackage.ErrEmptyQRData)
+	}
+
+	order, err := o.repo.GetOrderByQR(ctx, &entities.QRCode{QRData: qrData})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errPackage.NewDomainErrorWithCause("OrderService", "GetOrderByQRData", "order not found", errPackage.ErrOrderNotFoundByQR)
+		}
+
+		logs.Error("Failed to get order by qr data", map[string]interface{}{
+			"qrData": qrData,
+			"error":  err.Error(),
+		})
+		return nil, errPackage.NewDomainErrorWithCause("OrderService", "GetOrderByQRData", "failed to get order by qr data", err)
+	}
+
+	return order, nil
+}
+
+// GetParcelByQRData obtiene el bulto al que pertenece el contenido escaneado, devuelve nil si el
+// código no pertenece a un bulto sino al pedido completo
+func (o OrderService) GetParcelByQRData(ctx context.Context, qrData string) (*entities.Parcel, error) {
+	parcel, err := o.repo.GetParcelByTrackingNumber(ctx, strings.ToUpper(strings.TrimSpace(qrData)))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		logs.Error("Failed to get parcel by qr data", map[string]interface{}{
+			"qrData": qrData,
+			"error":  err.Error(),
+		})
+		return nil, errPackage.NewDomainErrorWithCause("OrderService", "GetParcelByQRData", "failed to get parcel by qr data", err)
+	}
+
+	return parcel, nil
+}
+
+// ChangeParcelStatus cambia el estado de un bulto y avanza el pedido al estado de su bulto menos avanzado
+func (o OrderService) ChangeParcelStatus(ctx context.Context, parcel *entities.Parcel, status string) error {
+	// 1. Obtener el pedido con todos sus bultos
+	order, err := o.repo.GetOrderByID(ctx, parcel.OrderID)
+	if err != nil {
+		logs.Error("Failed to get order by id", map[string]interface{}{
+			"orderID": parcel.OrderID,
+			"error":   err.Error(),
+		})
+		return errPackage.NewDomainErrorWithCause("OrderService", "ChangeParcelStatus", "failed to get order by id", err)
+	}
+
+	if order.DeletedAt != nil {
+		return errPackage.NewDomainErrorWithCause("OrderService", "ChangeParcelStatus", "order is deleted", errPackage.ErrOrderDeleted)
+	}
+
+	// 2. Validar que el pedido permita escanear sus bultos y la transición del bulto
+	if !constants.ParcelScanOrderStatuses[order.Status] {
+		return errPackage.NewDomainErrorWithCause("OrderService", "ChangeParcelStatus", "order does not allow parcel scans", errPackage.ErrParcelScanNotAllowed)
+	}
+
+	status = strings.ToUpper(status)
+	if !value_objects.NewParcelStatus(parcel.Status).CanTransitionTo(value_objects.NewParcelStatus(status)) {
+		logs.Warn("Invalid parcel transition", map[string]interface{}{
+			"parcelID": parcel.ID,
+			"from":     parcel.Status,
+			"to":       status,
+		})
+		return errPackage.NewDomainError("OrderService", "ChangeParcelStatus", fmt.Sprintf("invalid parcel transition from %s to %s", parcel.Status, status))
+	}
+
+	// 3. Derivar el estado del pedido a partir del bulto menos avanzado
+	// la entrega nunca se deriva de los bultos, siempre pasa por DeliverOrder
+	orderStatus := ""
+	if derived := deriveOrderStatusFromParcels(order.Parcels, parcel.ID, status); derived != order.Status &&
+		!value_objects.NewOrderStatus(derived).IsDelivered() &&
+		value_objects.NewOrderStatus(order.Status).CanTransitionTo(value_objects.NewOrderStatus(derived)) {
+		orderStatus = derived
+	}
+
+	// 4. Guardar el estado del bulto y, si cambió, el del pedido junto con su evento
+	var event *entities.SystemEvent
+	if orderStatus != "" {
+		event = orderStatusChangedEvent(order, orderStatus, map[string]interface{}{
+			"parcel_tracking_number": parcel.TrackingNumber,
+		})
+	}
+
+	if err = o.repo.ChangeParcelStatus(ctx, parcel, status, orderStatus, event); err != nil {
+		logs.Error("Failed to change parcel status", map[string]interface{}{
+			"parcelID": parcel.ID,
+			"status":   status,
+			"error":    err.Error(),
+		})
+		return errPackage.NewDomainErrorWithCause("OrderService", "ChangeParcelStatus", "failed to change parcel status", err)
+	}
+
+	return nil
+}
+
 func (o OrderService) GetOrdersByClientID(ctx context.Context, clientID string) ([]entities.Order, error) {
 	getOrders, err := o.repo.GetOrdersByUserID(ctx, clientID)
 	if err != nil {
@@ -53,7 +171,12 @@ func (o OrderService) GetOrdersByClientID(ctx context.Context, clientID string)
 }
 
 func (o OrderService) AssignDriverToOrder(ctx context.Context, orderID, driverID string) error {
-	err := o.repo.AssignDriverToOrder(ctx, orderID, driverID)
+	event := entities.NewSystemEvent(constants.SystemEventOrderDriverAssigned, constants.EventSourceOrder, orderID, constants.EventSeverityInfo, map[string]interface{}{
+		"order_id":  orderID,
+		"driver_id": driverID,
+	})
+
+	err := o.repo.AssignDriverToOrder(ctx, orderID, driverID, event)
 	if err != nil {
 		logs.Error("Failed to assign driver to order", map[string]interface{}{
 			"orderID":  orderID,
@@ -72,7 +195,14 @@ func (o OrderService) CreateOrder(ctx context.Context, order *entities.Order) er
 		return errPackage.NewDomainError("OrderService", "CreateOrder", "order is nil")
 	}
 
-	// 1. Generar estado historico inicial
+	// 1. Autorizar el pago anticipado antes de crear el pedido, un pago rechazado no crea el pedido
+	if order.Payment != nil {
+		if err := o.payments.AuthorizePayment(ctx, order.Payment); err != nil {
+			return err
+		}
+	}
+
+	// 2. Generar estado historico inicial
 	statusHistory := &entities.StatusHistory{
 		ID:      uuid.NewString(),
 		OrderID: order.ID,
@@ -80,34 +210,148 @@ func (o OrderService) CreateOrder(ctx context.Context, order *entities.Order) er
 	}
 	order.StatusHistory = append(order.StatusHistory, *statusHistory)
 
-	// 2. Generar tracking number
-	order.TrackingNumber = generateTrackingNumber()
-
-	// 3. Verificar puntos importantes
-	if err := order.Validate(); err != nil {
-		return err
-	}
-
-	//4. Crear pedido
-	err := o.repo.CreateOrder(ctx, order)
+	// 3. Generar tracking number y crear el pedido, reintentando si el número ya existe
+	var pin string
+	err := saveWithUniqueTrackingNumber(
+		func() (string, error) { return o.trackingGenerator.GenerateForCompany(ctx, order.CompanyID) },
+		func(trackingNumber string) {
+			order.TrackingNumber = trackingNumber
+			order.AssignParcelTrackingNumbers()
+		},
+		func() error {
+			// 4. Verificar puntos importantes
+			if err := order.Validate(); err != nil {
+				return err
+			}
+
+			// 5. Crear el pedido, su QR y su PIN de entrega en una sola transacción
+			return o.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+				if err := o.repo.CreateOrder(ctx, order, orderSystemEvent(constants.SystemEventOrderCreated, order, nil)); err != nil {
+					return err
+				}
+
+				if err := o.repo.CreateQRData(ctx, generateQRCode(*order)); err != nil {
+					logs.Error("Failed to create qr code", map[string]interface{}{
+						"orderID":        order.ID,
+						"trackingNumber": order.TrackingNumber,
+						"error":          err.Error(),
+					})
+					return err
+				}
+
+				if !order.Detail.RequiresDeliveryPIN {
+					return nil
+				}
+
+				var err error
+				pin, err = o.createDeliveryPIN(ctx, order)
+				return err
+			})
+		},
+	)
 	if err != nil {
 		logs.Error("Failed to create order", map[string]interface{}{
 			"orderID":        order.ID,
 			"trackingNumber": order.TrackingNumber,
 			"error":          err.Error(),
 		})
+
+		if order.Payment != nil {
+			o.payments.ReleasePayment(ctx, order.Payment)
+		}
 		return errPackage.NewDomainErrorWithCause("OrderService", "CreateOrder", "failed to create order", err)
 	}
 
-	//5. Crear QR
-	err = o.repo.CreateQRData(ctx, generateQRCode(*order))
+	// 6. Enviar el PIN de entrega al destinatario, el pedido ya fue creado y un fallo en el envío no lo revierte
+	if pin != "" {
+		if err = o.notifier.SendDeliveryPIN(ctx, order, pin); err != nil {
+			logs.Error("Failed to send delivery pin to recipient", map[string]interface{}{
+				"orderID": order.ID,
+				"error":   err.Error(),
+			})
+		}
+	}
+
+	// 7. Cobrar el pago autorizado, si falla queda pendiente de cobro y el trabajo de pagos lo reintenta
+	if order.Payment != nil {
+		if err = o.payments.CapturePayment(ctx, order.Payment); err != nil {
+			logs.Warn("Failed to capture order payment, it will be retried", map[string]interface{}{
+				"orderID": order.ID,
+				"error":   err.Error(),
+			})
+		}
+	}
+
+	return nil
+}
+
+func (o OrderService) DeliverOrder(ctx context.Context, orderID, driverID, pin string, collectedAmount *float64) error {
+	// 1. Obtener el pedido
+	order, err := o.repo.GetOrderByID(ctx, orderID)
 	if err != nil {
-		logs.Error("Failed to create qr code", map[string]interface{}{
-			"orderID":        order.ID,
-			"trackingNumber": order.TrackingNumber,
-			"error":          err.Error(),
+		logs.Error("Failed to get order by id", map[string]interface{}{
+			"orderID": orderID,
+			"error":   err.Error(),
+		})
+		return errPackage.NewDomainErrorWithCause("OrderService", "DeliverOrder", "failed to get order by id", err)
+	}
+
+	// 2. Validar que el pedido no este eliminado y pueda ser entregado
+	if order.DeletedAt != nil {
+		return errPackage.NewDomainErrorWithCause("OrderService", "DeliverOrder", "order is deleted", errPackage.ErrOrderDeleted)
+	}
+
+	if !value_objects.NewOrderStatus(order.Status).CanTransitionTo(value_objects.NewOrderStatus(constants.OrderStatusDelivered)) {
+		logs.Warn("Order cannot be delivered", map[string]interface{}{
+			"orderID": orderID,
+			"status":  order.Status,
+		})
+		return errPackage.NewDomainErrorWithCause("OrderService", "DeliverOrder", "order cannot be delivered", errPackage.ErrOrderCannotBeDelivered)
+	}
+
+	// 3. Validar que el pedido este asignado al repartidor que realiza la entrega
+	if driverID != "" && (order.DriverID == nil || *order.DriverID != driverID) {
+		logs.Warn("Order is not assigned to the driver", map[string]interface{}{
+			"orderID":  orderID,
+			"driverID": driverID,
+		})
+		return errPackage.NewDomainErrorWithCause("OrderService", "DeliverOrder", "order is not assigned to the driver", errPackage.ErrOrderNotAssignedToDriver)
+	}
+
+	// 4. Verificar el PIN de entrega si el pedido lo requiere
+	if order.Detail != nil && order.Detail.RequiresDeliveryPIN {
+		if err = o.verifyDeliveryPIN(ctx, orderID, pin); err != nil {
+			return err
+		}
+	}
+
+	// 5. Confirmar el cobro contra entrega y registrarlo en el libro de efectivo del repartidor
+	collection, err := newCODCollection(order, collectedAmount)
+	if err != nil {
+		return err
+	}
+
+	// 6. Calcular la ganancia del repartidor por la entrega
+	earning, err := o.earnings.CalculateDeliveryEarning(ctx, order)
+	if err != nil {
+		return err
+	}
+
+	// 7. Marcar el pedido como entregado
+	event := orderStatusChangedEvent(order, constants.OrderStatusDelivered, nil)
+	if err = o.repo.MarkOrderDelivered(ctx, orderID, collection, earning, event); err != nil {
+		if errors.Is(err, errPackage.ErrOrderDeliveryConflict) {
+			logs.Warn("Order is no longer deliverable", map[string]interface{}{
+				"orderID": orderID,
+			})
+			return errPackage.NewDomainErrorWithCause("OrderService", "DeliverOrder", "order is no longer deliverable", errPackage.ErrOrderDeliveryConflict)
+		}
+
+		logs.Error("Failed to mark order as delivered", map[string]interface{}{
+			"orderID": orderID,
+			"error":   err.Error(),
 		})
-		return errPackage.NewDomainErrorWithCause("OrderService", "CreateOrder", "failed to create qr code", err)
+		return errPackage.NewDomainErrorWithCause("OrderService", "DeliverOrder", "failed to mark order as delivered", err)
 	}
 
 	return nil
@@ -150,8 +394,24 @@ func (o OrderService) ChangeStatus(ctx context.Context, id, status string) error
 		return errPackage.NewDomainError("OrderService", "ChangeStatus", fmt.Sprintf("invalid transition from %s to %s", order.Status, status))
 	}
 
-	// 5. Cambiar estado
-	err = o.repo.ChangeStatus(ctx, id, status)
+	// 5. Las entregas solo se registran mediante DeliverOrder, que verifica el PIN, el cobro y la ganancia
+	if value_objects.NewOrderStatus(status).IsDelivered() {
+		logs.Warn("Dont change status, order must be delivered through the deliver flow", map[string]interface{}{
+			"orderID": id,
+		})
+		return errPackage.NewDomainErrorWithCause("OrderService", "ChangeStatus", "order must be delivered through the deliver flow", errPackage.ErrDeliveryRequiresDeliverFlow)
+	}
+
+	// 6. Los intentos fallidos solo se registran mediante RegisterDeliveryAttempt, que guarda el intento y reprograma la entrega
+	if value_objects.NewOrderStatus(status).IsFailed() {
+		logs.Warn("Dont change status, order must fail through the delivery attempt flow", map[string]interface{}{
+			"orderID": id,
+		})
+		return errPackage.NewDomainErrorWithCause("OrderService", "ChangeStatus", "order must fail through the delivery attempt flow", errPackage.ErrFailureRequiresAttemptFlow)
+	}
+
+	// 7. Cambiar estado
+	err = o.repo.ChangeStatus(ctx, id, status, orderStatusChangedEvent(order, status, nil))
 	if err != nil {
 		logs.Error("Failed to change status", map[string]interface{}{
 			"orderID": id,
@@ -161,6 +421,16 @@ func (o OrderService) ChangeStatus(ctx context.Context, id, status string) error
 		return errPackage.NewDomainErrorWithCause("OrderService", "ChangeStatus", "failed to change status", err)
 	}
 
+	// 8. Devolver el pago anticipado de los pedidos cancelados, si falla queda pendiente y el trabajo de pagos lo reintenta
+	if value_objects.NewOrderStatus(status).IsCancelled() {
+		if err = o.payments.RefundOrderPayment(ctx, id); err != nil {
+			logs.Warn("Failed to refund cancelled order payment, it will be retried", map[string]interface{}{
+				"orderID": id,
+				"error":   err.Error(),
+			})
+		}
+	}
+
 	return nil
 }
 
@@ -265,6 +535,14 @@ func (o OrderService) SoftDeleteOrder(ctx context.Context, id string) error {
 		return errPackage.NewDomainErrorWithCause("OrderService", "SoftDeleteOrder", "failed to soft delete order", err)
 	}
 
+	// 3. Devolver el pago anticipado del pedido eliminado, si falla queda pendiente y el trabajo de pagos lo reintenta
+	if err = o.payments.RefundOrderPayment(ctx, id); err != nil {
+		logs.Warn("Failed to refund deleted order payment, it will be retried", map[string]interface{}{
+			"orderID": id,
+			"error":   err.Error(),
+		})
+	}
+
 	return nil
 }
 
@@ -278,7 +556,22 @@ func (o OrderService) RestoreOrder(ctx context.Context, id string) error {
 		return errPackage.NewDomainErrorWithCause("OrderService", "RestoreOrder", "order is not deleted", errPackage.ErrOrderNotDeleted)
 	}
 
-	err := o.repo.RestoreOrder(ctx, id)
+	// 2. Al eliminar el pedido se devolvió su pago anticipado, restaurarlo dejaría un pedido prepagado sin cobro
+	payment, err := o.payments.GetOrderPayment(ctx, id)
+	if err != nil && !hasDomainCause(err, errPackage.ErrPaymentNotFound) {
+		return err
+	}
+
+	if payment != nil && value_objects.NewPaymentStatus(payment.Status).IsRefunded() {
+		logs.Warn("Dont restore order, order payment was refunded", map[string]interface{}{
+			"orderID": id,
+			"status":  payment.Status,
+		})
+		return errPackage.NewDomainErrorWithCause("OrderService", "RestoreOrder", "order payment was refunded", errPackage.ErrOrderPaymentRefunded)
+	}
+
+	// 3. Restaurar el pedido
+	err = o.repo.RestoreOrder(ctx, id)
 	if err != nil {
 		logs.Error("Failed to restore order", map[string]interface{}{
 			"orderID": id,
@@ -334,6 +627,277 @@ func (o OrderService) IsAvailableForDelete(ctx context.Context, orderID string)
 	return nil
 }
 
+// RegisterDeliveryAttempt registra un intento de entrega fallido, reprogramando la entrega en la siguiente
+// ventana de operación de la sucursal o devolviendo el pedido al remitente al agotar los intentos
+func (o OrderService) RegisterDeliveryAttempt(ctx context.Context, orderID, driverID, reasonCode, notes string) (*entities.DeliveryAttempt, error) {
+	// 1. Validar el motivo del intento fallido
+	if !constants.ValidDeliveryFailureReasons[reasonCode] {
+		return nil, errPackage.NewDomainErrorWithCause("OrderService", "RegisterDeliveryAttempt", "invalid reason code", errPackage.ErrInvalidDeliveryFailureReason)
+	}
+
+	// 2. Obtener el pedido
+	order, err := o.repo.GetOrderByID(ctx, orderID)
+	if err != nil {
+		logs.Error("Failed to get order by id", map[string]interface{}{
+			"orderID": orderID,
+			"error":   err.Error(),
+		})
+		return nil, errPackage.NewDomainErrorWithCause("OrderService", "RegisterDeliveryAttempt", "failed to get order by id", err)
+	}
+
+	if order.DeletedAt != nil {
+		return nil, errPackage.NewDomainErrorWithCause("OrderService", "RegisterDeliveryAttempt", "order is deleted", errPackage.ErrOrderDeleted)
+	}
+
+	// 3. Solo se registran intentos de pedidos en camino
+	if !value_objects.NewOrderStatus(order.Status).CanTransitionTo(value_objects.NewOrderStatus(constants.OrderStatusFailed)) ||
+		value_objects.NewOrderStatus(order.Status).IsFailed() {
+		logs.Warn("Order cannot register delivery attempt", map[string]interface{}{
+			"orderID": orderID,
+			"status":  order.Status,
+		})
+		return nil, errPackage.NewDomainErrorWithCause("OrderService", "RegisterDeliveryAttempt", "order cannot register delivery attempt", errPackage.ErrOrderCannotRegisterAttempt)
+	}
+
+	if driverID != "" && (order.DriverID == nil || *order.DriverID != driverID) {
+		return nil, errPackage.NewDomainErrorWithCause("OrderService", "RegisterDeliveryAttempt", "order is not assigned to the driver", errPackage.ErrOrderNotAssignedToDriver)
+	}
+
+	// 4. Determinar el número de intento y si se alcanzó el máximo de la empresa
+	maxAttempts := constants.DefaultMaxDeliveryAttempts
+	if order.Company != nil && order.Company.MaxDeliveryAttempts > 0 {
+		maxAttempts = order.Company.MaxDeliveryAttempts
+	}
+
+	now := time.Now()
+	attempt := &entities.DeliveryAttempt{
+		ID:            uuid.NewString(),
+		OrderID:       orderID,
+		DriverID:      order.DriverID,
+		AttemptNumber: len(order.DeliveryAttempts) + 1,
+		ReasonCode:    reasonCode,
+		Notes:         notes,
+		AttemptedAt:   now,
+	}
+
+	returnToSender := attempt.AttemptNumber >= maxAttempts
+
+	// 5. Reprogramar en la siguiente ventana de entrega si quedan intentos
+	if !returnToSender {
+		nextAttempt := nextDeliveryWindow(order.Branch, now)
+		attempt.NextAttemptAt = &nextAttempt
+	}
+
+	// 6. Guardar el intento y actualizar el estado
+	status := constants.OrderStatusFailed
+	if returnToSender {
+		status = constants.OrderStatusReturned
+	}
+	eventData := map[string]interface{}{
+		"attempt_number":   attempt.AttemptNumber,
+		"reason":           attempt.ReasonCode,
+		"return_to_sender": returnToSender,
+	}
+	if attempt.NextAttemptAt != nil {
+		eventData["next_attempt_at"] = attempt.NextAttemptAt.Format(time.RFC3339)
+	}
+	event := orderStatusChangedEvent(order, status, eventData)
+
+	if err = o.repo.RegisterDeliveryAttempt(ctx, attempt, returnToSender, event); err != nil {
+		if errors.Is(err, errPackage.ErrOrderAttemptConflict) {
+			logs.Warn("Order is no longer in transit", map[string]interface{}{
+				"orderID": orderID,
+			})
+			return nil, errPackage.NewDomainErrorWithCause("OrderService", "RegisterDeliveryAttempt", "order is no longer in transit", errPackage.ErrOrderAttemptConflict)
+		}
+
+		logs.Error("Failed to register delivery attempt", map[string]interface{}{
+			"orderID": orderID,
+			"error":   err.Error(),
+		})
+		return nil, errPackage.NewDomainErrorWithCause("OrderService", "RegisterDeliveryAttempt", "failed to register delivery attempt", err)
+	}
+
+	logs.Info("Delivery attempt registered", map[string]interface{}{
+		"orderID":        orderID,
+		"attemptNumber":  attempt.AttemptNumber,
+		"maxAttempts":    maxAttempts,
+		"returnToSender": returnToSender,
+	})
+
+	return attempt, nil
+}
+
+func (o OrderService) GetDeliveryAttempts(ctx context.Context, orderID string) ([]entities.DeliveryAttempt, error) {
+	attempts, err := o.repo.GetDeliveryAttempts(ctx, orderID)
+	if err != nil {
+		logs.Error("Failed to get delivery attempts", map[string]interface{}{
+			"orderID": orderID,
+			"error":   err.Error(),
+		})
+		return nil, errPackage.NewDomainErrorWithCause("OrderService", "GetDeliveryAttempts", "failed to get delivery attempts", err)
+	}
+
+	return attempts, nil
+}
+
+// RescheduleDueDeliveries vuelve a poner en camino los pedidos con la entrega fallida cuya siguiente ventana de
+// entrega ya comenzó, extendiendo su fecha límite de entrega desde el inicio de esa ventana
+func (o OrderService) RescheduleDueDeliveries(ctx context.Context) (int, error) {
+	// 1. Obtener el último intento de los pedidos que ya pueden volver a salir
+	attempts, err := o.repo.GetDueRedeliveries(ctx, time.Now(), constants.RedeliveryBatchSize)
+	if err != nil {
+		logs.Error("Failed to get due redeliveries", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return 0, errPackage.NewDomainErrorWithCause("OrderService", "RescheduleDueDeliveries", "failed to get due redeliveries", err)
+	}
+
+	rescheduled := 0
+	for _, attempt := range attempts {
+		// 2. Obtener el pedido para el evento
+		order, err := o.repo.GetOrderByID(ctx, attempt.OrderID)
+		if err != nil {
+			logs.Error("Failed to get order by id", map[string]interface{}{
+				"orderID": attempt.OrderID,
+				"error":   err.Error(),
+			})
+			continue
+		}
+
+		// 3. La nueva fecha límite nunca adelanta la que ya tenía el pedido
+		deadline := attempt.NextAttemptAt.Add(constants.RedeliveryDeadlineWindow)
+		if order.Detail != nil && order.Detail.DeliveryDeadline.After(deadline) {
+			deadline = order.Detail.DeliveryDeadline
+		}
+
+		// 4. Volver a poner el pedido en camino
+		event := orderStatusChangedEvent(order, constants.OrderStatusInTransit, map[string]interface{}{
+			"attempt_number":    attempt.AttemptNumber + 1,
+			"delivery_deadline": deadline,
+		})
+		if err = o.repo.RescheduleDelivery(ctx, order.ID, deadline, event); err != nil {
+			if errors.Is(err, errPackage.ErrOrderRescheduleConflict) {
+				continue
+			}
+
+			logs.Error("Failed to reschedule delivery", map[string]interface{}{
+				"orderID": order.ID,
+				"error":   err.Error(),
+			})
+			continue
+		}
+		rescheduled++
+	}
+
+	return rescheduled, nil
+}
+
+// nextDeliveryWindow calcula la siguiente apertura de la sucursal; si no tiene un horario válido se reprograma al día siguiente
+func nextDeliveryWindow(branch *entities.Branch, from time.Time) time.Time {
+	fallback := from.AddDate(0, 0, 1)
+	if branch == nil || branch.OperatingHours == "" {
+		return fallback
+	}
+
+	operatingHours, err := value_objects.NewOperatingHoursFromJSON(branch.OperatingHours)
+	if err != nil || !operatingHours.IsValid() {
+		logs.Warn("Branch has invalid operating hours, using fallback redelivery window", map[string]interface{}{
+			"branchID": branch.ID,
+		})
+		return fallback
+	}
+
+	next, ok := operatingHours.NextOpening(from)
+	if !ok {
+		return fallback
+	}
+
+	return next
+}
+
+// createDeliveryPIN genera el PIN de entrega y guarda su hash, devuelve el PIN para enviarlo al destinatario
+func (o OrderService) createDeliveryPIN(ctx context.Context, order *entities.Order) (string, error) {
+	pin, err := value_objects.GenerateDeliveryPIN()
+	if err != nil {
+		logs.Error("Failed to generate delivery pin", map[string]interface{}{
+			"orderID": order.ID,
+			"error":   err.Error(),
+		})
+		return "", errPackage.NewDomainErrorWithCause("OrderService", "CreateOrder", "failed to generate delivery pin", err)
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(pin.GetValue()), bcrypt.DefaultCost)
+	if err != nil {
+		logs.Error("Failed to hash delivery pin", map[string]interface{}{
+			"orderID": order.ID,
+			"error":   err.Error(),
+		})
+		return "", errPackage.NewDomainErrorWithCause("OrderService", "CreateOrder", "failed to hash delivery pin", err)
+	}
+
+	err = o.repo.CreateDeliveryPIN(ctx, &entities.DeliveryPIN{
+		OrderID: order.ID,
+		PINHash: string(hash),
+	})
+	if err != nil {
+		logs.Error("Failed to create delivery pin", map[string]interface{}{
+			"orderID": order.ID,
+			"error":   err.Error(),
+		})
+		return "", errPackage.NewDomainErrorWithCause("OrderService", "CreateOrder", "failed to create delivery pin", err)
+	}
+
+	return pin.GetValue(), nil
+}
+
+// verifyDeliveryPIN compara el PIN recibido con el almacenado y registra los intentos fallidos
+func (o OrderService) verifyDeliveryPIN(ctx context.Context, orderID, pin string) error {
+	deliveryPIN, err := o.repo.GetDeliveryPIN(ctx, orderID)
+	if err != nil {
+		logs.Error("Failed to get delivery pin", map[string]interface{}{
+			"orderID": orderID,
+			"error":   err.Error(),
+		})
+		return errPackage.NewDomainErrorWithCause("OrderService", "DeliverOrder", "failed to get delivery pin", err)
+	}
+
+	if deliveryPIN.IsLocked {
+		logs.Warn("Delivery pin is locked", map[string]interface{}{
+			"orderID": orderID,
+		})
+		return errPackage.NewDomainErrorWithCause("OrderService", "DeliverOrder", "delivery pin is locked", errPackage.ErrDeliveryPINLocked)
+	}
+
+	if !value_objects.NewDeliveryPIN(pin).IsValid() ||
+		bcrypt.CompareHashAndPassword([]byte(deliveryPIN.PINHash), []byte(value_objects.NewDeliveryPIN(pin).GetValue())) != nil {
+		updatedPIN, err := o.repo.RegisterFailedPINAttempt(ctx, orderID, constants.MaxDeliveryPINAttempts)
+		if err != nil {
+			logs.Error("Failed to register failed pin attempt", map[string]interface{}{
+				"orderID": orderID,
+				"error":   err.Error(),
+			})
+			return errPackage.NewDomainErrorWithCause("OrderService", "DeliverOrder", "failed to register failed pin attempt", err)
+		}
+
+		logs.Warn("Invalid delivery pin", map[string]interface{}{
+			"orderID":        orderID,
+			"failedAttempts": updatedPIN.FailedAttempts,
+			"locked":         updatedPIN.IsLocked,
+		})
+
+		if updatedPIN.IsLocked {
+			return errPackage.NewDomainErrorWithCause("OrderService", "DeliverOrder", "delivery pin is locked", errPackage.ErrDeliveryPINLocked)
+		}
+
+		return errPackage.NewDomainErrorWithCause("OrderService", "DeliverOrder",
+			fmt.Sprintf("invalid delivery pin, %d attempts remaining", constants.MaxDeliveryPINAttempts-updatedPIN.FailedAttempts),
+			errPackage.ErrInvalidDeliveryPIN)
+	}
+
+	return nil
+}
+
 func generateQRCode(order entities.Order) *entities.QRCode {
 	return &entities.QRCode{
 		OrderID: order.ID,
@@ -341,6 +905,90 @@ func generateQRCode(order entities.Order) *entities.QRCode {
 	}
 }
 
+// deriveOrderStatusFromParcels devuelve el estado del bulto menos avanzado considerando el nuevo estado del bulto escaneado
+func deriveOrderStatusFromParcels(parcels []entities.Parcel, parcelID, status string) string {
+	derived := status
+	for _, parcel := range parcels {
+		parcelStatus := parcel.Status
+		if parcel.ID == parcelID {
+			parcelStatus = status
+		}
+
+		if value_objects.NewParcelStatus(parcelStatus).Progress() < value_objects.NewParcelStatus(derived).Progress() {
+			derived = parcelStatus
+		}
+	}
+
+	return derived
+}
+
+// newCODCollection valida el monto cobrado por el repartidor y crea el movimiento de su libro de efectivo,
+// devuelve nil si el pedido no es contra entrega
+func newCODCollection(order *entities.Order, collectedAmount *float64) (*entities.CashLedgerEntry, error) {
+	if order.Detail == nil || !order.Detail.HasCashOnDelivery() {
+		return nil, nil
+	}
+
+	if collectedAmount == nil {
+		return nil, errPackage.NewDomainErrorWithCause("OrderService", "DeliverOrder", "collected amount is required", errPackage.ErrCODAmountRequired)
+	}
+
+	if order.DriverID == nil {
+		return nil, errPackage.NewDomainErrorWithCause("OrderService", "DeliverOrder", "order has no driver", errPackage.ErrCODRequiresDriver)
+	}
+
+	expected := value_objects.NewMoneyAmount(order.Detail.CODAmount, order.Detail.CODCurrency)
+	collected := value_objects.NewMoneyAmount(*collectedAmount, order.Detail.CODCurrency)
+	if !collected.Equals(expected) {
+		logs.Warn("Collected amount does not match the cash on delivery amount", map[string]interface{}{
+			"orderID":   order.ID,
+			"expected":  expected.ToString(),
+			"collected": collected.ToString(),
+		})
+		return nil, errPackage.NewDomainErrorWithCause("OrderService", "DeliverOrder", "collected amount mismatch", errPackage.ErrCODAmountMismatch)
+	}
+
+	return &entities.CashLedgerEntry{
+		ID:        uuid.NewString(),
+		DriverID:  *order.DriverID,
+		CompanyID: &order.CompanyID,
+		OrderID:   &order.ID,
+		Type:      constants.CashEntryCollection,
+		Amount:    collected.Amount(),
+		Currency:  collected.Currency(),
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+// orderSystemEvent crea el evento de dominio de un pedido con sus datos principales y los datos adicionales
+func orderSystemEvent(eventType string, order *entities.Order, data map[string]interface{}) *entities.SystemEvent {
+	eventData := map[string]interface{}{
+		"order_id":        order.ID,
+		"tracking_number": order.TrackingNumber,
+		"company_id":      order.CompanyID,
+		"branch_id":       order.BranchID,
+		"status":          order.Status,
+	}
+	for key, value := range data {
+		eventData[key] = value
+	}
+
+	return entities.NewSystemEvent(eventType, constants.EventSourceOrder, order.ID, constants.EventSeverityInfo, eventData)
+}
+
+// orderStatusChangedEvent crea el evento del cambio de estado del pedido, el pedido aún tiene su estado anterior
+func orderStatusChangedEvent(order *entities.Order, status string, data map[string]interface{}) *entities.SystemEvent {
+	eventData := map[string]interface{}{
+		"previous_status": order.Status,
+	}
+	for key, value := range data {
+		eventData[key] = value
+	}
+	eventData["status"] = status
+
+	return orderSystemEvent(constants.SystemEventOrderStatusChanged, order, eventData)
+}
+
 func canDeleteOrder(order *entities.Order) bool {
 	return constants.AllowedStatesToDelete[order.Status]
 }
@@ -348,12 +996,3 @@ func canDeleteOrder(order *entities.Order) bool {
 func canUpdateOrder(order *entities.Order) bool {
 	return constants.AllowedStatesToUpdate[order.Status]
 }
-
-func generateTrackingNumber() string {
-	// Formato: [prefijo]-[timestamp]-[aleatorio]
-	prefix := "DEL"
-	timestamp := time.Now().Format("060102")
-	random := fmt.Sprintf("%04d", rand.Intn(10000))
-
-	return fmt.Sprintf("%s-%s-%s", prefix, timestamp, random)
-}
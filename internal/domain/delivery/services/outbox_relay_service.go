@@ -0,0 +1,198 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+type OutboxRelayService struct {
+	repo ports.OutboxRepository
+
+	mu          sync.RWMutex
+	subscribers []interfaces.EventSubscriber
+}
+
+func NewOutboxRelayService(repo ports.OutboxRepository) interfaces.OutboxRelayer {
+	return &OutboxRelayService{
+		repo: repo,
+	}
+}
+
+// Subscribe registra un suscriptor de los eventos de dominio
+func (s *OutboxRelayService) Subscribe(subscriber interfaces.EventSubscriber) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.subscribers = append(s.subscribers, subscriber)
+}
+
+// RelayPendingEvents publica los eventos pendientes del outbox a sus suscriptores, devuelve los eventos publicados
+func (s *OutboxRelayService) RelayPendingEvents(ctx context.Context) (int, error) {
+	now := time.Now()
+
+	// 1. Obtener los eventos pendientes de publicar
+	events, err := s.repo.GetPendingEvents(ctx, now, constants.OutboxMaxAttempts, constants.OutboxBatchSize)
+	if err != nil {
+		logs.Error("Failed to get pending outbox events", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return 0, errPackage.NewDomainErrorWithCause("OutboxRelayService", "RelayPendingEvents", "failed to get pending outbox events", err)
+	}
+
+	published := 0
+	for i := range events {
+		if ctx.Err() != nil {
+			break
+		}
+
+		// 2. Reservar el evento para que otra instancia no lo publique al mismo tiempo
+		claimed, err := s.repo.ClaimEvent(ctx, events[i].ID, now, now.Add(constants.OutboxLease))
+		if err != nil {
+			logs.Warn("Failed to claim outbox event", map[string]interface{}{
+				"eventID": events[i].ID,
+				"error":   err.Error(),
+			})
+			continue
+		}
+		if !claimed {
+			continue
+		}
+
+		// 3. Publicar el evento
+		if s.relay(ctx, &events[i]) {
+			published++
+		}
+	}
+
+	return published, nil
+}
+
+// relay entrega el evento a los suscriptores que aún no lo recibieron; si todos lo reciben se marca como publicado,
+// si alguno falla se reintenta más tarde solo para los suscriptores pendientes
+func (s *OutboxRelayService) relay(ctx context.Context, event *entities.SystemEvent) bool {
+	// 1. Obtener los suscriptores que ya recibieron el evento en intentos anteriores
+	handled, err := s.repo.GetHandledSubscribers(ctx, event.ID)
+	if err != nil {
+		logs.Error("Failed to get outbox event subscribers", map[string]interface{}{
+			"eventID": event.ID,
+			"error":   err.Error(),
+		})
+		return false
+	}
+
+	// 2. Entregar el evento a los suscriptores pendientes
+	var lastErr error
+	for _, subscriber := range s.subscribersFor(event.EventType) {
+		if handled[subscriber.Name()] {
+			continue
+		}
+
+		if err = subscriber.Handle(ctx, event); err != nil {
+			lastErr = err
+			logs.Warn("Outbox subscriber failed to handle event", map[string]interface{}{
+				"eventID":    event.ID,
+				"eventType":  event.EventType,
+				"subscriber": subscriber.Name(),
+				"error":      err.Error(),
+			})
+			s.saveEventLog(ctx, event, subscriber.Name(), constants.EventLogLevelError, err.Error())
+			continue
+		}
+
+		s.saveEventLog(ctx, event, subscriber.Name(), constants.EventLogLevelInfo, "Evento entregado")
+	}
+
+	// 3. Marcar el evento como publicado o programar el reintento
+	if lastErr == nil {
+		if err = s.repo.MarkEventPublished(ctx, event.ID, time.Now()); err != nil {
+			logs.Error("Failed to mark outbox event as published", map[string]interface{}{
+				"eventID": event.ID,
+				"error":   err.Error(),
+			})
+			return false
+		}
+		return true
+	}
+
+	event.Attempts++
+	event.LastError = truncateText(lastErr.Error(), 1000)
+	nextAttemptAt := time.Now().Add(outboxRetryDelay(event.Attempts))
+	event.NextAttemptAt = &nextAttemptAt
+
+	if event.Attempts >= constants.OutboxMaxAttempts {
+		logs.Error("Outbox event exhausted its attempts", map[string]interface{}{
+			"eventID":   event.ID,
+			"eventType": event.EventType,
+			"attempts":  event.Attempts,
+			"error":     event.LastError,
+		})
+	}
+
+	if err = s.repo.MarkEventFailed(ctx, event); err != nil {
+		logs.Error("Failed to save outbox event attempt", map[string]interface{}{
+			"eventID": event.ID,
+			"error":   err.Error(),
+		})
+	}
+
+	return false
+}
+
+// saveEventLog registra el resultado de la entrega, el registro exitoso evita entregar de nuevo el evento al suscriptor
+func (s *OutboxRelayService) saveEventLog(ctx context.Context, event *entities.SystemEvent, subscriber, level, description string) {
+	log := &entities.EventLog{
+		ID:          uuid.NewString(),
+		EventID:     event.ID,
+		Subscriber:  subscriber,
+		LogLevel:    level,
+		Description: description,
+		Metadata:    "{}",
+		CreatedAt:   time.Now(),
+	}
+
+	if err := s.repo.SaveEventLog(ctx, log); err != nil {
+		logs.Error("Failed to save outbox event log", map[string]interface{}{
+			"eventID":    event.ID,
+			"subscriber": subscriber,
+			"error":      err.Error(),
+		})
+	}
+}
+
+// subscribersFor obtiene los suscriptores del tipo de evento
+func (s *OutboxRelayService) subscribersFor(eventType string) []interfaces.EventSubscriber {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	subscribers := make([]interfaces.EventSubscriber, 0, len(s.subscribers))
+	for _, subscriber := range s.subscribers {
+		eventTypes := subscriber.EventTypes()
+		if len(eventTypes) == 0 {
+			subscribers = append(subscribers, subscriber)
+			continue
+		}
+
+		for _, subscribed := range eventTypes {
+			if subscribed == eventType {
+				subscribers = append(subscribers, subscriber)
+				break
+			}
+		}
+	}
+
+	return subscribers
+}
+
+func outboxRetryDelay(attempts int) time.Duration {
+	return constants.OutboxRetryBaseDelay * time.Duration(1<<uint(attempts-1))
+}
@@ -0,0 +1,338 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/value_objects"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+type PaymentService struct {
+	repo    ports.PaymentRepository
+	gateway ports.PaymentGateway
+}
+
+func NewPaymentService(repo ports.PaymentRepository, gateway ports.PaymentGateway) interfaces.PaymentProcessor {
+	return &PaymentService{
+		repo:    repo,
+		gateway: gateway,
+	}
+}
+
+// AuthorizePayment solicita al proveedor retener el monto del pedido antes de crearlo, el pago no se persiste aquí
+// sino junto con el pedido
+func (s *PaymentService) AuthorizePayment(ctx context.Context, payment *entities.OrderPayment) error {
+	payment.Provider = s.gateway.Name()
+
+	// 1. Solicitar la autorización al proveedor
+	result, err := s.gateway.Authorize(ctx, payment)
+	if err != nil {
+		logs.Error("Failed to authorize payment", map[string]interface{}{
+			"orderID": payment.OrderID,
+			"error":   err.Error(),
+		})
+		return errPackage.NewDomainErrorWithCause("PaymentService", "AuthorizePayment", "failed to authorize payment", errPackage.ErrPaymentGatewayFailed)
+	}
+
+	// 2. Aplicar la respuesta del proveedor
+	payment.ProviderPaymentID = result.ProviderPaymentID
+	payment.Status = result.Status
+	payment.FailureReason = result.FailureReason
+	if payment.Status == constants.PaymentStatusAuthorized {
+		now := time.Now()
+		payment.AuthorizedAt = &now
+	}
+
+	if payment.Status == constants.PaymentStatusFailed {
+		logs.Warn("Payment declined", map[string]interface{}{
+			"orderID": payment.OrderID,
+			"reason":  payment.FailureReason,
+		})
+		return errPackage.NewDomainErrorWithCause("PaymentService", "AuthorizePayment", "payment declined", errPackage.ErrPaymentDeclined)
+	}
+
+	return nil
+}
+
+// CapturePayment cobra el monto autorizado de un pedido ya creado, si el proveedor falla el pago queda
+// pendiente de cobro para que RetryPendingPayments lo reintente
+func (s *PaymentService) CapturePayment(ctx context.Context, payment *entities.OrderPayment) error {
+	if !value_objects.NewPaymentStatus(payment.Status).IsCapturable() {
+		return nil
+	}
+
+	// 1. Solicitar el cobro al proveedor
+	result, err := s.gateway.Capture(ctx, payment.ProviderPaymentID, payment.Amount)
+	if err != nil {
+		logs.Error("Failed to capture payment", map[string]interface{}{
+			"orderID":   payment.OrderID,
+			"paymentID": payment.ID,
+			"error":     err.Error(),
+		})
+		s.markPending(ctx, payment, constants.PaymentStatusCapturePending)
+		return errPackage.NewDomainErrorWithCause("PaymentService", "CapturePayment", "failed to capture payment", errPackage.ErrPaymentGatewayFailed)
+	}
+
+	// 2. Registrar el cobro
+	payment.Status = result.Status
+	if payment.Status == constants.PaymentStatusCaptured {
+		now := time.Now()
+		payment.CapturedAt = &now
+	}
+
+	if err = s.repo.UpdatePayment(ctx, payment); err != nil {
+		logs.Error("Failed to update captured payment", map[string]interface{}{
+			"paymentID": payment.ID,
+			"error":     err.Error(),
+		})
+		return errPackage.NewDomainErrorWithCause("PaymentService", "CapturePayment", "failed to update payment", err)
+	}
+
+	return nil
+}
+
+// ReleasePayment libera una autorización cuyo pedido no llegó a crearse
+func (s *PaymentService) ReleasePayment(ctx context.Context, payment *entities.OrderPayment) {
+	if !value_objects.NewPaymentStatus(payment.Status).IsRefundable() {
+		return
+	}
+
+	if _, err := s.gateway.Refund(ctx, payment.ProviderPaymentID, payment.Amount); err != nil {
+		logs.Error("Failed to release payment authorization", map[string]interface{}{
+			"orderID":           payment.OrderID,
+			"providerPaymentID": payment.ProviderPaymentID,
+			"error":             err.Error(),
+		})
+	}
+}
+
+// RefundOrderPayment devuelve el pago anticipado de un pedido cancelado, los pedidos sin pago se ignoran
+func (s *PaymentService) RefundOrderPayment(ctx context.Context, orderID string) error {
+	// 1. Obtener el pago del pedido
+	payment, err := s.repo.GetPaymentByOrderID(ctx, orderID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+
+		logs.Error("Failed to get order payment", map[string]interface{}{
+			"orderID": orderID,
+			"error":   err.Error(),
+		})
+		return errPackage.NewDomainErrorWithCause("PaymentService", "RefundOrderPayment", "failed to get order payment", err)
+	}
+
+	return s.refund(ctx, payment)
+}
+
+func (s *PaymentService) GetOrderPayment(ctx context.Context, orderID string) (*entities.OrderPayment, error) {
+	payment, err := s.repo.GetPaymentByOrderID(ctx, orderID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errPackage.NewDomainErrorWithCause("PaymentService", "GetOrderPayment", "payment not found", errPackage.ErrPaymentNotFound)
+		}
+
+		logs.Error("Failed to get order payment", map[string]interface{}{
+			"orderID": orderID,
+			"error":   err.Error(),
+		})
+		return nil, errPackage.NewDomainErrorWithCause("PaymentService", "GetOrderPayment", "failed to get order payment", err)
+	}
+
+	return payment, nil
+}
+
+// HandleWebhook aplica un evento del proveedor al pago, los eventos repetidos se ignoran
+func (s *PaymentService) HandleWebhook(ctx context.Context, payload []byte, signature string) error {
+	// 1. Verificar la firma y leer el evento
+	event, err := s.gateway.VerifyWebhook(payload, signature)
+	if err != nil {
+		logs.Warn("Invalid payment webhook", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return errPackage.NewDomainErrorWithCause("PaymentService", "HandleWebhook", "invalid payment webhook", err)
+	}
+
+	// 2. Ignorar los eventos ya procesados
+	exists, err := s.repo.ExistsWebhookEvent(ctx, event.ID)
+	if err != nil {
+		logs.Error("Failed to check payment webhook event", map[string]interface{}{
+			"eventID": event.ID,
+			"error":   err.Error(),
+		})
+		return errPackage.NewDomainErrorWithCause("PaymentService", "HandleWebhook", "failed to check payment webhook event", err)
+	}
+
+	if exists {
+		logs.Info("Payment webhook event already processed", map[string]interface{}{
+			"eventID": event.ID,
+		})
+		return nil
+	}
+
+	// 3. Obtener el pago del evento
+	payment, err := s.repo.GetPaymentByProviderID(ctx, event.Provider, event.ProviderPaymentID)
+	if err != nil {
+		logs.Error("Failed to get payment of webhook event", map[string]interface{}{
+			"eventID":           event.ID,
+			"providerPaymentID": event.ProviderPaymentID,
+			"error":             err.Error(),
+		})
+
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errPackage.NewDomainErrorWithCause("PaymentService", "HandleWebhook", "payment not found", errPackage.ErrPaymentNotFound)
+		}
+
+		return errPackage.NewDomainErrorWithCause("PaymentService", "HandleWebhook", "failed to get payment", err)
+	}
+
+	// 4. Aplicar el nuevo estado si la transición es válida, de lo contrario solo se registra el evento
+	applied := applyWebhookEvent(payment, event)
+	var toUpdate *entities.OrderPayment
+	if applied {
+		toUpdate = payment
+	}
+
+	if err = s.repo.ApplyWebhookEvent(ctx, event, toUpdate); err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil
+		}
+
+		logs.Error("Failed to apply payment webhook event", map[string]interface{}{
+			"eventID": event.ID,
+			"error":   err.Error(),
+		})
+		return errPackage.NewDomainErrorWithCause("PaymentService", "HandleWebhook", "failed to apply payment webhook event", err)
+	}
+
+	// 5. Un pago confirmado después de cancelar el pedido se devuelve de inmediato
+	if applied && payment.Order != nil && isOrderCancelled(payment.Order) {
+		return s.refund(ctx, payment)
+	}
+
+	return nil
+}
+
+// RetryPendingPayments reintenta los cobros y las devoluciones que el proveedor no confirmó, los cobros de pedidos
+// cancelados o eliminados se devuelven en lugar de cobrarse
+func (s *PaymentService) RetryPendingPayments(ctx context.Context) (int, error) {
+	payments, err := s.repo.GetPaymentsByStatus(ctx, []string{constants.PaymentStatusCapturePending, constants.PaymentStatusRefundPending}, constants.PaymentRetryBatchSize)
+	if err != nil {
+		logs.Error("Failed to get pending payments", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return 0, errPackage.NewDomainErrorWithCause("PaymentService", "RetryPendingPayments", "failed to get pending payments", err)
+	}
+
+	completed := 0
+	for i := range payments {
+		payment := &payments[i]
+
+		if payment.Status == constants.PaymentStatusCapturePending && (payment.Order == nil || !isOrderCancelled(payment.Order)) {
+			err = s.CapturePayment(ctx, payment)
+		} else {
+			err = s.refund(ctx, payment)
+		}
+
+		// Los errores ya se registraron y el pago sigue pendiente para la siguiente ejecución
+		if err == nil {
+			completed++
+		}
+	}
+
+	return completed, nil
+}
+
+// markPending guarda el pago como pendiente de cobro o de devolución tras un fallo del proveedor
+func (s *PaymentService) markPending(ctx context.Context, payment *entities.OrderPayment, status string) {
+	payment.Status = status
+	if err := s.repo.UpdatePayment(ctx, payment); err != nil {
+		logs.Error("Failed to mark payment as pending", map[string]interface{}{
+			"paymentID": payment.ID,
+			"status":    status,
+			"error":     err.Error(),
+		})
+	}
+}
+
+// refund devuelve al cliente el monto retenido o cobrado
+func (s *PaymentService) refund(ctx context.Context, payment *entities.OrderPayment) error {
+	if !value_objects.NewPaymentStatus(payment.Status).IsRefundable() {
+		return nil
+	}
+
+	// 1. Solicitar la devolución al proveedor
+	result, err := s.gateway.Refund(ctx, payment.ProviderPaymentID, payment.Amount)
+	if err != nil {
+		logs.Error("Failed to refund payment", map[string]interface{}{
+			"orderID":   payment.OrderID,
+			"paymentID": payment.ID,
+			"error":     err.Error(),
+		})
+		s.markPending(ctx, payment, constants.PaymentStatusRefundPending)
+		return errPackage.NewDomainErrorWithCause("PaymentService", "RefundOrderPayment", "failed to refund payment", errPackage.ErrPaymentGatewayFailed)
+	}
+
+	// 2. Registrar la devolución
+	now := time.Now()
+	payment.Status = result.Status
+	payment.RefundedAmount = result.Amount
+	payment.RefundedAt = &now
+
+	if err = s.repo.UpdatePayment(ctx, payment); err != nil {
+		logs.Error("Failed to update refunded payment", map[string]interface{}{
+			"paymentID": payment.ID,
+			"error":     err.Error(),
+		})
+		return errPackage.NewDomainErrorWithCause("PaymentService", "RefundOrderPayment", "failed to update payment", err)
+	}
+
+	logs.Info("Order payment refunded", map[string]interface{}{
+		"orderID": payment.OrderID,
+		"amount":  payment.RefundedAmount,
+	})
+
+	return nil
+}
+
+// applyWebhookEvent actualiza el pago con el estado del evento, indica si hubo cambios
+func applyWebhookEvent(payment *entities.OrderPayment, event *entities.PaymentWebhookEvent) bool {
+	status, ok := constants.PaymentStatusByEvent[event.Type]
+	if !ok || !value_objects.NewPaymentStatus(payment.Status).CanTransitionTo(value_objects.NewPaymentStatus(status)) {
+		logs.Warn("Payment webhook event ignored", map[string]interface{}{
+			"eventID": event.ID,
+			"type":    event.Type,
+			"from":    payment.Status,
+		})
+		return false
+	}
+
+	now := time.Now()
+	payment.Status = status
+	switch status {
+	case constants.PaymentStatusAuthorized:
+		payment.AuthorizedAt = &now
+	case constants.PaymentStatusCaptured:
+		payment.CapturedAt = &now
+	case constants.PaymentStatusRefunded:
+		payment.RefundedAt = &now
+		payment.RefundedAmount = event.Amount
+	case constants.PaymentStatusFailed:
+		payment.FailureReason = event.FailureReason
+	}
+
+	return true
+}
+
+func isOrderCancelled(order *entities.Order) bool {
+	return order.Status == constants.OrderStatusCancelled || order.DeletedAt != nil
+}
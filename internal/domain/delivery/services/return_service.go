@@ -0,0 +1,266 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"strings"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/value_objects"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+type ReturnService struct {
+	repo              ports.ReturnRepository
+	orderRepo         ports.OrdererRepository
+	trackingGenerator interfaces.TrackingNumberGenerator
+}
+
+func NewReturnService(repo ports.ReturnRepository, orderRepo ports.OrdererRepository, trackingGenerator interfaces.TrackingNumberGenerator) interfaces.Returner {
+	return &ReturnService{
+		repo:              repo,
+		orderRepo:         orderRepo,
+		trackingGenerator: trackingGenerator,
+	}
+}
+
+// CreateReturn crea una devolución vinculada al pedido original con recogida en la dirección de entrega
+func (s *ReturnService) CreateReturn(ctx context.Context, orderReturn *entities.OrderReturn) error {
+	// 1. Validar el motivo de la devolución
+	if !constants.ValidReturnReasons[orderReturn.ReasonCode] {
+		return errPackage.NewDomainErrorWithCause("ReturnService", "CreateReturn", "invalid return reason", errPackage.ErrInvalidReturnReason)
+	}
+
+	// 2. Obtener el pedido original
+	order, err := s.orderRepo.GetOrderByID(ctx, orderReturn.OrderID)
+	if err != nil {
+		logs.Error("Failed to get order by id", map[string]interface{}{
+			"orderID": orderReturn.OrderID,
+			"error":   err.Error(),
+		})
+		return errPackage.NewDomainErrorWithCause("ReturnService", "CreateReturn", "failed to get order by id", err)
+	}
+
+	if order.DeletedAt != nil {
+		return errPackage.NewDomainErrorWithCause("ReturnService", "CreateReturn", "order is deleted", errPackage.ErrOrderDeleted)
+	}
+
+	if !constants.AllowedStatesToReturn[order.Status] {
+		logs.Warn("Order cannot be returned", map[string]interface{}{
+			"orderID": order.ID,
+			"status":  order.Status,
+		})
+		return errPackage.NewDomainErrorWithCause("ReturnService", "CreateReturn", "order cannot be returned", errPackage.ErrOrderCannotBeReturned)
+	}
+
+	// 3. Resolver el destino, por defecto la sucursal de origen del pedido
+	if orderReturn.DestinationType == "" {
+		orderReturn.DestinationType = constants.ReturnDestinationBranch
+		orderReturn.DestinationID = order.BranchID
+	}
+
+	if err = s.validateDestination(ctx, order.CompanyID, orderReturn.DestinationType, orderReturn.DestinationID); err != nil {
+		return err
+	}
+
+	// 4. La recogida se realiza en la dirección de entrega del pedido original
+	if order.DeliveryAddress != nil {
+		orderReturn.PickupName = order.DeliveryAddress.RecipientName
+		orderReturn.PickupPhone = order.DeliveryAddress.RecipientPhone
+		orderReturn.PickupAddress = formatDeliveryAddress(order.DeliveryAddress)
+	}
+
+	// 5. Completar los datos de la devolución
+	now := time.Now()
+	orderReturn.ID = uuid.NewString()
+	orderReturn.CompanyID = order.CompanyID
+	orderReturn.Status = constants.ReturnStatusRequested
+	orderReturn.CreatedAt = now
+	orderReturn.UpdatedAt = now
+
+	// 6. Guardar la devolución, el repositorio bloquea el pedido y verifica que no exista otra devolución en curso
+	err = saveWithUniqueTrackingNumber(
+		func() (string, error) { return s.trackingGenerator.Generate(constants.TrackingPrefixReturn) },
+		func(trackingNumber string) { orderReturn.TrackingNumber = trackingNumber },
+		func() error { return s.repo.CreateReturn(ctx, orderReturn) },
+	)
+	if errors.Is(err, errPackage.ErrReturnAlreadyExists) {
+		return errPackage.NewDomainErrorWithCause("ReturnService", "CreateReturn", "order already has an active return", errPackage.ErrReturnAlreadyExists)
+	}
+	if errors.Is(err, errPackage.ErrOrderCannotBeReturned) {
+		return errPackage.NewDomainErrorWithCause("ReturnService", "CreateReturn", "order cannot be returned", errPackage.ErrOrderCannotBeReturned)
+	}
+	if err != nil {
+		logs.Error("Failed to create return", map[string]interface{}{
+			"orderID": order.ID,
+			"error":   err.Error(),
+		})
+		return errPackage.NewDomainErrorWithCause("ReturnService", "CreateReturn", "failed to create return", err)
+	}
+
+	logs.Info("Return created successfully", map[string]interface{}{
+		"returnID":       orderReturn.ID,
+		"orderID":        order.ID,
+		"trackingNumber": orderReturn.TrackingNumber,
+		"initiatedBy":    orderReturn.InitiatedBy,
+	})
+
+	return nil
+}
+
+func (s *ReturnService) GetReturnByID(ctx context.Context, id string) (*entities.OrderReturn, error) {
+	orderReturn, err := s.repo.GetReturnByID(ctx, id)
+	if err != nil {
+		logs.Error("Failed to get return by id", map[string]interface{}{
+			"returnID": id,
+			"error":    err.Error(),
+		})
+
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errPackage.NewDomainErrorWithCause("ReturnService", "GetReturnByID", "return not found", errPackage.ErrReturnNotFound)
+		}
+
+		return nil, errPackage.NewDomainErrorWithCause("ReturnService", "GetReturnByID", "failed to get return by id", err)
+	}
+
+	return orderReturn, nil
+}
+
+func (s *ReturnService) GetReturnsByOrder(ctx context.Context, orderID string) ([]entities.OrderReturn, error) {
+	returns, err := s.repo.GetReturnsByOrder(ctx, orderID)
+	if err != nil {
+		logs.Error("Failed to get returns by order", map[string]interface{}{
+			"orderID": orderID,
+			"error":   err.Error(),
+		})
+		return nil, errPackage.NewDomainErrorWithCause("ReturnService", "GetReturnsByOrder", "failed to get returns by order", err)
+	}
+
+	return returns, nil
+}
+
+func (s *ReturnService) GetReturnsByCompany(ctx context.Context, companyID, status string) ([]entities.OrderReturn, error) {
+	if status != "" && !value_objects.NewReturnStatus(status).IsValid() {
+		return nil, errPackage.NewDomainErrorWithCause("ReturnService", "GetReturnsByCompany", "invalid return status", errPackage.ErrInvalidReturnStatus)
+	}
+
+	returns, err := s.repo.GetReturnsByCompany(ctx, companyID, strings.ToUpper(status))
+	if err != nil {
+		logs.Error("Failed to get returns by company", map[string]interface{}{
+			"companyID": companyID,
+			"error":     err.Error(),
+		})
+		return nil, errPackage.NewDomainErrorWithCause("ReturnService", "GetReturnsByCompany", "failed to get returns by company", err)
+	}
+
+	return returns, nil
+}
+
+// ChangeReturnStatus avanza la devolución por su flujo de recogida y traslado hasta el destino
+func (s *ReturnService) ChangeReturnStatus(ctx context.Context, id, status string, driverID *string) error {
+	// 1. Validar el estado solicitado
+	nextStatus := value_objects.NewReturnStatus(status)
+	if !nextStatus.IsValid() {
+		return errPackage.NewDomainErrorWithCause("ReturnService", "ChangeReturnStatus", "invalid return status", errPackage.ErrInvalidReturnStatus)
+	}
+
+	// 2. Obtener la devolución
+	orderReturn, err := s.GetReturnByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// 3. Validar la transición
+	if !value_objects.NewReturnStatus(orderReturn.Status).CanTransitionTo(nextStatus) {
+		logs.Warn("Invalid return status transition", map[string]interface{}{
+			"returnID": id,
+			"from":     orderReturn.Status,
+			"to":       nextStatus.GetValue(),
+		})
+		return errPackage.NewDomainErrorWithCause("ReturnService", "ChangeReturnStatus",
+			fmt.Sprintf("cannot change return status from %s to %s", orderReturn.Status, nextStatus.GetValue()),
+			errPackage.ErrInvalidReturnTransition)
+	}
+
+	// 4. Al recibirse la devolución, preparar el evento del pedido original si sigue entregado
+	var event *entities.SystemEvent
+	if nextStatus.GetValue() == constants.ReturnStatusReceived && orderReturn.Order != nil && orderReturn.Order.Status == constants.OrderStatusDelivered {
+		event = orderStatusChangedEvent(orderReturn.Order, constants.OrderStatusReturned, map[string]interface{}{
+			"return_id":       orderReturn.ID,
+			"return_tracking": orderReturn.TrackingNumber,
+		})
+	}
+
+	// 5. Actualizar el estado
+	if err = s.repo.UpdateReturnStatus(ctx, orderReturn, nextStatus.GetValue(), driverID, time.Now(), event); err != nil {
+		if errors.Is(err, errPackage.ErrReturnStatusConflict) {
+			logs.Warn("Return status changed concurrently", map[string]interface{}{
+				"returnID": id,
+				"from":     orderReturn.Status,
+			})
+			return errPackage.NewDomainErrorWithCause("ReturnService", "ChangeReturnStatus", "return status changed concurrently", errPackage.ErrReturnStatusConflict)
+		}
+
+		logs.Error("Failed to update return status", map[string]interface{}{
+			"returnID": id,
+			"status":   nextStatus.GetValue(),
+			"error":    err.Error(),
+		})
+		return errPackage.NewDomainErrorWithCause("ReturnService", "ChangeReturnStatus", "failed to update return status", err)
+	}
+
+	return nil
+}
+
+// validateDestination verifica que el destino de la devolución exista y esté activo
+func (s *ReturnService) validateDestination(ctx context.Context, companyID, destinationType, destinationID string) error {
+	var (
+		exists bool
+		err    error
+	)
+
+	switch destinationType {
+	case constants.ReturnDestinationBranch:
+		exists, err = s.repo.ExistsActiveBranch(ctx, companyID, destinationID)
+	case constants.ReturnDestinationWarehouse:
+		exists, err = s.repo.ExistsActiveWarehouse(ctx, destinationID)
+	default:
+		return errPackage.NewDomainErrorWithCause("ReturnService", "CreateReturn", "invalid return destination", errPackage.ErrInvalidReturnDestination)
+	}
+
+	if err != nil {
+		logs.Error("Failed to validate return destination", map[string]interface{}{
+			"destinationType": destinationType,
+			"destinationID":   destinationID,
+			"error":           err.Error(),
+		})
+		return errPackage.NewDomainErrorWithCause("ReturnService", "CreateReturn", "failed to validate return destination", err)
+	}
+
+	if !exists {
+		return errPackage.NewDomainErrorWithCause("ReturnService", "CreateReturn", "return destination not found or inactive", errPackage.ErrReturnDestinationInactive)
+	}
+
+	return nil
+}
+
+func formatDeliveryAddress(address *entities.DeliveryAddress) string {
+	parts := []string{address.AddressLine1}
+	if address.AddressLine2 != "" {
+		parts = append(parts, address.AddressLine2)
+	}
+	parts = append(parts, address.City, address.State)
+	if address.PostalCode != "" {
+		parts = append(parts, address.PostalCode)
+	}
+
+	return strings.Join(parts, ", ")
+}
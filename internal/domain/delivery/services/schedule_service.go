@@ -0,0 +1,378 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"strings"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/value_objects"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+type ScheduleService struct {
+	repo        ports.ScheduleRepository
+	companyRepo ports.CompanyRepository
+}
+
+func NewScheduleService(repo ports.ScheduleRepository, companyRepo ports.CompanyRepository) interfaces.OrderScheduler {
+	return &ScheduleService{
+		repo:        repo,
+		companyRepo: companyRepo,
+	}
+}
+
+// CreateSchedule valida y guarda una programación calculando su primera ejecución
+func (s *ScheduleService) CreateSchedule(ctx context.Context, schedule *entities.OrderSchedule) error {
+	// 1. Validar la programación contra el horario de la sucursal
+	if err := s.validateSchedule(ctx, schedule, time.Now()); err != nil {
+		return err
+	}
+
+	// 2. Completar los datos de la programación
+	now := time.Now()
+	schedule.ID = uuid.NewString()
+	schedule.Status = constants.ScheduleStatusActive
+	schedule.NextRunAt = nextScheduleRun(schedule, now)
+	schedule.CreatedAt = now
+	schedule.UpdatedAt = now
+
+	// 3. Guardar la programación
+	if err := s.repo.CreateSchedule(ctx, schedule); err != nil {
+		logs.Error("Failed to create schedule", map[string]interface{}{
+			"companyID": schedule.CompanyID,
+			"error":     err.Error(),
+		})
+		return errPackage.NewDomainErrorWithCause("ScheduleService", "CreateSchedule", "failed to create schedule", err)
+	}
+
+	logs.Info("Schedule created successfully", map[string]interface{}{
+		"scheduleID": schedule.ID,
+		"companyID":  schedule.CompanyID,
+		"nextRunAt":  schedule.NextRunAt,
+	})
+
+	return nil
+}
+
+// UpdateSchedule modifica una programación activa o pausada recalculando su siguiente ejecución
+func (s *ScheduleService) UpdateSchedule(ctx context.Context, schedule *entities.OrderSchedule) error {
+	// 1. Obtener la programación existente
+	existing, err := s.GetScheduleByID(ctx, schedule.ID)
+	if err != nil {
+		return err
+	}
+
+	if !existing.IsEditable() {
+		return errPackage.NewDomainErrorWithCause("ScheduleService", "UpdateSchedule", "schedule cannot be modified", errPackage.ErrScheduleNotEditable)
+	}
+
+	// 2. Conservar los datos que no se pueden modificar
+	schedule.CompanyID = existing.CompanyID
+	schedule.BranchID = existing.BranchID
+	schedule.CreatedByID = existing.CreatedByID
+	schedule.Status = existing.Status
+
+	// 3. Validar la programación actualizada
+	now := time.Now()
+	if err = s.validateSchedule(ctx, schedule, now); err != nil {
+		return err
+	}
+
+	// 4. Recalcular la siguiente ejecución, las programaciones pausadas la calculan al reanudarse
+	schedule.NextRunAt = nil
+	if schedule.Status == constants.ScheduleStatusActive {
+		schedule.NextRunAt = nextScheduleRun(schedule, now)
+	}
+	schedule.UpdatedAt = now
+
+	// 5. Guardar solo si la programación no cambió desde que se leyó
+	if err = s.repo.UpdateSchedule(ctx, schedule, existing.Status, existing.NextRunAt); err != nil {
+		if errors.Is(err, errPackage.ErrScheduleConflict) {
+			return scheduleConflictError("UpdateSchedule", schedule.ID)
+		}
+
+		logs.Error("Failed to update schedule", map[string]interface{}{
+			"scheduleID": schedule.ID,
+			"error":      err.Error(),
+		})
+		return errPackage.NewDomainErrorWithCause("ScheduleService", "UpdateSchedule", "failed to update schedule", err)
+	}
+
+	return nil
+}
+
+func (s *ScheduleService) GetScheduleByID(ctx context.Context, id string) (*entities.OrderSchedule, error) {
+	schedule, err := s.repo.GetScheduleByID(ctx, id)
+	if err != nil {
+		logs.Error("Failed to get schedule by id", map[string]interface{}{
+			"scheduleID": id,
+			"error":      err.Error(),
+		})
+
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errPackage.NewDomainErrorWithCause("ScheduleService", "GetScheduleByID", "schedule not found", errPackage.ErrScheduleNotFound)
+		}
+
+		return nil, errPackage.NewDomainErrorWithCause("ScheduleService", "GetScheduleByID", "failed to get schedule by id", err)
+	}
+
+	return schedule, nil
+}
+
+func (s *ScheduleService) GetSchedulesByCompany(ctx context.Context, companyID, status string) ([]entities.OrderSchedule, error) {
+	schedules, err := s.repo.GetSchedulesByCompany(ctx, companyID, strings.ToUpper(status))
+	if err != nil {
+		logs.Error("Failed to get schedules by company", map[string]interface{}{
+			"companyID": companyID,
+			"error":     err.Error(),
+		})
+		return nil, errPackage.NewDomainErrorWithCause("ScheduleService", "GetSchedulesByCompany", "failed to get schedules by company", err)
+	}
+
+	return schedules, nil
+}
+
+// PauseSchedule detiene temporalmente la materialización de pedidos
+func (s *ScheduleService) PauseSchedule(ctx context.Context, id string) error {
+	schedule, err := s.GetScheduleByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if schedule.Status != constants.ScheduleStatusActive {
+		return errPackage.NewDomainErrorWithCause("ScheduleService", "PauseSchedule", "schedule is not active", errPackage.ErrScheduleNotActive)
+	}
+
+	return s.saveStatus(ctx, schedule, constants.ScheduleStatusPaused, nil, "PauseSchedule")
+}
+
+// ResumeSchedule reactiva una programación pausada a partir de la siguiente ocurrencia
+func (s *ScheduleService) ResumeSchedule(ctx context.Context, id string) error {
+	schedule, err := s.GetScheduleByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if schedule.Status != constants.ScheduleStatusPaused {
+		return errPackage.NewDomainErrorWithCause("ScheduleService", "ResumeSchedule", "schedule is not paused", errPackage.ErrScheduleNotPaused)
+	}
+
+	status := constants.ScheduleStatusActive
+	nextRunAt := nextScheduleRun(schedule, time.Now())
+
+	// Una programación única cuya fecha ya pasó no tiene más ejecuciones
+	if nextRunAt == nil {
+		status = constants.ScheduleStatusCompleted
+	}
+
+	return s.saveStatus(ctx, schedule, status, nextRunAt, "ResumeSchedule")
+}
+
+// CancelSchedule cancela definitivamente una programación
+func (s *ScheduleService) CancelSchedule(ctx context.Context, id string) error {
+	schedule, err := s.GetScheduleByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if !schedule.IsEditable() {
+		return errPackage.NewDomainErrorWithCause("ScheduleService", "CancelSchedule", "schedule cannot be modified", errPackage.ErrScheduleNotEditable)
+	}
+
+	return s.saveStatus(ctx, schedule, constants.ScheduleStatusCancelled, nil, "CancelSchedule")
+}
+
+func (s *ScheduleService) GetDueSchedules(ctx context.Context, now time.Time) ([]entities.OrderSchedule, error) {
+	schedules, err := s.repo.GetDueSchedules(ctx, now, constants.MaxDueSchedulesPerRun)
+	if err != nil {
+		logs.Error("Failed to get due schedules", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, errPackage.NewDomainErrorWithCause("ScheduleService", "GetDueSchedules", "failed to get due schedules", err)
+	}
+
+	return schedules, nil
+}
+
+// ClaimScheduleRun reserva la ejecución actual avanzando la programación a su siguiente ocurrencia
+func (s *ScheduleService) ClaimScheduleRun(ctx context.Context, schedule *entities.OrderSchedule) (bool, error) {
+	if schedule.NextRunAt == nil {
+		return false, nil
+	}
+
+	status := constants.ScheduleStatusActive
+	nextRunAt := nextScheduleRun(schedule, *schedule.NextRunAt)
+	if nextRunAt == nil {
+		status = constants.ScheduleStatusCompleted
+	}
+
+	claimed, err := s.repo.ClaimScheduleRun(ctx, schedule.ID, *schedule.NextRunAt, nextRunAt, status)
+	if err != nil {
+		logs.Error("Failed to claim schedule run", map[string]interface{}{
+			"scheduleID": schedule.ID,
+			"error":      err.Error(),
+		})
+		return false, errPackage.NewDomainErrorWithCause("ScheduleService", "ClaimScheduleRun", "failed to claim schedule run", err)
+	}
+
+	return claimed, nil
+}
+
+// RecordScheduleRun guarda el resultado de la materialización
+func (s *ScheduleService) RecordScheduleRun(ctx context.Context, id string, orderID *string, runErr error) error {
+	lastError := ""
+	if runErr != nil {
+		lastError = runErr.Error()
+	}
+
+	if err := s.repo.RecordScheduleRun(ctx, id, orderID, lastError, time.Now()); err != nil {
+		logs.Error("Failed to record schedule run", map[string]interface{}{
+			"scheduleID": id,
+			"error":      err.Error(),
+		})
+		return errPackage.NewDomainErrorWithCause("ScheduleService", "RecordScheduleRun", "failed to record schedule run", err)
+	}
+
+	return nil
+}
+
+// ValidatePickupWindow verifica que la sucursal esté abierta a la hora de recogida
+func (s *ScheduleService) ValidatePickupWindow(branch *entities.Branch, pickupTime time.Time) error {
+	if branch == nil || branch.OperatingHours == "" {
+		return nil
+	}
+
+	operatingHours, err := value_objects.NewOperatingHoursFromJSON(branch.OperatingHours)
+	if err != nil {
+		return errPackage.NewDomainErrorWithCause("ScheduleService", "ValidatePickupWindow", "invalid operating hours", errPackage.ErrInvalidOperatingHours)
+	}
+
+	if !operatingHours.IsOpen(pickupTime) {
+		return errPackage.NewDomainErrorWithCause("ScheduleService", "ValidatePickupWindow",
+			"branch is closed at "+pickupTime.Format("Mon 15:04"), errPackage.ErrScheduleOutsideHours)
+	}
+
+	return nil
+}
+
+// validateSchedule verifica la recurrencia, la plantilla y el horario de la sucursal
+func (s *ScheduleService) validateSchedule(ctx context.Context, schedule *entities.OrderSchedule, now time.Time) error {
+	// 1. Validar la plantilla del pedido
+	if !json.Valid([]byte(schedule.OrderTemplate)) {
+		return errPackage.NewDomainErrorWithCause("ScheduleService", "ValidateSchedule", "invalid order template", errPackage.ErrInvalidOrderTemplate)
+	}
+
+	if schedule.DeliveryWindowMinutes <= 0 {
+		schedule.DeliveryWindowMinutes = constants.DefaultScheduleDeliveryWindow
+	}
+
+	// 2. Obtener la sucursal de recogida
+	branch, err := s.companyRepo.GetBranchByID(ctx, schedule.BranchID)
+	if err != nil {
+		logs.Error("Failed to get branch for schedule", map[string]interface{}{
+			"branchID": schedule.BranchID,
+			"error":    err.Error(),
+		})
+		return errPackage.NewDomainErrorWithCause("ScheduleService", "ValidateSchedule", "branch not found", errPackage.ErrBranchNotFound)
+	}
+
+	if branch.CompanyID != schedule.CompanyID {
+		return errPackage.NewDomainErrorWithCause("ScheduleService", "ValidateSchedule", "branch not found", errPackage.ErrBranchNotFound)
+	}
+
+	if !branch.IsActive {
+		return errPackage.NewDomainErrorWithCause("ScheduleService", "ValidateSchedule", "branch is inactive", errPackage.ErrBranchInactive)
+	}
+
+	// 3. Programación de una sola ejecución
+	if schedule.RunAt != nil {
+		if schedule.IsRecurring() || !schedule.RunAt.After(now) {
+			return errPackage.NewDomainErrorWithCause("ScheduleService", "ValidateSchedule", "invalid schedule", errPackage.ErrInvalidSchedule)
+		}
+
+		return s.ValidatePickupWindow(branch, *schedule.RunAt)
+	}
+
+	// 4. Programación recurrente, cada día programado debe caer dentro del horario de la sucursal
+	recurrence, err := value_objects.NewRecurrence(schedule.DaysOfWeek, schedule.TimeOfDay)
+	if err != nil || !recurrence.IsValid() {
+		return errPackage.NewDomainErrorWithCause("ScheduleService", "ValidateSchedule", "invalid schedule", errPackage.ErrInvalidSchedule)
+	}
+	schedule.DaysOfWeek = recurrence.ToString()
+
+	occurrence := now
+	for range recurrence.Days() {
+		next, ok := recurrence.Next(occurrence)
+		if !ok {
+			break
+		}
+
+		if err = s.ValidatePickupWindow(branch, next); err != nil {
+			return err
+		}
+		occurrence = next
+	}
+
+	return nil
+}
+
+// saveStatus cambia el estado y la siguiente ejecución de la programación leída, falla si otra solicitud o una
+// ejecución reservada la modificó antes
+func (s *ScheduleService) saveStatus(ctx context.Context, schedule *entities.OrderSchedule, status string, nextRunAt *time.Time, operation string) error {
+	if err := s.repo.UpdateScheduleStatus(ctx, schedule.ID, schedule.Status, schedule.NextRunAt, status, nextRunAt); err != nil {
+		if errors.Is(err, errPackage.ErrScheduleConflict) {
+			return scheduleConflictError(operation, schedule.ID)
+		}
+
+		logs.Error("Failed to update schedule status", map[string]interface{}{
+			"scheduleID": schedule.ID,
+			"status":     status,
+			"error":      err.Error(),
+		})
+		return errPackage.NewDomainErrorWithCause("ScheduleService", operation, "failed to update schedule status", err)
+	}
+
+	schedule.Status = status
+	schedule.NextRunAt = nextRunAt
+	return nil
+}
+
+func scheduleConflictError(operation, id string) error {
+	logs.Warn("Schedule changed concurrently", map[string]interface{}{
+		"scheduleID": id,
+		"operation":  operation,
+	})
+	return errPackage.NewDomainErrorWithCause("ScheduleService", operation, "schedule changed concurrently", errPackage.ErrScheduleConflict)
+}
+
+// nextScheduleRun calcula la siguiente ejecución posterior a from, nil si la programación no tiene más ejecuciones
+func nextScheduleRun(schedule *entities.OrderSchedule, from time.Time) *time.Time {
+	if !schedule.IsRecurring() {
+		if schedule.RunAt != nil && schedule.RunAt.After(from) {
+			runAt := *schedule.RunAt
+			return &runAt
+		}
+		return nil
+	}
+
+	recurrence, err := value_objects.NewRecurrence(schedule.DaysOfWeek, schedule.TimeOfDay)
+	if err != nil {
+		return nil
+	}
+
+	next, ok := recurrence.Next(from)
+	if !ok {
+		return nil
+	}
+
+	return &next
+}
@@ -0,0 +1,158 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+type SLAService struct {
+	repo     ports.SLARepository
+	notifier ports.SLANotifier
+}
+
+func NewSLAService(repo ports.SLARepository, notifier ports.SLANotifier) interfaces.SLAMonitor {
+	return &SLAService{
+		repo:     repo,
+		notifier: notifier,
+	}
+}
+
+// EvaluateOrder estima la entrega del pedido con el tiempo máximo de entrega de su zona y la compara con su plazo,
+// el pedido está en riesgo si la entrega estimada supera el plazo e incumplido si el plazo ya venció
+func (s *SLAService) EvaluateOrder(order *entities.Order, now time.Time) *entities.SLAEvaluation {
+	evaluation := &entities.SLAEvaluation{
+		Order:  order,
+		Status: constants.SLAStatusOnTrack,
+	}
+
+	if order == nil || order.Detail == nil {
+		return evaluation
+	}
+
+	// 1. Obtener el tiempo máximo de entrega de la zona de la sucursal
+	deliveryMinutes := constants.DefaultZoneDeliveryMinutes
+	if order.Branch != nil && order.Branch.Zone != nil && order.Branch.Zone.MaxDeliveryTime > 0 {
+		deliveryMinutes = order.Branch.Zone.MaxDeliveryTime
+	}
+	deliveryTime := time.Duration(deliveryMinutes) * time.Minute
+
+	// 2. Estimar la entrega, si el pedido no se ha recogido el tiempo corre desde que se recoja
+	if constants.SLAPickedUpStatuses[order.Status] {
+		evaluation.EstimatedDelivery = order.Detail.PickupTime.Add(deliveryTime)
+		if evaluation.EstimatedDelivery.Before(now) {
+			evaluation.EstimatedDelivery = now
+		}
+	} else {
+		pickup := order.Detail.PickupTime
+		if pickup.Before(now) {
+			pickup = now
+		}
+		evaluation.EstimatedDelivery = pickup.Add(deliveryTime)
+	}
+
+	// 3. Comparar con el plazo de entrega
+	deadline := order.Detail.DeliveryDeadline
+	switch {
+	case now.After(deadline):
+		evaluation.Status = constants.SLAStatusBreached
+	case evaluation.EstimatedDelivery.After(deadline):
+		evaluation.Status = constants.SLAStatusAtRisk
+	}
+
+	if evaluation.EstimatedDelivery.After(deadline) {
+		evaluation.DelayMinutes = int(evaluation.EstimatedDelivery.Sub(deadline).Minutes())
+	}
+
+	return evaluation
+}
+
+// GetOrdersSLA evalúa el plazo de entrega de los pedidos activos de una empresa, opcionalmente de una sucursal
+func (s *SLAService) GetOrdersSLA(ctx context.Context, companyID, branchID string) ([]entities.SLAEvaluation, error) {
+	// 1. Obtener los pedidos activos
+	orders, err := s.repo.GetSLAActiveOrders(ctx, companyID, branchID)
+	if err != nil {
+		logs.Error("Failed to get SLA active orders", map[string]interface{}{
+			"companyID": companyID,
+			"branchID":  branchID,
+			"error":     err.Error(),
+		})
+		return nil, errPackage.NewDomainErrorWithCause("SLAService", "GetOrdersSLA", "failed to get active orders", err)
+	}
+
+	// 2. Evaluar cada pedido
+	now := time.Now()
+	evaluations := make([]entities.SLAEvaluation, len(orders))
+	for i := range orders {
+		evaluations[i] = *s.EvaluateOrder(&orders[i], now)
+	}
+
+	return evaluations, nil
+}
+
+// MonitorActiveOrders reevalúa los pedidos activos, guarda los cambios de estado del SLA y alerta a la empresa
+// y a operaciones solo cuando un pedido pasa a estar en riesgo o incumplido, devuelve la cantidad de alertas enviadas
+func (s *SLAService) MonitorActiveOrders(ctx context.Context) (int, error) {
+	// 1. Obtener los pedidos activos de todas las empresas
+	orders, err := s.repo.GetSLAActiveOrders(ctx, "", "")
+	if err != nil {
+		logs.Error("Failed to get SLA active orders", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return 0, errPackage.NewDomainErrorWithCause("SLAService", "MonitorActiveOrders", "failed to get active orders", err)
+	}
+
+	now := time.Now()
+	alerts := 0
+	for i := range orders {
+		order := &orders[i]
+		evaluation := s.EvaluateOrder(order, now)
+
+		// 2. Ignorar los pedidos cuyo estado de SLA no cambió
+		if order.Detail == nil || order.Detail.SLAStatus == evaluation.Status {
+			continue
+		}
+
+		// 3. Guardar el nuevo estado y marcar el pedido si requiere atención
+		flagReason := ""
+		switch evaluation.Status {
+		case constants.SLAStatusAtRisk:
+			flagReason = constants.OrderFlagSLAAtRisk
+		case constants.SLAStatusBreached:
+			flagReason = constants.OrderFlagSLABreached
+		}
+
+		if err = s.repo.UpdateOrderSLAStatus(ctx, order.ID, evaluation.Status, flagReason, now); err != nil {
+			logs.Error("Failed to update order SLA status", map[string]interface{}{
+				"orderID":   order.ID,
+				"slaStatus": evaluation.Status,
+				"error":     err.Error(),
+			})
+			continue
+		}
+		order.Detail.SLAStatus = evaluation.Status
+
+		// 4. Alertar a la empresa y a operaciones
+		if !evaluation.IsAlert() {
+			continue
+		}
+
+		if err = s.notifier.NotifySLAAlert(ctx, evaluation); err != nil {
+			logs.Warn("Failed to send SLA alert", map[string]interface{}{
+				"orderID":   order.ID,
+				"slaStatus": evaluation.Status,
+				"error":     err.Error(),
+			})
+			continue
+		}
+		alerts++
+	}
+
+	return alerts, nil
+}
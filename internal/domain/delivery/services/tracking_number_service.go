@@ -0,0 +1,85 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/value_objects"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+type TrackingNumberService struct {
+	companyRepo ports.CompanyRepository
+}
+
+func NewTrackingNumberService(companyRepo ports.CompanyRepository) interfaces.TrackingNumberGenerator {
+	return &TrackingNumberService{
+		companyRepo: companyRepo,
+	}
+}
+
+// GenerateForCompany genera un número de seguimiento con el prefijo configurado por la empresa
+func (s *TrackingNumberService) GenerateForCompany(ctx context.Context, companyID string) (string, error) {
+	prefix, err := s.companyRepo.GetTrackingPrefix(ctx, companyID)
+	if err != nil {
+		logs.Error("Failed to get company tracking prefix", map[string]interface{}{
+			"companyID": companyID,
+			"error":     err.Error(),
+		})
+		return "", errPackage.NewDomainErrorWithCause("TrackingNumberService", "GenerateForCompany", "failed to get company tracking prefix", err)
+	}
+
+	if prefix == "" {
+		prefix = constants.TrackingPrefixOrder
+	}
+
+	return s.Generate(prefix)
+}
+
+// Generate genera un número de seguimiento con el prefijo indicado
+func (s *TrackingNumberService) Generate(prefix string) (string, error) {
+	if !value_objects.IsValidTrackingPrefix(prefix) {
+		return "", errPackage.NewDomainErrorWithCause("TrackingNumberService", "Generate", "invalid tracking prefix", errPackage.ErrInvalidTrackingPrefix)
+	}
+
+	trackingNumber, err := value_objects.GenerateTrackingNumber(prefix, time.Now())
+	if err != nil {
+		logs.Error("Failed to generate tracking number", map[string]interface{}{
+			"prefix": prefix,
+			"error":  err.Error(),
+		})
+		return "", errPackage.NewDomainErrorWithCause("TrackingNumberService", "Generate", "failed to generate tracking number", err)
+	}
+
+	return trackingNumber.GetValue(), nil
+}
+
+// saveWithUniqueTrackingNumber asigna un número de seguimiento y repite el guardado con uno nuevo
+// si choca con el índice único, lo que con 50 bits aleatorios por día solo ocurre de forma excepcional
+func saveWithUniqueTrackingNumber(generate func() (string, error), assign func(string), save func() error) error {
+	var err error
+	for attempt := 1; attempt <= constants.MaxTrackingNumberRetries; attempt++ {
+		trackingNumber, genErr := generate()
+		if genErr != nil {
+			return genErr
+		}
+		assign(trackingNumber)
+
+		if err = save(); !errors.Is(err, gorm.ErrDuplicatedKey) {
+			return err
+		}
+
+		logs.Warn("Tracking number collision, retrying with a new one", map[string]interface{}{
+			"trackingNumber": trackingNumber,
+			"attempt":        attempt,
+		})
+	}
+
+	return err
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"gorm.io/gorm"
 
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
 	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
 	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
 	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
@@ -213,8 +214,19 @@ func (s *userService) ActivateOrDeactivateUser(ctx context.Context, userID, logg
 		return error2.NewDomainError("UserService", "ActivateOrDeactivateUser", error2.ErrUserAlreadyActiveOrInactive.Error())
 	}
 
-	// 4. Activar o desactivar el usuario
-	err = s.userRepo.ActivateOrDeactivate(ctx, userID, active)
+	// 4. Activar o desactivar el usuario junto con su evento
+	eventType, severity := constants.SystemEventUserDeactivated, constants.EventSeverityWarning
+	if active {
+		eventType, severity = constants.SystemEventUserActivated, constants.EventSeverityInfo
+	}
+	event := entities.NewSystemEvent(eventType, constants.EventSourceUser, userID, severity, map[string]interface{}{
+		"user_id":    userID,
+		"company_id": user.CompanyID,
+		"email":      user.Email,
+		"changed_by": loggedUser,
+	})
+
+	err = s.userRepo.ActivateOrDeactivate(ctx, userID, active, event)
 	if err != nil {
 		logs.Error("Failed to activate or deactivate user", map[string]interface{}{
 			"error":   err.Error(),
@@ -0,0 +1,144 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+// WebhookEventSubscriber publica a los webhooks de la empresa los eventos de dominio de sus pedidos
+type WebhookEventSubscriber struct {
+	orderRepo   ports.OrdererRepository
+	webhooks    interfaces.WebhookPublisher
+	trackingURL string
+}
+
+func NewWebhookEventSubscriber(orderRepo ports.OrdererRepository, webhooks interfaces.WebhookPublisher, trackingURL string) interfaces.EventSubscriber {
+	if trackingURL == "" {
+		trackingURL = constants.DefaultTrackingURL
+	}
+
+	return &WebhookEventSubscriber{
+		orderRepo:   orderRepo,
+		webhooks:    webhooks,
+		trackingURL: strings.TrimRight(trackingURL, "/"),
+	}
+}
+
+func (s *WebhookEventSubscriber) Name() string {
+	return "webhooks.order_events"
+}
+
+func (s *WebhookEventSubscriber) EventTypes() []string {
+	return []string{
+		constants.SystemEventOrderCreated,
+		constants.SystemEventOrderStatusChanged,
+		constants.SystemEventOrderDriverAssigned,
+	}
+}
+
+// Handle registra las entregas de los webhooks del evento, si falla el evento se reintenta completo y el receptor
+// descarta los duplicados por el identificador del evento, que se deriva del evento de dominio
+func (s *WebhookEventSubscriber) Handle(ctx context.Context, event *entities.SystemEvent) error {
+	// 1. Obtener el pedido con el estado del evento, aunque haya cambiado después
+	order, err := eventOrder(ctx, s.orderRepo, event)
+	if err != nil || order == nil {
+		return err
+	}
+
+	data := event.Data()
+	payload := s.orderWebhookData(order)
+
+	// 2. Determinar los eventos de webhook que genera el evento de dominio
+	webhookEvents := make(map[string]map[string]interface{})
+	switch event.EventType {
+	case constants.SystemEventOrderCreated:
+		webhookEvents[constants.WebhookEventOrderCreated] = payload
+	case constants.SystemEventOrderDriverAssigned:
+		webhookEvents[constants.WebhookEventOrderDriverAssigned] = payload
+	case constants.SystemEventOrderStatusChanged:
+		webhookEvents[constants.WebhookEventOrderStatusChanged] = payload
+		if webhookEvent, ok := constants.WebhookEventsByStatus[order.Status]; ok {
+			webhookEvents[webhookEvent] = payload
+		}
+
+		if returnToSender, ok := data["return_to_sender"].(bool); ok {
+			attemptData := s.orderWebhookData(order)
+			attemptData["attempt_number"] = eventInt(data, "attempt_number")
+			attemptData["reason"] = data["reason"]
+			attemptData["return_to_sender"] = returnToSender
+			if nextAttemptAt, ok := data["next_attempt_at"].(string); ok {
+				attemptData["next_attempt_at"] = nextAttemptAt
+			}
+			webhookEvents[constants.WebhookEventOrderAttemptFailed] = attemptData
+		}
+	}
+
+	// 3. Registrar las entregas de cada evento
+	for webhookEvent, webhookData := range webhookEvents {
+		eventID := uuid.NewSHA1(uuid.NameSpaceURL, []byte(event.ID+"/"+webhookEvent)).String()
+		if err = s.webhooks.Publish(ctx, eventID, order.CompanyID, webhookEvent, webhookData); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// orderWebhookData datos del pedido incluidos en los eventos publicados a los webhooks
+func (s *WebhookEventSubscriber) orderWebhookData(order *entities.Order) map[string]interface{} {
+	data := map[string]interface{}{
+		"order_id":        order.ID,
+		"tracking_number": order.TrackingNumber,
+		"tracking_url":    s.trackingURL + "/" + order.TrackingNumber,
+		"status":          order.Status,
+		"branch_id":       order.BranchID,
+	}
+
+	if order.DriverID != nil {
+		data["driver_id"] = *order.DriverID
+	}
+	if order.Detail != nil {
+		data["delivery_deadline"] = order.Detail.DeliveryDeadline.Format(time.RFC3339)
+	}
+
+	return data
+}
+
+// eventOrder obtiene el pedido del evento con el estado que indica el evento, devuelve nil si el pedido ya no existe
+func eventOrder(ctx context.Context, orderRepo ports.OrdererRepository, event *entities.SystemEvent) (*entities.Order, error) {
+	order, err := orderRepo.GetOrderByID(ctx, event.SourceID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			logs.Warn("Order of the event no longer exists", map[string]interface{}{
+				"eventID": event.ID,
+				"orderID": event.SourceID,
+			})
+			return nil, nil
+		}
+		return nil, errPackage.NewDomainErrorWithCause("OrderEvents", "GetOrder", "failed to get order of the event", err)
+	}
+
+	if status, ok := event.Data()["status"].(string); ok && status != "" {
+		order.Status = status
+	}
+
+	return order, nil
+}
+
+// eventInt obtiene un número entero de los datos del evento, los números se decodifican del JSON como float64
+func eventInt(data map[string]interface{}, key string) int {
+	value, _ := data[key].(float64)
+	return int(value)
+}
@@ -0,0 +1,522 @@
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/value_objects"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+// webhookResponseBodyLimit caracteres de la respuesta del webhook que se guardan en el registro de entregas
+const webhookResponseBodyLimit = 2000
+
+type WebhookService struct {
+	repo         ports.WebhookRepository
+	sender       ports.WebhookSender
+	requireHTTPS bool
+}
+
+// NewWebhookService crea el servicio de webhooks, fuera de desarrollo requireHTTPS obliga a registrar direcciones https
+func NewWebhookService(repo ports.WebhookRepository, sender ports.WebhookSender, requireHTTPS bool) interfaces.WebhookManager {
+	return &WebhookService{
+		repo:         repo,
+		sender:       sender,
+		requireHTTPS: requireHTTPS,
+	}
+}
+
+// CreateEndpoint registra un webhook de la empresa y genera el secreto con el que se firman sus eventos
+func (s *WebhookService) CreateEndpoint(ctx context.Context, endpoint *entities.WebhookEndpoint) error {
+	// 1. Validar la dirección y los eventos
+	if err := normalizeWebhookEndpoint(endpoint, s.requireHTTPS); err != nil {
+		return errPackage.NewDomainErrorWithCause("WebhookService", "CreateEndpoint", "invalid webhook endpoint", err)
+	}
+
+	// 2. Generar el secreto
+	secret, err := generateWebhookSecret()
+	if err != nil {
+		return errPackage.NewDomainErrorWithCause("WebhookService", "CreateEndpoint", "failed to generate webhook secret", err)
+	}
+
+	now := time.Now()
+	endpoint.ID = uuid.NewString()
+	endpoint.Secret = secret
+	endpoint.IsActive = true
+	endpoint.CreatedAt = now
+	endpoint.UpdatedAt = now
+
+	// 3. Guardar el webhook
+	if err = s.repo.CreateEndpoint(ctx, endpoint); err != nil {
+		logs.Error("Failed to create webhook endpoint", map[string]interface{}{
+			"companyID": endpoint.CompanyID,
+			"error":     err.Error(),
+		})
+		return errPackage.NewDomainErrorWithCause("WebhookService", "CreateEndpoint", "failed to create webhook endpoint", err)
+	}
+
+	return nil
+}
+
+func (s *WebhookService) GetEndpoints(ctx context.Context, companyID string) ([]entities.WebhookEndpoint, error) {
+	endpoints, err := s.repo.GetEndpointsByCompany(ctx, companyID)
+	if err != nil {
+		logs.Error("Failed to get webhook endpoints", map[string]interface{}{
+			"companyID": companyID,
+			"error":     err.Error(),
+		})
+		return nil, errPackage.NewDomainErrorWithCause("WebhookService", "GetEndpoints", "failed to get webhook endpoints", err)
+	}
+
+	return endpoints, nil
+}
+
+func (s *WebhookService) GetEndpointByID(ctx context.Context, id string) (*entities.WebhookEndpoint, error) {
+	endpoint, err := s.repo.GetEndpointByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errPackage.NewDomainErrorWithCause("WebhookService", "GetEndpointByID", "webhook endpoint not found", errPackage.ErrWebhookEndpointNotFound)
+		}
+		logs.Error("Failed to get webhook endpoint", map[string]interface{}{
+			"endpointID": id,
+			"error":      err.Error(),
+		})
+		return nil, errPackage.NewDomainErrorWithCause("WebhookService", "GetEndpointByID", "failed to get webhook endpoint", err)
+	}
+
+	return endpoint, nil
+}
+
+// UpdateEndpoint actualiza la dirección, los eventos o el estado del webhook, reactivarlo reinicia sus fallos consecutivos
+func (s *WebhookService) UpdateEndpoint(ctx context.Context, endpoint *entities.WebhookEndpoint) error {
+	// 1. Validar la dirección y los eventos
+	if err := normalizeWebhookEndpoint(endpoint, s.requireHTTPS); err != nil {
+		return errPackage.NewDomainErrorWithCause("WebhookService", "UpdateEndpoint", "invalid webhook endpoint", err)
+	}
+
+	// 2. Reiniciar el conteo de fallos de los webhooks reactivados
+	if endpoint.IsActive && endpoint.DisabledAt != nil {
+		endpoint.ConsecutiveFailures = 0
+		endpoint.DisabledAt = nil
+		endpoint.DisabledReason = ""
+	}
+	endpoint.UpdatedAt = time.Now()
+
+	// 3. Guardar los cambios
+	if err := s.repo.UpdateEndpoint(ctx, endpoint); err != nil {
+		logs.Error("Failed to update webhook endpoint", map[string]interface{}{
+			"endpointID": endpoint.ID,
+			"error":      err.Error(),
+		})
+		return errPackage.NewDomainErrorWithCause("WebhookService", "UpdateEndpoint", "failed to update webhook endpoint", err)
+	}
+
+	return nil
+}
+
+// DeleteEndpoint elimina el webhook, sus entregas se conservan en el registro
+func (s *WebhookService) DeleteEndpoint(ctx context.Context, id string) error {
+	if _, err := s.GetEndpointByID(ctx, id); err != nil {
+		return err
+	}
+
+	if err := s.repo.DeleteEndpoint(ctx, id, time.Now()); err != nil {
+		logs.Error("Failed to delete webhook endpoint", map[string]interface{}{
+			"endpointID": id,
+			"error":      err.Error(),
+		})
+		return errPackage.NewDomainErrorWithCause("WebhookService", "DeleteEndpoint", "failed to delete webhook endpoint", err)
+	}
+
+	return nil
+}
+
+// RotateSecret reemplaza el secreto del webhook, los eventos siguientes se firman con el nuevo secreto
+func (s *WebhookService) RotateSecret(ctx context.Context, id string) (*entities.WebhookEndpoint, error) {
+	endpoint, err := s.GetEndpointByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := generateWebhookSecret()
+	if err != nil {
+		return nil, errPackage.NewDomainErrorWithCause("WebhookService", "RotateSecret", "failed to generate webhook secret", err)
+	}
+
+	endpoint.Secret = secret
+	endpoint.UpdatedAt = time.Now()
+	if err = s.repo.UpdateEndpoint(ctx, endpoint); err != nil {
+		logs.Error("Failed to rotate webhook secret", map[string]interface{}{
+			"endpointID": id,
+			"error":      err.Error(),
+		})
+		return nil, errPackage.NewDomainErrorWithCause("WebhookService", "RotateSecret", "failed to rotate webhook secret", err)
+	}
+
+	return endpoint, nil
+}
+
+// Publish registra la entrega del evento a cada webhook activo de la empresa suscrito a él, el envío lo realiza
+// ProcessDueDeliveries. El eventID identifica el evento en el cuerpo enviado, un reintento con el mismo eventID
+// permite al receptor descartar el duplicado
+func (s *WebhookService) Publish(ctx context.Context, eventID, companyID, event string, data map[string]interface{}) error {
+	if companyID == "" {
+		return nil
+	}
+
+	// 1. Obtener los webhooks suscritos al evento
+	endpoints, err := s.repo.GetActiveEndpointsByCompany(ctx, companyID)
+	if err != nil {
+		logs.Warn("Failed to get webhook endpoints to publish event", map[string]interface{}{
+			"companyID": companyID,
+			"event":     event,
+			"error":     err.Error(),
+		})
+		return errPackage.NewDomainErrorWithCause("WebhookService", "Publish", "failed to get webhook endpoints", err)
+	}
+
+	subscribed := make([]entities.WebhookEndpoint, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		if endpoint.Subscribes(event) {
+			subscribed = append(subscribed, endpoint)
+		}
+	}
+	if len(subscribed) == 0 {
+		return nil
+	}
+
+	// 2. Generar el cuerpo del evento, es el mismo para todos los webhooks
+	now := time.Now()
+	payload, err := json.Marshal(&entities.WebhookEvent{
+		ID:        eventID,
+		Type:      event,
+		CompanyID: companyID,
+		CreatedAt: now,
+		Data:      data,
+	})
+	if err != nil {
+		return errPackage.NewDomainErrorWithCause("WebhookService", "Publish", "failed to encode webhook event", err)
+	}
+
+	// 3. Registrar una entrega pendiente por cada webhook
+	deliveries := make([]entities.WebhookDelivery, len(subscribed))
+	for i, endpoint := range subscribed {
+		deliveries[i] = entities.WebhookDelivery{
+			ID:            uuid.NewString(),
+			EndpointID:    endpoint.ID,
+			EventID:       eventID,
+			Event:         event,
+			Payload:       string(payload),
+			Status:        constants.WebhookDeliveryStatusPending,
+			NextAttemptAt: &now,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		}
+	}
+
+	if err = s.repo.CreateDeliveries(ctx, deliveries); err != nil {
+		logs.Warn("Failed to create webhook deliveries", map[string]interface{}{
+			"companyID": companyID,
+			"event":     event,
+			"error":     err.Error(),
+		})
+		return errPackage.NewDomainErrorWithCause("WebhookService", "Publish", "failed to create webhook deliveries", err)
+	}
+
+	return nil
+}
+
+func (s *WebhookService) GetDeliveries(ctx context.Context, endpointID string, params *entities.PaginationQueryParams) ([]entities.WebhookDelivery, int64, error) {
+	deliveries, total, err := s.repo.GetDeliveriesByEndpoint(ctx, endpointID, params)
+	if err != nil {
+		logs.Error("Failed to get webhook deliveries", map[string]interface{}{
+			"endpointID": endpointID,
+			"error":      err.Error(),
+		})
+		return nil, 0, errPackage.NewDomainErrorWithCause("WebhookService", "GetDeliveries", "failed to get webhook deliveries", err)
+	}
+
+	return deliveries, total, nil
+}
+
+func (s *WebhookService) GetDeliveryByID(ctx context.Context, id string) (*entities.WebhookDelivery, error) {
+	delivery, err := s.repo.GetDeliveryByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errPackage.NewDomainErrorWithCause("WebhookService", "GetDeliveryByID", "webhook delivery not found", errPackage.ErrWebhookDeliveryNotFound)
+		}
+		logs.Error("Failed to get webhook delivery", map[string]interface{}{
+			"deliveryID": id,
+			"error":      err.Error(),
+		})
+		return nil, errPackage.NewDomainErrorWithCause("WebhookService", "GetDeliveryByID", "failed to get webhook delivery", err)
+	}
+
+	return delivery, nil
+}
+
+// Redeliver vuelve a enviar el evento de una entrega como una nueva entrega, con el mismo identificador de evento
+// para que el receptor pueda descartar duplicados
+func (s *WebhookService) Redeliver(ctx context.Context, deliveryID string) (*entities.WebhookDelivery, error) {
+	// 1. Obtener la entrega original y validar que su webhook siga activo
+	original, err := s.GetDeliveryByID(ctx, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+
+	if original.Endpoint == nil || original.Endpoint.DeletedAt != nil || !original.Endpoint.IsActive {
+		return nil, errPackage.NewDomainErrorWithCause("WebhookService", "Redeliver", "webhook endpoint is disabled", errPackage.ErrWebhookEndpointDisabled)
+	}
+
+	// 2. Registrar la nueva entrega reservada por este proceso
+	now := time.Now()
+	leaseUntil := now.Add(constants.WebhookDeliveryLease)
+	delivery := &entities.WebhookDelivery{
+		ID:            uuid.NewString(),
+		EndpointID:    original.EndpointID,
+		EventID:       original.EventID,
+		Event:         original.Event,
+		Payload:       original.Payload,
+		Status:        constants.WebhookDeliveryStatusPending,
+		NextAttemptAt: &leaseUntil,
+		RedeliveryOf:  &original.ID,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	if err = s.repo.CreateDeliveries(ctx, []entities.WebhookDelivery{*delivery}); err != nil {
+		logs.Error("Failed to create webhook redelivery", map[string]interface{}{
+			"deliveryID": deliveryID,
+			"error":      err.Error(),
+		})
+		return nil, errPackage.NewDomainErrorWithCause("WebhookService", "Redeliver", "failed to create webhook redelivery", err)
+	}
+
+	// 3. Enviar el evento, si falla se reintenta como cualquier otra entrega
+	delivery.Endpoint = original.Endpoint
+	if err = s.attempt(ctx, delivery); err != nil {
+		return nil, errPackage.NewDomainErrorWithCause("WebhookService", "Redeliver", "failed to save webhook delivery attempt", err)
+	}
+
+	return delivery, nil
+}
+
+// ProcessDueDeliveries envía las entregas pendientes cuyo intento ya corresponde, devuelve la cantidad de intentos realizados
+func (s *WebhookService) ProcessDueDeliveries(ctx context.Context) (int, error) {
+	now := time.Now()
+
+	// 1. Obtener las entregas pendientes
+	deliveries, err := s.repo.GetDueDeliveries(ctx, now, constants.WebhookDeliveryBatchSize)
+	if err != nil {
+		logs.Error("Failed to get due webhook deliveries", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return 0, errPackage.NewDomainErrorWithCause("WebhookService", "ProcessDueDeliveries", "failed to get due webhook deliveries", err)
+	}
+
+	processed := 0
+	for i := range deliveries {
+		if ctx.Err() != nil {
+			break
+		}
+
+		// 2. Reservar la entrega para que otro proceso no la envíe al mismo tiempo
+		claimed, err := s.repo.ClaimDelivery(ctx, deliveries[i].ID, now, now.Add(constants.WebhookDeliveryLease))
+		if err != nil {
+			logs.Warn("Failed to claim webhook delivery", map[string]interface{}{
+				"deliveryID": deliveries[i].ID,
+				"error":      err.Error(),
+			})
+			continue
+		}
+		if !claimed {
+			continue
+		}
+
+		// 3. Enviar el evento
+		if err = s.attempt(ctx, &deliveries[i]); err != nil {
+			logs.Error("Failed to save webhook delivery attempt", map[string]interface{}{
+				"deliveryID": deliveries[i].ID,
+				"error":      err.Error(),
+			})
+			continue
+		}
+		processed++
+	}
+
+	return processed, nil
+}
+
+// attempt envía el evento firmado al webhook y registra el resultado, los fallos se reintentan con espera exponencial
+// hasta agotar los intentos y el webhook se desactiva tras demasiados fallos consecutivos
+func (s *WebhookService) attempt(ctx context.Context, delivery *entities.WebhookDelivery) error {
+	endpoint := delivery.Endpoint
+	now := time.Now()
+
+	// 1. Las entregas de webhooks eliminados o desactivados no se envían
+	if endpoint == nil || endpoint.DeletedAt != nil || !endpoint.IsActive {
+		delivery.Status = constants.WebhookDeliveryStatusFailed
+		delivery.ErrorMessage = errPackage.ErrWebhookEndpointDisabled.Error()
+		delivery.NextAttemptAt = nil
+		delivery.UpdatedAt = now
+		_, err := s.repo.SaveDeliveryAttempt(ctx, delivery, false, constants.WebhookDisableAfterFailures)
+		return err
+	}
+
+	// 2. Firmar y enviar el evento
+	timestamp := now.Unix()
+	response, sendErr := s.sender.Send(ctx, &entities.WebhookRequest{
+		URL: endpoint.URL,
+		Headers: map[string]string{
+			"Content-Type":                   "application/json",
+			constants.WebhookEventHeader:     delivery.Event,
+			constants.WebhookDeliveryHeader:  delivery.ID,
+			constants.WebhookSignatureHeader: fmt.Sprintf("t=%d,v1=%s", timestamp, signWebhookPayload(endpoint.Secret, timestamp, delivery.Payload)),
+		},
+		Body: []byte(delivery.Payload),
+	})
+
+	delivery.Attempts++
+	delivery.LastAttemptAt = &now
+	delivery.UpdatedAt = now
+	delivery.ResponseCode = nil
+	delivery.ResponseBody = ""
+	if response != nil {
+		code := response.StatusCode
+		delivery.ResponseCode = &code
+		delivery.ResponseBody = truncateText(response.Body, webhookResponseBodyLimit)
+		delivery.DurationMs = response.Duration.Milliseconds()
+	}
+
+	// 3. Registrar el resultado
+	if sendErr == nil && response != nil && response.StatusCode >= 200 && response.StatusCode < 300 {
+		delivery.Status = constants.WebhookDeliveryStatusSucceeded
+		delivery.ErrorMessage = ""
+		delivery.DeliveredAt = &now
+		delivery.NextAttemptAt = nil
+		_, err := s.repo.SaveDeliveryAttempt(ctx, delivery, true, constants.WebhookDisableAfterFailures)
+		return err
+	}
+
+	if sendErr != nil {
+		delivery.ErrorMessage = truncateText(sendErr.Error(), 255)
+	} else {
+		delivery.ErrorMessage = errPackage.ErrWebhookRequestFailed.Error()
+	}
+
+	// 4. Reintentar con espera exponencial mientras queden intentos
+	if delivery.Attempts >= constants.WebhookMaxAttempts {
+		delivery.Status = constants.WebhookDeliveryStatusFailed
+		delivery.NextAttemptAt = nil
+	} else {
+		nextAttempt := now.Add(webhookRetryDelay(delivery.Attempts))
+		delivery.Status = constants.WebhookDeliveryStatusPending
+		delivery.NextAttemptAt = &nextAttempt
+	}
+
+	// 5. Registrar el fallo, el webhook se desactiva tras demasiados fallos consecutivos
+	disabled, err := s.repo.SaveDeliveryAttempt(ctx, delivery, true, constants.WebhookDisableAfterFailures)
+	if err != nil {
+		return err
+	}
+
+	if disabled {
+		logs.Warn("Webhook endpoint disabled after repeated failures", map[string]interface{}{
+			"endpointID": endpoint.ID,
+			"companyID":  endpoint.CompanyID,
+			"failures":   constants.WebhookDisableAfterFailures,
+		})
+	}
+
+	return nil
+}
+
+// normalizeWebhookEndpoint valida que la dirección del webhook sea pública, y https si se requiere,
+// y deja sus eventos sin repetir y en minúsculas
+func normalizeWebhookEndpoint(endpoint *entities.WebhookEndpoint, requireHTTPS bool) error {
+	endpoint.URL = strings.TrimSpace(endpoint.URL)
+	parsed, err := url.Parse(endpoint.URL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return errPackage.ErrInvalidWebhookURL
+	}
+
+	webhookURL := value_objects.NewWebhookURL(endpoint.URL)
+	if !webhookURL.IsValid() {
+		return errPackage.ErrWebhookURLNotPublic
+	}
+
+	if requireHTTPS && !webhookURL.IsSecure() {
+		return errPackage.ErrWebhookURLNotSecure
+	}
+
+	known := make(map[string]bool, len(constants.WebhookEvents))
+	for _, event := range constants.WebhookEvents {
+		known[event] = true
+	}
+
+	seen := make(map[string]bool)
+	events := make([]string, 0)
+	for _, event := range endpoint.EventList() {
+		event = strings.ToLower(strings.TrimSpace(event))
+		if !known[event] {
+			return errPackage.ErrInvalidWebhookEvents
+		}
+		if !seen[event] {
+			seen[event] = true
+			events = append(events, event)
+		}
+	}
+
+	if len(events) == 0 {
+		return errPackage.ErrInvalidWebhookEvents
+	}
+	endpoint.Events = strings.Join(events, ",")
+
+	return nil
+}
+
+// signWebhookPayload firma con HMAC-SHA256 la marca de tiempo junto con el cuerpo, el receptor la verifica con su secreto
+func signWebhookPayload(secret string, timestamp int64, payload string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(fmt.Sprintf("%d.%s", timestamp, payload)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func generateWebhookSecret() (string, error) {
+	secret := make([]byte, 24)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+
+	return constants.WebhookSecretPrefix + hex.EncodeToString(secret), nil
+}
+
+// webhookRetryDelay espera antes del siguiente intento, se duplica con cada intento fallido
+func webhookRetryDelay(attempts int) time.Duration {
+	return constants.WebhookRetryBaseDelay * time.Duration(1<<uint(attempts-1))
+}
+
+func truncateText(text string, limit int) string {
+	if len(text) <= limit {
+		return text
+	}
+
+	return text[:limit]
+}
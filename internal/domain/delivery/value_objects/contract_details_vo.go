@@ -2,9 +2,16 @@ package value_objects
 
 import (
 	"encoding/json"
+	"regexp"
+	"strconv"
 	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
 )
 
+// paymentTermDaysRegex extrae los días de crédito de condiciones como "Net 30"
+var paymentTermDaysRegex = regexp.MustCompile(`\d+`)
+
 // ContractDetails representa los detalles del contrato de una empresa
 type ContractDetails struct {
 	ContractType   string    `json:"contract_type"`
@@ -44,6 +51,21 @@ func (cd *ContractDetails) IsValid() bool {
 		cd.NoticePeriod >= 0
 }
 
+// PaymentTermDays obtiene los días de crédito de las condiciones de pago, por defecto los del sistema
+func (cd *ContractDetails) PaymentTermDays() int {
+	match := paymentTermDaysRegex.FindString(cd.PaymentTerms)
+	if match == "" {
+		return constants.DefaultPaymentTermDays
+	}
+
+	days, err := strconv.Atoi(match)
+	if err != nil || days <= 0 {
+		return constants.DefaultPaymentTermDays
+	}
+
+	return days
+}
+
 // ToJSON convierte los detalles del contrato a un string JSON
 func (cd *ContractDetails) ToJSON() (string, error) {
 	data, err := json.Marshal(cd)
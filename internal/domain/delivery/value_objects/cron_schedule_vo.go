@@ -0,0 +1,171 @@
+package value_objects
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CronSchedule representa una programación con el formato de cron de cinco campos:
+// minuto, hora, día del mes, mes y día de la semana (0 = domingo), p. ej. "*/15 * * * *" o "0 3 * * 1-5"
+type CronSchedule struct {
+	expression string
+	minutes    map[int]bool
+	hours      map[int]bool
+	days       map[int]bool
+	months     map[int]bool
+	weekdays   map[int]bool
+	anyDay     bool
+	anyWeekday bool
+}
+
+// NewCronSchedule crea la programación a partir de la expresión, cada campo acepta *, valores, rangos a-b,
+// listas separadas por coma y pasos con /n
+func NewCronSchedule(expression string) (*CronSchedule, error) {
+	fields := strings.Fields(expression)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("cron expression must have 5 fields: %s", expression)
+	}
+
+	schedule := &CronSchedule{
+		expression: strings.Join(fields, " "),
+		anyDay:     fields[2] == "*",
+		anyWeekday: fields[4] == "*",
+	}
+
+	var err error
+	if schedule.minutes, err = parseCronField(fields[0], 0, 59); err != nil {
+		return nil, err
+	}
+	if schedule.hours, err = parseCronField(fields[1], 0, 23); err != nil {
+		return nil, err
+	}
+	if schedule.days, err = parseCronField(fields[2], 1, 31); err != nil {
+		return nil, err
+	}
+	if schedule.months, err = parseCronField(fields[3], 1, 12); err != nil {
+		return nil, err
+	}
+	if schedule.weekdays, err = parseCronField(fields[4], 0, 7); err != nil {
+		return nil, err
+	}
+
+	// El 7 también representa el domingo
+	if schedule.weekdays[7] {
+		schedule.weekdays[0] = true
+	}
+
+	return schedule, nil
+}
+
+func (c *CronSchedule) IsValid() bool {
+	return len(c.minutes) > 0 && len(c.hours) > 0 && len(c.days) > 0 && len(c.months) > 0 && len(c.weekdays) > 0
+}
+
+func (c *CronSchedule) ToString() string {
+	return c.expression
+}
+
+func (c *CronSchedule) Equals(value ValidaterObject[string]) bool {
+	return c.ToString() == value.GetValue()
+}
+
+func (c *CronSchedule) GetValue() string {
+	return c.ToString()
+}
+
+// Next obtiene la siguiente ocurrencia estrictamente posterior a t, busca como máximo cinco años hacia adelante
+func (c *CronSchedule) Next(t time.Time) (time.Time, bool) {
+	next := t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+
+	for next.Before(limit) {
+		if !c.months[int(next.Month())] {
+			next = time.Date(next.Year(), next.Month()+1, 1, 0, 0, 0, 0, next.Location())
+			continue
+		}
+		if !c.matchesDay(next) {
+			next = time.Date(next.Year(), next.Month(), next.Day()+1, 0, 0, 0, 0, next.Location())
+			continue
+		}
+		if !c.hours[next.Hour()] {
+			next = time.Date(next.Year(), next.Month(), next.Day(), next.Hour()+1, 0, 0, 0, next.Location())
+			continue
+		}
+		if !c.minutes[next.Minute()] {
+			next = next.Add(time.Minute)
+			continue
+		}
+
+		return next, true
+	}
+
+	return time.Time{}, false
+}
+
+// matchesDay aplica la regla de cron: si se restringen el día del mes y el de la semana basta con que coincida uno
+func (c *CronSchedule) matchesDay(t time.Time) bool {
+	dayMatches := c.days[t.Day()]
+	weekdayMatches := c.weekdays[int(t.Weekday())]
+
+	switch {
+	case c.anyDay && c.anyWeekday:
+		return true
+	case c.anyDay:
+		return weekdayMatches
+	case c.anyWeekday:
+		return dayMatches
+	default:
+		return dayMatches || weekdayMatches
+	}
+}
+
+func parseCronField(field string, min, max int) (map[int]bool, error) {
+	values := make(map[int]bool)
+
+	for _, part := range strings.Split(field, ",") {
+		rangePart, step := part, 1
+		if index := strings.Index(part, "/"); index >= 0 {
+			var err error
+			rangePart = part[:index]
+			step, err = strconv.Atoi(part[index+1:])
+			if err != nil || step <= 0 {
+				return nil, fmt.Errorf("invalid cron step: %s", part)
+			}
+		}
+
+		start, end := min, max
+		switch {
+		case rangePart == "*":
+		case strings.Contains(rangePart, "-"):
+			bounds := strings.SplitN(rangePart, "-", 2)
+			var errStart, errEnd error
+			start, errStart = strconv.Atoi(bounds[0])
+			end, errEnd = strconv.Atoi(bounds[1])
+			if errStart != nil || errEnd != nil || start > end {
+				return nil, fmt.Errorf("invalid cron range: %s", part)
+			}
+		default:
+			value, err := strconv.Atoi(rangePart)
+			if err != nil {
+				return nil, fmt.Errorf("invalid cron value: %s", part)
+			}
+			start = value
+			// Un valor con paso, p. ej. 5/15, se repite hasta el máximo del campo
+			if !strings.Contains(part, "/") {
+				end = value
+			}
+		}
+
+		if start < min || end > max {
+			return nil, fmt.Errorf("cron value out of range %d-%d: %s", min, max, part)
+		}
+
+		for value := start; value <= end; value += step {
+			values[value] = true
+		}
+	}
+
+	return values, nil
+}
@@ -0,0 +1,51 @@
+package value_objects
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+)
+
+const deliveryPINLength = 6
+
+type DeliveryPIN struct {
+	value string
+}
+
+func NewDeliveryPIN(value string) *DeliveryPIN {
+	return &DeliveryPIN{value: strings.TrimSpace(value)}
+}
+
+// GenerateDeliveryPIN genera un PIN numérico aleatorio usando una fuente criptográficamente segura
+func GenerateDeliveryPIN() (*DeliveryPIN, error) {
+	max := big.NewInt(1)
+	for i := 0; i < deliveryPINLength; i++ {
+		max.Mul(max, big.NewInt(10))
+	}
+
+	n, err := rand.Int(rand.Reader, max)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DeliveryPIN{value: fmt.Sprintf("%0*d", deliveryPINLength, n.Int64())}, nil
+}
+
+func (p *DeliveryPIN) IsValid() bool {
+	regex := regexp.MustCompile(fmt.Sprintf(`^[0-9]{%d}$`, deliveryPINLength))
+	return regex.MatchString(p.value)
+}
+
+func (p *DeliveryPIN) ToString() string {
+	return p.value
+}
+
+func (p *DeliveryPIN) Equals(value ValidaterObject[string]) bool {
+	return p.value == value.GetValue()
+}
+
+func (p *DeliveryPIN) GetValue() string {
+	return p.value
+}
@@ -0,0 +1,56 @@
+package value_objects
+
+import (
+	"strings"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+)
+
+type InvoiceStatus struct {
+	value string
+}
+
+func NewInvoiceStatus(value string) *InvoiceStatus {
+	return &InvoiceStatus{value: strings.ToUpper(value)}
+}
+
+func (s *InvoiceStatus) IsValid() bool {
+	for _, status := range constants.ValidInvoiceStatuses {
+		if s.value == status {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *InvoiceStatus) ToString() string {
+	return s.value
+}
+
+func (s *InvoiceStatus) Equals(value ValidaterObject[string]) bool {
+	return s.value == value.GetValue()
+}
+
+func (s *InvoiceStatus) GetValue() string {
+	return s.value
+}
+
+func (s *InvoiceStatus) IsDraft() bool {
+	return s.value == constants.InvoiceStatusDraft
+}
+
+func (s *InvoiceStatus) CanTransitionTo(nextStatus *InvoiceStatus) bool {
+	validTransitions := map[string][]string{
+		constants.InvoiceStatusDraft:  {constants.InvoiceStatusIssued, constants.InvoiceStatusVoid},
+		constants.InvoiceStatusIssued: {constants.InvoiceStatusPaid, constants.InvoiceStatusVoid},
+		constants.InvoiceStatusPaid:   {},
+		constants.InvoiceStatusVoid:   {},
+	}
+
+	for _, validNext := range validTransitions[s.value] {
+		if validNext == nextStatus.value {
+			return true
+		}
+	}
+	return false
+}
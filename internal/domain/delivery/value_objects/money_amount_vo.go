@@ -3,8 +3,12 @@ package value_objects
 import (
 	"fmt"
 	"math"
+	"regexp"
 )
 
+// currencyRegex código de moneda ISO 4217
+var currencyRegex = regexp.MustCompile(`^[A-Z]{3}$`)
+
 type MoneyAmount struct {
 	value    float64
 	currency string
@@ -23,6 +27,15 @@ func (m *MoneyAmount) IsValid() bool {
 	return !math.IsNaN(m.value) && !math.IsInf(m.value, 0)
 }
 
+// HasValidCurrency indica si la moneda es un código ISO 4217 de tres letras
+func (m *MoneyAmount) HasValidCurrency() bool {
+	return currencyRegex.MatchString(m.currency)
+}
+
+func (m *MoneyAmount) IsPositive() bool {
+	return m.IsValid() && m.value > 0
+}
+
 func (m *MoneyAmount) ToString() string {
 	return fmt.Sprintf("%.2f %s", m.value, m.currency)
 }
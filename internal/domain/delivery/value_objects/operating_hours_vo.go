@@ -94,6 +94,31 @@ func (oh *OperatingHours) IsOpen(t time.Time) bool {
 	}
 }
 
+// NextOpening obtiene el inicio de la siguiente ventana de operación posterior a t
+func (oh *OperatingHours) NextOpening(t time.Time) (time.Time, bool) {
+	// Se revisa una semana completa para cubrir horarios de fin de semana
+	for i := 0; i <= 7; i++ {
+		day := t.AddDate(0, 0, i)
+
+		hours := oh.Weekdays
+		if day.Weekday() == time.Saturday || day.Weekday() == time.Sunday {
+			hours = oh.Weekends
+		}
+
+		start, err := time.Parse("15:04", hours.Start)
+		if err != nil {
+			continue
+		}
+
+		opening := time.Date(day.Year(), day.Month(), day.Day(), start.Hour(), start.Minute(), 0, 0, t.Location())
+		if opening.After(t) {
+			return opening, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
 // Helper para verificar el formato de la hora (HH:MM)
 func isValidTimeFormat(timeStr string) bool {
 	_, err := time.Parse("15:04", timeStr)
@@ -64,26 +64,38 @@ func (s *OrderStatus) IsCompleted() bool {
 	return s.value == constants.OrderStatusCompleted
 }
 
+func (s *OrderStatus) IsFailed() bool {
+	return s.value == constants.OrderStatusFailed
+}
+
 func (s *OrderStatus) IsLost() bool {
 	return s.value == constants.OrderStatusLost
 }
 
-func (s *OrderStatus) CanTransitionTo(nextStatus *OrderStatus) bool {
-	validTransitions := map[string][]string{
-		constants.OrderStatusPending:     {constants.OrderStatusAccepted, constants.OrderStatusCancelled},
-		constants.OrderStatusAccepted:    {constants.OrderStatusPickedUp, constants.OrderStatusCancelled},
-		constants.OrderStatusPickedUp:    {constants.OrderStatusInTransit, constants.OrderStatusInWarehouse, constants.OrderStatusCancelled},
-		constants.OrderStatusInWarehouse: {constants.OrderStatusInTransit, constants.OrderStatusCancelled},
-		constants.OrderStatusInTransit:   {constants.OrderStatusDelivered, constants.OrderStatusReturned, constants.OrderStatusCancelled},
-		constants.OrderStatusDelivered:   {constants.OrderStatusReturned},
-		constants.OrderStatusReturned:    {},
-		constants.OrderStatusCancelled:   {},
-	}
+// orderStatusTransitions estados a los que puede pasar un pedido desde cada estado
+var orderStatusTransitions = map[string][]string{
+	constants.OrderStatusPending:     {constants.OrderStatusAccepted, constants.OrderStatusCancelled},
+	constants.OrderStatusAccepted:    {constants.OrderStatusPickedUp, constants.OrderStatusCancelled},
+	constants.OrderStatusPickedUp:    {constants.OrderStatusInTransit, constants.OrderStatusInWarehouse, constants.OrderStatusCancelled, constants.OrderStatusLost},
+	constants.OrderStatusInWarehouse: {constants.OrderStatusInTransit, constants.OrderStatusCancelled, constants.OrderStatusLost},
+	constants.OrderStatusInTransit:   {constants.OrderStatusDelivered, constants.OrderStatusFailed, constants.OrderStatusReturned, constants.OrderStatusCancelled, constants.OrderStatusLost},
+	constants.OrderStatusFailed:      {constants.OrderStatusInTransit, constants.OrderStatusReturned, constants.OrderStatusCancelled, constants.OrderStatusLost},
+	constants.OrderStatusDelivered:   {constants.OrderStatusReturned},
+	constants.OrderStatusReturned:    {},
+	constants.OrderStatusCancelled:   {},
+	constants.OrderStatusLost:        {},
+}
 
-	for _, validNext := range validTransitions[s.value] {
+func (s *OrderStatus) CanTransitionTo(nextStatus *OrderStatus) bool {
+	for _, validNext := range orderStatusTransitions[s.value] {
 		if validNext == nextStatus.value {
 			return true
 		}
 	}
 	return false
 }
+
+// NextStatuses devuelve los estados a los que puede pasar el pedido desde el estado actual
+func (s *OrderStatus) NextStatuses() []string {
+	return append([]string(nil), orderStatusTransitions[s.value]...)
+}
@@ -0,0 +1,50 @@
+package value_objects
+
+import (
+	"strings"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+)
+
+type ParcelStatus struct {
+	value string
+}
+
+func NewParcelStatus(value string) *ParcelStatus {
+	return &ParcelStatus{value: strings.ToUpper(value)}
+}
+
+// parcelStatusTransitions estados a los que puede pasar un bulto al ser escaneado, la entrega
+// se registra siempre a nivel de pedido
+var parcelStatusTransitions = map[string][]string{
+	constants.OrderStatusPending:     {constants.OrderStatusPickedUp},
+	constants.OrderStatusPickedUp:    {constants.OrderStatusInWarehouse, constants.OrderStatusInTransit},
+	constants.OrderStatusInWarehouse: {constants.OrderStatusInTransit},
+}
+
+func (s *ParcelStatus) GetValue() string {
+	return s.value
+}
+
+func (s *ParcelStatus) CanTransitionTo(nextStatus *ParcelStatus) bool {
+	for _, validNext := range parcelStatusTransitions[s.value] {
+		if validNext == nextStatus.value {
+			return true
+		}
+	}
+	return false
+}
+
+// NextStatuses devuelve los estados a los que puede pasar el bulto desde el estado actual
+func (s *ParcelStatus) NextStatuses() []string {
+	return append([]string(nil), parcelStatusTransitions[s.value]...)
+}
+
+// Progress devuelve el avance del estado dentro del recorrido del bulto, -1 si no forma parte de él
+func (s *ParcelStatus) Progress() int {
+	progress, ok := constants.ParcelStatusProgress[s.value]
+	if !ok {
+		return -1
+	}
+	return progress
+}
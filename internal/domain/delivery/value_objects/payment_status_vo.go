@@ -0,0 +1,62 @@
+package value_objects
+
+import (
+	"strings"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+)
+
+type PaymentStatus struct {
+	value string
+}
+
+func NewPaymentStatus(value string) *PaymentStatus {
+	return &PaymentStatus{value: strings.ToUpper(value)}
+}
+
+func (s *PaymentStatus) ToString() string {
+	return s.value
+}
+
+func (s *PaymentStatus) Equals(value ValidaterObject[string]) bool {
+	return s.value == value.GetValue()
+}
+
+func (s *PaymentStatus) GetValue() string {
+	return s.value
+}
+
+// IsRefundable indica si el pago retuvo o cobró dinero al cliente que deba devolverse
+func (s *PaymentStatus) IsRefundable() bool {
+	return s.value == constants.PaymentStatusAuthorized || s.value == constants.PaymentStatusCaptured ||
+		s.value == constants.PaymentStatusCapturePending || s.value == constants.PaymentStatusRefundPending
+}
+
+// IsCapturable indica si el pago tiene un monto autorizado que aún no se cobró
+func (s *PaymentStatus) IsCapturable() bool {
+	return s.value == constants.PaymentStatusAuthorized || s.value == constants.PaymentStatusCapturePending
+}
+
+// IsRefunded indica si el pago ya se devolvió o su devolución está pendiente de confirmarse
+func (s *PaymentStatus) IsRefunded() bool {
+	return s.value == constants.PaymentStatusRefunded || s.value == constants.PaymentStatusRefundPending
+}
+
+func (s *PaymentStatus) CanTransitionTo(nextStatus *PaymentStatus) bool {
+	validTransitions := map[string][]string{
+		constants.PaymentStatusPending:        {constants.PaymentStatusAuthorized, constants.PaymentStatusCaptured, constants.PaymentStatusFailed},
+		constants.PaymentStatusAuthorized:     {constants.PaymentStatusCaptured, constants.PaymentStatusFailed, constants.PaymentStatusRefunded, constants.PaymentStatusCapturePending, constants.PaymentStatusRefundPending},
+		constants.PaymentStatusCapturePending: {constants.PaymentStatusCaptured, constants.PaymentStatusFailed, constants.PaymentStatusRefunded, constants.PaymentStatusRefundPending},
+		constants.PaymentStatusCaptured:       {constants.PaymentStatusRefunded, constants.PaymentStatusRefundPending},
+		constants.PaymentStatusRefundPending:  {constants.PaymentStatusRefunded},
+		constants.PaymentStatusFailed:         {},
+		constants.PaymentStatusRefunded:       {},
+	}
+
+	for _, validNext := range validTransitions[s.value] {
+		if validNext == nextStatus.value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,99 @@
+package value_objects
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Recurrence representa una recurrencia semanal a una hora fija, p. ej. días hábiles a las 17:00
+type Recurrence struct {
+	days      []time.Weekday
+	timeOfDay string
+}
+
+// NewRecurrence crea una recurrencia a partir de los días de la semana (0 = domingo) separados por coma y la hora HH:MM
+func NewRecurrence(daysOfWeek, timeOfDay string) (*Recurrence, error) {
+	recurrence := &Recurrence{timeOfDay: timeOfDay}
+	seen := make(map[time.Weekday]bool)
+
+	for _, part := range strings.Split(daysOfWeek, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		day, err := strconv.Atoi(part)
+		if err != nil || day < 0 || day > 6 {
+			return nil, fmt.Errorf("invalid day of week: %s", part)
+		}
+
+		if !seen[time.Weekday(day)] {
+			seen[time.Weekday(day)] = true
+			recurrence.days = append(recurrence.days, time.Weekday(day))
+		}
+	}
+
+	sort.Slice(recurrence.days, func(i, j int) bool { return recurrence.days[i] < recurrence.days[j] })
+
+	return recurrence, nil
+}
+
+func (r *Recurrence) IsValid() bool {
+	return len(r.days) > 0 && isValidTimeFormat(r.timeOfDay)
+}
+
+func (r *Recurrence) ToString() string {
+	days := make([]string, len(r.days))
+	for i, day := range r.days {
+		days[i] = strconv.Itoa(int(day))
+	}
+
+	return strings.Join(days, ",")
+}
+
+func (r *Recurrence) Equals(value ValidaterObject[string]) bool {
+	return r.ToString() == value.GetValue()
+}
+
+func (r *Recurrence) GetValue() string {
+	return r.ToString()
+}
+
+// Days retorna los días de la semana en los que se repite
+func (r *Recurrence) Days() []time.Weekday {
+	return r.days
+}
+
+// Next obtiene la siguiente ocurrencia estrictamente posterior a t
+func (r *Recurrence) Next(t time.Time) (time.Time, bool) {
+	clock, err := time.Parse("15:04", r.timeOfDay)
+	if err != nil || len(r.days) == 0 {
+		return time.Time{}, false
+	}
+
+	for i := 0; i <= 7; i++ {
+		day := t.AddDate(0, 0, i)
+		if !r.includes(day.Weekday()) {
+			continue
+		}
+
+		occurrence := time.Date(day.Year(), day.Month(), day.Day(), clock.Hour(), clock.Minute(), 0, 0, t.Location())
+		if occurrence.After(t) {
+			return occurrence, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+func (r *Recurrence) includes(weekday time.Weekday) bool {
+	for _, day := range r.days {
+		if day == weekday {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,61 @@
+package value_objects
+
+import (
+	"strings"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+)
+
+type ReturnStatus struct {
+	value string
+}
+
+func NewReturnStatus(value string) *ReturnStatus {
+	return &ReturnStatus{value: strings.ToUpper(value)}
+}
+
+func (s *ReturnStatus) IsValid() bool {
+	for _, status := range constants.ValidReturnStatuses {
+		if s.value == status {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *ReturnStatus) ToString() string {
+	return s.value
+}
+
+func (s *ReturnStatus) Equals(value ValidaterObject[string]) bool {
+	return s.value == value.GetValue()
+}
+
+func (s *ReturnStatus) GetValue() string {
+	return s.value
+}
+
+func (s *ReturnStatus) IsReceived() bool {
+	return s.value == constants.ReturnStatusReceived
+}
+
+func (s *ReturnStatus) IsCancelled() bool {
+	return s.value == constants.ReturnStatusCancelled
+}
+
+func (s *ReturnStatus) CanTransitionTo(nextStatus *ReturnStatus) bool {
+	validTransitions := map[string][]string{
+		constants.ReturnStatusRequested: {constants.ReturnStatusPickedUp, constants.ReturnStatusCancelled},
+		constants.ReturnStatusPickedUp:  {constants.ReturnStatusInTransit},
+		constants.ReturnStatusInTransit: {constants.ReturnStatusReceived},
+		constants.ReturnStatusReceived:  {},
+		constants.ReturnStatusCancelled: {},
+	}
+
+	for _, validNext := range validTransitions[s.value] {
+		if validNext == nextStatus.value {
+			return true
+		}
+	}
+	return false
+}
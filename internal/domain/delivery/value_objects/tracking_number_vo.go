@@ -1,8 +1,27 @@
 package value_objects
 
 import (
+	"crypto/rand"
 	"regexp"
 	"strings"
+	"time"
+)
+
+// Formato: [prefijo 2-5 letras][fecha AAMMDD][10 caracteres aleatorios][dígito de control]
+// Ejemplo: DEL250115TE68JBQF14V
+const (
+	trackingRandomLength = 10
+
+	// trackingRandomAlphabet alfabeto Crockford Base32, sin I, L, O ni U para evitar confusiones al dictarlo
+	trackingRandomAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+	// trackingCheckAlphabet alfabeto sobre el que se calcula el dígito de control
+	trackingCheckAlphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+)
+
+var (
+	trackingNumberRegex = regexp.MustCompile(`^[A-Z]{2,5}[0-9]{6}[0-9A-Z]{11}$`)
+	trackingPrefixRegex = regexp.MustCompile(`^[A-Z]{2,5}$`)
 )
 
 type TrackingNumber struct {
@@ -13,9 +32,35 @@ func NewTrackingNumber(value string) *TrackingNumber {
 	return &TrackingNumber{value: strings.ToUpper(strings.TrimSpace(value))}
 }
 
+// GenerateTrackingNumber genera un número de seguimiento con 50 bits de aleatoriedad criptográfica
+// y un dígito de control que detecta errores de un carácter y transposiciones adyacentes
+func GenerateTrackingNumber(prefix string, now time.Time) (*TrackingNumber, error) {
+	random := make([]byte, trackingRandomLength)
+	if _, err := rand.Read(random); err != nil {
+		return nil, err
+	}
+
+	// 256 es múltiplo de 32, por lo que el módulo no introduce sesgo
+	for i, b := range random {
+		random[i] = trackingRandomAlphabet[int(b)%len(trackingRandomAlphabet)]
+	}
+
+	body := strings.ToUpper(prefix) + now.Format("060102") + string(random)
+	return &TrackingNumber{value: body + string(trackingCheckCharacter(body))}, nil
+}
+
+// IsValidTrackingPrefix indica si el prefijo puede usarse para generar números de seguimiento
+func IsValidTrackingPrefix(prefix string) bool {
+	return trackingPrefixRegex.MatchString(prefix)
+}
+
 func (t *TrackingNumber) IsValid() bool {
-	regex := regexp.MustCompile(`^[A-Z0-9]{8,30}$`)
-	return regex.MatchString(t.value)
+	if !trackingNumberRegex.MatchString(t.value) {
+		return false
+	}
+
+	body := t.value[:len(t.value)-1]
+	return trackingCheckCharacter(body) == t.value[len(t.value)-1]
 }
 
 func (t *TrackingNumber) ToString() string {
@@ -29,3 +74,24 @@ func (t *TrackingNumber) Equals(value ValidaterObject[string]) bool {
 func (t *TrackingNumber) GetValue() string {
 	return t.value
 }
+
+// trackingCheckCharacter calcula el carácter de control con el algoritmo Luhn mod N
+func trackingCheckCharacter(body string) byte {
+	n := len(trackingCheckAlphabet)
+	factor := 2
+	sum := 0
+
+	for i := len(body) - 1; i >= 0; i-- {
+		addend := factor * strings.IndexByte(trackingCheckAlphabet, body[i])
+		addend = addend/n + addend%n
+		sum += addend
+
+		if factor == 2 {
+			factor = 1
+		} else {
+			factor = 2
+		}
+	}
+
+	return trackingCheckAlphabet[(n-sum%n)%n]
+}
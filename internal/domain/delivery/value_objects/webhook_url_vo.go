@@ -0,0 +1,98 @@
+package value_objects
+
+import (
+	"net"
+	"net/url"
+	"strings"
+)
+
+// nonPublicNetworks rangos que no son alcanzables desde internet además de los que ya detecta net.IP:
+// red compartida de operadores (CGNAT), red de pruebas de benchmarking y rangos reservados
+var nonPublicNetworks = []*net.IPNet{
+	mustParseCIDR("100.64.0.0/10"),
+	mustParseCIDR("192.0.0.0/24"),
+	mustParseCIDR("198.18.0.0/15"),
+	mustParseCIDR("240.0.0.0/4"),
+	mustParseCIDR("64:ff9b::/96"),
+}
+
+// WebhookURL dirección a la que se envían los eventos de una empresa, debe apuntar a un servidor público
+type WebhookURL struct {
+	value  string
+	parsed *url.URL
+}
+
+func NewWebhookURL(value string) *WebhookURL {
+	value = strings.TrimSpace(value)
+	parsed, err := url.Parse(value)
+	if err != nil {
+		parsed = nil
+	}
+
+	return &WebhookURL{value: value, parsed: parsed}
+}
+
+// IsValid verifica que la dirección sea http o https absoluta y que su host no sea local ni una IP privada.
+// Los nombres de dominio se vuelven a verificar al conectar, después de resolverlos
+func (u *WebhookURL) IsValid() bool {
+	if u.parsed == nil || (u.parsed.Scheme != "http" && u.parsed.Scheme != "https") || u.parsed.Hostname() == "" {
+		return false
+	}
+
+	host := strings.ToLower(strings.TrimSuffix(u.parsed.Hostname(), "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return false
+	}
+
+	if ip := net.ParseIP(host); ip != nil {
+		return IsPublicIP(ip)
+	}
+
+	return true
+}
+
+// IsSecure indica si la dirección usa https
+func (u *WebhookURL) IsSecure() bool {
+	return u.parsed != nil && u.parsed.Scheme == "https"
+}
+
+func (u *WebhookURL) ToString() string {
+	return u.value
+}
+
+func (u *WebhookURL) Equals(value ValidaterObject[string]) bool {
+	return u.value == value.GetValue()
+}
+
+func (u *WebhookURL) GetValue() string {
+	return u.value
+}
+
+// IsPublicIP indica si la IP es alcanzable desde internet, descarta loopback, redes privadas, link-local
+// (incluida la metadata de la nube 169.254.169.254), multicast y rangos reservados
+func IsPublicIP(ip net.IP) bool {
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+	}
+
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() || ip.IsMulticast() {
+		return false
+	}
+
+	for _, network := range nonPublicNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func mustParseCIDR(cidr string) *net.IPNet {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		panic(err)
+	}
+	return network
+}
@@ -10,7 +10,120 @@ var (
 	ErrOrderAlreadyDeleted          = errors.New("the order has already been deleted")
 	ErrOrderNotDeleted              = errors.New("the order has not been deleted")
 	ErrOrderDeleted                 = errors.New("the order has been deleted")
+	ErrOrderNotFoundByQR            = errors.New("no order was found for the scanned QR code")
+	ErrEmptyQRData                  = errors.New("the scanned QR data is required")
+	ErrScanNotAllowed               = errors.New("the user role is not allowed to scan orders")
+	ErrScanActionNotAllowed         = errors.New("the requested status is not an available action for the scanned order")
+	ErrTooManyParcels               = errors.New("too many parcels in the order, the maximum is 50")
+	ErrInvalidParcel                = errors.New("parcel weight and dimensions cannot be negative")
+	ErrInvalidCODAmount             = errors.New("cash on delivery amount must be positive and have a valid ISO 4217 currency")
+	ErrCODAmountRequired            = errors.New("the collected amount is required to deliver a cash on delivery order")
+	ErrCODAmountMismatch            = errors.New("the collected amount does not match the cash on delivery amount of the order")
+	ErrCODRequiresDriver            = errors.New("cash on delivery orders must have an assigned driver to be delivered")
+	ErrInvalidHandInAmount          = errors.New("the handed in amount cannot be negative")
+	ErrNothingToReconcile           = errors.New("the driver has no cash pending to reconcile in this currency")
+	ErrDriverIDRequired             = errors.New("driver id is required")
+	ErrInvalidReportDates           = errors.New("start_date and end_date must be RFC3339 dates and start_date must be before end_date")
+	ErrParcelScanNotAllowed         = errors.New("parcels can only be scanned individually while the order is accepted, picked up or in warehouse")
 	ErrDeliveryDeadlineBeforePickup = errors.New("delivery deadline must be after pickup deadline")
+	ErrDeliveryRequiresDeliverFlow  = errors.New("orders can only be marked as delivered through the deliver endpoint")
+	ErrInvalidDeliveryPIN           = errors.New("the delivery PIN is invalid")
+	ErrDeliveryPINLocked            = errors.New("the delivery PIN has been locked after too many failed attempts")
+	ErrOrderCannotBeDelivered       = errors.New("the order cannot be delivered, only orders with status 'in transit' can be delivered")
+	ErrOrderDeliveryConflict        = errors.New("the order status changed while it was being delivered, it is no longer in a deliverable status")
+	ErrOrderNotAssignedToDriver     = errors.New("the order is not assigned to the driver")
+	ErrInvalidDeliveryFailureReason = errors.New("invalid delivery failure reason code")
+	ErrOrderCannotRegisterAttempt   = errors.New("delivery attempts can only be registered for orders with status 'in transit'")
+	ErrOrderAttemptConflict         = errors.New("the order status changed while the delivery attempt was being registered, it is no longer in transit")
+	ErrFailureRequiresAttemptFlow   = errors.New("orders can only be marked as delivery failed by registering a delivery attempt")
+	ErrOrderRescheduleConflict      = errors.New("the order is no longer waiting for a new delivery attempt")
+	ErrInvalidMaxDeliveryAttempts   = errors.New("max delivery attempts must be between 1 and 10")
+	ErrInvalidTrackingPrefix        = errors.New("tracking prefix must have between 2 and 5 uppercase letters and cannot be RET")
+
+	ErrReturnNotFound            = errors.New("return not found")
+	ErrOrderCannotBeReturned     = errors.New("the order cannot be returned, only orders with status 'delivered' can be returned")
+	ErrReturnAlreadyExists       = errors.New("the order already has an active return")
+	ErrInvalidReturnReason       = errors.New("invalid return reason code")
+	ErrInvalidReturnDestination  = errors.New("invalid return destination")
+	ErrInvalidReturnStatus       = errors.New("invalid return status")
+	ErrInvalidReturnTransition   = errors.New("the return cannot transition to the requested status")
+	ErrReturnStatusConflict      = errors.New("the return status changed while it was being updated, reload it and try again")
+	ErrReturnDestinationInactive = errors.New("the return destination is not found or is inactive")
+
+	ErrScheduleNotFound     = errors.New("schedule not found")
+	ErrInvalidSchedule      = errors.New("a schedule requires either a future run_at or valid days_of_week and time_of_day")
+	ErrScheduleNotEditable  = errors.New("only active or paused schedules can be modified")
+	ErrScheduleNotPaused    = errors.New("only paused schedules can be resumed")
+	ErrScheduleNotActive    = errors.New("only active schedules can be paused")
+	ErrScheduleOutsideHours = errors.New("the scheduled pickup time is outside the branch operating hours")
+	ErrScheduleConflict     = errors.New("the schedule changed while it was being updated, reload it and try again")
+	ErrInvalidOrderTemplate = errors.New("invalid order template")
+
+	ErrImportJobNotFound    = errors.New("import job not found")
+	ErrInvalidImportFormat  = errors.New("invalid import format, only CSV and JSON are supported")
+	ErrInvalidImportFile    = errors.New("the import file could not be read")
+	ErrEmptyImport          = errors.New("the import file does not contain any order")
+	ErrTooManyImportRows    = errors.New("the import file exceeds the maximum number of orders")
+	ErrImportReportNotReady = errors.New("the import has not finished yet")
+	ErrMissingImportColumns = errors.New("the import file is missing required columns")
+
+	ErrInvalidLabelFormat = errors.New("invalid label format, only PDF and ZPL are supported")
+	ErrTooManyLabels      = errors.New("too many orders in the label batch, the maximum is 100")
+	ErrEmptyLabelBatch    = errors.New("at least one order is required to print labels")
+
+	ErrInvoiceNotFound          = errors.New("invoice not found")
+	ErrInvalidInvoiceStatus     = errors.New("invalid invoice status")
+	ErrInvalidInvoiceTransition = errors.New("the invoice cannot transition to the requested status")
+	ErrInvoiceAlreadyExists     = errors.New("the company already has an invoice for the billing period")
+	ErrNothingToInvoice         = errors.New("the company has no delivered or lost orders to invoice in the billing period")
+	ErrInvalidBillingPeriod     = errors.New("the billing period start must be before its end and cannot be in the future")
+	ErrInvalidTaxRate           = errors.New("the tax rate must be between 0 and 1")
+	ErrInvalidInvoiceFormat     = errors.New("invalid invoice format, only PDF and JSON are supported")
+	ErrVoidReasonRequired       = errors.New("a reason is required to void an invoice")
+
+	ErrPaymentNotFound         = errors.New("payment not found")
+	ErrPaymentDeclined         = errors.New("the payment was declined by the payment provider")
+	ErrInvalidPaymentRequest   = errors.New("prepaid orders require a payment token, a valid ISO 4217 currency and a positive price")
+	ErrPrepaidWithCOD          = errors.New("an order cannot be prepaid and cash on delivery at the same time")
+	ErrInvalidWebhookSignature = errors.New("the payment webhook signature is invalid")
+	ErrInvalidWebhookPayload   = errors.New("the payment webhook payload is invalid")
+	ErrPaymentGatewayFailed    = errors.New("the payment provider could not process the operation")
+	ErrOrderPaymentRefunded    = errors.New("the order payment was refunded, the order cannot be restored")
+
+	ErrEarningRuleNotFound      = errors.New("earning rule not found")
+	ErrEarningRuleAlreadyExists = errors.New("an earning rule already exists for the zone")
+	ErrInvalidEarningRule       = errors.New("earning rates cannot be negative and the zone multiplier must be greater than 0 and at most 5")
+	ErrDriverNotFound           = errors.New("driver not found")
+	ErrInvalidAdjustment        = errors.New("an adjustment requires a driver, a non-zero amount and a reason")
+	ErrPayoutStatementNotFound  = errors.New("payout statement not found")
+	ErrInvalidPayoutPeriod      = errors.New("the payout period start must be before its end and cannot be in the future")
+	ErrNothingToPay             = errors.New("the driver has no earnings pending to pay")
+	ErrPayoutStatementExists    = errors.New("the driver already has a payout statement for the period")
+	ErrPayoutStatementConflict  = errors.New("some earnings were already included in another payout statement")
+
+	ErrInvalidSLAStatus = errors.New("invalid SLA status, allowed values are 'ON_TRACK', 'AT_RISK' and 'BREACHED'")
+
+	ErrInvalidNotificationRequest    = errors.New("a notification requires a user and a type")
+	ErrNotificationTemplateNotFound  = errors.New("there is no active notification template for the type")
+	ErrInvalidNotificationTemplate   = errors.New("the notification template could not be rendered")
+	ErrNotificationRecipientNotFound = errors.New("notification recipient not found")
+	ErrNotificationNotFound          = errors.New("notification not found")
+	ErrInvalidNotificationEvent      = errors.New("the notification event is not configurable")
+	ErrNoRecipientAddress            = errors.New("the recipient has no address for the channel")
+	ErrInvalidNotificationType       = errors.New("unknown notification type")
+	ErrInvalidDeviceToken            = errors.New("a device token is required")
+	ErrInvalidDeviceType             = errors.New("invalid device type, allowed values are 'IOS', 'ANDROID' and 'WEB'")
+	ErrNotificationDeviceNotFound    = errors.New("notification device not found")
+	ErrPushTokenUnregistered         = errors.New("the push provider no longer accepts the device token")
+
+	ErrInvalidWebhookURL       = errors.New("the webhook URL must be an absolute http or https URL")
+	ErrWebhookURLNotPublic     = errors.New("the webhook URL must point to a public address")
+	ErrWebhookURLNotSecure     = errors.New("the webhook URL must use https")
+	ErrInvalidWebhookEvents    = errors.New("the webhook requires at least one known event")
+	ErrWebhookEndpointNotFound = errors.New("webhook endpoint not found")
+	ErrWebhookDeliveryNotFound = errors.New("webhook delivery not found")
+	ErrWebhookEndpointDisabled = errors.New("the webhook endpoint is disabled")
+	ErrWebhookRequestFailed    = errors.New("the webhook endpoint did not respond successfully")
 
 	ErrFailedToSignToken       = errors.New("failed to sign token")
 	ErrUnexpectedSigningMethod = errors.New("unexpected signing method")
@@ -0,0 +1,62 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	domainPorts "github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+// SessionRevocationSubscriber revoca las sesiones abiertas de los usuarios desactivados
+type SessionRevocationSubscriber struct {
+	userRepo     domainPorts.UserRepository
+	tokenService ports.TokenProvider
+}
+
+func NewSessionRevocationSubscriber(userRepo domainPorts.UserRepository, tokenService ports.TokenProvider) interfaces.EventSubscriber {
+	return &SessionRevocationSubscriber{
+		userRepo:     userRepo,
+		tokenService: tokenService,
+	}
+}
+
+func (s *SessionRevocationSubscriber) Name() string {
+	return "auth.session_revocation"
+}
+
+func (s *SessionRevocationSubscriber) EventTypes() []string {
+	return []string{constants.SystemEventUserDeactivated}
+}
+
+func (s *SessionRevocationSubscriber) Handle(ctx context.Context, event *entities.SystemEvent) error {
+	// 1. Obtener las sesiones vigentes del usuario
+	sessions, err := s.userRepo.GetActiveSessionsByUserID(ctx, event.SourceID)
+	if err != nil {
+		return errPackage.NewGeneralServiceError("SessionRevocationSubscriber", "Handle", err)
+	}
+
+	// 2. Revocar cada token y eliminar su sesión, una sesión ya eliminada no interrumpe el resto
+	for _, session := range sessions {
+		if err = s.tokenService.RevokeToken(session.Token); err != nil {
+			return errPackage.NewGeneralServiceError("SessionRevocationSubscriber", "Handle", err)
+		}
+
+		if err = s.userRepo.DeleteSession(ctx, session.ID); err != nil {
+			return errPackage.NewGeneralServiceError("SessionRevocationSubscriber", "Handle", err)
+		}
+	}
+
+	if len(sessions) > 0 {
+		logs.Info("Sessions of deactivated user revoked", map[string]interface{}{
+			"user_id":  event.SourceID,
+			"sessions": len(sessions),
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package cache
+
+import (
+	"context"
+	"github.com/go-redis/redis/v8"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+const idempotencyKeyPrefix = "idempotency:"
+
+type RedisIdempotencyStore struct {
+	client *redis.Client
+}
+
+// NewRedisIdempotencyStore crea un almacén de llaves de idempotencia sobre el cliente de Redis existente
+func NewRedisIdempotencyStore(client *redis.Client) ports.IdempotencyStore {
+	return &RedisIdempotencyStore{
+		client: client,
+	}
+}
+
+// Reserve usa SETNX para que solo una solicitud pueda tomar la llave
+func (s *RedisIdempotencyStore) Reserve(ctx context.Context, key string, record []byte, ttl time.Duration) (bool, error) {
+	reserved, err := s.client.SetNX(ctx, idempotencyKeyPrefix+key, record, ttl).Result()
+	if err != nil {
+		logs.Error("Failed to reserve idempotency key in Redis", map[string]interface{}{
+			"key":   key,
+			"error": err.Error(),
+		})
+		return false, errPackage.NewGeneralServiceError("RedisIdempotencyStore", "Reserve", errPackage.ErrFailedIdempotencyStore)
+	}
+
+	return reserved, nil
+}
+
+func (s *RedisIdempotencyStore) Get(ctx context.Context, key string) ([]byte, error) {
+	record, err := s.client.Get(ctx, idempotencyKeyPrefix+key).Bytes()
+	if err != nil {
+		logs.Error("Failed to get idempotency key from Redis", map[string]interface{}{
+			"key":   key,
+			"error": err.Error(),
+		})
+		return nil, errPackage.NewGeneralServiceError("RedisIdempotencyStore", "Get", errPackage.ErrFailedIdempotencyStore)
+	}
+
+	return record, nil
+}
+
+func (s *RedisIdempotencyStore) Save(ctx context.Context, key string, record []byte, ttl time.Duration) error {
+	if err := s.client.Set(ctx, idempotencyKeyPrefix+key, record, ttl).Err(); err != nil {
+		logs.Error("Failed to save idempotency key in Redis", map[string]interface{}{
+			"key":   key,
+			"error": err.Error(),
+		})
+		return errPackage.NewGeneralServiceError("RedisIdempotencyStore", "Save", errPackage.ErrFailedIdempotencyStore)
+	}
+
+	return nil
+}
+
+func (s *RedisIdempotencyStore) Release(ctx context.Context, key string) error {
+	if err := s.client.Del(ctx, idempotencyKeyPrefix+key).Err(); err != nil {
+		logs.Error("Failed to release idempotency key in Redis", map[string]interface{}{
+			"key":   key,
+			"error": err.Error(),
+		})
+		return errPackage.NewGeneralServiceError("RedisIdempotencyStore", "Release", errPackage.ErrFailedIdempotencyStore)
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+const jobLockKeyPrefix = "jobs:lock:"
+
+type RedisJobLocker struct {
+	client *redis.Client
+}
+
+// NewRedisJobLocker crea el bloqueo distribuido de los trabajos sobre el cliente de Redis existente
+func NewRedisJobLocker(client *redis.Client) ports.JobLocker {
+	return &RedisJobLocker{
+		client: client,
+	}
+}
+
+// Acquire usa SETNX para que solo una instancia tome la llave, la llave expira sola y no se libera al terminar
+// para que las instancias que lleguen tarde a la misma ejecución la omitan
+func (l *RedisJobLocker) Acquire(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	acquired, err := l.client.SetNX(ctx, jobLockKeyPrefix+key, time.Now().Format(time.RFC3339), ttl).Result()
+	if err != nil {
+		logs.Error("Failed to acquire job lock in Redis", map[string]interface{}{
+			"key":   key,
+			"error": err.Error(),
+		})
+		return false, errPackage.NewGeneralServiceError("RedisJobLocker", "Acquire", errPackage.ErrFailedToAcquireJobLock)
+	}
+
+	return acquired, nil
+}
@@ -0,0 +1,200 @@
+package eventbus
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+type memoryMessage struct {
+	id    string
+	event *entities.SystemEvent
+}
+
+type memoryPending struct {
+	message     memoryMessage
+	deliveries  int
+	deliveredAt time.Time
+	lastError   string
+}
+
+// memoryGroup posición de un grupo de consumidores en el stream y sus mensajes entregados sin confirmar
+type memoryGroup struct {
+	next    int64
+	pending map[string]*memoryPending
+	order   []string
+}
+
+// MemoryEventBus bus de eventos del proceso, solo sirve cuando la API y los procesos en segundo plano corren en un
+// único proceso: un worker aparte tendría su propio bus. Los mensajes no sobreviven a un reinicio, el outbox sigue
+// siendo la fuente de verdad de los eventos
+type MemoryEventBus struct {
+	mu         sync.Mutex
+	messages   []memoryMessage
+	first      int64
+	groups     map[string]*memoryGroup
+	ackTimeout time.Duration
+}
+
+// NewMemoryEventBus crea el bus, los mensajes sin confirmar se vuelven a entregar pasado ackTimeout
+func NewMemoryEventBus(ackTimeout time.Duration) interfaces.EventBus {
+	return &MemoryEventBus{
+		groups:     make(map[string]*memoryGroup),
+		ackTimeout: ackTimeout,
+	}
+}
+
+// Publish agrega el evento al stream, descartando los mensajes más antiguos al superar el máximo
+func (b *MemoryEventBus) Publish(ctx context.Context, event *entities.SystemEvent) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	id := strconv.FormatInt(b.first+int64(len(b.messages)), 10)
+	b.messages = append(b.messages, memoryMessage{id: id, event: event})
+
+	if overflow := len(b.messages) - constants.EventBusMaxLen; overflow > 0 {
+		b.messages = b.messages[overflow:]
+		b.first += int64(overflow)
+	}
+
+	return nil
+}
+
+// Consume entrega al suscriptor los mensajes sin confirmar que vencieron y los mensajes nuevos de su grupo
+func (b *MemoryEventBus) Consume(ctx context.Context, subscriber interfaces.EventSubscriber, limit int) (int, error) {
+	// 1. Reservar los mensajes a procesar
+	deliveries := b.reserve(subscriber.Name(), limit)
+
+	// 2. Procesar cada mensaje, los eventos que el suscriptor no recibe se confirman sin procesar
+	processed := 0
+	for _, message := range deliveries {
+		if !subscribesTo(subscriber, message.event.EventType) {
+			b.ack(subscriber.Name(), message.id, nil)
+			continue
+		}
+
+		err := subscriber.Handle(ctx, message.event)
+		b.ack(subscriber.Name(), message.id, err)
+		if err != nil {
+			logs.Warn("Failed to handle event from event bus", map[string]interface{}{
+				"group":     subscriber.Name(),
+				"eventID":   message.event.ID,
+				"eventType": message.event.EventType,
+				"error":     err.Error(),
+			})
+			continue
+		}
+		processed++
+	}
+
+	return processed, nil
+}
+
+// reserve marca como entregados los mensajes pendientes vencidos y los siguientes mensajes nuevos del grupo,
+// los pendientes que agotaron sus entregas se descartan y quedan en el log como dead letter
+func (b *MemoryEventBus) reserve(group string, limit int) []memoryMessage {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	state, ok := b.groups[group]
+	if !ok {
+		state = &memoryGroup{next: b.first, pending: make(map[string]*memoryPending)}
+		b.groups[group] = state
+	}
+
+	now := time.Now()
+	var reserved []memoryMessage
+
+	// 1. Volver a entregar los mensajes que no se confirmaron a tiempo
+	remaining := state.order[:0]
+	for _, id := range state.order {
+		pending, ok := state.pending[id]
+		if !ok {
+			continue
+		}
+		if len(reserved) >= limit || now.Sub(pending.deliveredAt) < b.ackTimeout {
+			remaining = append(remaining, id)
+			continue
+		}
+
+		if pending.deliveries >= constants.EventBusMaxDeliveries {
+			delete(state.pending, id)
+			logDeadLetter(group, id, pending.message.event, int64(pending.deliveries), pending.lastError)
+			continue
+		}
+
+		pending.deliveries++
+		pending.deliveredAt = now
+		reserved = append(reserved, pending.message)
+		remaining = append(remaining, id)
+	}
+	state.order = remaining
+
+	// 2. Entregar los mensajes nuevos, los que se descartaron del stream antes de leerse se pierden
+	if state.next < b.first {
+		state.next = b.first
+	}
+	for len(reserved) < limit && state.next < b.first+int64(len(b.messages)) {
+		message := b.messages[state.next-b.first]
+		state.next++
+
+		state.pending[message.id] = &memoryPending{message: message, deliveries: 1, deliveredAt: now}
+		state.order = append(state.order, message.id)
+		reserved = append(reserved, message)
+	}
+
+	return reserved
+}
+
+// ack confirma el mensaje procesado o guarda el error para el dead letter, el mensaje con error se vuelve a
+// entregar cuando vence su tiempo de confirmación
+func (b *MemoryEventBus) ack(group, id string, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	state := b.groups[group]
+	if err != nil {
+		if pending, ok := state.pending[id]; ok {
+			pending.lastError = err.Error()
+		}
+		return
+	}
+
+	delete(state.pending, id)
+}
+
+// subscribesTo indica si el suscriptor recibe el tipo de evento, sin tipos recibe todos los eventos
+func subscribesTo(subscriber interfaces.EventSubscriber, eventType string) bool {
+	eventTypes := subscriber.EventTypes()
+	if len(eventTypes) == 0 {
+		return true
+	}
+
+	for _, subscribed := range eventTypes {
+		if subscribed == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+func logDeadLetter(group, messageID string, event *entities.SystemEvent, deliveries int64, lastError string) {
+	fields := map[string]interface{}{
+		"group":      group,
+		"messageID":  messageID,
+		"deliveries": deliveries,
+		"error":      lastError,
+	}
+	if event != nil {
+		fields["eventID"] = event.ID
+		fields["eventType"] = event.EventType
+	}
+
+	logs.Warn("Event moved to dead letter", fields)
+}
@@ -0,0 +1,32 @@
+package eventbus
+
+import (
+	"context"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+// OutboxPublisher suscriptor del outbox que publica todos los eventos de dominio en el bus de eventos. El outbox
+// reintenta la publicación si el bus no está disponible, así ningún evento guardado se pierde
+type OutboxPublisher struct {
+	bus interfaces.EventBus
+}
+
+func NewOutboxPublisher(bus interfaces.EventBus) interfaces.EventSubscriber {
+	return &OutboxPublisher{
+		bus: bus,
+	}
+}
+
+func (p *OutboxPublisher) Name() string {
+	return "event_bus"
+}
+
+func (p *OutboxPublisher) EventTypes() []string {
+	return nil
+}
+
+func (p *OutboxPublisher) Handle(ctx context.Context, event *entities.SystemEvent) error {
+	return p.bus.Publish(ctx, event)
+}
@@ -0,0 +1,287 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+// redisLastErrorKey prefijo del hash con el último error de cada mensaje pendiente de un grupo
+const redisLastErrorKey = "events:last_error:"
+
+// RedisEventBus bus de eventos sobre Redis Streams, cada suscriptor es un grupo de consumidores del stream
+// y cada instancia del servicio un consumidor del grupo
+type RedisEventBus struct {
+	client     *redis.Client
+	consumer   string
+	groups     sync.Map
+	ackTimeout time.Duration
+}
+
+// NewRedisEventBus crea el bus sobre el cliente de Redis existente, la instancia se identifica por su host y proceso.
+// Los mensajes sin confirmar pasado ackTimeout los reclama el siguiente consumidor del grupo
+func NewRedisEventBus(client *redis.Client, ackTimeout time.Duration) interfaces.EventBus {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "delivery-backend"
+	}
+
+	return &RedisEventBus{
+		client:     client,
+		consumer:   fmt.Sprintf("%s-%d", hostname, os.Getpid()),
+		ackTimeout: ackTimeout,
+	}
+}
+
+// Publish agrega el evento al stream, Redis descarta los mensajes más antiguos al superar el máximo
+func (b *RedisEventBus) Publish(ctx context.Context, event *entities.SystemEvent) error {
+	err := b.client.XAdd(ctx, &redis.XAddArgs{
+		Stream: constants.EventBusStream,
+		MaxLen: constants.EventBusMaxLen,
+		Approx: true,
+		Values: eventValues(event),
+	}).Err()
+	if err != nil {
+		logs.Error("Failed to publish event to Redis stream", map[string]interface{}{
+			"eventID":   event.ID,
+			"eventType": event.EventType,
+			"error":     err.Error(),
+		})
+		return errPackage.NewGeneralServiceError("RedisEventBus", "Publish", errPackage.ErrFailedToPublishEvent)
+	}
+
+	return nil
+}
+
+// Consume entrega al suscriptor los mensajes sin confirmar que vencieron y los mensajes nuevos de su grupo
+func (b *RedisEventBus) Consume(ctx context.Context, subscriber interfaces.EventSubscriber, limit int) (int, error) {
+	group := subscriber.Name()
+
+	// 1. Crear el grupo la primera vez, un grupo nuevo lee el stream desde el inicio
+	if err := b.ensureGroup(ctx, group); err != nil {
+		return 0, err
+	}
+
+	// 2. Reclamar los mensajes que no se confirmaron a tiempo y descartar los que agotaron sus entregas
+	messages, err := b.claimExpired(ctx, group, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	// 3. Leer los mensajes nuevos del grupo sin bloquear
+	if len(messages) < limit {
+		streams, err := b.client.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    group,
+			Consumer: b.consumer,
+			Streams:  []string{constants.EventBusStream, ">"},
+			Count:    int64(limit - len(messages)),
+			Block:    -1,
+		}).Result()
+		if err != nil && !errors.Is(err, redis.Nil) {
+			return 0, b.consumeError(group, err)
+		}
+
+		for _, stream := range streams {
+			messages = append(messages, stream.Messages...)
+		}
+	}
+
+	// 4. Procesar cada mensaje, los eventos que el suscriptor no recibe se confirman sin procesar
+	processed := 0
+	for _, message := range messages {
+		event, err := eventFromValues(message.Values)
+		if err != nil {
+			b.deadLetter(ctx, group, message, 1, err.Error())
+			continue
+		}
+
+		if subscribesTo(subscriber, event.EventType) {
+			if err = subscriber.Handle(ctx, event); err != nil {
+				logs.Warn("Failed to handle event from event bus", map[string]interface{}{
+					"group":     group,
+					"eventID":   event.ID,
+					"eventType": event.EventType,
+					"error":     err.Error(),
+				})
+				b.client.HSet(ctx, redisLastErrorKey+group, message.ID, err.Error())
+				continue
+			}
+			processed++
+		}
+
+		if err = b.ack(ctx, group, message.ID); err != nil {
+			return processed, err
+		}
+	}
+
+	return processed, nil
+}
+
+func (b *RedisEventBus) ensureGroup(ctx context.Context, group string) error {
+	if _, ok := b.groups.Load(group); ok {
+		return nil
+	}
+
+	err := b.client.XGroupCreateMkStream(ctx, constants.EventBusStream, group, "0").Err()
+	if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		return b.consumeError(group, err)
+	}
+
+	b.groups.Store(group, struct{}{})
+	return nil
+}
+
+// claimExpired reclama para esta instancia los mensajes pendientes del grupo que superaron el tiempo de
+// confirmación, incluso los de instancias detenidas. Los que ya agotaron sus entregas pasan al dead letter
+func (b *RedisEventBus) claimExpired(ctx context.Context, group string, limit int) ([]redis.XMessage, error) {
+	pending, err := b.client.XPendingExt(ctx, &redis.XPendingExtArgs{
+		Stream: constants.EventBusStream,
+		Group:  group,
+		Start:  "-",
+		End:    "+",
+		Count:  int64(limit),
+	}).Result()
+	if err != nil {
+		return nil, b.consumeError(group, err)
+	}
+
+	var ids []string
+	for _, message := range pending {
+		if message.Idle < b.ackTimeout {
+			continue
+		}
+
+		if message.RetryCount < constants.EventBusMaxDeliveries {
+			ids = append(ids, message.ID)
+			continue
+		}
+
+		// El mensaje puede haberse descartado del stream, en ese caso solo se confirma
+		entries, err := b.client.XRange(ctx, constants.EventBusStream, message.ID, message.ID).Result()
+		if err != nil {
+			return nil, b.consumeError(group, err)
+		}
+		lastError := b.client.HGet(ctx, redisLastErrorKey+group, message.ID).Val()
+		if len(entries) == 0 {
+			entries = []redis.XMessage{{ID: message.ID}}
+		}
+		b.deadLetter(ctx, group, entries[0], message.RetryCount, lastError)
+	}
+
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	messages, err := b.client.XClaim(ctx, &redis.XClaimArgs{
+		Stream:   constants.EventBusStream,
+		Group:    group,
+		Consumer: b.consumer,
+		MinIdle:  b.ackTimeout,
+		Messages: ids,
+	}).Result()
+	if err != nil {
+		return nil, b.consumeError(group, err)
+	}
+
+	return messages, nil
+}
+
+// deadLetter copia el mensaje al stream de descartados del grupo y lo confirma para que no se vuelva a entregar
+func (b *RedisEventBus) deadLetter(ctx context.Context, group string, message redis.XMessage, deliveries int64, lastError string) {
+	values := map[string]interface{}{
+		"message_id":       message.ID,
+		"group":            group,
+		"deliveries":       deliveries,
+		"last_error":       lastError,
+		"dead_lettered_at": time.Now().Format(time.RFC3339),
+	}
+	for key, value := range message.Values {
+		values[key] = value
+	}
+
+	if err := b.client.XAdd(ctx, &redis.XAddArgs{
+		Stream: constants.EventBusDeadLetterStream + group,
+		MaxLen: constants.EventBusMaxLen,
+		Approx: true,
+		Values: values,
+	}).Err(); err != nil {
+		logs.Error("Failed to move event to dead letter", map[string]interface{}{
+			"group":     group,
+			"messageID": message.ID,
+			"error":     err.Error(),
+		})
+		return
+	}
+
+	event, _ := eventFromValues(message.Values)
+	logDeadLetter(group, message.ID, event, deliveries, lastError)
+	_ = b.ack(ctx, group, message.ID)
+}
+
+func (b *RedisEventBus) ack(ctx context.Context, group, id string) error {
+	if err := b.client.XAck(ctx, constants.EventBusStream, group, id).Err(); err != nil {
+		return b.consumeError(group, err)
+	}
+
+	b.client.HDel(ctx, redisLastErrorKey+group, id)
+	return nil
+}
+
+func (b *RedisEventBus) consumeError(group string, err error) error {
+	logs.Error("Failed to consume events from Redis stream", map[string]interface{}{
+		"group": group,
+		"error": err.Error(),
+	})
+	return errPackage.NewGeneralServiceError("RedisEventBus", "Consume", errPackage.ErrFailedToConsumeEvents)
+}
+
+// eventValues campos del mensaje con los que se reconstruye el evento al consumirlo
+func eventValues(event *entities.SystemEvent) map[string]interface{} {
+	return map[string]interface{}{
+		"event_id":    event.ID,
+		"event_type":  event.EventType,
+		"source":      event.Source,
+		"source_id":   event.SourceID,
+		"severity":    event.Severity,
+		"event_data":  event.EventData,
+		"occurred_at": event.OccurredAt.Format(time.RFC3339Nano),
+	}
+}
+
+func eventFromValues(values map[string]interface{}) (*entities.SystemEvent, error) {
+	field := func(key string) string {
+		value, _ := values[key].(string)
+		return value
+	}
+
+	if field("event_id") == "" || field("event_type") == "" {
+		return nil, errors.New("message is not a system event")
+	}
+
+	occurredAt, err := time.Parse(time.RFC3339Nano, field("occurred_at"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid occurred_at: %w", err)
+	}
+
+	return &entities.SystemEvent{
+		ID:         field("event_id"),
+		EventType:  field("event_type"),
+		Source:     field("source"),
+		SourceID:   field("source_id"),
+		Severity:   field("severity"),
+		EventData:  field("event_data"),
+		OccurredAt: occurredAt,
+	}, nil
+}
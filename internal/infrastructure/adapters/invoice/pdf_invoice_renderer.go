@@ -0,0 +1,171 @@
+package invoice
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/jung-kurt/gofpdf"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+// Medidas de la página A4 de la factura, en milímetros
+const (
+	pdfMargin   = 15.0
+	pdfContentW = 210.0 - 2*pdfMargin
+	pdfRowH     = 6.0
+	dateLayout  = "02/01/2006"
+)
+
+// Anchos de las columnas de la tabla de líneas: número, descripción, cantidad, precio unitario e importe
+var pdfColumnWidths = []float64{10, 105, 15, 25, 25}
+
+type PDFInvoiceRenderer struct{}
+
+// NewPDFInvoiceRenderer crea un generador de facturas PDF en tamaño A4
+func NewPDFInvoiceRenderer() ports.InvoiceRenderer {
+	return &PDFInvoiceRenderer{}
+}
+
+func (r *PDFInvoiceRenderer) ContentType() string {
+	return "application/pdf"
+}
+
+func (r *PDFInvoiceRenderer) FileExtension() string {
+	return "pdf"
+}
+
+func (r *PDFInvoiceRenderer) Render(invoice *entities.Invoice) ([]byte, error) {
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(pdfMargin, pdfMargin, pdfMargin)
+	pdf.SetAutoPageBreak(true, pdfMargin)
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+	pdf.AddPage()
+
+	r.renderHeader(pdf, tr, invoice)
+	r.renderLines(pdf, tr, invoice)
+	r.renderTotals(pdf, tr, invoice)
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		logs.Error("Failed to render PDF invoice", map[string]interface{}{
+			"invoiceID": invoice.ID,
+			"error":     err.Error(),
+		})
+		return nil, errPackage.NewGeneralServiceError("PDFInvoiceRenderer", "Render", errPackage.ErrFailedToRenderInvoice)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// renderHeader escribe el número de factura, el cliente facturado, el periodo y las fechas de emisión y vencimiento
+func (r *PDFInvoiceRenderer) renderHeader(pdf *gofpdf.Fpdf, tr func(string) string, invoice *entities.Invoice) {
+	// 1. Título y estado
+	pdf.SetFont("Helvetica", "B", 18)
+	pdf.CellFormat(pdfContentW/2, 10, tr("FACTURA"), "", 0, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "B", 11)
+	pdf.CellFormat(pdfContentW/2, 10, tr(invoice.InvoiceNumber+"  ["+invoice.Status+"]"), "", 1, "R", false, 0, "")
+	pdf.Ln(2)
+
+	// 2. Cliente facturado
+	pdf.SetFont("Helvetica", "B", 9)
+	pdf.CellFormat(pdfContentW, 5, tr("FACTURADO A"), "", 1, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 10)
+	if invoice.Company != nil {
+		pdf.CellFormat(pdfContentW, 5, tr(invoice.Company.LegalName), "", 1, "L", false, 0, "")
+		pdf.CellFormat(pdfContentW, 5, tr("NIT: "+invoice.Company.TaxID), "", 1, "L", false, 0, "")
+		pdf.CellFormat(pdfContentW, 5, tr(invoice.Company.ContactEmail), "", 1, "L", false, 0, "")
+	} else {
+		pdf.CellFormat(pdfContentW, 5, tr(invoice.CompanyID), "", 1, "L", false, 0, "")
+	}
+	pdf.Ln(3)
+
+	// 3. Periodo y fechas
+	pdf.SetFont("Helvetica", "", 9)
+	period := fmt.Sprintf("Periodo: %s - %s", invoice.PeriodStart.Format(dateLayout), invoice.PeriodEnd.AddDate(0, 0, -1).Format(dateLayout))
+	pdf.CellFormat(pdfContentW, 5, tr(period), "", 1, "L", false, 0, "")
+	if invoice.IssuedAt != nil {
+		pdf.CellFormat(pdfContentW, 5, tr("Fecha de emisión: "+invoice.IssuedAt.Format(dateLayout)), "", 1, "L", false, 0, "")
+	}
+	if invoice.DueDate != nil {
+		pdf.CellFormat(pdfContentW, 5, tr("Fecha de vencimiento: "+invoice.DueDate.Format(dateLayout)), "", 1, "L", false, 0, "")
+	}
+	if invoice.PaymentTerms != "" {
+		pdf.CellFormat(pdfContentW, 5, tr("Condiciones de pago: "+invoice.PaymentTerms), "", 1, "L", false, 0, "")
+	}
+	if invoice.VoidReason != "" {
+		pdf.CellFormat(pdfContentW, 5, tr("Anulada: "+invoice.VoidReason), "", 1, "L", false, 0, "")
+	}
+	pdf.Ln(4)
+}
+
+// renderLines escribe la tabla de líneas repitiendo el encabezado en cada página
+func (r *PDFInvoiceRenderer) renderLines(pdf *gofpdf.Fpdf, tr func(string) string, invoice *entities.Invoice) {
+	headers := []string{"#", "Descripción", "Cant.", "P. unitario", "Importe"}
+	aligns := []string{"C", "L", "R", "R", "R"}
+
+	writeHeader := func() {
+		pdf.SetFont("Helvetica", "B", 9)
+		pdf.SetFillColor(230, 230, 230)
+		for i, header := range headers {
+			pdf.CellFormat(pdfColumnWidths[i], pdfRowH, tr(header), "1", 0, aligns[i], true, 0, "")
+		}
+		pdf.Ln(-1)
+		pdf.SetFont("Helvetica", "", 9)
+	}
+
+	_, pageHeight := pdf.GetPageSize()
+	writeHeader()
+	for _, line := range invoice.Lines {
+		if pdf.GetY()+pdfRowH > pageHeight-pdfMargin {
+			pdf.AddPage()
+			writeHeader()
+		}
+
+		values := []string{
+			fmt.Sprintf("%d", line.LineNumber),
+			line.Description,
+			fmt.Sprintf("%d", line.Quantity),
+			formatAmount(line.UnitPrice),
+			formatAmount(line.Amount),
+		}
+		for i, value := range values {
+			pdf.CellFormat(pdfColumnWidths[i], pdfRowH, tr(value), "1", 0, aligns[i], false, 0, "")
+		}
+		pdf.Ln(-1)
+	}
+	pdf.Ln(4)
+}
+
+// renderTotals escribe el resumen de cargos, recargos, créditos, impuesto y total
+func (r *PDFInvoiceRenderer) renderTotals(pdf *gofpdf.Fpdf, tr func(string) string, invoice *entities.Invoice) {
+	labelW := pdfContentW - 40
+	rows := []struct {
+		label  string
+		amount float64
+		bold   bool
+	}{
+		{"Entregas", invoice.Subtotal, false},
+		{"Recargos", invoice.SurchargeTotal, false},
+		{"Créditos", invoice.CreditTotal, false},
+		{fmt.Sprintf("Impuesto (%.2f%%)", invoice.TaxRate*100), invoice.TaxAmount, false},
+		{"TOTAL " + invoice.Currency, invoice.Total, true},
+	}
+
+	for _, row := range rows {
+		style := ""
+		if row.bold {
+			style = "B"
+		}
+		pdf.SetFont("Helvetica", style, 10)
+		pdf.CellFormat(labelW, pdfRowH, tr(row.label), "", 0, "R", false, 0, "")
+		pdf.CellFormat(40, pdfRowH, tr(formatAmount(row.amount)), "", 1, "R", false, 0, "")
+	}
+}
+
+func formatAmount(amount float64) string {
+	return fmt.Sprintf("%.2f", amount)
+}
@@ -0,0 +1,158 @@
+package label
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+// labelContent reúne los textos que se imprimen en la etiqueta, compartidos por todos los formatos
+type labelContent struct {
+	TrackingNumber string
+	QRData         string
+	SenderName     string
+	SenderBranch   string
+	SenderPhone    string
+	SenderAddress  []string
+	RecipientName  string
+	RecipientPhone string
+	RecipientLines []string
+	AddressNotes   string
+	IsFragile      bool
+	IsUrgent       bool
+	RequiresPIN    bool
+	Weight         string
+	Dimensions     string
+	Instructions   string
+	OrderTracking  string
+	ParcelCaption  string
+}
+
+// newLabelContents devuelve una etiqueta por cada bulto del pedido, o una sola para pedidos sin bultos
+func newLabelContents(order *entities.Order) []labelContent {
+	base := newLabelContent(order)
+	if len(order.Parcels) == 0 {
+		return []labelContent{base}
+	}
+
+	contents := make([]labelContent, len(order.Parcels))
+	for i, parcel := range order.Parcels {
+		content := base
+		content.TrackingNumber = parcel.TrackingNumber
+		content.QRData = parcel.TrackingNumber
+		content.OrderTracking = order.TrackingNumber
+		content.ParcelCaption = fmt.Sprintf("Bulto %d de %d", parcel.Sequence, len(order.Parcels))
+		content.IsFragile = parcel.IsFragile
+		content.Weight = ""
+		if parcel.Weight > 0 {
+			content.Weight = fmt.Sprintf("%.2f kg", parcel.Weight)
+		}
+		content.Dimensions = formatDimensions(parcel.Dimensions)
+		contents[i] = content
+	}
+
+	return contents
+}
+
+func newLabelContent(order *entities.Order) labelContent {
+	content := labelContent{
+		TrackingNumber: order.TrackingNumber,
+		QRData:         order.TrackingNumber,
+	}
+
+	if order.QRCode != nil && order.QRCode.QRData != "" {
+		content.QRData = order.QRCode.QRData
+	}
+
+	if order.Company != nil {
+		content.SenderName = order.Company.Name
+	}
+
+	if order.Branch != nil {
+		content.SenderBranch = order.Branch.Name
+		content.SenderPhone = order.Branch.ContactPhone
+	}
+
+	if order.PickupAddress != nil {
+		content.SenderAddress = addressLines(order.PickupAddress.AddressLine1, order.PickupAddress.AddressLine2,
+			order.PickupAddress.City, order.PickupAddress.State, order.PickupAddress.PostalCode)
+	}
+
+	if order.DeliveryAddress != nil {
+		content.RecipientName = order.DeliveryAddress.RecipientName
+		content.RecipientPhone = order.DeliveryAddress.RecipientPhone
+		content.RecipientLines = addressLines(order.DeliveryAddress.AddressLine1, order.DeliveryAddress.AddressLine2,
+			order.DeliveryAddress.City, order.DeliveryAddress.State, order.DeliveryAddress.PostalCode)
+		content.AddressNotes = order.DeliveryAddress.AddressNotes
+	}
+
+	if order.PackageDetail != nil {
+		content.IsFragile = order.PackageDetail.IsFragile
+		content.IsUrgent = order.PackageDetail.IsUrgent
+		content.Instructions = order.PackageDetail.SpecialInstructions
+		if order.PackageDetail.Weight > 0 {
+			content.Weight = fmt.Sprintf("%.2f kg", order.PackageDetail.Weight)
+		}
+		content.Dimensions = formatDimensions(order.PackageDetail.Dimensions)
+	}
+
+	if order.Detail != nil {
+		content.RequiresPIN = order.Detail.RequiresDeliveryPIN
+	}
+
+	return content
+}
+
+// addressLines agrupa la dirección en líneas cortas para la etiqueta
+func addressLines(line1, line2, city, state, postalCode string) []string {
+	lines := []string{line1}
+	if line2 != "" {
+		lines = append(lines, line2)
+	}
+
+	cityLine := strings.TrimSpace(strings.Join([]string{city, state}, ", "))
+	if postalCode != "" {
+		cityLine += " " + postalCode
+	}
+
+	return append(lines, strings.Trim(cityLine, ", "))
+}
+
+func formatDimensions(dimensionsJSON string) string {
+	if dimensionsJSON == "" {
+		return ""
+	}
+
+	var dimensions struct {
+		Length float64 `json:"length"`
+		Width  float64 `json:"width"`
+		Height float64 `json:"height"`
+	}
+	if err := json.Unmarshal([]byte(dimensionsJSON), &dimensions); err != nil {
+		return ""
+	}
+
+	if dimensions.Length == 0 && dimensions.Width == 0 && dimensions.Height == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("%.0f x %.0f x %.0f cm", dimensions.Length, dimensions.Width, dimensions.Height)
+}
+
+// flags devuelve las marcas de manejo especial del paquete
+func (c labelContent) flags() []string {
+	var flags []string
+	if c.IsFragile {
+		flags = append(flags, "FRAGIL")
+	}
+	if c.IsUrgent {
+		flags = append(flags, "URGENTE")
+	}
+	if c.RequiresPIN {
+		flags = append(flags, "PIN")
+	}
+
+	return flags
+}
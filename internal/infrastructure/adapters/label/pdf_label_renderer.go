@@ -0,0 +1,169 @@
+package label
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/boombuler/barcode/qr"
+	"github.com/jung-kurt/gofpdf"
+	"github.com/jung-kurt/gofpdf/contrib/barcode"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+// Medidas de una etiqueta térmica estándar de 4x6 pulgadas, en milímetros
+const (
+	pdfLabelWidth  = 101.6
+	pdfLabelHeight = 152.4
+	pdfMargin      = 4.0
+	pdfContentW    = pdfLabelWidth - 2*pdfMargin
+)
+
+type PDFLabelRenderer struct{}
+
+// NewPDFLabelRenderer crea un generador de etiquetas PDF con una página de 4x6 pulgadas por pedido
+func NewPDFLabelRenderer() ports.LabelRenderer {
+	return &PDFLabelRenderer{}
+}
+
+func (r *PDFLabelRenderer) ContentType() string {
+	return "application/pdf"
+}
+
+func (r *PDFLabelRenderer) FileExtension() string {
+	return "pdf"
+}
+
+func (r *PDFLabelRenderer) Render(orders []entities.Order) ([]byte, error) {
+	pdf := gofpdf.NewCustom(&gofpdf.InitType{
+		UnitStr: "mm",
+		Size:    gofpdf.SizeType{Wd: pdfLabelWidth, Ht: pdfLabelHeight},
+	})
+	pdf.SetMargins(pdfMargin, pdfMargin, pdfMargin)
+	pdf.SetAutoPageBreak(false, 0)
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+
+	for i := range orders {
+		for _, content := range newLabelContents(&orders[i]) {
+			r.renderPage(pdf, tr, content)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		logs.Error("Failed to render PDF labels", map[string]interface{}{
+			"orders": len(orders),
+			"error":  err.Error(),
+		})
+		return nil, errPackage.NewGeneralServiceError("PDFLabelRenderer", "Render", errPackage.ErrFailedToRenderLabel)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (r *PDFLabelRenderer) renderPage(pdf *gofpdf.Fpdf, tr func(string) string, content labelContent) {
+	pdf.AddPage()
+	pdf.SetLineWidth(0.4)
+
+	// 1. Remitente: empresa, sucursal y dirección de recolección
+	pdf.SetXY(pdfMargin, pdfMargin)
+	pdf.SetFont("Helvetica", "B", 8)
+	pdf.CellFormat(pdfContentW, 4, tr("REMITENTE"), "", 1, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "B", 10)
+	pdf.CellFormat(pdfContentW, 5, tr(content.SenderName), "", 1, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 8)
+	if content.SenderBranch != "" {
+		pdf.CellFormat(pdfContentW, 4, tr("Sucursal: "+content.SenderBranch+phoneSuffix(content.SenderPhone)), "", 1, "L", false, 0, "")
+	}
+	for _, line := range content.SenderAddress {
+		pdf.CellFormat(pdfContentW, 4, tr(line), "", 1, "L", false, 0, "")
+	}
+	r.separator(pdf)
+
+	// 2. Destinatario con la dirección de entrega en letra grande
+	pdf.SetFont("Helvetica", "B", 8)
+	pdf.CellFormat(pdfContentW, 4, tr("ENVIAR A"), "", 1, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "B", 13)
+	pdf.MultiCell(pdfContentW, 6, tr(content.RecipientName), "", "L", false)
+	pdf.SetFont("Helvetica", "", 11)
+	for _, line := range content.RecipientLines {
+		pdf.MultiCell(pdfContentW, 5, tr(line), "", "L", false)
+	}
+	if content.RecipientPhone != "" {
+		pdf.CellFormat(pdfContentW, 5, tr("Tel: "+content.RecipientPhone), "", 1, "L", false, 0, "")
+	}
+	if content.AddressNotes != "" {
+		pdf.SetFont("Helvetica", "I", 8)
+		pdf.MultiCell(pdfContentW, 4, tr(content.AddressNotes), "", "L", false)
+	}
+	r.separator(pdf)
+
+	// 3. Marcas de manejo especial en recuadros invertidos y datos del paquete
+	top := pdf.GetY()
+	flagX := pdfMargin
+	pdf.SetFont("Helvetica", "B", 12)
+	pdf.SetFillColor(0, 0, 0)
+	pdf.SetTextColor(255, 255, 255)
+	for _, flag := range content.flags() {
+		width := pdf.GetStringWidth(flag) + 6
+		pdf.SetXY(flagX, top)
+		pdf.CellFormat(width, 8, flag, "", 0, "C", true, 0, "")
+		flagX += width + 2
+	}
+	pdf.SetTextColor(0, 0, 0)
+
+	pdf.SetXY(pdfMargin, top+10)
+	pdf.SetFont("Helvetica", "", 9)
+	var packageInfo []string
+	if content.Weight != "" {
+		packageInfo = append(packageInfo, "Peso: "+content.Weight)
+	}
+	if content.Dimensions != "" {
+		packageInfo = append(packageInfo, "Dim: "+content.Dimensions)
+	}
+	pdf.CellFormat(pdfContentW, 5, tr(strings.Join(packageInfo, "   ")), "", 1, "L", false, 0, "")
+	if content.Instructions != "" {
+		pdf.SetFont("Helvetica", "I", 8)
+		pdf.MultiCell(pdfContentW, 4, tr(content.Instructions), "", "L", false)
+	}
+	r.separator(pdf)
+
+	// 4. Código QR y código de barras Code-128 del número de seguimiento
+	codesTop := pdf.GetY() + 1
+	qrSize := 30.0
+	qrKey := barcode.RegisterQR(pdf, content.QRData, qr.M, qr.Auto)
+	barcode.Barcode(pdf, qrKey, pdfMargin, codesTop, qrSize, qrSize, false)
+
+	barcodeX := pdfMargin + qrSize + 3
+	barcodeW := pdfContentW - qrSize - 3
+	codeKey := barcode.RegisterCode128(pdf, content.TrackingNumber)
+	barcode.Barcode(pdf, codeKey, barcodeX, codesTop+2, barcodeW, 18, false)
+
+	pdf.SetXY(barcodeX, codesTop+21)
+	pdf.SetFont("Courier", "B", 10)
+	pdf.CellFormat(barcodeW, 5, content.TrackingNumber, "", 1, "C", false, 0, "")
+
+	// 5. Número del bulto dentro del envío
+	if content.ParcelCaption != "" {
+		pdf.SetX(barcodeX)
+		pdf.SetFont("Helvetica", "B", 9)
+		pdf.CellFormat(barcodeW, 5, tr(content.ParcelCaption+" - Pedido "+content.OrderTracking), "", 1, "C", false, 0, "")
+	}
+}
+
+func (r *PDFLabelRenderer) separator(pdf *gofpdf.Fpdf) {
+	y := pdf.GetY() + 1.5
+	pdf.Line(pdfMargin, y, pdfLabelWidth-pdfMargin, y)
+	pdf.SetXY(pdfMargin, y+1.5)
+}
+
+func phoneSuffix(phone string) string {
+	if phone == "" {
+		return ""
+	}
+
+	return " - Tel: " + phone
+}
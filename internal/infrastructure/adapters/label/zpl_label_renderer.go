@@ -0,0 +1,128 @@
+package label
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+// Medidas en puntos de una etiqueta de 4x6 pulgadas para impresoras de 203 dpi
+const (
+	zplLabelWidth  = 812
+	zplLabelHeight = 1218
+	zplMargin      = 30
+)
+
+type ZPLLabelRenderer struct{}
+
+// NewZPLLabelRenderer crea un generador de etiquetas ZPL para impresoras térmicas Zebra
+func NewZPLLabelRenderer() ports.LabelRenderer {
+	return &ZPLLabelRenderer{}
+}
+
+func (r *ZPLLabelRenderer) ContentType() string {
+	return "application/x-zpl"
+}
+
+func (r *ZPLLabelRenderer) FileExtension() string {
+	return "zpl"
+}
+
+func (r *ZPLLabelRenderer) Render(orders []entities.Order) ([]byte, error) {
+	var sb strings.Builder
+	for i := range orders {
+		for _, content := range newLabelContents(&orders[i]) {
+			r.renderLabel(&sb, content)
+		}
+	}
+
+	return []byte(sb.String()), nil
+}
+
+func (r *ZPLLabelRenderer) renderLabel(sb *strings.Builder, content labelContent) {
+	// 1. Encabezado con tamaño de la etiqueta y codificación UTF-8
+	sb.WriteString("^XA\n^CI28\n")
+	fmt.Fprintf(sb, "^PW%d\n^LL%d\n", zplLabelWidth, zplLabelHeight)
+
+	// 2. Remitente
+	y := zplMargin
+	y = r.text(sb, y, 22, "REMITENTE")
+	y = r.text(sb, y, 30, content.SenderName)
+	if content.SenderBranch != "" {
+		y = r.text(sb, y, 22, "Sucursal: "+content.SenderBranch+phoneSuffix(content.SenderPhone))
+	}
+	for _, line := range content.SenderAddress {
+		y = r.text(sb, y, 22, line)
+	}
+	y = r.separator(sb, y)
+
+	// 3. Destinatario
+	y = r.text(sb, y, 22, "ENVIAR A")
+	y = r.text(sb, y, 40, content.RecipientName)
+	for _, line := range content.RecipientLines {
+		y = r.text(sb, y, 32, line)
+	}
+	if content.RecipientPhone != "" {
+		y = r.text(sb, y, 28, "Tel: "+content.RecipientPhone)
+	}
+	if content.AddressNotes != "" {
+		y = r.text(sb, y, 22, content.AddressNotes)
+	}
+	y = r.separator(sb, y)
+
+	// 4. Marcas de manejo especial en recuadros invertidos y datos del paquete
+	if flags := content.flags(); len(flags) > 0 {
+		x := zplMargin
+		for _, flag := range flags {
+			width := len(flag)*26 + 30
+			fmt.Fprintf(sb, "^FO%d,%d^GB%d,56,56^FS\n", x, y, width)
+			fmt.Fprintf(sb, "^FO%d,%d^FR^A0N,40,40^FD%s^FS\n", x+15, y+9, flag)
+			x += width + 15
+		}
+		y += 70
+	}
+	var packageInfo []string
+	if content.Weight != "" {
+		packageInfo = append(packageInfo, "Peso: "+content.Weight)
+	}
+	if content.Dimensions != "" {
+		packageInfo = append(packageInfo, "Dim: "+content.Dimensions)
+	}
+	if len(packageInfo) > 0 {
+		y = r.text(sb, y, 26, strings.Join(packageInfo, "   "))
+	}
+	if content.Instructions != "" {
+		y = r.text(sb, y, 22, content.Instructions)
+	}
+	y = r.separator(sb, y)
+
+	// 5. Código de barras Code-128 con el número legible y código QR
+	fmt.Fprintf(sb, "^FO%d,%d^BY2,3,120^BCN,120,Y,N,N^FD%s^FS\n", zplMargin, y+10, sanitizeZPL(content.TrackingNumber))
+	fmt.Fprintf(sb, "^FO%d,%d^BQN,2,6^FDMA,%s^FS\n", zplMargin, y+180, sanitizeZPL(content.QRData))
+
+	// 6. Número del bulto dentro del envío junto al código QR
+	if content.ParcelCaption != "" {
+		fmt.Fprintf(sb, "^FO%d,%d^A0N,34,34^FD%s^FS\n", zplLabelWidth/2, y+200, sanitizeZPL(content.ParcelCaption))
+		fmt.Fprintf(sb, "^FO%d,%d^A0N,24,24^FDPedido %s^FS\n", zplLabelWidth/2, y+244, sanitizeZPL(content.OrderTracking))
+	}
+
+	sb.WriteString("^XZ\n")
+}
+
+// text escribe una línea de texto y devuelve la posición vertical de la siguiente
+func (r *ZPLLabelRenderer) text(sb *strings.Builder, y, height int, value string) int {
+	fmt.Fprintf(sb, "^FO%d,%d^A0N,%d,%d^FB%d,1,0,L^FD%s^FS\n", zplMargin, y, height, height, zplLabelWidth-2*zplMargin, sanitizeZPL(value))
+	return y + height + 8
+}
+
+func (r *ZPLLabelRenderer) separator(sb *strings.Builder, y int) int {
+	fmt.Fprintf(sb, "^FO%d,%d^GB%d,3,3^FS\n", zplMargin, y+4, zplLabelWidth-2*zplMargin)
+	return y + 20
+}
+
+// sanitizeZPL elimina los caracteres de control de ZPL para que los datos no alteren los comandos
+func sanitizeZPL(value string) string {
+	return strings.NewReplacer("^", "", "~", "", "\n", " ", "\r", "").Replace(value)
+}
@@ -0,0 +1,78 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+// LogEmailSender, LogSMSSender y LogPushSender son implementaciones locales que registran los mensajes en el log
+// en lugar de enviarlos, útiles mientras no existan proveedores reales de correo, SMS y push
+type LogEmailSender struct{}
+
+type LogSMSSender struct{}
+
+type LogPushSender struct{}
+
+func NewLogEmailSender() ports.EmailSender {
+	return &LogEmailSender{}
+}
+
+func NewLogSMSSender() ports.SMSSender {
+	return &LogSMSSender{}
+}
+
+func NewLogPushSender() ports.PushSender {
+	return &LogPushSender{}
+}
+
+// SendEmail registra el correo destinado al usuario
+func (s *LogEmailSender) SendEmail(ctx context.Context, to, subject, body string) error {
+	logs.Info("Email notification sent", map[string]interface{}{
+		"to":      to,
+		"subject": subject,
+	})
+	logs.Debug("Email notification content", map[string]interface{}{
+		"to":   to,
+		"body": body,
+	})
+
+	return nil
+}
+
+// SendSMS registra el SMS destinado al usuario
+func (s *LogSMSSender) SendSMS(ctx context.Context, phone, message string) error {
+	logs.Info("SMS notification sent", map[string]interface{}{
+		"phone": phone,
+	})
+	logs.Debug("SMS notification content", map[string]interface{}{
+		"phone":   phone,
+		"message": message,
+	})
+
+	return nil
+}
+
+// SendPush registra la notificación push destinada al dispositivo
+func (s *LogPushSender) SendPush(ctx context.Context, deviceToken, title, body string, data map[string]string) error {
+	logs.Info("Push notification sent", map[string]interface{}{
+		"deviceToken": maskDeviceToken(deviceToken),
+		"title":       title,
+	})
+	logs.Debug("Push notification content", map[string]interface{}{
+		"deviceToken": maskDeviceToken(deviceToken),
+		"body":        body,
+		"data":        data,
+	})
+
+	return nil
+}
+
+func maskDeviceToken(token string) string {
+	if len(token) <= 8 {
+		return "****"
+	}
+
+	return token[:4] + "****" + token[len(token)-4:]
+}
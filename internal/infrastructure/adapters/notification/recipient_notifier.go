@@ -0,0 +1,48 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+)
+
+// RecipientNotifier envía los mensajes al destinatario de un pedido mediante el motor de notificaciones,
+// la notificación queda en la bandeja del cliente del pedido y el SMS se envía al teléfono del destinatario
+type RecipientNotifier struct {
+	notifier interfaces.Notifier
+}
+
+func NewRecipientNotifier(notifier interfaces.Notifier) ports.RecipientNotifier {
+	return &RecipientNotifier{
+		notifier: notifier,
+	}
+}
+
+// SendDeliveryPIN envía el PIN de entrega al destinatario, el PIN no se guarda en el contenido de la notificación
+func (n *RecipientNotifier) SendDeliveryPIN(ctx context.Context, order *entities.Order, pin string) error {
+	if order == nil || order.DeliveryAddress == nil {
+		return errPackage.ErrNilOrder
+	}
+
+	_, err := n.notifier.Notify(ctx, &entities.NotificationRequest{
+		UserID: order.ClientID,
+		Type:   constants.NotificationTypeDeliveryPIN,
+		Data: map[string]string{
+			"tracking_number": order.TrackingNumber,
+			"recipient_name":  order.DeliveryAddress.RecipientName,
+		},
+		Secrets: map[string]string{
+			"pin": pin,
+		},
+		Metadata: map[string]interface{}{
+			"order_id": order.ID,
+		},
+		Phone: order.DeliveryAddress.RecipientPhone,
+	})
+
+	return err
+}
@@ -0,0 +1,179 @@
+package payment
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	domainErr "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+// fakePaymentIDPrefix prefijo de los identificadores que emite el proveedor local
+const fakePaymentIDPrefix = "fake_pay_"
+
+// FakePaymentGateway es una implementación local de la pasarela de pagos que simula
+// las respuestas del proveedor, útil mientras no exista una integración real.
+// El estado en memoria es solo del proceso, los pagos emitidos por otro proceso o antes de un reinicio
+// se aceptan como autorizados porque el servicio de pagos ya valida el estado guardado en la base de datos
+type FakePaymentGateway struct {
+	webhookSecret string
+	mu            sync.Mutex
+	payments      map[string]*entities.GatewayResult
+}
+
+// fakeWebhookPayload cuerpo de los eventos que envía el proveedor local
+type fakeWebhookPayload struct {
+	ID            string  `json:"id"`
+	Type          string  `json:"type"`
+	PaymentID     string  `json:"payment_id"`
+	Amount        float64 `json:"amount"`
+	FailureReason string  `json:"failure_reason"`
+}
+
+func NewFakePaymentGateway(webhookSecret string) ports.PaymentGateway {
+	return &FakePaymentGateway{
+		webhookSecret: webhookSecret,
+		payments:      make(map[string]*entities.GatewayResult),
+	}
+}
+
+func (g *FakePaymentGateway) Name() string {
+	return constants.PaymentProviderFake
+}
+
+// Authorize reserva el monto del pago, el token determina la respuesta simulada
+func (g *FakePaymentGateway) Authorize(ctx context.Context, payment *entities.OrderPayment) (*entities.GatewayResult, error) {
+	if payment == nil || payment.Token == "" {
+		return nil, errPackage.NewGeneralServiceError("FakePaymentGateway", "Authorize", domainErr.ErrInvalidPaymentRequest)
+	}
+
+	result := &entities.GatewayResult{
+		ProviderPaymentID: fakePaymentIDPrefix + strings.ReplaceAll(uuid.NewString(), "-", "")[:16],
+		Amount:            payment.Amount,
+	}
+
+	switch payment.Token {
+	case constants.FakePaymentTokenDeclined:
+		result.Status = constants.PaymentStatusFailed
+		result.FailureReason = "card_declined"
+	case constants.FakePaymentTokenPending:
+		result.Status = constants.PaymentStatusPending
+	default:
+		result.Status = constants.PaymentStatusAuthorized
+	}
+
+	g.mu.Lock()
+	g.payments[result.ProviderPaymentID] = result
+	g.mu.Unlock()
+
+	logs.Info("Fake payment authorized", map[string]interface{}{
+		"orderID":           payment.OrderID,
+		"providerPaymentID": result.ProviderPaymentID,
+		"status":            result.Status,
+	})
+
+	return copyResult(result), nil
+}
+
+// Capture cobra el monto previamente autorizado
+func (g *FakePaymentGateway) Capture(ctx context.Context, providerPaymentID string, amount float64) (*entities.GatewayResult, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	payment, ok := g.payment(providerPaymentID)
+	if !ok || payment.Status != constants.PaymentStatusAuthorized {
+		return nil, errPackage.NewGeneralServiceError("FakePaymentGateway", "Capture", domainErr.ErrPaymentGatewayFailed)
+	}
+
+	payment.Status = constants.PaymentStatusCaptured
+	payment.Amount = amount
+
+	return copyResult(payment), nil
+}
+
+// Refund devuelve el monto cobrado o libera la autorización del pago
+func (g *FakePaymentGateway) Refund(ctx context.Context, providerPaymentID string, amount float64) (*entities.GatewayResult, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	payment, ok := g.payment(providerPaymentID)
+	if !ok {
+		return nil, errPackage.NewGeneralServiceError("FakePaymentGateway", "Refund", domainErr.ErrPaymentGatewayFailed)
+	}
+
+	if payment.Status != constants.PaymentStatusAuthorized && payment.Status != constants.PaymentStatusCaptured {
+		return nil, errPackage.NewGeneralServiceError("FakePaymentGateway", "Refund", domainErr.ErrPaymentGatewayFailed)
+	}
+
+	payment.Status = constants.PaymentStatusRefunded
+	payment.Amount = amount
+
+	return copyResult(payment), nil
+}
+
+// VerifyWebhook valida la firma HMAC-SHA256 del cuerpo y lo convierte en un evento del proveedor
+func (g *FakePaymentGateway) VerifyWebhook(payload []byte, signature string) (*entities.PaymentWebhookEvent, error) {
+	// 1. Validar la firma del cuerpo con el secreto compartido
+	mac := hmac.New(sha256.New, []byte(g.webhookSecret))
+	mac.Write(payload)
+	expected := hex.EncodeToString(mac.Sum(nil))
+
+	if g.webhookSecret == "" || !hmac.Equal([]byte(expected), []byte(strings.ToLower(signature))) {
+		return nil, errPackage.NewGeneralServiceError("FakePaymentGateway", "VerifyWebhook", domainErr.ErrInvalidWebhookSignature)
+	}
+
+	// 2. Decodificar el cuerpo del evento
+	var body fakeWebhookPayload
+	if err := json.Unmarshal(payload, &body); err != nil {
+		return nil, errPackage.NewGeneralServiceError("FakePaymentGateway", "VerifyWebhook", domainErr.ErrInvalidWebhookPayload)
+	}
+
+	// 3. Validar que el evento tenga identificador, pago y un tipo conocido
+	if _, ok := constants.PaymentStatusByEvent[body.Type]; !ok || body.ID == "" || body.PaymentID == "" {
+		return nil, errPackage.NewGeneralServiceError("FakePaymentGateway", "VerifyWebhook", domainErr.ErrInvalidWebhookPayload)
+	}
+
+	return &entities.PaymentWebhookEvent{
+		ID:                body.ID,
+		Provider:          constants.PaymentProviderFake,
+		Type:              body.Type,
+		ProviderPaymentID: body.PaymentID,
+		Amount:            body.Amount,
+		FailureReason:     body.FailureReason,
+	}, nil
+}
+
+// payment busca el pago en memoria, si lo emitió otro proceso del proveedor local lo registra como autorizado
+func (g *FakePaymentGateway) payment(providerPaymentID string) (*entities.GatewayResult, bool) {
+	if payment, ok := g.payments[providerPaymentID]; ok {
+		return payment, true
+	}
+
+	if !strings.HasPrefix(providerPaymentID, fakePaymentIDPrefix) {
+		return nil, false
+	}
+
+	payment := &entities.GatewayResult{
+		ProviderPaymentID: providerPaymentID,
+		Status:            constants.PaymentStatusAuthorized,
+	}
+	g.payments[providerPaymentID] = payment
+
+	return payment, true
+}
+
+func copyResult(result *entities.GatewayResult) *entities.GatewayResult {
+	copied := *result
+	return &copied
+}
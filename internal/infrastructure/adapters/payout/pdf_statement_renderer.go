@@ -0,0 +1,183 @@
+package payout
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/jung-kurt/gofpdf"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+// Medidas de la página A4 del estado de pago, en milímetros
+const (
+	pdfMargin   = 15.0
+	pdfContentW = 210.0 - 2*pdfMargin
+	pdfRowH     = 6.0
+	dateLayout  = "02/01/2006"
+)
+
+// Anchos de las columnas de la tabla de movimientos: fecha, concepto, km, base, distancia, bono, multiplicador e importe
+var pdfColumnWidths = []float64{20, 58, 14, 17, 19, 15, 15, 22}
+
+type PDFStatementRenderer struct{}
+
+// NewPDFStatementRenderer crea un generador de estados de pago PDF en tamaño A4
+func NewPDFStatementRenderer() ports.PayoutStatementRenderer {
+	return &PDFStatementRenderer{}
+}
+
+func (r *PDFStatementRenderer) ContentType() string {
+	return "application/pdf"
+}
+
+func (r *PDFStatementRenderer) FileExtension() string {
+	return "pdf"
+}
+
+func (r *PDFStatementRenderer) Render(statement *entities.PayoutStatement) ([]byte, error) {
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(pdfMargin, pdfMargin, pdfMargin)
+	pdf.SetAutoPageBreak(true, pdfMargin)
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+	pdf.AddPage()
+
+	r.renderHeader(pdf, tr, statement)
+	r.renderEntries(pdf, tr, statement)
+	r.renderTotals(pdf, tr, statement)
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		logs.Error("Failed to render PDF payout statement", map[string]interface{}{
+			"statementID": statement.ID,
+			"error":       err.Error(),
+		})
+		return nil, errPackage.NewGeneralServiceError("PDFStatementRenderer", "Render", errPackage.ErrFailedToRenderPayout)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// renderHeader escribe el número del estado de pago, el repartidor y el periodo liquidado
+func (r *PDFStatementRenderer) renderHeader(pdf *gofpdf.Fpdf, tr func(string) string, statement *entities.PayoutStatement) {
+	// 1. Título
+	pdf.SetFont("Helvetica", "B", 18)
+	pdf.CellFormat(pdfContentW/2, 10, tr("ESTADO DE PAGO"), "", 0, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "B", 11)
+	pdf.CellFormat(pdfContentW/2, 10, tr(statement.StatementNumber), "", 1, "R", false, 0, "")
+	pdf.Ln(2)
+
+	// 2. Repartidor
+	pdf.SetFont("Helvetica", "B", 9)
+	pdf.CellFormat(pdfContentW, 5, tr("REPARTIDOR"), "", 1, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 10)
+	if statement.Driver != nil && statement.Driver.User != nil {
+		pdf.CellFormat(pdfContentW, 5, tr(statement.Driver.User.FullName), "", 1, "L", false, 0, "")
+		pdf.CellFormat(pdfContentW, 5, tr("Vehículo: "+statement.Driver.VehicleType+" "+statement.Driver.VehiclePlate), "", 1, "L", false, 0, "")
+	} else {
+		pdf.CellFormat(pdfContentW, 5, tr(statement.DriverID), "", 1, "L", false, 0, "")
+	}
+	pdf.Ln(3)
+
+	// 3. Periodo
+	pdf.SetFont("Helvetica", "", 9)
+	period := fmt.Sprintf("Semana: %s - %s", statement.PeriodStart.Format(dateLayout), statement.PeriodEnd.AddDate(0, 0, -1).Format(dateLayout))
+	pdf.CellFormat(pdfContentW, 5, tr(period), "", 1, "L", false, 0, "")
+	pdf.CellFormat(pdfContentW, 5, tr("Fecha de emisión: "+statement.CreatedAt.Format(dateLayout)), "", 1, "L", false, 0, "")
+	pdf.Ln(4)
+}
+
+// renderEntries escribe la tabla de entregas y ajustes repitiendo el encabezado en cada página
+func (r *PDFStatementRenderer) renderEntries(pdf *gofpdf.Fpdf, tr func(string) string, statement *entities.PayoutStatement) {
+	headers := []string{"Fecha", "Concepto", "Km", "Base", "Distancia", "Bono", "Mult.", "Importe"}
+	aligns := []string{"C", "L", "R", "R", "R", "R", "R", "R"}
+
+	writeHeader := func() {
+		pdf.SetFont("Helvetica", "B", 8)
+		pdf.SetFillColor(230, 230, 230)
+		for i, header := range headers {
+			pdf.CellFormat(pdfColumnWidths[i], pdfRowH, tr(header), "1", 0, aligns[i], true, 0, "")
+		}
+		pdf.Ln(-1)
+		pdf.SetFont("Helvetica", "", 8)
+	}
+
+	_, pageHeight := pdf.GetPageSize()
+	writeHeader()
+	for _, entry := range statement.Entries {
+		if pdf.GetY()+pdfRowH > pageHeight-pdfMargin {
+			pdf.AddPage()
+			writeHeader()
+		}
+
+		values := []string{entry.CreatedAt.Format(dateLayout), entryConcept(entry), "", "", "", "", "", formatAmount(entry.Amount)}
+		if entry.Type == constants.EarningTypeDelivery {
+			values[2] = formatAmount(entry.Distance)
+			values[3] = formatAmount(entry.BaseAmount)
+			values[4] = formatAmount(entry.DistanceAmount)
+			values[5] = formatAmount(entry.UrgentBonus)
+			values[6] = fmt.Sprintf("x%.2f", entry.ZoneMultiplier)
+		}
+
+		for i, value := range values {
+			pdf.CellFormat(pdfColumnWidths[i], pdfRowH, tr(value), "1", 0, aligns[i], false, 0, "")
+		}
+		pdf.Ln(-1)
+	}
+	pdf.Ln(4)
+}
+
+// renderTotals escribe el resumen de entregas, ajustes y total a pagar
+func (r *PDFStatementRenderer) renderTotals(pdf *gofpdf.Fpdf, tr func(string) string, statement *entities.PayoutStatement) {
+	labelW := pdfContentW - 40
+	rows := []struct {
+		label  string
+		amount string
+		bold   bool
+	}{
+		{"Entregas realizadas", fmt.Sprintf("%d", statement.DeliveryCount), false},
+		{"Ganancias por entregas", formatAmount(statement.DeliveryTotal), false},
+		{"Ajustes", formatAmount(statement.AdjustmentTotal), false},
+		{"TOTAL A PAGAR " + statement.Currency, formatAmount(statement.Total), true},
+	}
+
+	for _, row := range rows {
+		style := ""
+		if row.bold {
+			style = "B"
+		}
+		pdf.SetFont("Helvetica", style, 10)
+		pdf.CellFormat(labelW, pdfRowH, tr(row.label), "", 0, "R", false, 0, "")
+		pdf.CellFormat(40, pdfRowH, tr(row.amount), "", 1, "R", false, 0, "")
+	}
+}
+
+// entryConcept describe el movimiento con el número de seguimiento del pedido o el motivo del ajuste
+func entryConcept(entry entities.DriverEarning) string {
+	if entry.Type == constants.EarningTypeAdjustment {
+		return truncate("Ajuste: "+entry.Reason, 38)
+	}
+
+	if entry.Order != nil {
+		return "Entrega " + entry.Order.TrackingNumber
+	}
+
+	return "Entrega"
+}
+
+func truncate(value string, max int) string {
+	runes := []rune(value)
+	if len(runes) <= max {
+		return value
+	}
+
+	return string(runes[:max-3]) + "..."
+}
+
+func formatAmount(amount float64) string {
+	return fmt.Sprintf("%.2f", amount)
+}
@@ -0,0 +1,72 @@
+package realtime
+
+import (
+	"sync"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+// inboxSubscriberBuffer eventos que puede acumular un cliente lento antes de descartar los nuevos
+const inboxSubscriberBuffer = 16
+
+// InboxHub mantiene en memoria los clientes conectados a la bandeja de cada usuario y les reenvía
+// los eventos publicados, los eventos de usuarios sin clientes conectados se descartan
+type InboxHub struct {
+	mu          sync.RWMutex
+	subscribers map[string]map[chan entities.InboxEvent]struct{}
+}
+
+func NewInboxHub() *InboxHub {
+	return &InboxHub{
+		subscribers: make(map[string]map[chan entities.InboxEvent]struct{}),
+	}
+}
+
+// Subscribe registra un cliente de la bandeja del usuario, la función devuelta lo desconecta
+func (h *InboxHub) Subscribe(userID string) (<-chan entities.InboxEvent, func()) {
+	events := make(chan entities.InboxEvent, inboxSubscriberBuffer)
+
+	h.mu.Lock()
+	if h.subscribers[userID] == nil {
+		h.subscribers[userID] = make(map[chan entities.InboxEvent]struct{})
+	}
+	h.subscribers[userID][events] = struct{}{}
+	h.mu.Unlock()
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			h.mu.Lock()
+			delete(h.subscribers[userID], events)
+			if len(h.subscribers[userID]) == 0 {
+				delete(h.subscribers, userID)
+			}
+			h.mu.Unlock()
+			close(events)
+		})
+	}
+
+	return events, unsubscribe
+}
+
+// PublishInboxEvent envía el evento a cada cliente conectado del usuario sin bloquear al publicador
+func (h *InboxHub) PublishInboxEvent(userID string, event *entities.InboxEvent) {
+	if event == nil {
+		return
+	}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for events := range h.subscribers[userID] {
+		select {
+		case events <- *event:
+		default:
+			logs.Warn("Inbox subscriber is not keeping up, event discarded", map[string]interface{}{
+				"userID": userID,
+				"type":   event.Type,
+			})
+		}
+	}
+}
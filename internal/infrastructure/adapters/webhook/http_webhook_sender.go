@@ -0,0 +1,107 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/value_objects"
+	domainErr "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+)
+
+const (
+	// httpWebhookTimeout tiempo máximo de espera de la respuesta del webhook
+	httpWebhookTimeout = 10 * time.Second
+
+	// httpWebhookResponseLimit bytes de la respuesta del webhook que se leen
+	httpWebhookResponseLimit = 4096
+
+	httpWebhookUserAgent = "delivery-backend-webhooks/1.0"
+)
+
+// HTTPWebhookSender envía los eventos firmados a los webhooks de las empresas mediante HTTP POST
+type HTTPWebhookSender struct {
+	client *http.Client
+}
+
+// NewHTTPWebhookSender crea el cliente de webhooks, solo se conecta a IPs públicas para que una empresa no pueda
+// alcanzar servicios internos; la IP se verifica al conectar, después de resolver el nombre, lo que también cubre
+// el cambio de resolución de DNS entre la validación y el envío
+func NewHTTPWebhookSender() ports.WebhookSender {
+	dialer := &net.Dialer{
+		Timeout: httpWebhookTimeout,
+		Control: rejectNonPublicAddress,
+	}
+
+	return &HTTPWebhookSender{
+		client: &http.Client{
+			Timeout: httpWebhookTimeout,
+			Transport: &http.Transport{
+				// Sin proxy, la conexión debe ir directamente a la IP verificada
+				Proxy:               nil,
+				DialContext:         dialer.DialContext,
+				TLSHandshakeTimeout: httpWebhookTimeout,
+				MaxIdleConns:        100,
+				IdleConnTimeout:     90 * time.Second,
+			},
+			// Las redirecciones no se siguen, el webhook debe responder en la dirección registrada
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+// Send envía el evento y devuelve la respuesta del webhook, cualquier código de estado se considera una respuesta
+func (s *HTTPWebhookSender) Send(ctx context.Context, request *entities.WebhookRequest) (*entities.WebhookResponse, error) {
+	// 1. Construir la solicitud
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, request.URL, bytes.NewReader(request.Body))
+	if err != nil {
+		return nil, errPackage.NewGeneralServiceError("HTTPWebhookSender", "Send", domainErr.ErrInvalidWebhookURL)
+	}
+
+	httpRequest.Header.Set("User-Agent", httpWebhookUserAgent)
+	for key, value := range request.Headers {
+		httpRequest.Header.Set(key, value)
+	}
+
+	// 2. Enviar el evento
+	start := time.Now()
+	httpResponse, err := s.client.Do(httpRequest)
+	if err != nil {
+		return nil, errPackage.NewGeneralServiceError("HTTPWebhookSender", "Send", err)
+	}
+	defer httpResponse.Body.Close()
+
+	// 3. Leer la respuesta
+	body, _ := io.ReadAll(io.LimitReader(httpResponse.Body, httpWebhookResponseLimit))
+
+	return &entities.WebhookResponse{
+		StatusCode: httpResponse.StatusCode,
+		Body:       string(body),
+		Duration:   time.Since(start),
+	}, nil
+}
+
+// rejectNonPublicAddress se ejecuta antes de cada conexión con la IP ya resuelta y la rechaza si no es pública,
+// Send envuelve el error en un error de servicio
+func rejectNonPublicAddress(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return errPackage.ErrWebhookTargetNotAllowed
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil || !value_objects.IsPublicIP(ip) {
+		return errPackage.ErrWebhookTargetNotAllowed
+	}
+
+	return nil
+}
@@ -156,6 +156,9 @@ type BranchMetricsResponse struct {
 	// Tiempo promedio de entrega (en minutos)
 	AverageDeliveryTime float64 `json:"average_delivery_time" example:"40.2"`
 
+	// Tasa de entregas realizadas antes del plazo de entrega (porcentaje)
+	OnTimeDeliveryRate float64 `json:"on_time_delivery_rate" example:"91.7"`
+
 	// Ingresos totales generados
 	TotalRevenue float64 `json:"total_revenue" example:"5000.75"`
 
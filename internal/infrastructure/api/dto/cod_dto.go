@@ -0,0 +1,174 @@
+package dto
+
+import (
+	"strings"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/value_objects"
+	domainErr "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	infraErr "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+)
+
+// CashReconciliationRequest represents the request body for reconciling the cash handed in by a driver
+// @Description Request structure for the end-of-day reconciliation of a driver's cash on delivery collections
+type CashReconciliationRequest struct {
+	// Driver who hands in the cash
+	// @required
+	DriverID string `json:"driver_id" example:"d1e2f3g4-h5i6-j7k8-l9m0-n1o2p3q4r5s6" binding:"required"`
+
+	// ISO 4217 currency of the handed in cash
+	// @required
+	Currency string `json:"currency" example:"USD" binding:"required"`
+
+	// Amount of cash handed in by the driver
+	// @required
+	HandedInAmount float64 `json:"handed_in_amount" example:"125.50" binding:"required"`
+
+	// Additional notes about the reconciliation
+	Notes string `json:"notes,omitempty" example:"Missing change from order DEL250115TE68JBQF14V"`
+}
+
+func (r *CashReconciliationRequest) Validate() error {
+	if r.DriverID == "" {
+		return infraErr.NewGeneralServiceError("CODDTO", "Validate", domainErr.ErrDriverIDRequired)
+	}
+
+	if !value_objects.NewMoneyAmount(0, strings.ToUpper(r.Currency)).HasValidCurrency() {
+		return infraErr.NewGeneralServiceError("CODDTO", "Validate", domainErr.ErrInvalidCODAmount)
+	}
+
+	if r.HandedInAmount < 0 {
+		return infraErr.NewGeneralServiceError("CODDTO", "Validate", domainErr.ErrInvalidHandInAmount)
+	}
+
+	return nil
+}
+
+// CashBalanceResponse represents the cash a driver still has to hand in for a currency
+// @Description Pending cash balance of a driver
+type CashBalanceResponse struct {
+	// ISO 4217 currency
+	Currency string `json:"currency" example:"USD"`
+
+	// Amount pending to be handed in
+	Amount float64 `json:"amount" example:"125.50"`
+}
+
+// CashLedgerEntryResponse represents a movement of the driver's cash ledger
+// @Description Cash on delivery collection or cash hand-in of a driver
+type CashLedgerEntryResponse struct {
+	// Unique identifier of the movement
+	ID string `json:"id" example:"f1e2d3c4-b5a6-7980-1a2b-3c4d5e6f7a8b"`
+
+	// Type of movement
+	Type string `json:"type" example:"COLLECTION" enums:"COLLECTION,HAND_IN"`
+
+	// Amount of the movement, hand-ins are negative
+	Amount float64 `json:"amount" example:"25.00"`
+
+	// ISO 4217 currency
+	Currency string `json:"currency" example:"USD"`
+
+	// Order collected, only for collections
+	OrderID *string `json:"order_id,omitempty" example:"a1b2c3d4-e5f6-7g8h-9i0j-k1l2m3n4o5p6"`
+
+	// Tracking number of the order collected
+	TrackingNumber string `json:"tracking_number,omitempty" example:"DEL250115TE68JBQF14V"`
+
+	// Reconciliation that settled the movement
+	ReconciliationID *string `json:"reconciliation_id,omitempty" example:"c1d2e3f4-a5b6-7c8d-9e0f-1a2b3c4d5e6f"`
+
+	// When the movement was registered
+	CreatedAt time.Time `json:"created_at" format:"date-time"`
+}
+
+// CashLedgerResponse represents the cash ledger of a driver
+// @Description Cash ledger of a driver with its pending balances
+type CashLedgerResponse struct {
+	// Driver ID
+	DriverID string `json:"driver_id" example:"d1e2f3g4-h5i6-j7k8-l9m0-n1o2p3q4r5s6"`
+
+	// Cash pending to be handed in per currency
+	Balances []CashBalanceResponse `json:"balances"`
+
+	// Movements of the requested period
+	Entries []CashLedgerEntryResponse `json:"entries"`
+}
+
+// CashReconciliationResponse represents the result of a cash reconciliation
+// @Description Reconciliation of the cash handed in by a driver against the collections pending
+type CashReconciliationResponse struct {
+	// Unique identifier of the reconciliation
+	ID string `json:"id" example:"c1d2e3f4-a5b6-7c8d-9e0f-1a2b3c4d5e6f"`
+
+	// Driver ID
+	DriverID string `json:"driver_id" example:"d1e2f3g4-h5i6-j7k8-l9m0-n1o2p3q4r5s6"`
+
+	// ISO 4217 currency
+	Currency string `json:"currency" example:"USD"`
+
+	// Cash the driver was expected to hand in
+	ExpectedAmount float64 `json:"expected_amount" example:"125.50"`
+
+	// Cash the driver handed in
+	HandedInAmount float64 `json:"handed_in_amount" example:"120.50"`
+
+	// Handed in minus expected, negative when cash is missing
+	Difference float64 `json:"difference" example:"-5.00"`
+
+	// Result of the reconciliation
+	Status string `json:"status" example:"SHORT" enums:"BALANCED,SHORT,OVER"`
+
+	// Number of collections settled
+	Collections int `json:"collections" example:"6"`
+
+	// User who performed the reconciliation
+	ReconciledByID string `json:"reconciled_by_id" example:"u1v2w3x4-y5z6-a7b8-c9d0-e1f2g3h4i5j6"`
+
+	// Additional notes
+	Notes string `json:"notes,omitempty" example:"Missing change from order DEL250115TE68JBQF14V"`
+
+	// When the reconciliation was performed
+	CreatedAt time.Time `json:"created_at" format:"date-time"`
+}
+
+// CODSettlementResponse represents the cash on delivery owed to a company in a currency
+// @Description Settlement report of the cash on delivery collected for a company
+type CODSettlementResponse struct {
+	// Company ID
+	CompanyID string `json:"company_id" example:"c1d2e3f4-a5b6-7c8d-9e0f-1a2b3c4d5e6f"`
+
+	// ISO 4217 currency
+	Currency string `json:"currency" example:"USD"`
+
+	// Number of orders collected
+	Orders int64 `json:"orders" example:"42"`
+
+	// Total collected from recipients, owed to the company
+	OwedAmount float64 `json:"owed_amount" example:"1050.00"`
+
+	// Part of the owed amount already handed in by the drivers
+	HandedInAmount float64 `json:"handed_in_amount" example:"900.00"`
+
+	// Part of the owed amount still held by the drivers
+	PendingAmount float64 `json:"pending_amount" example:"150.00"`
+
+	// Collections included in the settlement
+	Collections []CashLedgerEntryResponse `json:"collections"`
+}
+
+// CODSettlementReportResponse represents the settlement report of a company for a period
+// @Description Cash on delivery settlement report per currency
+type CODSettlementReportResponse struct {
+	// Company ID
+	CompanyID string `json:"company_id" example:"c1d2e3f4-a5b6-7c8d-9e0f-1a2b3c4d5e6f"`
+
+	// Start of the period
+	StartDate time.Time `json:"start_date" format:"date-time"`
+
+	// End of the period
+	EndDate time.Time `json:"end_date" format:"date-time"`
+
+	// Settlement per currency
+	Settlements []CODSettlementResponse `json:"settlements"`
+}
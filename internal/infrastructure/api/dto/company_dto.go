@@ -30,6 +30,12 @@ type CompanyCreateRequest struct {
 	// Tarifa base de entrega
 	DeliveryRate float64 `json:"delivery_rate" example:"20.50" binding:"required"`
 
+	// Máximo de intentos de entrega antes de devolver el pedido al remitente (opcional, por defecto 3)
+	MaxDeliveryAttempts int `json:"max_delivery_attempts,omitempty" example:"3"`
+
+	// Prefijo de 2 a 5 letras de los números de seguimiento de sus pedidos (opcional, por defecto DEL)
+	TrackingPrefix string `json:"tracking_prefix,omitempty" example:"EXP"`
+
 	// URL del logo de la empresa (opcional)
 	LogoURL string `json:"logo_url,omitempty" example:"https://www.example.com/logo.png"`
 
@@ -117,6 +123,12 @@ type CompanyUpdateRequest struct {
 	// Tarifa base de entrega
 	DeliveryRate *float64 `json:"delivery_rate,omitempty" example:"20.50"`
 
+	// Máximo de intentos de entrega antes de devolver el pedido al remitente
+	MaxDeliveryAttempts *int `json:"max_delivery_attempts,omitempty" example:"3"`
+
+	// Prefijo de 2 a 5 letras de los números de seguimiento de sus pedidos
+	TrackingPrefix string `json:"tracking_prefix,omitempty" example:"EXP"`
+
 	// URL del logo de la empresa
 	LogoURL string `json:"logo_url,omitempty" example:"https://www.example.com/logo.png"`
 
@@ -156,6 +168,12 @@ type CompanyResponse struct {
 	// Tarifa base de entrega
 	DeliveryRate float64 `json:"delivery_rate" example:"20.50"`
 
+	// Máximo de intentos de entrega antes de devolver el pedido al remitente
+	MaxDeliveryAttempts int `json:"max_delivery_attempts" example:"3"`
+
+	// Prefijo de los números de seguimiento de sus pedidos
+	TrackingPrefix string `json:"tracking_prefix" example:"EXP"`
+
 	// URL del logo de la empresa
 	LogoURL string `json:"logo_url,omitempty" example:"https://www.example.com/logo.png"`
 
@@ -243,6 +261,9 @@ type CompanyMetricsResponse struct {
 	// Tiempo promedio de entrega (en minutos)
 	AverageDeliveryTime float64 `json:"average_delivery_time" example:"45.5"`
 
+	// Tasa de entregas realizadas antes del plazo de entrega (porcentaje)
+	OnTimeDeliveryRate float64 `json:"on_time_delivery_rate" example:"93.4"`
+
 	// Ingresos totales generados
 	TotalRevenue float64 `json:"total_revenue" example:"25000.50"`
 
@@ -0,0 +1,248 @@
+package dto
+
+import (
+	"strings"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	domainErr "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	infraErr "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+)
+
+// EarningRuleRequest represents the request body for creating or updating an earning rule
+// @Description Rates used to compute the earning of a driver for each delivery, a rule without zone is the default rule
+type EarningRuleRequest struct {
+	// Zone where the rule applies, omit it for the default rule. Cannot be changed once created
+	ZoneID *string `json:"zone_id,omitempty" example:"z1a2b3c4-d5e6-7f8g-9h0i-j1k2l3m4n5o6"`
+
+	// Fixed amount paid per delivery
+	// @required
+	BasePerDelivery float64 `json:"base_per_delivery" example:"2.50" binding:"required"`
+
+	// Amount paid per kilometer of the delivery
+	// @required
+	PerKmRate float64 `json:"per_km_rate" example:"0.35" binding:"required"`
+
+	// Bonus paid for urgent packages
+	UrgentBonus float64 `json:"urgent_bonus" example:"1.50"`
+
+	// Multiplier applied to the earning in the zone, 1 by default
+	ZoneMultiplier *float64 `json:"zone_multiplier,omitempty" example:"1.20"`
+
+	// Whether the rule is applied, true by default
+	IsActive *bool `json:"is_active,omitempty" example:"true"`
+}
+
+func (r *EarningRuleRequest) Validate() error {
+	if r.BasePerDelivery < 0 || r.PerKmRate < 0 || r.UrgentBonus < 0 {
+		return infraErr.NewGeneralServiceError("EarningDTO", "Validate", domainErr.ErrInvalidEarningRule)
+	}
+
+	if r.ZoneMultiplier != nil && (*r.ZoneMultiplier <= 0 || *r.ZoneMultiplier > constants.MaxZoneMultiplier) {
+		return infraErr.NewGeneralServiceError("EarningDTO", "Validate", domainErr.ErrInvalidEarningRule)
+	}
+
+	return nil
+}
+
+// EarningAdjustmentRequest represents the request body for adding an adjustment to the earnings of a driver
+// @Description Positive or negative amount added to the earnings ledger of a driver with its reason
+type EarningAdjustmentRequest struct {
+	// Driver that receives the adjustment
+	// @required
+	DriverID string `json:"driver_id" example:"d1e2f3a4-b5c6-7d8e-9f0a-1b2c3d4e5f6a" binding:"required"`
+
+	// Amount of the adjustment, negative to deduct
+	// @required
+	Amount float64 `json:"amount" example:"-5.00" binding:"required"`
+
+	// Reason of the adjustment
+	// @required
+	Reason string `json:"reason" example:"Daño en paquete por mala manipulación" binding:"required"`
+}
+
+func (r *EarningAdjustmentRequest) Validate() error {
+	if r.DriverID == "" || r.Amount == 0 || strings.TrimSpace(r.Reason) == "" {
+		return infraErr.NewGeneralServiceError("EarningDTO", "Validate", domainErr.ErrInvalidAdjustment)
+	}
+
+	return nil
+}
+
+// PayoutStatementGenerateRequest represents the request body for generating the payout statement of a driver
+// @Description Request structure for settling the pending earnings of a driver, by default for the previous week
+type PayoutStatementGenerateRequest struct {
+	// Driver to pay
+	// @required
+	DriverID string `json:"driver_id" example:"d1e2f3a4-b5c6-7d8e-9f0a-1b2c3d4e5f6a" binding:"required"`
+
+	// Start of the payout period, defaults to the monday of the previous week
+	PeriodStart *time.Time `json:"period_start,omitempty" example:"2025-01-06T00:00:00Z" format:"date-time"`
+
+	// End of the payout period (exclusive), defaults to the monday of the current week
+	PeriodEnd *time.Time `json:"period_end,omitempty" example:"2025-01-13T00:00:00Z" format:"date-time"`
+}
+
+func (r *PayoutStatementGenerateRequest) Validate() error {
+	if r.DriverID == "" {
+		return infraErr.NewGeneralServiceError("EarningDTO", "Validate", domainErr.ErrDriverIDRequired)
+	}
+
+	if (r.PeriodStart == nil) != (r.PeriodEnd == nil) {
+		return infraErr.NewGeneralServiceError("EarningDTO", "Validate", domainErr.ErrInvalidPayoutPeriod)
+	}
+
+	return nil
+}
+
+// EarningRuleResponse represents an earning rule
+// @Description Rates used to compute the earning of a driver for each delivery
+type EarningRuleResponse struct {
+	// Unique identifier of the rule
+	ID string `json:"id" example:"e1a2b3c4-d5e6-7f8a-9b0c-d1e2f3a4b5c6"`
+
+	// Zone where the rule applies, empty for the default rule
+	ZoneID *string `json:"zone_id,omitempty" example:"z1a2b3c4-d5e6-7f8g-9h0i-j1k2l3m4n5o6"`
+
+	// Name of the zone
+	ZoneName string `json:"zone_name,omitempty" example:"San Salvador Centro"`
+
+	// Fixed amount paid per delivery
+	BasePerDelivery float64 `json:"base_per_delivery" example:"2.50"`
+
+	// Amount paid per kilometer
+	PerKmRate float64 `json:"per_km_rate" example:"0.35"`
+
+	// Bonus paid for urgent packages
+	UrgentBonus float64 `json:"urgent_bonus" example:"1.50"`
+
+	// Multiplier applied in the zone
+	ZoneMultiplier float64 `json:"zone_multiplier" example:"1.20"`
+
+	// Whether the rule is applied
+	IsActive bool `json:"is_active" example:"true"`
+
+	// When the rule was last updated
+	UpdatedAt time.Time `json:"updated_at" format:"date-time"`
+}
+
+// DriverEarningResponse represents an entry of the earnings ledger of a driver
+// @Description Earning of a delivery with its breakdown or an adjustment with its reason
+type DriverEarningResponse struct {
+	// Unique identifier of the entry
+	ID string `json:"id" example:"a9b8c7d6-e5f4-3a2b-1c0d-e9f8a7b6c5d4"`
+
+	// Type of entry
+	Type string `json:"type" example:"DELIVERY" enums:"DELIVERY,ADJUSTMENT"`
+
+	// Order delivered
+	OrderID *string `json:"order_id,omitempty" example:"a1b2c3d4-e5f6-7g8h-9i0j-k1l2m3n4o5p6"`
+
+	// Tracking number of the order delivered
+	TrackingNumber string `json:"tracking_number,omitempty" example:"DEL250115TE68JBQF14V"`
+
+	// Base amount of the delivery
+	BaseAmount float64 `json:"base_amount,omitempty" example:"2.50"`
+
+	// Kilometers of the delivery
+	Distance float64 `json:"distance,omitempty" example:"8.40"`
+
+	// Amount paid for the distance
+	DistanceAmount float64 `json:"distance_amount,omitempty" example:"2.94"`
+
+	// Bonus paid because the package was urgent
+	UrgentBonus float64 `json:"urgent_bonus,omitempty" example:"1.50"`
+
+	// Multiplier of the zone applied
+	ZoneMultiplier float64 `json:"zone_multiplier,omitempty" example:"1.20"`
+
+	// Total amount of the entry
+	Amount float64 `json:"amount" example:"8.33"`
+
+	// ISO 4217 currency
+	Currency string `json:"currency" example:"USD"`
+
+	// Reason of the adjustment
+	Reason string `json:"reason,omitempty" example:"Daño en paquete por mala manipulación"`
+
+	// Payout statement that settled the entry
+	StatementID *string `json:"statement_id,omitempty" example:"p1a2b3c4-d5e6-7f8a-9b0c-d1e2f3a4b5c6"`
+
+	// When the entry was registered
+	CreatedAt time.Time `json:"created_at" format:"date-time"`
+}
+
+// DriverEarningsResponse represents the earnings ledger of a driver in a period
+// @Description Earnings and adjustments of a driver in a period with their totals
+type DriverEarningsResponse struct {
+	// Driver of the ledger
+	DriverID string `json:"driver_id" example:"d1e2f3a4-b5c6-7d8e-9f0a-1b2c3d4e5f6a"`
+
+	// Number of deliveries in the period
+	DeliveryCount int `json:"delivery_count" example:"42"`
+
+	// Sum of the delivery earnings
+	DeliveryTotal float64 `json:"delivery_total" example:"312.45"`
+
+	// Sum of the adjustments
+	AdjustmentTotal float64 `json:"adjustment_total" example:"-5.00"`
+
+	// Total earned in the period
+	Total float64 `json:"total" example:"307.45"`
+
+	// ISO 4217 currency
+	Currency string `json:"currency" example:"USD"`
+
+	// Entries of the ledger
+	Entries []DriverEarningResponse `json:"entries"`
+}
+
+// PayoutStatementResponse represents the weekly payout statement of a driver
+// @Description Statement of the earnings and adjustments paid to a driver for a week
+type PayoutStatementResponse struct {
+	// Unique identifier of the statement
+	ID string `json:"id" example:"p1a2b3c4-d5e6-7f8a-9b0c-d1e2f3a4b5c6"`
+
+	// Statement number
+	StatementNumber string `json:"statement_number" example:"PAY-20250106-3F9A1C2B"`
+
+	// Driver paid
+	DriverID string `json:"driver_id" example:"d1e2f3a4-b5c6-7d8e-9f0a-1b2c3d4e5f6a"`
+
+	// Name of the driver paid
+	DriverName string `json:"driver_name,omitempty" example:"Juan Pérez"`
+
+	// Start of the payout period
+	PeriodStart time.Time `json:"period_start" format:"date-time"`
+
+	// End of the payout period (exclusive)
+	PeriodEnd time.Time `json:"period_end" format:"date-time"`
+
+	// Number of deliveries paid
+	DeliveryCount int `json:"delivery_count" example:"42"`
+
+	// Sum of the delivery earnings
+	DeliveryTotal float64 `json:"delivery_total" example:"312.45"`
+
+	// Sum of the adjustments
+	AdjustmentTotal float64 `json:"adjustment_total" example:"-5.00"`
+
+	// Total to pay
+	Total float64 `json:"total" example:"307.45"`
+
+	// ISO 4217 currency
+	Currency string `json:"currency" example:"USD"`
+
+	// Entries settled, only included when getting a single statement
+	Entries []DriverEarningResponse `json:"entries,omitempty"`
+
+	// When the statement was generated
+	CreatedAt time.Time `json:"created_at" format:"date-time"`
+}
+
+// PayoutStatementDocument is the file returned by the payout statement download endpoint
+type PayoutStatementDocument struct {
+	Content     []byte
+	ContentType string
+	FileName    string
+}
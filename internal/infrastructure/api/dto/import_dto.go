@@ -0,0 +1,64 @@
+package dto
+
+import "time"
+
+// OrderImportRow is a single order read from an import file
+// Parse errors are kept per row so they can be reported without rejecting the whole file
+type OrderImportRow struct {
+	Row   int                 `json:"row"`
+	Order *OrderCreateRequest `json:"order,omitempty"`
+	Error string              `json:"error,omitempty"`
+}
+
+// OrderImportRowResult represents the result of a single row of an import
+// @Description Result of a single imported order
+type OrderImportRowResult struct {
+	// Row number inside the import file, starting at 1
+	Row int `json:"row" example:"1"`
+
+	// Result of the row
+	Status string `json:"status" example:"CREATED" enums:"CREATED,FAILED"`
+
+	// Identifier of the created order
+	OrderID string `json:"order_id,omitempty" example:"a1b2c3d4-e5f6-7g8h-9i0j-k1l2m3n4o5p6"`
+
+	// Tracking number of the created order
+	TrackingNumber string `json:"tracking_number,omitempty" example:"DEL250115TE68JBQF14V"`
+
+	// Validation error of the row, if any
+	Error string `json:"error,omitempty" example:"client ID is required"`
+}
+
+// ImportJobResponse represents the response for a bulk order import
+// @Description Bulk order import information
+type ImportJobResponse struct {
+	// Unique identifier of the import
+	ID string `json:"id" example:"e5f6a7b8-c9d0-1e2f-3a4b-5c6d7e8f9a0b"`
+
+	// Format of the imported file
+	Format string `json:"format" example:"CSV" enums:"CSV,JSON"`
+
+	// Status of the import
+	Status string `json:"status" example:"COMPLETED" enums:"PENDING,PROCESSING,COMPLETED,FAILED"`
+
+	// Number of orders in the file
+	TotalRows int `json:"total_rows" example:"120"`
+
+	// Number of orders created
+	CreatedRows int `json:"created_rows" example:"118"`
+
+	// Number of rows that could not be imported
+	FailedRows int `json:"failed_rows" example:"2"`
+
+	// General error of the import, if any
+	ErrorMessage string `json:"error_message,omitempty"`
+
+	// When the import started processing
+	StartedAt *time.Time `json:"started_at,omitempty" format:"date-time"`
+
+	// When the import finished processing
+	FinishedAt *time.Time `json:"finished_at,omitempty" format:"date-time"`
+
+	// When the import was uploaded
+	CreatedAt time.Time `json:"created_at" format:"date-time"`
+}
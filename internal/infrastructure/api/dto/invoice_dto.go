@@ -0,0 +1,165 @@
+package dto
+
+import (
+	"time"
+
+	domainErr "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	infraErr "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+)
+
+// InvoiceGenerateRequest represents the request body for generating the invoice of a company
+// @Description Request structure for generating a draft invoice from the delivered orders of a billing period
+type InvoiceGenerateRequest struct {
+	// Company to invoice
+	// @required
+	CompanyID string `json:"company_id" example:"c1d2e3f4-a5b6-7c8d-9e0f-1a2b3c4d5e6f" binding:"required"`
+
+	// Start of the billing period, defaults to the start of the previous month
+	PeriodStart *time.Time `json:"period_start,omitempty" example:"2025-01-01T00:00:00Z" format:"date-time"`
+
+	// End of the billing period (exclusive), defaults to the start of the current month
+	PeriodEnd *time.Time `json:"period_end,omitempty" example:"2025-02-01T00:00:00Z" format:"date-time"`
+
+	// Tax rate applied to the invoice, 0.13 by default
+	TaxRate *float64 `json:"tax_rate,omitempty" example:"0.13"`
+}
+
+func (r *InvoiceGenerateRequest) Validate() error {
+	if r.CompanyID == "" {
+		return infraErr.NewGeneralServiceError("InvoiceDTO", "Validate", domainErr.ErrCompanyIDRequired)
+	}
+
+	if (r.PeriodStart == nil) != (r.PeriodEnd == nil) {
+		return infraErr.NewGeneralServiceError("InvoiceDTO", "Validate", domainErr.ErrInvalidBillingPeriod)
+	}
+
+	if r.TaxRate != nil && (*r.TaxRate < 0 || *r.TaxRate > 1) {
+		return infraErr.NewGeneralServiceError("InvoiceDTO", "Validate", domainErr.ErrInvalidTaxRate)
+	}
+
+	return nil
+}
+
+// InvoiceStatusRequest represents the request body for changing the status of an invoice
+// @Description Request structure for issuing, paying or voiding an invoice
+type InvoiceStatusRequest struct {
+	// New status of the invoice
+	// @required
+	Status string `json:"status" example:"ISSUED" binding:"required" enums:"ISSUED,PAID,VOID"`
+
+	// Reason of the change, required to void an invoice
+	Reason string `json:"reason,omitempty" example:"Duplicated billing period"`
+}
+
+func (r *InvoiceStatusRequest) Validate() error {
+	if r.Status == "" {
+		return infraErr.NewGeneralServiceError("InvoiceDTO", "Validate", domainErr.ErrInvalidInvoiceStatus)
+	}
+
+	return nil
+}
+
+// InvoiceLineResponse represents a line item of an invoice
+// @Description Delivery charge, surcharge or credit of an order
+type InvoiceLineResponse struct {
+	// Position of the line in the invoice
+	LineNumber int `json:"line_number" example:"1"`
+
+	// Type of line
+	Type string `json:"type" example:"DELIVERY" enums:"DELIVERY,SURCHARGE,CREDIT"`
+
+	// Description of the line
+	Description string `json:"description" example:"Entrega DEL250115TE68JBQF14V"`
+
+	// Order of the line
+	OrderID *string `json:"order_id,omitempty" example:"a1b2c3d4-e5f6-7g8h-9i0j-k1l2m3n4o5p6"`
+
+	// Tracking number of the order
+	TrackingNumber string `json:"tracking_number,omitempty" example:"DEL250115TE68JBQF14V"`
+
+	// Quantity billed
+	Quantity int `json:"quantity" example:"1"`
+
+	// Price per unit, credits are negative
+	UnitPrice float64 `json:"unit_price" example:"20.50"`
+
+	// Amount of the line
+	Amount float64 `json:"amount" example:"20.50"`
+}
+
+// InvoiceResponse represents an invoice of a company
+// @Description Invoice of a billing period with its totals and line items
+type InvoiceResponse struct {
+	// Unique identifier of the invoice
+	ID string `json:"id" example:"f1e2d3c4-b5a6-7980-1a2b-3c4d5e6f7a8b"`
+
+	// Invoice number
+	InvoiceNumber string `json:"invoice_number" example:"INV-202501-3F9A1C2B"`
+
+	// Company invoiced
+	CompanyID string `json:"company_id" example:"c1d2e3f4-a5b6-7c8d-9e0f-1a2b3c4d5e6f"`
+
+	// Legal name of the company invoiced
+	CompanyName string `json:"company_name,omitempty" example:"Acme Corporation S.A. de C.V."`
+
+	// Status of the invoice
+	Status string `json:"status" example:"ISSUED" enums:"DRAFT,ISSUED,PAID,VOID"`
+
+	// ISO 4217 currency of the invoice
+	Currency string `json:"currency" example:"USD"`
+
+	// Start of the billing period
+	PeriodStart time.Time `json:"period_start" format:"date-time"`
+
+	// End of the billing period (exclusive)
+	PeriodEnd time.Time `json:"period_end" format:"date-time"`
+
+	// Sum of the delivery charges
+	Subtotal float64 `json:"subtotal" example:"820.00"`
+
+	// Sum of the surcharges
+	SurchargeTotal float64 `json:"surcharge_total" example:"61.50"`
+
+	// Sum of the credits, negative
+	CreditTotal float64 `json:"credit_total" example:"-20.50"`
+
+	// Tax rate applied
+	TaxRate float64 `json:"tax_rate" example:"0.13"`
+
+	// Tax amount
+	TaxAmount float64 `json:"tax_amount" example:"111.93"`
+
+	// Total to pay
+	Total float64 `json:"total" example:"972.93"`
+
+	// Payment terms of the company contract
+	PaymentTerms string `json:"payment_terms,omitempty" example:"Net 30"`
+
+	// When the invoice was issued
+	IssuedAt *time.Time `json:"issued_at,omitempty" format:"date-time"`
+
+	// When the invoice must be paid
+	DueDate *time.Time `json:"due_date,omitempty" format:"date-time"`
+
+	// When the invoice was paid
+	PaidAt *time.Time `json:"paid_at,omitempty" format:"date-time"`
+
+	// When the invoice was voided
+	VoidedAt *time.Time `json:"voided_at,omitempty" format:"date-time"`
+
+	// Reason the invoice was voided
+	VoidReason string `json:"void_reason,omitempty" example:"Duplicated billing period"`
+
+	// Line items, only included when getting a single invoice
+	Lines []InvoiceLineResponse `json:"lines,omitempty"`
+
+	// When the invoice was created
+	CreatedAt time.Time `json:"created_at" format:"date-time"`
+}
+
+// InvoiceDocument is the file returned by the invoice download endpoint
+type InvoiceDocument struct {
+	Content     []byte
+	ContentType string
+	FileName    string
+}
@@ -0,0 +1,37 @@
+package dto
+
+import "time"
+
+// JobRunResponse represents an attempt to run a background job
+// @Description Execution of a scheduled job with its result, one record per attempt
+type JobRunResponse struct {
+	// Run ID
+	ID string `json:"id" example:"b1c2d3e4-f5a6-7b8c-9d0e-1f2a3b4c5d6e"`
+
+	// Job name: clean_expired_sessions, purge_deleted_records or recompute_metrics
+	JobName string `json:"job_name" example:"clean_expired_sessions"`
+
+	// Run status: RUNNING, SUCCEEDED or FAILED
+	Status string `json:"status" example:"SUCCEEDED"`
+
+	// Attempt number of the scheduled run, retries increase it
+	Attempt int `json:"attempt" example:"1"`
+
+	// Instance that ran the job
+	Instance string `json:"instance" example:"worker-1-4242"`
+
+	// Time the run was scheduled for
+	ScheduledAt time.Time `json:"scheduled_at" example:"2025-01-15T03:00:00Z"`
+
+	// Time the attempt started
+	StartedAt time.Time `json:"started_at" example:"2025-01-15T03:00:00Z"`
+
+	// Time the attempt finished, empty while running
+	FinishedAt *time.Time `json:"finished_at,omitempty" example:"2025-01-15T03:00:02Z"`
+
+	// Summary of the work done
+	Result string `json:"result,omitempty" example:"deleted 42 expired sessions"`
+
+	// Error of the failed attempt
+	Error string `json:"error,omitempty" example:"database is unavailable"`
+}
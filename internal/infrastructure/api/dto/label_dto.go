@@ -0,0 +1,37 @@
+package dto
+
+import (
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	domainErr "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	infraErr "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+)
+
+// LabelBatchRequest represents the request body for printing the labels of many orders
+// @Description Request structure for printing shipping labels in batch
+type LabelBatchRequest struct {
+	// Identifiers of the orders to print, up to 100
+	// @required
+	OrderIDs []string `json:"order_ids" example:"a1b2c3d4-e5f6-7g8h-9i0j-k1l2m3n4o5p6" binding:"required"`
+
+	// Format of the labels, PDF by default
+	Format string `json:"format,omitempty" example:"ZPL" enums:"PDF,ZPL"`
+}
+
+func (r *LabelBatchRequest) Validate() error {
+	if len(r.OrderIDs) == 0 {
+		return infraErr.NewGeneralServiceError("LabelDTO", "Validate", domainErr.ErrEmptyLabelBatch)
+	}
+
+	if len(r.OrderIDs) > constants.MaxLabelsPerBatch {
+		return infraErr.NewGeneralServiceError("LabelDTO", "Validate", domainErr.ErrTooManyLabels)
+	}
+
+	return nil
+}
+
+// LabelDocument is the printable file returned by the label endpoints
+type LabelDocument struct {
+	Content     []byte
+	ContentType string
+	FileName    string
+}
@@ -0,0 +1,235 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+
+	domainErr "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	infraErr "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+)
+
+// CompanyNotificationSettingRequest represents the audiences notified for an order event
+// @Description Whether an order event is notified to the recipient and to the company users
+type CompanyNotificationSettingRequest struct {
+	// Order event: ORDER_DRIVER_ASSIGNED, ORDER_OUT_FOR_DELIVERY, ORDER_DELIVERED, ORDER_DELIVERY_ATTEMPT_FAILED,
+	// ORDER_RETURNED, ORDER_CANCELLED, ORDER_LOST, SLA_AT_RISK or SLA_BREACHED
+	// @required
+	Event string `json:"event" example:"ORDER_OUT_FOR_DELIVERY" binding:"required"`
+
+	// Notify the recipient of the order
+	NotifyRecipient bool `json:"notify_recipient" example:"true"`
+
+	// Notify the users of the company
+	NotifyCompany bool `json:"notify_company" example:"false"`
+}
+
+// UpdateCompanyNotificationSettingsRequest represents the request body for updating the notification settings of a company
+// @Description Order events to configure, events not included keep their current settings
+type UpdateCompanyNotificationSettingsRequest struct {
+	// Company ID (admin only), defaults to the company of the authenticated user
+	CompanyID string `json:"company_id,omitempty" example:"c1d2e3f4-a5b6-7c8d-9e0f-1a2b3c4d5e6f"`
+
+	// Settings per order event
+	// @required
+	Settings []CompanyNotificationSettingRequest `json:"settings" binding:"required"`
+}
+
+func (r *UpdateCompanyNotificationSettingsRequest) Validate() error {
+	if len(r.Settings) == 0 {
+		return infraErr.NewGeneralServiceError("NotificationDTO", "Validate", domainErr.ErrInvalidNotificationEvent)
+	}
+
+	for _, setting := range r.Settings {
+		if setting.Event == "" {
+			return infraErr.NewGeneralServiceError("NotificationDTO", "Validate", domainErr.ErrInvalidNotificationEvent)
+		}
+	}
+
+	return nil
+}
+
+// CompanyNotificationSettingResponse represents the audiences notified for an order event
+type CompanyNotificationSettingResponse struct {
+	// Order event
+	Event string `json:"event" example:"ORDER_OUT_FOR_DELIVERY"`
+
+	// Whether the recipient of the order is notified
+	NotifyRecipient bool `json:"notify_recipient" example:"true"`
+
+	// Whether the users of the company are notified
+	NotifyCompany bool `json:"notify_company" example:"false"`
+}
+
+// CompanyNotificationSettingsResponse represents the notification settings of a company
+// @Description Audiences notified for each order event of a company, events not configured show their defaults
+type CompanyNotificationSettingsResponse struct {
+	// Company ID
+	CompanyID string `json:"company_id" example:"c1d2e3f4-a5b6-7c8d-9e0f-1a2b3c4d5e6f"`
+
+	// Settings per order event
+	Settings []CompanyNotificationSettingResponse `json:"settings"`
+}
+
+// NotificationResponse represents a notification of the inbox of the authenticated user
+type NotificationResponse struct {
+	// Notification ID
+	ID string `json:"id" example:"a1b2c3d4-e5f6-7a8b-9c0d-1e2f3a4b5c6d"`
+
+	// Notification type
+	Type string `json:"type" example:"ORDER_OUT_FOR_DELIVERY"`
+
+	// Notification title
+	Title string `json:"title" example:"Tu pedido va en camino"`
+
+	// Notification content, sensitive values are masked
+	Content string `json:"content" example:"Tu pedido TRK-123456 va en camino."`
+
+	// Additional information, for example the related order
+	Metadata json.RawMessage `json:"metadata,omitempty" swaggertype:"object"`
+
+	// Whether the notification was read
+	IsRead bool `json:"is_read" example:"false"`
+
+	// When the notification was read
+	ReadAt *time.Time `json:"read_at,omitempty" example:"2025-01-01T12:00:00Z"`
+
+	// When the notification was created
+	CreatedAt time.Time `json:"created_at" example:"2025-01-01T10:00:00Z"`
+}
+
+// UnreadNotificationsResponse represents the unread notifications of the authenticated user
+type UnreadNotificationsResponse struct {
+	// Total unread notifications
+	Total int64 `json:"total" example:"3"`
+
+	// Unread notifications per type
+	ByType map[string]int64 `json:"by_type"`
+}
+
+// MarkAllNotificationsReadResponse represents the result of marking all the notifications as read
+type MarkAllNotificationsReadResponse struct {
+	// Notifications marked as read
+	Updated int64 `json:"updated" example:"3"`
+}
+
+// InboxEventResponse represents an event sent through the notifications stream
+type InboxEventResponse struct {
+	// Event type: notification or unread_count
+	Type string `json:"type" example:"notification"`
+
+	// New notification, only for notification events
+	Notification *NotificationResponse `json:"notification,omitempty"`
+
+	// Total unread notifications
+	UnreadCount int64 `json:"unread_count" example:"3"`
+}
+
+// NotificationPreferenceRequest represents the channels enabled for a notification type
+type NotificationPreferenceRequest struct {
+	// Notification type
+	// @required
+	NotificationType string `json:"notification_type" example:"ORDER_OUT_FOR_DELIVERY" binding:"required"`
+
+	// Send the notification by email
+	EmailEnabled bool `json:"email_enabled" example:"true"`
+
+	// Send the notification by SMS
+	SMSEnabled bool `json:"sms_enabled" example:"false"`
+
+	// Send the notification to the registered devices
+	PushEnabled bool `json:"push_enabled" example:"true"`
+}
+
+// UpdateNotificationPreferencesRequest represents the request body for updating the notification preferences
+// @Description Channels per notification type, types not included keep their current preferences
+type UpdateNotificationPreferencesRequest struct {
+	// Preferences per notification type
+	// @required
+	Preferences []NotificationPreferenceRequest `json:"preferences" binding:"required"`
+}
+
+func (r *UpdateNotificationPreferencesRequest) Validate() error {
+	if len(r.Preferences) == 0 {
+		return infraErr.NewGeneralServiceError("NotificationDTO", "Validate", domainErr.ErrInvalidNotificationType)
+	}
+
+	for _, preference := range r.Preferences {
+		if preference.NotificationType == "" {
+			return infraErr.NewGeneralServiceError("NotificationDTO", "Validate", domainErr.ErrInvalidNotificationType)
+		}
+	}
+
+	return nil
+}
+
+// NotificationPreferenceResponse represents the channels enabled for a notification type
+type NotificationPreferenceResponse struct {
+	// Notification type
+	NotificationType string `json:"notification_type" example:"ORDER_OUT_FOR_DELIVERY"`
+
+	// Whether the notification is sent by email
+	EmailEnabled bool `json:"email_enabled" example:"true"`
+
+	// Whether the notification is sent by SMS
+	SMSEnabled bool `json:"sms_enabled" example:"false"`
+
+	// Whether the notification is sent to the registered devices
+	PushEnabled bool `json:"push_enabled" example:"true"`
+}
+
+// NotificationPreferencesResponse represents the notification preferences of the authenticated user
+// @Description Channels per notification type, types not configured show the defaults of the user role
+type NotificationPreferencesResponse struct {
+	// Preferences per notification type
+	Preferences []NotificationPreferenceResponse `json:"preferences"`
+}
+
+// RegisterNotificationDeviceRequest represents the request body for registering or refreshing a push device token
+type RegisterNotificationDeviceRequest struct {
+	// Push token issued by the provider
+	// @required
+	DeviceToken string `json:"device_token" example:"fcm_3f9a1c2b7d4e" binding:"required"`
+
+	// Device type: IOS, ANDROID or WEB
+	// @required
+	DeviceType string `json:"device_type" example:"ANDROID" binding:"required"`
+
+	// Token replaced by the provider, it is deactivated when the device refreshes its token
+	PreviousToken string `json:"previous_token,omitempty" example:"fcm_1a2b3c4d5e6f"`
+}
+
+func (r *RegisterNotificationDeviceRequest) Validate() error {
+	if r.DeviceToken == "" {
+		return infraErr.NewGeneralServiceError("NotificationDTO", "Validate", domainErr.ErrInvalidDeviceToken)
+	}
+
+	if r.DeviceType == "" {
+		return infraErr.NewGeneralServiceError("NotificationDTO", "Validate", domainErr.ErrInvalidDeviceType)
+	}
+
+	return nil
+}
+
+// NotificationDeviceResponse represents a device registered to receive push notifications
+type NotificationDeviceResponse struct {
+	// Device ID
+	ID string `json:"id" example:"d1e2f3a4-b5c6-7d8e-9f0a-1b2c3d4e5f6a"`
+
+	// Device type
+	DeviceType string `json:"device_type" example:"ANDROID"`
+
+	// Masked push token
+	DeviceToken string `json:"device_token" example:"fcm_****7d4e"`
+
+	// Whether the device receives push notifications
+	IsActive bool `json:"is_active" example:"true"`
+
+	// Last push notification sent to the device
+	LastUsedAt *time.Time `json:"last_used_at,omitempty" example:"2025-01-01T12:00:00Z"`
+
+	// When the device was registered
+	CreatedAt time.Time `json:"created_at" example:"2025-01-01T10:00:00Z"`
+
+	// When the device token was last refreshed
+	UpdatedAt time.Time `json:"updated_at" example:"2025-01-01T10:00:00Z"`
+}
@@ -1,8 +1,11 @@
 package dto
 
 import (
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/value_objects"
 	domainErr "github.com/MarlonG1/delivery-backend/internal/domain/error"
 	infraErr "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+	"strings"
 	"time"
 )
 
@@ -38,13 +41,25 @@ type OrderCreateRequest struct {
 	// Whether recipient signature is required for delivery
 	RequiresSignature bool `json:"requires_signature" example:"false"`
 
+	// Whether the recipient must provide a one-time PIN to complete the delivery
+	RequiresDeliveryPIN bool `json:"requires_delivery_pin" example:"false"`
+
 	// Additional notes for the delivery
 	DeliveryNotes string `json:"delivery_notes,omitempty" example:"Please call recipient 5 minutes before arrival"`
 
+	// Amount the driver must collect from the recipient at the door
+	CashOnDelivery *CashOnDeliveryRequest `json:"cash_on_delivery,omitempty"`
+
+	// Prepayment of the delivery price, charged through the payment provider when the order is created
+	Payment *OrderPaymentRequest `json:"payment,omitempty"`
+
 	// Details about the package being delivered
 	// @required
 	PackageDetails PackageDetailRequest `json:"package_details" binding:"required"`
 
+	// Boxes of the shipment, up to 50. When omitted the order has a single parcel built from package_details
+	Parcels []ParcelRequest `json:"parcels,omitempty"`
+
 	// Contact name for pickup location
 	// @required
 	PickupContactName string `json:"pickup_contact_name" binding:"required"`
@@ -71,9 +86,87 @@ func (o *OrderCreateRequest) Validate() error {
 		return infraErr.NewGeneralServiceError("OrderDTO", "Validate", domainErr.ErrClientIDRequired)
 	}
 
+	if o.CashOnDelivery != nil {
+		amount := value_objects.NewMoneyAmount(o.CashOnDelivery.Amount, strings.ToUpper(o.CashOnDelivery.Currency))
+		if !amount.IsPositive() || !amount.HasValidCurrency() {
+			return infraErr.NewGeneralServiceError("OrderDTO", "Validate", domainErr.ErrInvalidCODAmount)
+		}
+	}
+
+	if o.Payment != nil {
+		if o.CashOnDelivery != nil {
+			return infraErr.NewGeneralServiceError("OrderDTO", "Validate", domainErr.ErrPrepaidWithCOD)
+		}
+
+		amount := value_objects.NewMoneyAmount(o.Price, strings.ToUpper(o.Payment.Currency))
+		if o.Payment.Token == "" || !amount.IsPositive() || !amount.HasValidCurrency() {
+			return infraErr.NewGeneralServiceError("OrderDTO", "Validate", domainErr.ErrInvalidPaymentRequest)
+		}
+	}
+
+	if len(o.Parcels) > constants.MaxParcelsPerOrder {
+		return infraErr.NewGeneralServiceError("OrderDTO", "Validate", domainErr.ErrTooManyParcels)
+	}
+
+	for _, parcel := range o.Parcels {
+		if parcel.Weight < 0 || parcel.Length < 0 || parcel.Width < 0 || parcel.Height < 0 {
+			return infraErr.NewGeneralServiceError("OrderDTO", "Validate", domainErr.ErrInvalidParcel)
+		}
+	}
+
 	return nil
 }
 
+// CashOnDeliveryRequest contains the amount to collect at delivery
+// @Description Cash on delivery amount with its currency
+type CashOnDeliveryRequest struct {
+	// Amount to collect
+	// @required
+	Amount float64 `json:"amount" example:"49.99" binding:"required,gt=0"`
+
+	// ISO 4217 currency code
+	// @required
+	Currency string `json:"currency" example:"USD" binding:"required"`
+}
+
+// OrderPaymentRequest contains the payment method used to prepay the order
+// @Description Single-use token of the payment method and the currency of the price
+type OrderPaymentRequest struct {
+	// Single-use token of the payment method issued by the payment provider
+	// @required
+	Token string `json:"token" example:"tok_visa" binding:"required"`
+
+	// ISO 4217 currency code of the price
+	// @required
+	Currency string `json:"currency" example:"USD" binding:"required"`
+}
+
+// ParcelRequest contains the characteristics of one box of the shipment
+// @Description Box of a multi-parcel order, each one gets its own label and QR code
+type ParcelRequest struct {
+	// Whether the box contains fragile items
+	IsFragile bool `json:"is_fragile" example:"true"`
+
+	// Weight of the box in kilograms
+	// @minimum 0
+	Weight float64 `json:"weight,omitempty" example:"1.2" binding:"omitempty,min=0"`
+
+	// Length of the box in centimeters
+	// @minimum 0
+	Length float64 `json:"length,omitempty" example:"30" binding:"omitempty,min=0"`
+
+	// Width of the box in centimeters
+	// @minimum 0
+	Width float64 `json:"width,omitempty" example:"20" binding:"omitempty,min=0"`
+
+	// Height of the box in centimeters
+	// @minimum 0
+	Height float64 `json:"height,omitempty" example:"15" binding:"omitempty,min=0"`
+
+	// Short description of the content
+	Description string `json:"description,omitempty" example:"Glassware"`
+}
+
 // PackageDetailRequest contains details about the package
 // @Description Package characteristics and handling information
 type PackageDetailRequest struct {
@@ -169,11 +262,17 @@ type OrderResponse struct {
 	DriverName string `json:"driver_name,omitempty" example:"Michael Johnson"`
 
 	// Tracking number for the order
-	TrackingNumber string `json:"tracking_number" example:"DEL-230512-7890"`
+	TrackingNumber string `json:"tracking_number" example:"DEL250115TE68JBQF14V"`
 
 	// Current status of the order
 	Status string `json:"status" example:"PENDING"`
 
+	// Whether the order has been flagged for review
+	IsFlagged bool `json:"is_flagged" example:"false"`
+
+	// Reason why the order was flagged
+	FlagReason string `json:"flag_reason,omitempty" example:"DELIVERY_PIN_LOCKED"`
+
 	// When the order was created
 	CreatedAt time.Time `json:"created_at" example:"2023-05-15T10:30:00Z" format:"date-time"`
 
@@ -186,6 +285,12 @@ type OrderResponse struct {
 	// Details about the package
 	PackageDetail PackageDetailResponse `json:"package_detail"`
 
+	// Boxes of the shipment with their own tracking number and status
+	Parcels []ParcelResponse `json:"parcels,omitempty"`
+
+	// Prepayment of the order, only for prepaid orders
+	Payment *OrderPaymentResponse `json:"payment,omitempty"`
+
 	// Delivery destination address
 	DeliveryAddress DeliveryAddressResponse `json:"delivery_address"`
 
@@ -195,6 +300,9 @@ type OrderResponse struct {
 	// Order status history
 	StatusHistory []OrderStatusHistoryResponse `json:"status_history"`
 
+	// Failed delivery attempts
+	DeliveryAttempts []DeliveryAttemptResponse `json:"delivery_attempts,omitempty"`
+
 	// Current tracking status
 	CurrentStatus string `json:"current_status" example:"IN_TRANSIT"`
 
@@ -226,8 +334,17 @@ type OrderDetailResponse struct {
 	// Whether recipient signature is required
 	RequiresSignature bool `json:"requires_signature" example:"false"`
 
+	// Whether a delivery PIN is required to complete the delivery
+	RequiresDeliveryPIN bool `json:"requires_delivery_pin" example:"false"`
+
 	// Additional notes for delivery
 	DeliveryNotes string `json:"delivery_notes,omitempty" example:"Please call recipient 5 minutes before arrival"`
+
+	// Amount to collect at the door, zero when the order is prepaid
+	CODAmount float64 `json:"cod_amount,omitempty" example:"49.99"`
+
+	// Currency of the amount to collect
+	CODCurrency string `json:"cod_currency,omitempty" example:"USD"`
 }
 
 // PackageDetailResponse contains information about the package
@@ -249,6 +366,34 @@ type PackageDetailResponse struct {
 	SpecialInstructions string `json:"special_instructions,omitempty" example:"Contains glass items, handle with care"`
 }
 
+// ParcelResponse contains the information of one box of the shipment
+// @Description Box of a multi-parcel order
+type ParcelResponse struct {
+	// Unique identifier of the parcel
+	ID string `json:"id" example:"f1e2d3c4-b5a6-9788-1234-56789abcdef0"`
+
+	// Position of the parcel in the order
+	Sequence int `json:"sequence" example:"1"`
+
+	// Tracking number of the parcel, also encoded in its QR code
+	TrackingNumber string `json:"tracking_number" example:"DEL250115TE68JBQF14V-01"`
+
+	// Current status of the parcel
+	Status string `json:"status" example:"IN_WAREHOUSE"`
+
+	// Whether the parcel contains fragile items
+	IsFragile bool `json:"is_fragile" example:"true"`
+
+	// Weight of the parcel in kilograms
+	Weight float64 `json:"weight,omitempty" example:"1.2"`
+
+	// Parcel dimensions in JSON format
+	Dimensions string `json:"dimensions,omitempty" example:"{\"length\":30,\"width\":20,\"height\":15,\"unit\":\"cm\"}"`
+
+	// Short description of the content
+	Description string `json:"description,omitempty" example:"Glassware"`
+}
+
 type OrderStatusHistoryResponse struct {
 	// Name of the status
 	Status string `json:"status" example:"PENDING"`
@@ -258,6 +403,29 @@ type OrderStatusHistoryResponse struct {
 	UpdatedAt string `json:"updated_at" example:"2023-05-15T12:45:00Z" format:"date-time"`
 }
 
+// DeliveryAttemptResponse contains the information of a failed delivery attempt
+// @Description Failed delivery attempt with its reason and the rescheduled window
+type DeliveryAttemptResponse struct {
+	// Unique identifier of the attempt
+	ID string `json:"id" example:"e1f2a3b4-c5d6-7e8f-9a0b-c1d2e3f4a5b6"`
+
+	// Sequential number of the attempt
+	AttemptNumber int `json:"attempt_number" example:"1"`
+
+	// Reason code of the failure
+	// @enum [RECIPIENT_ABSENT,WRONG_ADDRESS,RECIPIENT_REFUSED,ACCESS_DENIED,UNSAFE_LOCATION,OTHER]
+	ReasonCode string `json:"reason_code" example:"RECIPIENT_ABSENT"`
+
+	// Additional notes from the driver
+	Notes string `json:"notes,omitempty" example:"Nobody answered the door"`
+
+	// When the attempt happened
+	AttemptedAt time.Time `json:"attempted_at" example:"2023-05-15T16:15:00Z" format:"date-time"`
+
+	// When the next delivery attempt is scheduled, empty when the order was returned to sender
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty" example:"2023-05-16T08:00:00Z" format:"date-time"`
+}
+
 // DeliveryAddressResponse contains the destination address details
 // @Description Delivery address information
 type DeliveryAddressResponse struct {
@@ -333,7 +501,7 @@ type OrderListResponse struct {
 	ID string `json:"id" example:"a1b2c3d4-e5f6-7g8h-9i0j-k1l2m3n4o5p6"`
 
 	// Tracking number for the order
-	TrackingNumber string `json:"tracking_number" example:"DEL-230512-7890"`
+	TrackingNumber string `json:"tracking_number" example:"DEL250115TE68JBQF14V"`
 
 	// Full name of the client
 	ClientName string `json:"client_name" example:"John Smith"`
@@ -348,8 +516,8 @@ type OrderListResponse struct {
 	Price float64 `json:"price" example:"25.50"`
 
 	// Current status of the order
-	// @enum [PENDING,ACCEPTED,PICKED_UP,IN_TRANSIT,DELIVERED,CANCELLED]
-	Status string `json:"status" example:"PENDING" enums:"PENDING,ACCEPTED,PICKED_UP,IN_TRANSIT,DELIVERED,CANCELLED"`
+	// @enum [PENDING,ACCEPTED,PICKED_UP,IN_TRANSIT,DELIVERY_FAILED,DELIVERED,CANCELLED]
+	Status string `json:"status" example:"PENDING" enums:"PENDING,ACCEPTED,PICKED_UP,IN_TRANSIT,DELIVERY_FAILED,DELIVERED,CANCELLED"`
 
 	// Driver ID assigned to the order (if any)
 	DriverID *string `json:"driver_id,omitempty" example:"d1e2f3g4-h5i6-j7k8-l9m0-n1o2p3q4r5s6"`
@@ -395,6 +563,35 @@ type OrderDriverAssignRequest struct {
 	DriverID string `json:"driver_id" example:"d1e2f3g4-h5i6-j7k8-l9m0-n1o2p3q4r5s6" binding:"required,uuid"`
 }
 
+// OrderDeliverRequest represents the request to complete the delivery of an order
+// @Description Request to confirm the delivery of an order with the recipient PIN
+type OrderDeliverRequest struct {
+	// One-time PIN provided by the recipient
+	PIN string `json:"pin,omitempty" example:"482913"`
+
+	// Cash collected from the recipient, required for cash on delivery orders
+	CollectedAmount *float64 `json:"collected_amount,omitempty" example:"49.99"`
+}
+
+// DeliveryAttemptRequest represents the request to register a failed delivery attempt
+// @Description Request to register a failed delivery attempt
+type DeliveryAttemptRequest struct {
+	// Reason code of the failure
+	// @required
+	ReasonCode string `json:"reason_code" example:"RECIPIENT_ABSENT" binding:"required" enums:"RECIPIENT_ABSENT,WRONG_ADDRESS,RECIPIENT_REFUSED,ACCESS_DENIED,UNSAFE_LOCATION,OTHER"`
+
+	// Additional notes from the driver
+	Notes string `json:"notes,omitempty" example:"Nobody answered the door"`
+}
+
+func (r *DeliveryAttemptRequest) Validate() error {
+	if r.ReasonCode == "" {
+		return infraErr.NewGeneralServiceError("OrderDTO", "Validate", domainErr.ErrInvalidDeliveryFailureReason)
+	}
+
+	return nil
+}
+
 // OrderUpdateRequest represents the request body for updating an existing order
 // @Description Request structure for updating a delivery order
 type OrderUpdateRequest struct {
@@ -507,10 +704,44 @@ type DeliveryAddressUpdateRequest struct {
 // @Description Request to change the status of an order
 type OrderStatusUpdateRequest struct {
 	// New status for the order
-	// @enum [PENDING,ACCEPTED,PICKED_UP,IN_TRANSIT,DELIVERED,CANCELLED]
+	// @enum [PENDING,ACCEPTED,PICKED_UP,IN_WAREHOUSE,IN_TRANSIT,RETURNED,CANCELLED,LOST]
 	// @required
-	Status string `json:"status" example:"ACCEPTED" binding:"required" enums:"PENDING,ACCEPTED,PICKED_UP,IN_TRANSIT,DELIVERED,CANCELLED"`
+	Status string `json:"status" example:"ACCEPTED" binding:"required" enums:"PENDING,ACCEPTED,PICKED_UP,IN_WAREHOUSE,IN_TRANSIT,RETURNED,CANCELLED,LOST"`
 
 	// Optional description about the status change
 	Description string `json:"description,omitempty" example:"Driver has accepted the order and is heading to pickup location"`
 }
+
+// OrderPaymentResponse contains the payment state of a prepaid order
+// @Description Prepayment of an order processed through the payment provider
+type OrderPaymentResponse struct {
+	// Payment provider
+	Provider string `json:"provider" example:"FAKE"`
+
+	// Identifier of the payment in the provider
+	ProviderPaymentID string `json:"provider_payment_id,omitempty" example:"fake_pay_3f9a1c2b4d5e"`
+
+	// Status of the payment
+	Status string `json:"status" example:"CAPTURED" enums:"PENDING,AUTHORIZED,CAPTURE_PENDING,CAPTURED,REFUND_PENDING,FAILED,REFUNDED"`
+
+	// Amount charged
+	Amount float64 `json:"amount" example:"25.50"`
+
+	// ISO 4217 currency
+	Currency string `json:"currency" example:"USD"`
+
+	// Amount refunded to the customer
+	RefundedAmount float64 `json:"refunded_amount,omitempty" example:"25.50"`
+
+	// Reason the provider declined the payment
+	FailureReason string `json:"failure_reason,omitempty" example:"card_declined"`
+
+	// When the amount was authorized
+	AuthorizedAt *time.Time `json:"authorized_at,omitempty" format:"date-time"`
+
+	// When the amount was captured
+	CapturedAt *time.Time `json:"captured_at,omitempty" format:"date-time"`
+
+	// When the amount was refunded
+	RefundedAt *time.Time `json:"refunded_at,omitempty" format:"date-time"`
+}
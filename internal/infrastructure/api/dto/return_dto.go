@@ -0,0 +1,94 @@
+package dto
+
+import (
+	domainErr "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	infraErr "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+	"time"
+)
+
+// ReturnCreateRequest represents the request body for creating a return of an order
+// @Description Request structure for creating a return linked to an original order
+type ReturnCreateRequest struct {
+	// Reason code of the return
+	// @required
+	ReasonCode string `json:"reason_code" example:"DAMAGED" binding:"required" enums:"DAMAGED,WRONG_ITEM,NOT_AS_DESCRIBED,RECIPIENT_REFUSED,UNDELIVERABLE,OTHER"`
+
+	// Additional notes about the return
+	Notes string `json:"notes,omitempty" example:"The box arrived crushed"`
+
+	// Destination type of the return, defaults to the branch that created the order
+	DestinationType string `json:"destination_type,omitempty" example:"BRANCH" enums:"BRANCH,WAREHOUSE"`
+
+	// Destination ID (branch or warehouse), required when destination_type is provided
+	DestinationID string `json:"destination_id,omitempty" example:"b5f8c3d1-2e59-4c4b-a6e8-e5f3c0c3d1b5"`
+}
+
+func (r *ReturnCreateRequest) Validate() error {
+	if r.ReasonCode == "" {
+		return infraErr.NewGeneralServiceError("ReturnDTO", "Validate", domainErr.ErrInvalidReturnReason)
+	}
+
+	if (r.DestinationType == "") != (r.DestinationID == "") {
+		return infraErr.NewGeneralServiceError("ReturnDTO", "Validate", domainErr.ErrInvalidReturnDestination)
+	}
+
+	return nil
+}
+
+// ReturnResponse represents the response for a return
+// @Description Return information linked to the original order
+type ReturnResponse struct {
+	// Unique identifier of the return
+	ID string `json:"id" example:"f1e2d3c4-b5a6-7980-1a2b-3c4d5e6f7a8b"`
+
+	// Original order ID
+	OrderID string `json:"order_id" example:"a1b2c3d4-e5f6-7g8h-9i0j-k1l2m3n4o5p6"`
+
+	// Original order tracking number
+	OrderTrackingNumber string `json:"order_tracking_number,omitempty" example:"DEL250115TE68JBQF14V"`
+
+	// Tracking number of the return
+	TrackingNumber string `json:"tracking_number" example:"RET250120FQ0YF144W66"`
+
+	// Current status of the return
+	Status string `json:"status" example:"REQUESTED" enums:"REQUESTED,PICKED_UP,IN_TRANSIT,RECEIVED,CANCELLED"`
+
+	// Who initiated the return
+	InitiatedBy string `json:"initiated_by" example:"RECIPIENT" enums:"COMPANY,RECIPIENT"`
+
+	// Reason code of the return
+	ReasonCode string `json:"reason_code" example:"DAMAGED"`
+
+	// Additional notes
+	Notes string `json:"notes,omitempty" example:"The box arrived crushed"`
+
+	// Driver assigned to the pickup
+	DriverID *string `json:"driver_id,omitempty" example:"d1e2f3g4-h5i6-j7k8-l9m0-n1o2p3q4r5s6"`
+
+	// Destination type of the return
+	DestinationType string `json:"destination_type" example:"BRANCH"`
+
+	// Destination ID (branch or warehouse)
+	DestinationID string `json:"destination_id" example:"b5f8c3d1-2e59-4c4b-a6e8-e5f3c0c3d1b5"`
+
+	// Pickup contact name
+	PickupName string `json:"pickup_name" example:"John Doe"`
+
+	// Pickup contact phone
+	PickupPhone string `json:"pickup_phone" example:"+1234567890"`
+
+	// Pickup address, the original delivery address
+	PickupAddress string `json:"pickup_address" example:"123 Main Street, New York, NY, 10001"`
+
+	// When the return was picked up
+	PickedUpAt *time.Time `json:"picked_up_at,omitempty" format:"date-time"`
+
+	// When the return was received at the destination
+	ReceivedAt *time.Time `json:"received_at,omitempty" format:"date-time"`
+
+	// When the return was created
+	CreatedAt time.Time `json:"created_at" format:"date-time"`
+
+	// When the return was last updated
+	UpdatedAt time.Time `json:"updated_at" format:"date-time"`
+}
@@ -0,0 +1,54 @@
+package dto
+
+import (
+	domainErr "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	infraErr "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+	"strings"
+)
+
+// ScanRequest represents a QR code scanned from a handheld device
+// @Description Scanned QR data with an optional status transition to perform in the same call
+type ScanRequest struct {
+	// Raw content read from the QR code of the label
+	// @required
+	QRData string `json:"qr_data" example:"DEL250115TE68JBQF14V" binding:"required"`
+
+	// Status to apply to the order, must be one of the available actions
+	Status string `json:"status,omitempty" example:"PICKED_UP" enums:"PICKED_UP,IN_WAREHOUSE,IN_TRANSIT,DELIVERED,DELIVERY_FAILED,RETURNED"`
+
+	// One-time PIN provided by the recipient, required to deliver orders protected with PIN
+	PIN string `json:"pin,omitempty" example:"482913"`
+
+	// Cash collected from the recipient, required to deliver cash on delivery orders
+	CollectedAmount *float64 `json:"collected_amount,omitempty" example:"49.99"`
+
+	// Reason code of the failure, required when the status is DELIVERY_FAILED
+	ReasonCode string `json:"reason_code,omitempty" example:"RECIPIENT_ABSENT" enums:"RECIPIENT_ABSENT,WRONG_ADDRESS,RECIPIENT_REFUSED,ACCESS_DENIED,UNSAFE_LOCATION,OTHER"`
+
+	// Additional notes of the failed delivery attempt
+	Notes string `json:"notes,omitempty" example:"Nobody answered the door"`
+}
+
+func (r *ScanRequest) Validate() error {
+	if strings.TrimSpace(r.QRData) == "" {
+		return infraErr.NewGeneralServiceError("ScanDTO", "Validate", domainErr.ErrEmptyQRData)
+	}
+
+	return nil
+}
+
+// ScanResponse represents the scanned order and what the caller may do next
+// @Description Scanned order with the status transitions available for the caller role
+type ScanResponse struct {
+	// Parcel scanned when the QR code belongs to a single box of the order
+	Parcel *ParcelResponse `json:"parcel,omitempty"`
+
+	// Status applied in this call, to the parcel when one was scanned, empty when only looking up
+	AppliedStatus string `json:"applied_status,omitempty" example:"PICKED_UP"`
+
+	// Statuses the caller may apply next by scanning the same code again
+	AvailableActions []string `json:"available_actions" example:"IN_TRANSIT,IN_WAREHOUSE"`
+
+	// Scanned order after applying the requested status
+	Order *OrderResponse `json:"order"`
+}
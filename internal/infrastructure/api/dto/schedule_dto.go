@@ -0,0 +1,92 @@
+package dto
+
+import (
+	domainErr "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	infraErr "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+	"time"
+)
+
+// ScheduleRequest represents the request body for creating or editing an order schedule
+// @Description Request structure for a one-off future order or a weekly recurring pickup
+type ScheduleRequest struct {
+	// Descriptive name of the schedule
+	// @required
+	Name string `json:"name" example:"Weekday evening pickup" binding:"required"`
+
+	// Date and time of a one-off scheduled order, mutually exclusive with days_of_week
+	RunAt *time.Time `json:"run_at,omitempty" example:"2023-05-20T17:00:00Z" format:"date-time"`
+
+	// Days of the week for recurring orders (0 = Sunday ... 6 = Saturday)
+	DaysOfWeek []int `json:"days_of_week,omitempty" example:"1,2,3,4,5"`
+
+	// Pickup time of recurring orders in HH:MM format
+	TimeOfDay string `json:"time_of_day,omitempty" example:"17:00"`
+
+	// Minutes between the pickup and the delivery deadline, defaults to 240
+	DeliveryWindowMinutes int `json:"delivery_window_minutes,omitempty" example:"180"`
+
+	// Template of the orders to create, pickup_time and delivery_deadline are calculated on each run
+	// @required
+	Order OrderCreateRequest `json:"order" binding:"required"`
+}
+
+func (r *ScheduleRequest) Validate() error {
+	if r.RunAt == nil && (len(r.DaysOfWeek) == 0 || r.TimeOfDay == "") {
+		return infraErr.NewGeneralServiceError("ScheduleDTO", "Validate", domainErr.ErrInvalidSchedule)
+	}
+
+	if r.RunAt != nil && len(r.DaysOfWeek) > 0 {
+		return infraErr.NewGeneralServiceError("ScheduleDTO", "Validate", domainErr.ErrInvalidSchedule)
+	}
+
+	return r.Order.Validate()
+}
+
+// ScheduleResponse represents the response for an order schedule
+// @Description Order schedule information
+type ScheduleResponse struct {
+	// Unique identifier of the schedule
+	ID string `json:"id" example:"c3d4e5f6-a7b8-9c0d-1e2f-3a4b5c6d7e8f"`
+
+	// Descriptive name of the schedule
+	Name string `json:"name" example:"Weekday evening pickup"`
+
+	// Branch where the orders are picked up
+	BranchID string `json:"branch_id" example:"b5f8c3d1-2e59-4c4b-a6e8-e5f3c0c3d1b5"`
+
+	// Status of the schedule
+	Status string `json:"status" example:"ACTIVE" enums:"ACTIVE,PAUSED,CANCELLED,COMPLETED"`
+
+	// Date and time of a one-off scheduled order
+	RunAt *time.Time `json:"run_at,omitempty" format:"date-time"`
+
+	// Days of the week for recurring orders
+	DaysOfWeek []int `json:"days_of_week,omitempty" example:"1,2,3,4,5"`
+
+	// Pickup time of recurring orders
+	TimeOfDay string `json:"time_of_day,omitempty" example:"17:00"`
+
+	// Minutes between the pickup and the delivery deadline
+	DeliveryWindowMinutes int `json:"delivery_window_minutes" example:"180"`
+
+	// Next time an order will be created
+	NextRunAt *time.Time `json:"next_run_at,omitempty" format:"date-time"`
+
+	// Last time an order was created
+	LastRunAt *time.Time `json:"last_run_at,omitempty" format:"date-time"`
+
+	// Last order created by the schedule
+	LastOrderID *string `json:"last_order_id,omitempty" example:"a1b2c3d4-e5f6-7g8h-9i0j-k1l2m3n4o5p6"`
+
+	// Error of the last run, if any
+	LastError string `json:"last_error,omitempty" example:"the scheduled pickup time is outside the branch operating hours"`
+
+	// Template of the orders to create
+	Order OrderCreateRequest `json:"order"`
+
+	// When the schedule was created
+	CreatedAt time.Time `json:"created_at" format:"date-time"`
+
+	// When the schedule was last updated
+	UpdatedAt time.Time `json:"updated_at" format:"date-time"`
+}
@@ -0,0 +1,62 @@
+package dto
+
+import "time"
+
+// OrderSLAResponse represents the delivery SLA evaluation of an active order
+// @Description Estimated delivery of an active order compared with its delivery deadline
+type OrderSLAResponse struct {
+	// Order ID
+	OrderID string `json:"order_id" example:"o1a2b3c4-d5e6-7f8g-9h0i-j1k2l3m4n5o6"`
+
+	// Tracking number of the order
+	TrackingNumber string `json:"tracking_number" example:"DEL-20250101-ABC123"`
+
+	// Company ID
+	CompanyID string `json:"company_id" example:"c1d2e3f4-a5b6-7c8d-9e0f-1a2b3c4d5e6f"`
+
+	// Branch ID
+	BranchID string `json:"branch_id" example:"b1c2d3e4-f5a6-7b8c-9d0e-1f2a3b4c5d6e"`
+
+	// Current status of the order
+	OrderStatus string `json:"order_status" example:"IN_TRANSIT"`
+
+	// SLA status: ON_TRACK, AT_RISK or BREACHED
+	SLAStatus string `json:"sla_status" example:"AT_RISK"`
+
+	// Scheduled pickup time
+	PickupTime time.Time `json:"pickup_time" format:"date-time"`
+
+	// Delivery deadline agreed for the order
+	DeliveryDeadline time.Time `json:"delivery_deadline" format:"date-time"`
+
+	// Estimated delivery based on the maximum delivery time of the branch zone
+	EstimatedDelivery time.Time `json:"estimated_delivery" format:"date-time"`
+
+	// Minutes the estimated delivery exceeds the deadline
+	DelayMinutes int `json:"delay_minutes" example:"25"`
+
+	// Whether the order is flagged for review
+	IsFlagged bool `json:"is_flagged" example:"true"`
+}
+
+// OrdersSLAResponse represents the SLA view of the active orders of a company or branch
+// @Description Active orders with their SLA status and a summary per SLA status
+type OrdersSLAResponse struct {
+	// Company ID, empty when an admin queries every company
+	CompanyID string `json:"company_id,omitempty" example:"c1d2e3f4-a5b6-7c8d-9e0f-1a2b3c4d5e6f"`
+
+	// Branch ID, empty when the whole company is queried
+	BranchID string `json:"branch_id,omitempty" example:"b1c2d3e4-f5a6-7b8c-9d0e-1f2a3b4c5d6e"`
+
+	// Active orders on track
+	OnTrack int `json:"on_track" example:"42"`
+
+	// Active orders at risk of missing their deadline
+	AtRisk int `json:"at_risk" example:"3"`
+
+	// Active orders past their deadline
+	Breached int `json:"breached" example:"1"`
+
+	// Active orders, filtered by SLA status when requested
+	Orders []OrderSLAResponse `json:"orders"`
+}
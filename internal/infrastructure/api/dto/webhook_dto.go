@@ -0,0 +1,158 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+
+	domainErr "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	infraErr "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+)
+
+// CreateWebhookRequest represents the request body for registering a webhook endpoint
+// @Description Endpoint of the company that receives the signed order events it subscribes to
+type CreateWebhookRequest struct {
+	// Company ID (admin only), defaults to the company of the authenticated user
+	CompanyID string `json:"company_id,omitempty" example:"c1d2e3f4-a5b6-7c8d-9e0f-1a2b3c4d5e6f"`
+
+	// Absolute http or https URL that receives the events
+	// @required
+	URL string `json:"url" example:"https://example.com/webhooks/delivery" binding:"required"`
+
+	// Subscribed events: order.created, order.status_changed, order.driver_assigned, order.delivered,
+	// order.delivery_attempt_failed or order.cancelled
+	// @required
+	Events []string `json:"events" example:"order.created,order.delivered" binding:"required"`
+
+	// Description of the endpoint
+	Description string `json:"description,omitempty" example:"ERP integration"`
+}
+
+func (r *CreateWebhookRequest) Validate() error {
+	if r.URL == "" {
+		return infraErr.NewGeneralServiceError("WebhookDTO", "Validate", domainErr.ErrInvalidWebhookURL)
+	}
+
+	if len(r.Events) == 0 {
+		return infraErr.NewGeneralServiceError("WebhookDTO", "Validate", domainErr.ErrInvalidWebhookEvents)
+	}
+
+	return nil
+}
+
+// UpdateWebhookRequest represents the request body for updating a webhook endpoint
+// @Description Fields not included keep their current value, activating a disabled endpoint resets its failures
+type UpdateWebhookRequest struct {
+	// Absolute http or https URL that receives the events
+	URL *string `json:"url,omitempty" example:"https://example.com/webhooks/delivery"`
+
+	// Subscribed events
+	Events []string `json:"events,omitempty" example:"order.status_changed"`
+
+	// Description of the endpoint
+	Description *string `json:"description,omitempty" example:"ERP integration"`
+
+	// Whether the endpoint receives events
+	IsActive *bool `json:"is_active,omitempty" example:"true"`
+}
+
+func (r *UpdateWebhookRequest) Validate() error {
+	if r.URL != nil && *r.URL == "" {
+		return infraErr.NewGeneralServiceError("WebhookDTO", "Validate", domainErr.ErrInvalidWebhookURL)
+	}
+
+	if r.Events != nil && len(r.Events) == 0 {
+		return infraErr.NewGeneralServiceError("WebhookDTO", "Validate", domainErr.ErrInvalidWebhookEvents)
+	}
+
+	return nil
+}
+
+// WebhookEndpointResponse represents a webhook endpoint of a company
+type WebhookEndpointResponse struct {
+	// Webhook ID
+	ID string `json:"id" example:"e1f2a3b4-c5d6-7e8f-9a0b-1c2d3e4f5a6b"`
+
+	// Company ID
+	CompanyID string `json:"company_id" example:"c1d2e3f4-a5b6-7c8d-9e0f-1a2b3c4d5e6f"`
+
+	// URL that receives the events
+	URL string `json:"url" example:"https://example.com/webhooks/delivery"`
+
+	// Subscribed events
+	Events []string `json:"events" example:"order.created,order.delivered"`
+
+	// Description of the endpoint
+	Description string `json:"description,omitempty" example:"ERP integration"`
+
+	// Secret used to sign the events, only returned when the endpoint is created or its secret is rotated
+	Secret string `json:"secret,omitempty" example:"whsec_9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822c"`
+
+	// Whether the endpoint receives events
+	IsActive bool `json:"is_active" example:"true"`
+
+	// Failed delivery attempts in a row
+	ConsecutiveFailures int `json:"consecutive_failures" example:"0"`
+
+	// When the endpoint was disabled after repeated failures
+	DisabledAt *time.Time `json:"disabled_at,omitempty" example:"2025-01-01T12:00:00Z"`
+
+	// Why the endpoint was disabled
+	DisabledReason string `json:"disabled_reason,omitempty" example:"Desactivado tras 15 intentos de entrega fallidos consecutivos"`
+
+	// When the endpoint was registered
+	CreatedAt time.Time `json:"created_at" example:"2025-01-01T10:00:00Z"`
+
+	// When the endpoint was last updated
+	UpdatedAt time.Time `json:"updated_at" example:"2025-01-01T10:00:00Z"`
+}
+
+// WebhookDeliveryResponse represents the delivery of an event to a webhook endpoint
+type WebhookDeliveryResponse struct {
+	// Delivery ID, sent in the X-Webhook-Delivery header
+	ID string `json:"id" example:"f1a2b3c4-d5e6-7f8a-9b0c-1d2e3f4a5b6c"`
+
+	// Webhook ID
+	EndpointID string `json:"endpoint_id" example:"e1f2a3b4-c5d6-7e8f-9a0b-1c2d3e4f5a6b"`
+
+	// Event ID, shared by the redeliveries of the event
+	EventID string `json:"event_id" example:"a1b2c3d4-e5f6-7a8b-9c0d-1e2f3a4b5c6d"`
+
+	// Event type
+	Event string `json:"event" example:"order.delivered"`
+
+	// Body sent to the endpoint
+	Payload json.RawMessage `json:"payload" swaggertype:"object"`
+
+	// Delivery status: PENDING, SUCCEEDED or FAILED
+	Status string `json:"status" example:"SUCCEEDED"`
+
+	// Attempts made
+	Attempts int `json:"attempts" example:"1"`
+
+	// HTTP status code of the last attempt
+	ResponseCode *int `json:"response_code,omitempty" example:"200"`
+
+	// Body of the last response, truncated
+	ResponseBody string `json:"response_body,omitempty" example:"ok"`
+
+	// Error of the last attempt
+	ErrorMessage string `json:"error_message,omitempty" example:"the webhook endpoint did not respond successfully"`
+
+	// Duration of the last attempt in milliseconds
+	DurationMs int64 `json:"duration_ms" example:"120"`
+
+	// Next scheduled attempt
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty" example:"2025-01-01T10:01:00Z"`
+
+	// Last attempt
+	LastAttemptAt *time.Time `json:"last_attempt_at,omitempty" example:"2025-01-01T10:00:30Z"`
+
+	// When the endpoint accepted the event
+	DeliveredAt *time.Time `json:"delivered_at,omitempty" example:"2025-01-01T10:00:30Z"`
+
+	// Delivery redelivered by this one
+	RedeliveryOf *string `json:"redelivery_of,omitempty" example:"b1c2d3e4-f5a6-7b8c-9d0e-1f2a3b4c5d6e"`
+
+	// When the delivery was created
+	CreatedAt time.Time `json:"created_at" example:"2025-01-01T10:00:00Z"`
+}
@@ -95,6 +95,7 @@ func (h *BranchHandler) GetBranchByID(w http.ResponseWriter, r *http.Request) {
 // @Produce      json
 // @Security     BearerAuth
 // @Param        branch body dto.BranchCreateRequest true "Información de la sucursal"
+// @Param        Idempotency-Key header string false "Unique key to safely retry the request without creating duplicates"
 // @Success      201  {string}  string "Sucursal creada exitosamente"
 // @Failure      400  {object}  responser.APIErrorResponse
 // @Router       /api/v1/branches [post]
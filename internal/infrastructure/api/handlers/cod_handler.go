@@ -0,0 +1,140 @@
+package handlers
+
+import (
+	"encoding/json"
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/responser"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/mappers/response_mapper"
+	"net/http"
+)
+
+type CODHandler struct {
+	useCase    ports.CODUseCase
+	respWriter *responser.ResponseWriter
+}
+
+func NewCODHandler(useCase ports.CODUseCase) *CODHandler {
+	return &CODHandler{
+		useCase:    useCase,
+		respWriter: responser.NewResponseWriter(),
+	}
+}
+
+// GetDriverLedger godoc
+// @Summary      This endpoint is used to get the cash ledger of a driver
+// @Description  Get the cash on delivery collections and hand-ins of a driver with the cash still pending to hand in, drivers can only see their own ledger
+// @Tags         cod
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        driver_id query string false "Driver ID (admin and warehouse staff only)"
+// @Param        start_date query string false "Start date (RFC3339), defaults to the start of the current month"
+// @Param        end_date query string false "End date (RFC3339), defaults to now"
+// @Success      200  {object}  dto.CashLedgerResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/cod/ledger [get]
+func (h *CODHandler) GetDriverLedger(w http.ResponseWriter, r *http.Request) {
+	// 1. Extraer filtros
+	query := r.URL.Query()
+
+	// 2. Obtener el libro de efectivo
+	ledger, err := h.useCase.GetDriverLedger(r.Context(), query.Get("driver_id"), query.Get("start_date"), query.Get("end_date"))
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 3. Responder
+	h.respWriter.Success(w, http.StatusOK, ledger)
+}
+
+// ReconcileDriverCash godoc
+// @Summary      This endpoint is used to reconcile the cash handed in by a driver
+// @Description  Compare the cash handed in by a driver at the end of the day against the cash on delivery collections pending
+// @Tags         cod
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        reconciliation body dto.CashReconciliationRequest true "Cash handed in"
+// @Success      201  {object}  dto.CashReconciliationResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/cod/reconciliations [post]
+func (h *CODHandler) ReconcileDriverCash(w http.ResponseWriter, r *http.Request) {
+	// 1. Decodificar solicitud
+	var requestDTO dto.CashReconciliationRequest
+	if err := json.NewDecoder(r.Body).Decode(&requestDTO); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 2. Verificar si la solicitud es válida
+	if err := requestDTO.Validate(); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 3. Llamar al caso de uso
+	reconciliation, err := h.useCase.ReconcileDriverCash(r.Context(), &requestDTO)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 4. Responder
+	h.respWriter.Success(w, http.StatusCreated, response_mapper.CashReconciliationToResponseDTO(reconciliation))
+}
+
+// GetReconciliations godoc
+// @Summary      This endpoint is used to get the cash reconciliations of a driver
+// @Description  Get the cash reconciliations of a driver, drivers can only see their own reconciliations
+// @Tags         cod
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        driver_id query string false "Driver ID (admin and warehouse staff only)"
+// @Success      200  {array}   dto.CashReconciliationResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/cod/reconciliations [get]
+func (h *CODHandler) GetReconciliations(w http.ResponseWriter, r *http.Request) {
+	// 1. Extraer filtros
+	driverID := r.URL.Query().Get("driver_id")
+
+	// 2. Obtener conciliaciones
+	reconciliations, err := h.useCase.GetReconciliations(r.Context(), driverID)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 3. Responder
+	h.respWriter.Success(w, http.StatusOK, response_mapper.CashReconciliationsToResponseDTO(reconciliations))
+}
+
+// GetSettlementReport godoc
+// @Summary      This endpoint is used to get the cash on delivery settlement report of a company
+// @Description  Get the cash on delivery owed to the authenticated user's company per currency, admins can filter by company
+// @Tags         cod
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        company_id query string false "Company ID (admin only)"
+// @Param        start_date query string false "Start date (RFC3339), defaults to the start of the current month"
+// @Param        end_date query string false "End date (RFC3339), defaults to now"
+// @Success      200  {object}  dto.CODSettlementReportResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/cod/settlements [get]
+func (h *CODHandler) GetSettlementReport(w http.ResponseWriter, r *http.Request) {
+	// 1. Extraer filtros
+	query := r.URL.Query()
+
+	// 2. Obtener la liquidación
+	report, err := h.useCase.GetSettlementReport(r.Context(), query.Get("company_id"), query.Get("start_date"), query.Get("end_date"))
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 3. Responder
+	h.respWriter.Success(w, http.StatusOK, report)
+}
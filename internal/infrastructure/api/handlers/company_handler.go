@@ -68,6 +68,7 @@ func (h *CompanyHandler) GetCompanyProfile(w http.ResponseWriter, r *http.Reques
 // @Produce      json
 // @Security     BearerAuth
 // @Param        company body dto.CompanyCreateRequest true "Company information"
+// @Param        Idempotency-Key header string false "Unique key to safely retry the request without creating duplicates"
 // @Success      201  {string}  string "Company created successfully"
 // @Failure      400  {object}  responser.APIErrorResponse
 // @Router       /api/v1/companies [post]
@@ -0,0 +1,303 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/responser"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/mappers/response_mapper"
+	"github.com/gorilla/mux"
+)
+
+type EarningHandler struct {
+	useCase    ports.EarningUseCase
+	respWriter *responser.ResponseWriter
+}
+
+func NewEarningHandler(useCase ports.EarningUseCase) *EarningHandler {
+	return &EarningHandler{
+		useCase:    useCase,
+		respWriter: responser.NewResponseWriter(),
+	}
+}
+
+// GetEarningRules godoc
+// @Summary      This endpoint is used to get the driver earning rules
+// @Description  Get the default earning rule and the rules of each zone used to compute the earning of a delivery
+// @Tags         earnings
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {array}   dto.EarningRuleResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/earnings/rules [get]
+func (h *EarningHandler) GetEarningRules(w http.ResponseWriter, r *http.Request) {
+	// 1. Obtener las reglas
+	rules, err := h.useCase.GetEarningRules(r.Context())
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 2. Responder
+	h.respWriter.Success(w, http.StatusOK, response_mapper.EarningRulesToResponseDTO(rules))
+}
+
+// CreateEarningRule godoc
+// @Summary      This endpoint is used to create a driver earning rule
+// @Description  Create the default earning rule or the rule of a zone with the base per delivery, per-km rate, urgent bonus and zone multiplier
+// @Tags         earnings
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        rule body dto.EarningRuleRequest true "Earning rates"
+// @Success      201  {object}  dto.EarningRuleResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/earnings/rules [post]
+func (h *EarningHandler) CreateEarningRule(w http.ResponseWriter, r *http.Request) {
+	// 1. Decodificar solicitud
+	var requestDTO dto.EarningRuleRequest
+	if err := json.NewDecoder(r.Body).Decode(&requestDTO); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 2. Verificar si la solicitud es válida
+	if err := requestDTO.Validate(); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 3. Llamar al caso de uso
+	rule, err := h.useCase.CreateEarningRule(r.Context(), &requestDTO)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 4. Responder
+	h.respWriter.Success(w, http.StatusCreated, response_mapper.EarningRuleToResponseDTO(rule))
+}
+
+// UpdateEarningRule godoc
+// @Summary      This endpoint is used to update a driver earning rule
+// @Description  Update the rates of an earning rule, deliveries already registered keep the earning computed when they were delivered
+// @Tags         earnings
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        rule_id path string true "Earning rule ID"
+// @Param        rule body dto.EarningRuleRequest true "Earning rates"
+// @Success      200  {object}  dto.EarningRuleResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Failure      404  {object}  responser.APIErrorResponse
+// @Router       /api/v1/earnings/rules/{rule_id} [put]
+func (h *EarningHandler) UpdateEarningRule(w http.ResponseWriter, r *http.Request) {
+	// 1. Extraer ID de la regla
+	ruleID := mux.Vars(r)["rule_id"]
+
+	// 2. Decodificar solicitud
+	var requestDTO dto.EarningRuleRequest
+	if err := json.NewDecoder(r.Body).Decode(&requestDTO); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 3. Verificar si la solicitud es válida
+	if err := requestDTO.Validate(); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 4. Actualizar la regla
+	rule, err := h.useCase.UpdateEarningRule(r.Context(), ruleID, &requestDTO)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 5. Responder
+	h.respWriter.Success(w, http.StatusOK, response_mapper.EarningRuleToResponseDTO(rule))
+}
+
+// GetDriverEarnings godoc
+// @Summary      This endpoint is used to get the earnings ledger of a driver
+// @Description  Get the delivery earnings with their breakdown and the adjustments of a driver in a period, drivers can only see their own ledger
+// @Tags         earnings
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        driver_id query string false "Driver ID (admin only)"
+// @Param        start_date query string false "Start date (RFC3339), defaults to the start of the current month"
+// @Param        end_date query string false "End date (RFC3339), defaults to now"
+// @Success      200  {object}  dto.DriverEarningsResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/earnings [get]
+func (h *EarningHandler) GetDriverEarnings(w http.ResponseWriter, r *http.Request) {
+	// 1. Extraer filtros
+	query := r.URL.Query()
+
+	// 2. Obtener el libro de ganancias
+	earnings, err := h.useCase.GetDriverEarnings(r.Context(), query.Get("driver_id"), query.Get("start_date"), query.Get("end_date"))
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 3. Responder
+	h.respWriter.Success(w, http.StatusOK, earnings)
+}
+
+// AddAdjustment godoc
+// @Summary      This endpoint is used to add an adjustment to the earnings of a driver
+// @Description  Add a positive or negative amount with its reason to the earnings ledger of a driver, it is paid in the next payout statement
+// @Tags         earnings
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        adjustment body dto.EarningAdjustmentRequest true "Adjustment"
+// @Success      201  {object}  dto.DriverEarningResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/earnings/adjustments [post]
+func (h *EarningHandler) AddAdjustment(w http.ResponseWriter, r *http.Request) {
+	// 1. Decodificar solicitud
+	var requestDTO dto.EarningAdjustmentRequest
+	if err := json.NewDecoder(r.Body).Decode(&requestDTO); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 2. Verificar si la solicitud es válida
+	if err := requestDTO.Validate(); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 3. Llamar al caso de uso
+	adjustment, err := h.useCase.AddAdjustment(r.Context(), &requestDTO)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 4. Responder
+	h.respWriter.Success(w, http.StatusCreated, response_mapper.DriverEarningToResponseDTO(adjustment))
+}
+
+// GeneratePayoutStatement godoc
+// @Summary      This endpoint is used to generate the payout statement of a driver
+// @Description  Settle the earnings and adjustments of a driver pending to pay before the end of the period, by default for the previous week
+// @Tags         earnings
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        statement body dto.PayoutStatementGenerateRequest true "Driver and payout period"
+// @Success      201  {object}  dto.PayoutStatementResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/payouts/statements [post]
+func (h *EarningHandler) GeneratePayoutStatement(w http.ResponseWriter, r *http.Request) {
+	// 1. Decodificar solicitud
+	var requestDTO dto.PayoutStatementGenerateRequest
+	if err := json.NewDecoder(r.Body).Decode(&requestDTO); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 2. Verificar si la solicitud es válida
+	if err := requestDTO.Validate(); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 3. Llamar al caso de uso
+	statement, err := h.useCase.GeneratePayoutStatement(r.Context(), &requestDTO)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 4. Responder
+	h.respWriter.Success(w, http.StatusCreated, response_mapper.PayoutStatementToResponseDTO(statement))
+}
+
+// GetPayoutStatements godoc
+// @Summary      This endpoint is used to get the payout statements of a driver
+// @Description  Get the weekly payout statements of a driver without their entries, drivers can only see their own statements
+// @Tags         earnings
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        driver_id query string false "Driver ID (admin only)"
+// @Success      200  {array}   dto.PayoutStatementResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/payouts/statements [get]
+func (h *EarningHandler) GetPayoutStatements(w http.ResponseWriter, r *http.Request) {
+	// 1. Obtener los estados de pago
+	statements, err := h.useCase.GetPayoutStatements(r.Context(), r.URL.Query().Get("driver_id"))
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 2. Responder
+	h.respWriter.Success(w, http.StatusOK, response_mapper.PayoutStatementsToResponseDTO(statements))
+}
+
+// GetPayoutStatementByID godoc
+// @Summary      This endpoint is used to get a payout statement by ID
+// @Description  Get a payout statement with the earnings and adjustments it settles
+// @Tags         earnings
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        statement_id path string true "Payout statement ID"
+// @Success      200  {object}  dto.PayoutStatementResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Failure      404  {object}  responser.APIErrorResponse
+// @Router       /api/v1/payouts/statements/{statement_id} [get]
+func (h *EarningHandler) GetPayoutStatementByID(w http.ResponseWriter, r *http.Request) {
+	// 1. Extraer ID del estado de pago
+	statementID := mux.Vars(r)["statement_id"]
+
+	// 2. Obtener el estado de pago
+	statement, err := h.useCase.GetPayoutStatementByID(r.Context(), statementID)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 3. Responder
+	h.respWriter.Success(w, http.StatusOK, response_mapper.PayoutStatementToResponseDTO(statement))
+}
+
+// DownloadPayoutStatement godoc
+// @Summary      This endpoint is used to download a payout statement
+// @Description  Download a payout statement as a printable PDF
+// @Tags         earnings
+// @Accept       json
+// @Produce      application/pdf
+// @Security     BearerAuth
+// @Param        statement_id path string true "Payout statement ID"
+// @Success      200  {file}    file
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/payouts/statements/{statement_id}/download [get]
+func (h *EarningHandler) DownloadPayoutStatement(w http.ResponseWriter, r *http.Request) {
+	// 1. Extraer ID del estado de pago
+	statementID := mux.Vars(r)["statement_id"]
+
+	// 2. Llamar al caso de uso
+	document, err := h.useCase.ExportPayoutStatement(r.Context(), statementID)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 3. Responder con el archivo
+	w.Header().Set("Content-Type", document.ContentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", document.FileName))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(document.Content)
+}
@@ -0,0 +1,192 @@
+package handlers
+
+import (
+	"fmt"
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	domainErr "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/responser"
+	infraErr "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/mappers/response_mapper"
+	"github.com/gorilla/mux"
+	"io"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strings"
+)
+
+type ImportHandler struct {
+	useCase    ports.ImportUseCase
+	respWriter *responser.ResponseWriter
+}
+
+func NewImportHandler(useCase ports.ImportUseCase) *ImportHandler {
+	return &ImportHandler{
+		useCase:    useCase,
+		respWriter: responser.NewResponseWriter(),
+	}
+}
+
+// CreateImport godoc
+// @Summary      This endpoint is used to import orders in bulk from a CSV or JSON file
+// @Description  Upload a CSV file or a JSON array of orders, the orders are created in background and a per-row report is available once the import finishes
+// @Tags         imports
+// @Accept       text/csv,application/json,multipart/form-data
+// @Produce      json
+// @Security     BearerAuth
+// @Param        format query string false "File format, detected from the content type or file name when omitted" Enums(csv, json)
+// @Param        file formData file false "Import file when uploading as multipart/form-data"
+// @Success      202  {object}  dto.ImportJobResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/imports [post]
+func (h *ImportHandler) CreateImport(w http.ResponseWriter, r *http.Request) {
+	// 1. Limitar el tamaño del archivo
+	r.Body = http.MaxBytesReader(w, r.Body, constants.MaxImportFileSize)
+
+	// 2. Leer el archivo y detectar su formato
+	content, format, err := readImportFile(r)
+	if err != nil {
+		h.respWriter.HandleError(w, infraErr.NewGeneralServiceError("ImportHandler", "CreateImport", err))
+		return
+	}
+
+	// 3. Llamar al caso de uso
+	job, err := h.useCase.CreateImport(r.Context(), format, content)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 4. Responder
+	h.respWriter.Success(w, http.StatusAccepted, response_mapper.ImportJobToResponseDTO(job))
+}
+
+// GetImportJobs godoc
+// @Summary      This endpoint is used to get the bulk order imports of the company
+// @Description  Get bulk order imports of the authenticated user's company
+// @Tags         imports
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {array}   dto.ImportJobResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/imports [get]
+func (h *ImportHandler) GetImportJobs(w http.ResponseWriter, r *http.Request) {
+	jobs, err := h.useCase.GetImportJobs(r.Context())
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, response_mapper.ImportJobsToResponseDTO(jobs))
+}
+
+// GetImportJobByID godoc
+// @Summary      This endpoint is used to get the status of a bulk order import
+// @Description  Get the status and totals of a bulk order import
+// @Tags         imports
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        import_id path string true "Import ID"
+// @Success      200  {object}  dto.ImportJobResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/imports/{import_id} [get]
+func (h *ImportHandler) GetImportJobByID(w http.ResponseWriter, r *http.Request) {
+	// 1. Extraer ID de la importación
+	importID := mux.Vars(r)["import_id"]
+
+	// 2. Obtener la importación
+	job, err := h.useCase.GetImportJobByID(r.Context(), importID)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 3. Responder
+	h.respWriter.Success(w, http.StatusOK, response_mapper.ImportJobToResponseDTO(job))
+}
+
+// GetImportReport godoc
+// @Summary      This endpoint is used to download the report of a bulk order import
+// @Description  Get the per-row result of a finished import, with the created tracking numbers or the validation errors
+// @Tags         imports
+// @Accept       json
+// @Produce      json,text/csv
+// @Security     BearerAuth
+// @Param        import_id path string true "Import ID"
+// @Param        format query string false "Report format" Enums(json, csv)
+// @Success      200  {array}   dto.OrderImportRowResult
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/imports/{import_id}/report [get]
+func (h *ImportHandler) GetImportReport(w http.ResponseWriter, r *http.Request) {
+	// 1. Extraer ID de la importación
+	importID := mux.Vars(r)["import_id"]
+
+	// 2. Obtener el reporte
+	results, err := h.useCase.GetImportReport(r.Context(), importID)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 3. Responder en JSON por defecto
+	if !strings.EqualFold(r.URL.Query().Get("format"), constants.ImportFormatCSV) {
+		h.respWriter.Success(w, http.StatusOK, results)
+		return
+	}
+
+	// 4. Generar el archivo CSV para su descarga
+	report, err := response_mapper.ImportReportToCSV(results)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/csv")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"import-%s-report.csv\"", importID))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(report)
+}
+
+// readImportFile obtiene el contenido del archivo desde el cuerpo o un formulario multipart,
+// detectando el formato por parámetro, nombre de archivo o tipo de contenido
+func readImportFile(r *http.Request) ([]byte, string, error) {
+	format := strings.ToUpper(r.URL.Query().Get("format"))
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+
+	var reader io.Reader = r.Body
+	if mediaType == "multipart/form-data" {
+		file, fileHeader, err := r.FormFile("file")
+		if err != nil {
+			return nil, "", domainErr.ErrInvalidImportFile
+		}
+		defer file.Close()
+
+		reader = file
+		if format == "" {
+			format = strings.ToUpper(strings.TrimPrefix(filepath.Ext(fileHeader.Filename), "."))
+		}
+	}
+
+	if format == "" {
+		switch mediaType {
+		case "text/csv", "application/csv":
+			format = constants.ImportFormatCSV
+		case "application/json":
+			format = constants.ImportFormatJSON
+		}
+	}
+
+	if format != constants.ImportFormatCSV && format != constants.ImportFormatJSON {
+		return nil, "", domainErr.ErrInvalidImportFormat
+	}
+
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, "", domainErr.ErrInvalidImportFile
+	}
+
+	return content, format, nil
+}
@@ -0,0 +1,185 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/responser"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/mappers/response_mapper"
+	"github.com/gorilla/mux"
+	"net/http"
+)
+
+type InvoiceHandler struct {
+	useCase    ports.InvoiceUseCase
+	respWriter *responser.ResponseWriter
+}
+
+func NewInvoiceHandler(useCase ports.InvoiceUseCase) *InvoiceHandler {
+	return &InvoiceHandler{
+		useCase:    useCase,
+		respWriter: responser.NewResponseWriter(),
+	}
+}
+
+// GenerateInvoice godoc
+// @Summary      This endpoint is used to generate the invoice of a company
+// @Description  Generate a draft invoice with a line per delivered order, its surcharges, credits for lost orders and taxes, by default for the previous month
+// @Tags         invoices
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        invoice body dto.InvoiceGenerateRequest true "Company and billing period"
+// @Success      201  {object}  dto.InvoiceResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/invoices [post]
+func (h *InvoiceHandler) GenerateInvoice(w http.ResponseWriter, r *http.Request) {
+	// 1. Decodificar solicitud
+	var requestDTO dto.InvoiceGenerateRequest
+	if err := json.NewDecoder(r.Body).Decode(&requestDTO); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 2. Verificar si la solicitud es válida
+	if err := requestDTO.Validate(); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 3. Llamar al caso de uso
+	invoice, err := h.useCase.GenerateInvoice(r.Context(), &requestDTO)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 4. Responder
+	h.respWriter.Success(w, http.StatusCreated, response_mapper.InvoiceToResponseDTO(invoice))
+}
+
+// GetInvoices godoc
+// @Summary      This endpoint is used to get the invoices of a company
+// @Description  Get invoices of the authenticated user's company without their lines, admins can filter by company and see drafts
+// @Tags         invoices
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        status query string false "Invoice status"
+// @Param        company_id query string false "Company ID (admin only)"
+// @Success      200  {array}   dto.InvoiceResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/invoices [get]
+func (h *InvoiceHandler) GetInvoices(w http.ResponseWriter, r *http.Request) {
+	// 1. Extraer filtros
+	status := r.URL.Query().Get("status")
+	companyID := r.URL.Query().Get("company_id")
+
+	// 2. Obtener facturas
+	invoices, err := h.useCase.GetInvoices(r.Context(), companyID, status)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 3. Responder
+	h.respWriter.Success(w, http.StatusOK, response_mapper.InvoicesToResponseDTO(invoices))
+}
+
+// GetInvoiceByID godoc
+// @Summary      This endpoint is used to get an invoice by ID
+// @Description  Get invoice by ID with its line items
+// @Tags         invoices
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        invoice_id path string true "Invoice ID"
+// @Success      200  {object}  dto.InvoiceResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Failure      404  {object}  responser.APIErrorResponse
+// @Router       /api/v1/invoices/{invoice_id} [get]
+func (h *InvoiceHandler) GetInvoiceByID(w http.ResponseWriter, r *http.Request) {
+	// 1. Extraer ID de la factura
+	invoiceID := mux.Vars(r)["invoice_id"]
+
+	// 2. Obtener factura
+	invoice, err := h.useCase.GetInvoiceByID(r.Context(), invoiceID)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 3. Responder
+	h.respWriter.Success(w, http.StatusOK, response_mapper.InvoiceToResponseDTO(invoice))
+}
+
+// ChangeInvoiceStatus godoc
+// @Summary      This endpoint is used to change the status of an invoice
+// @Description  Issue a draft invoice, mark an issued invoice as paid or void it with a reason
+// @Tags         invoices
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        invoice_id path string true "Invoice ID"
+// @Param        status body dto.InvoiceStatusRequest true "New status"
+// @Success      200  {object}  dto.InvoiceResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/invoices/{invoice_id} [patch]
+func (h *InvoiceHandler) ChangeInvoiceStatus(w http.ResponseWriter, r *http.Request) {
+	// 1. Extraer ID de la factura
+	invoiceID := mux.Vars(r)["invoice_id"]
+
+	// 2. Decodificar solicitud
+	var requestDTO dto.InvoiceStatusRequest
+	if err := json.NewDecoder(r.Body).Decode(&requestDTO); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 3. Verificar si la solicitud es válida
+	if err := requestDTO.Validate(); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 4. Cambiar estado
+	invoice, err := h.useCase.ChangeInvoiceStatus(r.Context(), invoiceID, &requestDTO)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 5. Responder
+	h.respWriter.Success(w, http.StatusOK, response_mapper.InvoiceToResponseDTO(invoice))
+}
+
+// DownloadInvoice godoc
+// @Summary      This endpoint is used to download an invoice
+// @Description  Download an invoice as a printable PDF or as a JSON export
+// @Tags         invoices
+// @Accept       json
+// @Produce      application/pdf,application/json
+// @Security     BearerAuth
+// @Param        invoice_id path string true "Invoice ID"
+// @Param        format query string false "Export format, PDF by default" Enums(pdf, json)
+// @Success      200  {file}    file
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/invoices/{invoice_id}/download [get]
+func (h *InvoiceHandler) DownloadInvoice(w http.ResponseWriter, r *http.Request) {
+	// 1. Extraer ID de la factura
+	invoiceID := mux.Vars(r)["invoice_id"]
+
+	// 2. Llamar al caso de uso
+	document, err := h.useCase.ExportInvoice(r.Context(), invoiceID, r.URL.Query().Get("format"))
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 3. Responder con el archivo
+	w.Header().Set("Content-Type", document.ContentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", document.FileName))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(document.Content)
+}
@@ -0,0 +1,42 @@
+package handlers
+
+import (
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/responser"
+	"net/http"
+)
+
+type JobHandler struct {
+	useCase    ports.JobUseCase
+	respWriter *responser.ResponseWriter
+}
+
+func NewJobHandler(useCase ports.JobUseCase) *JobHandler {
+	return &JobHandler{
+		useCase:    useCase,
+		respWriter: responser.NewResponseWriter(),
+	}
+}
+
+// GetJobRuns godoc
+// @Summary      This endpoint is used to get the run history of the background jobs
+// @Description  Get the runs of the scheduled jobs, most recent first, with their attempt, instance, result and error (admin only)
+// @Tags         jobs
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        job query string false "Job name (clean_expired_sessions, purge_deleted_records, recompute_metrics)"
+// @Param        page query int false "Page number (default 1)"
+// @Param        page_size query int false "Page size (default 20)"
+// @Success      200  {object}  dto.PaginatedResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/jobs/runs [get]
+func (h *JobHandler) GetJobRuns(w http.ResponseWriter, r *http.Request) {
+	runs, err := h.useCase.GetJobRuns(r.Context(), r)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, runs)
+}
@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/responser"
+	"github.com/gorilla/mux"
+	"net/http"
+)
+
+type LabelHandler struct {
+	useCase    ports.LabelUseCase
+	respWriter *responser.ResponseWriter
+}
+
+func NewLabelHandler(useCase ports.LabelUseCase) *LabelHandler {
+	return &LabelHandler{
+		useCase:    useCase,
+		respWriter: responser.NewResponseWriter(),
+	}
+}
+
+// GetOrderLabel godoc
+// @Summary      This endpoint is used to print the shipping label of an order
+// @Description  Generate a 4x6 label with sender branch, recipient address, package flags, weight, a QR code and a Code-128 barcode of the tracking number
+// @Tags         labels
+// @Accept       json
+// @Produce      application/pdf,application/x-zpl
+// @Security     BearerAuth
+// @Param        order_id path string true "Order ID"
+// @Param        format query string false "Label format, PDF by default" Enums(pdf, zpl)
+// @Success      200  {file}    file
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/orders/{order_id}/label [get]
+func (h *LabelHandler) GetOrderLabel(w http.ResponseWriter, r *http.Request) {
+	// 1. Extraer ID del pedido
+	orderID := mux.Vars(r)["order_id"]
+
+	// 2. Llamar al caso de uso
+	label, err := h.useCase.GenerateLabel(r.Context(), orderID, r.URL.Query().Get("format"))
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 3. Responder con el archivo
+	writeLabel(w, label)
+}
+
+// GetOrderLabels godoc
+// @Summary      This endpoint is used to print the shipping labels of many orders at once
+// @Description  Generate a single document with one label per order, in the requested order
+// @Tags         labels
+// @Accept       json
+// @Produce      application/pdf,application/x-zpl
+// @Security     BearerAuth
+// @Param        labels body dto.LabelBatchRequest true "Orders to print"
+// @Success      200  {file}    file
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/orders/labels [post]
+func (h *LabelHandler) GetOrderLabels(w http.ResponseWriter, r *http.Request) {
+	// 1. Decodificar solicitud
+	var requestDTO dto.LabelBatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&requestDTO); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 2. Verificar si la solicitud es válida
+	if err := requestDTO.Validate(); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 3. Llamar al caso de uso
+	label, err := h.useCase.GenerateLabels(r.Context(), &requestDTO)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 4. Responder con el archivo
+	writeLabel(w, label)
+}
+
+func writeLabel(w http.ResponseWriter, label *dto.LabelDocument) {
+	w.Header().Set("Content-Type", label.ContentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", label.FileName))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(label.Content)
+}
@@ -0,0 +1,393 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/responser"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/mappers/response_mapper"
+	"github.com/gorilla/mux"
+	"net/http"
+	"time"
+)
+
+// notificationStreamHeartbeat intervalo de los comentarios que mantienen abierta la conexión del stream
+const notificationStreamHeartbeat = 25 * time.Second
+
+type NotificationHandler struct {
+	useCase    ports.NotificationUseCase
+	respWriter *responser.ResponseWriter
+}
+
+func NewNotificationHandler(useCase ports.NotificationUseCase) *NotificationHandler {
+	return &NotificationHandler{
+		useCase:    useCase,
+		respWriter: responser.NewResponseWriter(),
+	}
+}
+
+// GetCompanySettings godoc
+// @Summary      This endpoint is used to get the order notification settings of a company
+// @Description  Get which order events are notified to the recipient and to the company users, events not configured show their defaults
+// @Tags         notifications
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        company_id query string false "Company ID (admin only)"
+// @Success      200  {object}  dto.CompanyNotificationSettingsResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/companies/notification-settings [get]
+func (h *NotificationHandler) GetCompanySettings(w http.ResponseWriter, r *http.Request) {
+	// 1. Obtener la configuración
+	settings, err := h.useCase.GetCompanySettings(r.Context(), r.URL.Query().Get("company_id"))
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 2. Responder
+	h.respWriter.Success(w, http.StatusOK, settings)
+}
+
+// UpdateCompanySettings godoc
+// @Summary      This endpoint is used to update the order notification settings of a company
+// @Description  Choose which order events are notified to the recipient and to the company users, events not included keep their current settings
+// @Tags         notifications
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        settings body dto.UpdateCompanyNotificationSettingsRequest true "Settings per order event"
+// @Success      200  {object}  dto.CompanyNotificationSettingsResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/companies/notification-settings [put]
+func (h *NotificationHandler) UpdateCompanySettings(w http.ResponseWriter, r *http.Request) {
+	// 1. Decodificar solicitud
+	var requestDTO dto.UpdateCompanyNotificationSettingsRequest
+	if err := json.NewDecoder(r.Body).Decode(&requestDTO); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 2. Verificar si la solicitud es válida
+	if err := requestDTO.Validate(); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 3. Llamar al caso de uso
+	settings, err := h.useCase.UpdateCompanySettings(r.Context(), &requestDTO)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 4. Responder
+	h.respWriter.Success(w, http.StatusOK, settings)
+}
+
+// GetNotifications godoc
+// @Summary      This endpoint is used to get the notifications of the authenticated user
+// @Description  Get the inbox of the authenticated user, most recent first, filterable by type and read state
+// @Tags         notifications
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        type query string false "Notification type"
+// @Param        is_read query bool false "Read state"
+// @Param        page query int false "Page number (default 1)"
+// @Param        page_size query int false "Page size (default 10)"
+// @Param        sort_by query string false "Sort field: created_at, read_at or type"
+// @Param        sort_direction query string false "Sort direction: asc or desc (default desc)"
+// @Success      200  {object}  dto.PaginatedResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/notifications [get]
+func (h *NotificationHandler) GetNotifications(w http.ResponseWriter, r *http.Request) {
+	// 1. Obtener las notificaciones
+	notifications, err := h.useCase.GetNotifications(r.Context(), r)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 2. Responder
+	h.respWriter.Success(w, http.StatusOK, notifications)
+}
+
+// GetUnreadCounts godoc
+// @Summary      This endpoint is used to get the unread notifications count of the authenticated user
+// @Description  Get the total of unread notifications and the count per notification type
+// @Tags         notifications
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  dto.UnreadNotificationsResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/notifications/unread-count [get]
+func (h *NotificationHandler) GetUnreadCounts(w http.ResponseWriter, r *http.Request) {
+	counts, err := h.useCase.GetUnreadCounts(r.Context())
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, counts)
+}
+
+// MarkAsRead godoc
+// @Summary      This endpoint is used to mark a notification as read
+// @Description  Mark a notification of the authenticated user as read, marking it again keeps the original read date
+// @Tags         notifications
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        notification_id path string true "Notification ID"
+// @Success      200  {string}  string "Notification marked as read"
+// @Failure      404  {object}  responser.APIErrorResponse
+// @Router       /api/v1/notifications/{notification_id}/read [patch]
+func (h *NotificationHandler) MarkAsRead(w http.ResponseWriter, r *http.Request) {
+	// 1. Marcar la notificación
+	if err := h.useCase.MarkAsRead(r.Context(), mux.Vars(r)["notification_id"]); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 2. Responder
+	h.respWriter.Success(w, http.StatusOK, "Notification marked as read")
+}
+
+// MarkAllAsRead godoc
+// @Summary      This endpoint is used to mark all the notifications as read
+// @Description  Mark every unread notification of the authenticated user as read
+// @Tags         notifications
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  dto.MarkAllNotificationsReadResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/notifications/read-all [post]
+func (h *NotificationHandler) MarkAllAsRead(w http.ResponseWriter, r *http.Request) {
+	result, err := h.useCase.MarkAllAsRead(r.Context())
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, result)
+}
+
+// StreamNotifications godoc
+// @Summary      This endpoint is used to receive the notifications of the authenticated user in realtime
+// @Description  Server-Sent Events stream, sends the current unread count on connect, then a notification event for each new
+// @Description  notification and an unread_count event when notifications are marked as read
+// @Tags         notifications
+// @Produce      text/event-stream
+// @Security     BearerAuth
+// @Success      200  {object}  dto.InboxEventResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/notifications/stream [get]
+func (h *NotificationHandler) StreamNotifications(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// 1. Conectarse a la bandeja del usuario
+	events, unsubscribe, unread, err := h.useCase.SubscribeInbox(ctx)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+	defer unsubscribe()
+
+	// 2. Quitar el plazo de escritura del servidor, el stream permanece abierto mientras el cliente esté conectado
+	controller := http.NewResponseController(w)
+	if err = controller.SetWriteDeadline(time.Time{}); err != nil {
+		logs.Warn("Failed to clear write deadline for notification stream", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	// 3. Enviar el contador actual
+	if err = writeInboxEvent(w, controller, &entities.InboxEvent{Type: constants.InboxEventUnreadCount, UnreadCount: unread}); err != nil {
+		return
+	}
+
+	// 4. Reenviar los eventos hasta que el cliente se desconecte
+	heartbeat := time.NewTicker(notificationStreamHeartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			if err = writeInboxEvent(w, controller, &event); err != nil {
+				return
+			}
+		case <-heartbeat.C:
+			if _, err = fmt.Fprint(w, ": heartbeat\n\n"); err != nil {
+				return
+			}
+			if err = controller.Flush(); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// GetPreferences godoc
+// @Summary      This endpoint is used to get the notification preferences of the authenticated user
+// @Description  Get the channels enabled for each notification type, types not configured show the defaults of the user role
+// @Tags         notifications
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  dto.NotificationPreferencesResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/notifications/preferences [get]
+func (h *NotificationHandler) GetPreferences(w http.ResponseWriter, r *http.Request) {
+	preferences, err := h.useCase.GetPreferences(r.Context())
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, preferences)
+}
+
+// UpdatePreferences godoc
+// @Summary      This endpoint is used to update the notification preferences of the authenticated user
+// @Description  Choose the channels of each notification type, types not included keep their current preferences
+// @Tags         notifications
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        preferences body dto.UpdateNotificationPreferencesRequest true "Channels per notification type"
+// @Success      200  {object}  dto.NotificationPreferencesResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/notifications/preferences [put]
+func (h *NotificationHandler) UpdatePreferences(w http.ResponseWriter, r *http.Request) {
+	// 1. Decodificar solicitud
+	var requestDTO dto.UpdateNotificationPreferencesRequest
+	if err := json.NewDecoder(r.Body).Decode(&requestDTO); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 2. Verificar si la solicitud es válida
+	if err := requestDTO.Validate(); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 3. Llamar al caso de uso
+	preferences, err := h.useCase.UpdatePreferences(r.Context(), &requestDTO)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 4. Responder
+	h.respWriter.Success(w, http.StatusOK, preferences)
+}
+
+// GetDevices godoc
+// @Summary      This endpoint is used to get the push devices of the authenticated user
+// @Description  Get the devices registered to receive push notifications, tokens are masked
+// @Tags         notifications
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {array}   dto.NotificationDeviceResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/notifications/devices [get]
+func (h *NotificationHandler) GetDevices(w http.ResponseWriter, r *http.Request) {
+	devices, err := h.useCase.GetDevices(r.Context())
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, devices)
+}
+
+// RegisterDevice godoc
+// @Summary      This endpoint is used to register or refresh a push device token
+// @Description  Register the push token of a device, a token already registered is reassigned instead of duplicated
+// @Description  and the previous token is deactivated when the device refreshes it
+// @Tags         notifications
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        device body dto.RegisterNotificationDeviceRequest true "Device token"
+// @Success      200  {object}  dto.NotificationDeviceResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/notifications/devices [post]
+func (h *NotificationHandler) RegisterDevice(w http.ResponseWriter, r *http.Request) {
+	// 1. Decodificar solicitud
+	var requestDTO dto.RegisterNotificationDeviceRequest
+	if err := json.NewDecoder(r.Body).Decode(&requestDTO); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 2. Verificar si la solicitud es válida
+	if err := requestDTO.Validate(); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 3. Llamar al caso de uso
+	device, err := h.useCase.RegisterDevice(r.Context(), &requestDTO)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 4. Responder
+	h.respWriter.Success(w, http.StatusOK, device)
+}
+
+// DeregisterDevice godoc
+// @Summary      This endpoint is used to deregister a push device
+// @Description  Deactivate a device of the authenticated user so it stops receiving push notifications
+// @Tags         notifications
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        device_id path string true "Device ID"
+// @Success      200  {string}  string "Device deregistered"
+// @Failure      404  {object}  responser.APIErrorResponse
+// @Router       /api/v1/notifications/devices/{device_id} [delete]
+func (h *NotificationHandler) DeregisterDevice(w http.ResponseWriter, r *http.Request) {
+	if err := h.useCase.DeregisterDevice(r.Context(), mux.Vars(r)["device_id"]); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, "Device deregistered")
+}
+
+// writeInboxEvent escribe el evento en formato Server-Sent Events y lo envía al cliente
+func writeInboxEvent(w http.ResponseWriter, controller *http.ResponseController, event *entities.InboxEvent) error {
+	payload, err := json.Marshal(response_mapper.InboxEventToResponseDTO(event))
+	if err != nil {
+		return err
+	}
+
+	if _, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.Type, payload); err != nil {
+		return err
+	}
+
+	return controller.Flush()
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"github.com/MarlonG1/delivery-backend/internal/application/ports"
 	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/auth"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
 	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
 	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/responser"
 	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
@@ -32,6 +33,7 @@ func NewOrderHandler(useCase ports.OrdererUseCase) *OrderHandler {
 // @Produce      json
 // @Security     BearerAuth
 // @Param        order body dto.OrderCreateRequest true "Order information"
+// @Param        Idempotency-Key header string false "Unique key to safely retry the request without creating duplicates"
 // @Success      201  {object}  string "Order created successfully"
 // @Failure      400  {object}  responser.APIErrorResponse
 // @Router       /api/v1/orders [post]
@@ -170,6 +172,110 @@ func (h *OrderHandler) ChangeOrderStatus(w http.ResponseWriter, r *http.Request)
 	h.respWriter.Success(w, http.StatusOK, "Order status changed successfully")
 }
 
+// DeliverOrder godoc
+// @Summary      This endpoint is used to complete the delivery of an order
+// @Description  Mark an order as delivered, verifying the recipient PIN when required
+// @Tags         orders
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        order_id path string true "Order ID"
+// @Param        delivery body dto.OrderDeliverRequest true "Delivery confirmation"
+// @Success      200  {object}  string "Order delivered successfully"
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/orders/{order_id}/deliver [post]
+func (h *OrderHandler) DeliverOrder(w http.ResponseWriter, r *http.Request) {
+	// 1. Extraer ID del pedido
+	vars := mux.Vars(r)
+	orderID := vars["order_id"]
+
+	// 2. Decodificar solicitud
+	var requestDTO dto.OrderDeliverRequest
+	if err := json.NewDecoder(r.Body).Decode(&requestDTO); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 3. Llamar al caso de uso
+	err := h.useCase.DeliverOrder(r.Context(), orderID, &requestDTO)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 4. Responder
+	h.respWriter.Success(w, http.StatusOK, "Order delivered successfully")
+}
+
+// RegisterDeliveryAttempt godoc
+// @Summary      This endpoint is used to register a failed delivery attempt
+// @Description  Register a failed delivery attempt, rescheduling the delivery or returning the order to sender after the last attempt
+// @Tags         orders
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        order_id path string true "Order ID"
+// @Param        attempt body dto.DeliveryAttemptRequest true "Delivery attempt information"
+// @Success      201  {object}  dto.DeliveryAttemptResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/orders/{order_id}/attempts [post]
+func (h *OrderHandler) RegisterDeliveryAttempt(w http.ResponseWriter, r *http.Request) {
+	// 1. Extraer ID del pedido
+	vars := mux.Vars(r)
+	orderID := vars["order_id"]
+
+	// 2. Decodificar solicitud
+	var requestDTO dto.DeliveryAttemptRequest
+	if err := json.NewDecoder(r.Body).Decode(&requestDTO); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 3. Verificar si la solicitud es válida
+	if err := requestDTO.Validate(); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 4. Llamar al caso de uso
+	attempt, err := h.useCase.RegisterDeliveryAttempt(r.Context(), orderID, &requestDTO)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 5. Responder
+	response := response_mapper.DeliveryAttemptsToResponseDTO([]entities.DeliveryAttempt{*attempt})
+	h.respWriter.Success(w, http.StatusCreated, response[0])
+}
+
+// GetDeliveryAttempts godoc
+// @Summary      This endpoint is used to get the delivery attempts of an order
+// @Description  Get delivery attempts of an order
+// @Tags         orders
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        order_id path string true "Order ID"
+// @Success      200  {array}   dto.DeliveryAttemptResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/orders/{order_id}/attempts [get]
+func (h *OrderHandler) GetDeliveryAttempts(w http.ResponseWriter, r *http.Request) {
+	// 1. Extraer ID del pedido
+	vars := mux.Vars(r)
+	orderID := vars["order_id"]
+
+	// 2. Obtener intentos
+	attempts, err := h.useCase.GetDeliveryAttempts(r.Context(), orderID)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 3. Responder
+	h.respWriter.Success(w, http.StatusOK, response_mapper.DeliveryAttemptsToResponseDTO(attempts))
+}
+
 // GetOrdersByCompany godoc
 // @Summary      This endpoint is used to get orders by company
 // @Description  Get orders by company
@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/responser"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/mappers/response_mapper"
+	"github.com/gorilla/mux"
+)
+
+type PaymentHandler struct {
+	useCase    ports.PaymentUseCase
+	respWriter *responser.ResponseWriter
+}
+
+func NewPaymentHandler(useCase ports.PaymentUseCase) *PaymentHandler {
+	return &PaymentHandler{
+		useCase:    useCase,
+		respWriter: responser.NewResponseWriter(),
+	}
+}
+
+// GetOrderPayment godoc
+// @Summary      This endpoint is used to get the payment of a prepaid order
+// @Description  Get the payment status of a prepaid order, including authorization, capture and refund dates
+// @Tags         payments
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        order_id path string true "Order ID"
+// @Success      200  {object}  dto.OrderPaymentResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Failure      404  {object}  responser.APIErrorResponse
+// @Router       /api/v1/orders/{order_id}/payment [get]
+func (h *PaymentHandler) GetOrderPayment(w http.ResponseWriter, r *http.Request) {
+	// 1. Extraer el ID del pedido
+	orderID := mux.Vars(r)["order_id"]
+
+	// 2. Obtener el pago del pedido
+	payment, err := h.useCase.GetOrderPayment(r.Context(), orderID)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 3. Responder
+	h.respWriter.Success(w, http.StatusOK, response_mapper.OrderPaymentToResponseDTO(payment))
+}
+
+// HandleWebhook godoc
+// @Summary      This endpoint receives the events of the payment provider
+// @Description  Apply a payment event signed with HMAC-SHA256 in the X-Payment-Signature header, repeated events are acknowledged without being applied again
+// @Tags         payments
+// @Accept       json
+// @Produce      json
+// @Param        X-Payment-Signature header string true "Hex HMAC-SHA256 of the body"
+// @Success      200  {string}  string "Webhook processed"
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/payments/webhook [post]
+func (h *PaymentHandler) HandleWebhook(w http.ResponseWriter, r *http.Request) {
+	// 1. Leer el cuerpo sin decodificar, la firma se calcula sobre los bytes originales
+	payload, err := io.ReadAll(r.Body)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 2. Procesar el evento
+	if err = h.useCase.HandleWebhook(r.Context(), payload, r.Header.Get(constants.PaymentSignatureHeader)); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 3. Responder
+	h.respWriter.Success(w, http.StatusOK, "Webhook processed")
+}
@@ -0,0 +1,170 @@
+package handlers
+
+import (
+	"encoding/json"
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/responser"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/mappers/response_mapper"
+	"github.com/gorilla/mux"
+	"net/http"
+)
+
+type ReturnHandler struct {
+	useCase    ports.ReturnUseCase
+	respWriter *responser.ResponseWriter
+}
+
+func NewReturnHandler(useCase ports.ReturnUseCase) *ReturnHandler {
+	return &ReturnHandler{
+		useCase:    useCase,
+		respWriter: responser.NewResponseWriter(),
+	}
+}
+
+// CreateReturn godoc
+// @Summary      This endpoint is used to create a return for an order
+// @Description  Create a return linked to the original delivered order, picked up at the original delivery address
+// @Tags         returns
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        order_id path string true "Order ID"
+// @Param        return body dto.ReturnCreateRequest true "Return information"
+// @Success      201  {object}  dto.ReturnResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/orders/{order_id}/returns [post]
+func (h *ReturnHandler) CreateReturn(w http.ResponseWriter, r *http.Request) {
+	// 1. Extraer ID del pedido
+	orderID := mux.Vars(r)["order_id"]
+
+	// 2. Decodificar solicitud
+	var requestDTO dto.ReturnCreateRequest
+	if err := json.NewDecoder(r.Body).Decode(&requestDTO); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 3. Verificar si la solicitud es válida
+	if err := requestDTO.Validate(); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 4. Llamar al caso de uso
+	orderReturn, err := h.useCase.CreateReturn(r.Context(), orderID, &requestDTO)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 5. Responder
+	h.respWriter.Success(w, http.StatusCreated, response_mapper.ReturnToResponseDTO(orderReturn))
+}
+
+// GetReturnsByOrder godoc
+// @Summary      This endpoint is used to get the returns of an order
+// @Description  Get returns of an order
+// @Tags         returns
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        order_id path string true "Order ID"
+// @Success      200  {array}   dto.ReturnResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/orders/{order_id}/returns [get]
+func (h *ReturnHandler) GetReturnsByOrder(w http.ResponseWriter, r *http.Request) {
+	// 1. Extraer ID del pedido
+	orderID := mux.Vars(r)["order_id"]
+
+	// 2. Obtener devoluciones
+	returns, err := h.useCase.GetReturnsByOrder(r.Context(), orderID)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 3. Responder
+	h.respWriter.Success(w, http.StatusOK, response_mapper.ReturnsToResponseDTO(returns))
+}
+
+// GetReturns godoc
+// @Summary      This endpoint is used to get the returns of a company
+// @Description  Get returns of the authenticated user's company, admins can filter by company
+// @Tags         returns
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        status query string false "Return status"
+// @Param        company_id query string false "Company ID (admin only)"
+// @Success      200  {array}   dto.ReturnResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/returns [get]
+func (h *ReturnHandler) GetReturns(w http.ResponseWriter, r *http.Request) {
+	// 1. Extraer filtros
+	status := r.URL.Query().Get("status")
+	companyID := r.URL.Query().Get("company_id")
+
+	// 2. Obtener devoluciones
+	returns, err := h.useCase.GetReturnsByCompany(r.Context(), companyID, status)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 3. Responder
+	h.respWriter.Success(w, http.StatusOK, response_mapper.ReturnsToResponseDTO(returns))
+}
+
+// GetReturnByID godoc
+// @Summary      This endpoint is used to get a return by ID
+// @Description  Get return by ID
+// @Tags         returns
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        return_id path string true "Return ID"
+// @Success      200  {object}  dto.ReturnResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/returns/{return_id} [get]
+func (h *ReturnHandler) GetReturnByID(w http.ResponseWriter, r *http.Request) {
+	// 1. Extraer ID de la devolución
+	returnID := mux.Vars(r)["return_id"]
+
+	// 2. Obtener devolución
+	orderReturn, err := h.useCase.GetReturnByID(r.Context(), returnID)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 3. Responder
+	h.respWriter.Success(w, http.StatusOK, response_mapper.ReturnToResponseDTO(orderReturn))
+}
+
+// ChangeReturnStatus godoc
+// @Summary      This endpoint is used to change the status of a return
+// @Description  Change return status following the pickup and routing flow
+// @Tags         returns
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        return_id path string true "Return ID"
+// @Param        status query string true "New status"
+// @Success      200  {object}  string "Return status changed successfully"
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/returns/{return_id} [patch]
+func (h *ReturnHandler) ChangeReturnStatus(w http.ResponseWriter, r *http.Request) {
+	// 1. Extraer ID de la devolución y nuevo estado
+	returnID := mux.Vars(r)["return_id"]
+	status := r.URL.Query().Get("status")
+
+	// 2. Cambiar estado
+	if err := h.useCase.ChangeReturnStatus(r.Context(), returnID, status); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 3. Responder
+	h.respWriter.Success(w, http.StatusOK, "Return status changed successfully")
+}
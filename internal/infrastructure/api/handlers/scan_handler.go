@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"encoding/json"
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/responser"
+	"net/http"
+)
+
+type ScanHandler struct {
+	useCase    ports.ScanUseCase
+	respWriter *responser.ResponseWriter
+}
+
+func NewScanHandler(useCase ports.ScanUseCase) *ScanHandler {
+	return &ScanHandler{
+		useCase:    useCase,
+		respWriter: responser.NewResponseWriter(),
+	}
+}
+
+// ScanOrder godoc
+// @Summary      This endpoint is used by handheld scanners to look up an order by its QR code
+// @Description  Return the scanned order with the status transitions available for the caller role, optionally applying one of them in the same call. Parcel QR codes move only that parcel and the order advances once all its parcels do. Only drivers, collectors, warehouse staff and admins can scan, and drivers only their assigned orders
+// @Tags         orders
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        scan body dto.ScanRequest true "Scanned QR data and optional status"
+// @Success      200  {object}  dto.ScanResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Failure      404  {object}  responser.APIErrorResponse
+// @Router       /api/v1/orders/scan [post]
+func (h *ScanHandler) ScanOrder(w http.ResponseWriter, r *http.Request) {
+	// 1. Decodificar solicitud
+	var requestDTO dto.ScanRequest
+	if err := json.NewDecoder(r.Body).Decode(&requestDTO); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 2. Verificar si la solicitud es válida
+	if err := requestDTO.Validate(); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 3. Llamar al caso de uso
+	response, err := h.useCase.ScanOrder(r.Context(), &requestDTO)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 4. Responder
+	h.respWriter.Success(w, http.StatusOK, response)
+}
@@ -0,0 +1,217 @@
+package handlers
+
+import (
+	"encoding/json"
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/responser"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/mappers/response_mapper"
+	"github.com/gorilla/mux"
+	"net/http"
+)
+
+type ScheduleHandler struct {
+	useCase    ports.ScheduleUseCase
+	respWriter *responser.ResponseWriter
+}
+
+func NewScheduleHandler(useCase ports.ScheduleUseCase) *ScheduleHandler {
+	return &ScheduleHandler{
+		useCase:    useCase,
+		respWriter: responser.NewResponseWriter(),
+	}
+}
+
+// CreateSchedule godoc
+// @Summary      This endpoint is used to schedule a future or recurring order
+// @Description  Create a one-off future order or a weekly recurring pickup from the user's branch
+// @Tags         schedules
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        schedule body dto.ScheduleRequest true "Schedule information"
+// @Success      201  {object}  dto.ScheduleResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/schedules [post]
+func (h *ScheduleHandler) CreateSchedule(w http.ResponseWriter, r *http.Request) {
+	// 1. Decodificar solicitud
+	var requestDTO dto.ScheduleRequest
+	if err := json.NewDecoder(r.Body).Decode(&requestDTO); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 2. Verificar si la solicitud es válida
+	if err := requestDTO.Validate(); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 3. Llamar al caso de uso
+	schedule, err := h.useCase.CreateSchedule(r.Context(), &requestDTO)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 4. Responder
+	h.respWriter.Success(w, http.StatusCreated, response_mapper.ScheduleToResponseDTO(schedule))
+}
+
+// GetSchedules godoc
+// @Summary      This endpoint is used to get the order schedules of the company
+// @Description  Get order schedules of the authenticated user's company
+// @Tags         schedules
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        status query string false "Schedule status"
+// @Success      200  {array}   dto.ScheduleResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/schedules [get]
+func (h *ScheduleHandler) GetSchedules(w http.ResponseWriter, r *http.Request) {
+	// 1. Extraer filtros
+	status := r.URL.Query().Get("status")
+
+	// 2. Obtener programaciones
+	schedules, err := h.useCase.GetSchedules(r.Context(), status)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 3. Responder
+	h.respWriter.Success(w, http.StatusOK, response_mapper.SchedulesToResponseDTO(schedules))
+}
+
+// GetScheduleByID godoc
+// @Summary      This endpoint is used to get an order schedule by ID
+// @Description  Get an order schedule by ID
+// @Tags         schedules
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        schedule_id path string true "Schedule ID"
+// @Success      200  {object}  dto.ScheduleResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/schedules/{schedule_id} [get]
+func (h *ScheduleHandler) GetScheduleByID(w http.ResponseWriter, r *http.Request) {
+	// 1. Extraer ID de la programación
+	scheduleID := mux.Vars(r)["schedule_id"]
+
+	// 2. Obtener programación
+	schedule, err := h.useCase.GetScheduleByID(r.Context(), scheduleID)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 3. Responder
+	h.respWriter.Success(w, http.StatusOK, response_mapper.ScheduleToResponseDTO(schedule))
+}
+
+// UpdateSchedule godoc
+// @Summary      This endpoint is used to edit an order schedule
+// @Description  Replace the recurrence and order template of an active or paused schedule
+// @Tags         schedules
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        schedule_id path string true "Schedule ID"
+// @Param        schedule body dto.ScheduleRequest true "Schedule information"
+// @Success      200  {object}  dto.ScheduleResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/schedules/{schedule_id} [put]
+func (h *ScheduleHandler) UpdateSchedule(w http.ResponseWriter, r *http.Request) {
+	// 1. Extraer ID de la programación
+	scheduleID := mux.Vars(r)["schedule_id"]
+
+	// 2. Decodificar solicitud
+	var requestDTO dto.ScheduleRequest
+	if err := json.NewDecoder(r.Body).Decode(&requestDTO); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 3. Verificar si la solicitud es válida
+	if err := requestDTO.Validate(); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 4. Llamar al caso de uso
+	schedule, err := h.useCase.UpdateSchedule(r.Context(), scheduleID, &requestDTO)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 5. Responder
+	h.respWriter.Success(w, http.StatusOK, response_mapper.ScheduleToResponseDTO(schedule))
+}
+
+// PauseSchedule godoc
+// @Summary      This endpoint is used to pause an order schedule
+// @Description  Pause an active schedule, no orders are created while paused
+// @Tags         schedules
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        schedule_id path string true "Schedule ID"
+// @Success      200  {string}  string "Programación pausada exitosamente"
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/schedules/{schedule_id}/pause [post]
+func (h *ScheduleHandler) PauseSchedule(w http.ResponseWriter, r *http.Request) {
+	scheduleID := mux.Vars(r)["schedule_id"]
+
+	if err := h.useCase.PauseSchedule(r.Context(), scheduleID); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, "Programación pausada exitosamente")
+}
+
+// ResumeSchedule godoc
+// @Summary      This endpoint is used to resume a paused order schedule
+// @Description  Resume a paused schedule from its next occurrence
+// @Tags         schedules
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        schedule_id path string true "Schedule ID"
+// @Success      200  {string}  string "Programación reanudada exitosamente"
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/schedules/{schedule_id}/resume [post]
+func (h *ScheduleHandler) ResumeSchedule(w http.ResponseWriter, r *http.Request) {
+	scheduleID := mux.Vars(r)["schedule_id"]
+
+	if err := h.useCase.ResumeSchedule(r.Context(), scheduleID); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, "Programación reanudada exitosamente")
+}
+
+// CancelSchedule godoc
+// @Summary      This endpoint is used to cancel an order schedule
+// @Description  Cancel a schedule, orders already created are not affected
+// @Tags         schedules
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        schedule_id path string true "Schedule ID"
+// @Success      200  {string}  string "Programación cancelada exitosamente"
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/schedules/{schedule_id} [delete]
+func (h *ScheduleHandler) CancelSchedule(w http.ResponseWriter, r *http.Request) {
+	scheduleID := mux.Vars(r)["schedule_id"]
+
+	if err := h.useCase.CancelSchedule(r.Context(), scheduleID); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, "Programación cancelada exitosamente")
+}
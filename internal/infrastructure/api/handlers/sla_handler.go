@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/responser"
+	"net/http"
+)
+
+type SLAHandler struct {
+	useCase    ports.SLAUseCase
+	respWriter *responser.ResponseWriter
+}
+
+func NewSLAHandler(useCase ports.SLAUseCase) *SLAHandler {
+	return &SLAHandler{
+		useCase:    useCase,
+		respWriter: responser.NewResponseWriter(),
+	}
+}
+
+// GetOrdersSLA godoc
+// @Summary      This endpoint is used to get the delivery SLA of the active orders
+// @Description  Get the active orders of the authenticated user's company with their estimated delivery and SLA status (on track, at risk or breached), admins can filter by company
+// @Tags         orders
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        company_id query string false "Company ID (admin only)"
+// @Param        branch_id query string false "Branch ID"
+// @Param        status query string false "SLA status (ON_TRACK, AT_RISK, BREACHED)"
+// @Success      200  {object}  dto.OrdersSLAResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/orders/sla [get]
+func (h *SLAHandler) GetOrdersSLA(w http.ResponseWriter, r *http.Request) {
+	// 1. Extraer filtros
+	query := r.URL.Query()
+
+	// 2. Obtener el SLA de los pedidos activos
+	response, err := h.useCase.GetOrdersSLA(r.Context(), query.Get("company_id"), query.Get("branch_id"), query.Get("status"))
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 3. Responder
+	h.respWriter.Success(w, http.StatusOK, response)
+}
@@ -54,6 +54,7 @@ func (h *UserHandler) GetUserProfile(w http.ResponseWriter, r *http.Request) {
 // @Produce      json
 // @Security     BearerAuth
 // @Param        user body dto.UserDTO true "User object that needs to be created"
+// @Param        Idempotency-Key header string false "Unique key to safely retry the request without creating duplicates"
 // @Success      201  string  "User created successfully"
 // @Failure      400  {object}  responser.APIErrorResponse
 // @Router       /api/v1/users [post]
@@ -0,0 +1,223 @@
+package handlers
+
+import (
+	"encoding/json"
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/responser"
+	"github.com/gorilla/mux"
+	"net/http"
+)
+
+type WebhookHandler struct {
+	useCase    ports.WebhookUseCase
+	respWriter *responser.ResponseWriter
+}
+
+func NewWebhookHandler(useCase ports.WebhookUseCase) *WebhookHandler {
+	return &WebhookHandler{
+		useCase:    useCase,
+		respWriter: responser.NewResponseWriter(),
+	}
+}
+
+// CreateWebhook godoc
+// @Summary      This endpoint is used to register a webhook of a company
+// @Description  Register an HTTPS URL that receives the subscribed order events signed with HMAC-SHA256, the secret is only returned on creation and rotation
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        webhook body dto.CreateWebhookRequest true "Webhook data"
+// @Success      201  {object}  dto.WebhookEndpointResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/companies/webhooks [post]
+func (h *WebhookHandler) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+	// 1. Decodificar solicitud
+	var requestDTO dto.CreateWebhookRequest
+	if err := json.NewDecoder(r.Body).Decode(&requestDTO); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 2. Verificar si la solicitud es válida
+	if err := requestDTO.Validate(); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 3. Llamar al caso de uso
+	webhook, err := h.useCase.CreateWebhook(r.Context(), &requestDTO)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 4. Responder
+	h.respWriter.Success(w, http.StatusCreated, webhook)
+}
+
+// GetWebhooks godoc
+// @Summary      This endpoint is used to get the webhooks of a company
+// @Description  Get the registered webhooks of the company with their subscribed events and delivery state
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        company_id query string false "Company ID (admin only)"
+// @Success      200  {array}   dto.WebhookEndpointResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Router       /api/v1/companies/webhooks [get]
+func (h *WebhookHandler) GetWebhooks(w http.ResponseWriter, r *http.Request) {
+	webhooks, err := h.useCase.GetWebhooks(r.Context(), r.URL.Query().Get("company_id"))
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, webhooks)
+}
+
+// GetWebhook godoc
+// @Summary      This endpoint is used to get a webhook by ID
+// @Description  Get a webhook of the company with its subscribed events and delivery state
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        webhook_id path string true "Webhook ID"
+// @Success      200  {object}  dto.WebhookEndpointResponse
+// @Failure      404  {object}  responser.APIErrorResponse
+// @Router       /api/v1/companies/webhooks/{webhook_id} [get]
+func (h *WebhookHandler) GetWebhook(w http.ResponseWriter, r *http.Request) {
+	webhook, err := h.useCase.GetWebhook(r.Context(), mux.Vars(r)["webhook_id"])
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, webhook)
+}
+
+// UpdateWebhook godoc
+// @Summary      This endpoint is used to update a webhook
+// @Description  Update the URL, subscribed events, description or active state of a webhook, activating it again resets its failure count
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        webhook_id path string true "Webhook ID"
+// @Param        webhook body dto.UpdateWebhookRequest true "Fields to update"
+// @Success      200  {object}  dto.WebhookEndpointResponse
+// @Failure      400  {object}  responser.APIErrorResponse
+// @Failure      404  {object}  responser.APIErrorResponse
+// @Router       /api/v1/companies/webhooks/{webhook_id} [put]
+func (h *WebhookHandler) UpdateWebhook(w http.ResponseWriter, r *http.Request) {
+	// 1. Decodificar solicitud
+	var requestDTO dto.UpdateWebhookRequest
+	if err := json.NewDecoder(r.Body).Decode(&requestDTO); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 2. Verificar si la solicitud es válida
+	if err := requestDTO.Validate(); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 3. Llamar al caso de uso
+	webhook, err := h.useCase.UpdateWebhook(r.Context(), mux.Vars(r)["webhook_id"], &requestDTO)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// 4. Responder
+	h.respWriter.Success(w, http.StatusOK, webhook)
+}
+
+// DeleteWebhook godoc
+// @Summary      This endpoint is used to delete a webhook
+// @Description  Delete a webhook of the company, pending deliveries are no longer sent
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        webhook_id path string true "Webhook ID"
+// @Success      200  {string}  string "Webhook deleted"
+// @Failure      404  {object}  responser.APIErrorResponse
+// @Router       /api/v1/companies/webhooks/{webhook_id} [delete]
+func (h *WebhookHandler) DeleteWebhook(w http.ResponseWriter, r *http.Request) {
+	if err := h.useCase.DeleteWebhook(r.Context(), mux.Vars(r)["webhook_id"]); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, "Webhook deleted")
+}
+
+// RotateWebhookSecret godoc
+// @Summary      This endpoint is used to rotate the secret of a webhook
+// @Description  Generate a new signing secret for the webhook, the previous secret stops being used immediately
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        webhook_id path string true "Webhook ID"
+// @Success      200  {object}  dto.WebhookEndpointResponse
+// @Failure      404  {object}  responser.APIErrorResponse
+// @Router       /api/v1/companies/webhooks/{webhook_id}/rotate-secret [post]
+func (h *WebhookHandler) RotateWebhookSecret(w http.ResponseWriter, r *http.Request) {
+	webhook, err := h.useCase.RotateWebhookSecret(r.Context(), mux.Vars(r)["webhook_id"])
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, webhook)
+}
+
+// GetWebhookDeliveries godoc
+// @Summary      This endpoint is used to get the delivery log of a webhook
+// @Description  Get the deliveries of a webhook, most recent first, with their attempts, response code and error
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        webhook_id path string true "Webhook ID"
+// @Param        page query int false "Page number (default 1)"
+// @Param        page_size query int false "Page size (default 10)"
+// @Success      200  {object}  dto.PaginatedResponse
+// @Failure      404  {object}  responser.APIErrorResponse
+// @Router       /api/v1/companies/webhooks/{webhook_id}/deliveries [get]
+func (h *WebhookHandler) GetWebhookDeliveries(w http.ResponseWriter, r *http.Request) {
+	deliveries, err := h.useCase.GetWebhookDeliveries(r.Context(), mux.Vars(r)["webhook_id"], r)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, deliveries)
+}
+
+// RedeliverWebhook godoc
+// @Summary      This endpoint is used to redeliver a webhook event
+// @Description  Send again the event of a delivery as a new delivery with the same event ID, and return its result
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        delivery_id path string true "Delivery ID"
+// @Success      200  {object}  dto.WebhookDeliveryResponse
+// @Failure      404  {object}  responser.APIErrorResponse
+// @Router       /api/v1/companies/webhooks/deliveries/{delivery_id}/redeliver [post]
+func (h *WebhookHandler) RedeliverWebhook(w http.ResponseWriter, r *http.Request) {
+	delivery, err := h.useCase.RedeliverWebhook(r.Context(), mux.Vars(r)["delivery_id"])
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, delivery)
+}
@@ -16,7 +16,7 @@ func NewCorsMiddleware(origins, methods, headers []string) *CorsMiddleware {
 		methods = []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete, http.MethodOptions}
 	}
 	if len(headers) == 0 {
-		headers = []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token"}
+		headers = []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token", "Idempotency-Key"}
 	}
 
 	return &CorsMiddleware{
@@ -107,3 +107,9 @@ func (w *statusWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
 	}
 	return nil, nil, fmt.Errorf("hijacking not supported")
 }
+
+// Unwrap expone el http.ResponseWriter original para que http.ResponseController pueda usar Flush y los
+// plazos de escritura, necesario para los streams de eventos
+func (w *statusWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
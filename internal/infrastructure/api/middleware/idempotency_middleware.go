@@ -0,0 +1,187 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/auth"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/responser"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+const (
+	IdempotencyKeyHeader      = "Idempotency-Key"
+	IdempotencyReplayedHeader = "Idempotent-Replayed"
+
+	maxIdempotencyKeyLength = 255
+
+	idempotencyInProgress = "IN_PROGRESS"
+	idempotencyCompleted  = "COMPLETED"
+)
+
+// idempotencyRecord es lo que se guarda en el almacén por cada Idempotency-Key
+type idempotencyRecord struct {
+	Fingerprint string `json:"fingerprint"`
+	State       string `json:"state"`
+	StatusCode  int    `json:"status_code,omitempty"`
+	ContentType string `json:"content_type,omitempty"`
+	Body        []byte `json:"body,omitempty"`
+}
+
+type IdempotencyMiddleware struct {
+	store      ports.IdempotencyStore
+	ttl        time.Duration
+	respWriter *responser.ResponseWriter
+}
+
+// NewIdempotencyMiddleware crea el middleware que evita procesar dos veces un POST con la misma Idempotency-Key
+func NewIdempotencyMiddleware(store ports.IdempotencyStore, ttl time.Duration) *IdempotencyMiddleware {
+	return &IdempotencyMiddleware{
+		store:      store,
+		ttl:        ttl,
+		respWriter: responser.NewResponseWriter(),
+	}
+}
+
+// Handle guarda la respuesta de los POST que envían Idempotency-Key y la repite en los reintentos.
+// Si la llave se reutiliza con otro cuerpo se rechaza, y si el almacén no está disponible la solicitud continúa sin protección
+func (m *IdempotencyMiddleware) Handle(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(IdempotencyKeyHeader)
+		if r.Method != http.MethodPost || key == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if len(key) > maxIdempotencyKeyLength {
+			m.respWriter.Error(w, http.StatusBadRequest, errPackage.ErrInvalidIdempotencyKey.Error(), nil)
+			return
+		}
+
+		// 1. Leer el cuerpo para calcular la huella de la solicitud
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			m.respWriter.Error(w, http.StatusBadRequest, "Bad request", nil)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		storeKey := idempotencyScope(r) + ":" + key
+		fingerprint := requestFingerprint(r, body)
+
+		// 2. Reservar la llave, si ya existe se responde con lo guardado
+		reservation, _ := json.Marshal(idempotencyRecord{Fingerprint: fingerprint, State: idempotencyInProgress})
+		reserved, err := m.store.Reserve(r.Context(), storeKey, reservation, m.ttl)
+		if err != nil {
+			logs.Warn("Idempotency store unavailable, processing request without protection", map[string]interface{}{
+				"path":  r.URL.Path,
+				"error": err.Error(),
+			})
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if !reserved {
+			m.replay(w, r, storeKey, fingerprint)
+			return
+		}
+
+		// 3. Procesar la solicitud capturando la respuesta
+		recorder := &responseRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(recorder, r)
+
+		// 4. Los errores del servidor liberan la llave para que el cliente pueda reintentar
+		if recorder.status >= http.StatusInternalServerError {
+			_ = m.store.Release(r.Context(), storeKey)
+			return
+		}
+
+		record, _ := json.Marshal(idempotencyRecord{
+			Fingerprint: fingerprint,
+			State:       idempotencyCompleted,
+			StatusCode:  recorder.status,
+			ContentType: recorder.Header().Get("Content-Type"),
+			Body:        recorder.body.Bytes(),
+		})
+		if err = m.store.Save(r.Context(), storeKey, record, m.ttl); err != nil {
+			_ = m.store.Release(r.Context(), storeKey)
+		}
+	})
+}
+
+// replay responde a un reintento con la respuesta guardada de la solicitud original
+func (m *IdempotencyMiddleware) replay(w http.ResponseWriter, r *http.Request, storeKey, fingerprint string) {
+	stored, err := m.store.Get(r.Context(), storeKey)
+	if err != nil {
+		m.respWriter.Error(w, http.StatusConflict, errPackage.ErrIdempotencyRequestInProcess.Error(), nil)
+		return
+	}
+
+	var record idempotencyRecord
+	if err = json.Unmarshal(stored, &record); err != nil {
+		m.respWriter.Error(w, http.StatusInternalServerError, "An unexpected error occurred", nil)
+		return
+	}
+
+	if record.Fingerprint != fingerprint {
+		logs.Warn("Idempotency key reused with a different request", map[string]interface{}{
+			"path": r.URL.Path,
+		})
+		m.respWriter.Error(w, http.StatusUnprocessableEntity, errPackage.ErrIdempotencyKeyReused.Error(), nil)
+		return
+	}
+
+	if record.State != idempotencyCompleted {
+		m.respWriter.Error(w, http.StatusConflict, errPackage.ErrIdempotencyRequestInProcess.Error(), nil)
+		return
+	}
+
+	if record.ContentType != "" {
+		w.Header().Set("Content-Type", record.ContentType)
+	}
+	w.Header().Set(IdempotencyReplayedHeader, "true")
+	w.WriteHeader(record.StatusCode)
+	_, _ = w.Write(record.Body)
+}
+
+// idempotencyScope separa las llaves por usuario para que dos clientes no compartan respuestas
+func idempotencyScope(r *http.Request) string {
+	if claims, ok := r.Context().Value("claims").(*auth.AuthClaims); ok {
+		return claims.UserID
+	}
+
+	return "anonymous"
+}
+
+// requestFingerprint identifica la solicitud por método, ruta y cuerpo
+func requestFingerprint(r *http.Request, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(r.Method + " " + r.URL.RequestURI() + "\n"))
+	hash.Write(body)
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// responseRecorder copia la respuesta mientras se envía al cliente
+type responseRecorder struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (w *responseRecorder) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *responseRecorder) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
@@ -0,0 +1,14 @@
+package routes
+
+import (
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/handlers"
+	"github.com/gorilla/mux"
+	"net/http"
+)
+
+func RegisterCODRoutes(router *mux.Router, codHandler *handlers.CODHandler) {
+	router.HandleFunc("/cod/ledger", codHandler.GetDriverLedger).Methods(http.MethodGet)
+	router.HandleFunc("/cod/reconciliations", codHandler.ReconcileDriverCash).Methods(http.MethodPost)
+	router.HandleFunc("/cod/reconciliations", codHandler.GetReconciliations).Methods(http.MethodGet)
+	router.HandleFunc("/cod/settlements", codHandler.GetSettlementReport).Methods(http.MethodGet)
+}
@@ -0,0 +1,19 @@
+package routes
+
+import (
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/handlers"
+	"github.com/gorilla/mux"
+	"net/http"
+)
+
+func RegisterEarningRoutes(router *mux.Router, earningHandler *handlers.EarningHandler) {
+	router.HandleFunc("/earnings", earningHandler.GetDriverEarnings).Methods(http.MethodGet)
+	router.HandleFunc("/earnings/rules", earningHandler.GetEarningRules).Methods(http.MethodGet)
+	router.HandleFunc("/earnings/rules", earningHandler.CreateEarningRule).Methods(http.MethodPost)
+	router.HandleFunc("/earnings/rules/{rule_id}", earningHandler.UpdateEarningRule).Methods(http.MethodPut)
+	router.HandleFunc("/earnings/adjustments", earningHandler.AddAdjustment).Methods(http.MethodPost)
+	router.HandleFunc("/payouts/statements", earningHandler.GeneratePayoutStatement).Methods(http.MethodPost)
+	router.HandleFunc("/payouts/statements", earningHandler.GetPayoutStatements).Methods(http.MethodGet)
+	router.HandleFunc("/payouts/statements/{statement_id}", earningHandler.GetPayoutStatementByID).Methods(http.MethodGet)
+	router.HandleFunc("/payouts/statements/{statement_id}/download", earningHandler.DownloadPayoutStatement).Methods(http.MethodGet)
+}
@@ -0,0 +1,14 @@
+package routes
+
+import (
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/handlers"
+	"github.com/gorilla/mux"
+	"net/http"
+)
+
+func RegisterImportRoutes(router *mux.Router, importHandler *handlers.ImportHandler) {
+	router.HandleFunc("/imports", importHandler.CreateImport).Methods(http.MethodPost)
+	router.HandleFunc("/imports", importHandler.GetImportJobs).Methods(http.MethodGet)
+	router.HandleFunc("/imports/{import_id}", importHandler.GetImportJobByID).Methods(http.MethodGet)
+	router.HandleFunc("/imports/{import_id}/report", importHandler.GetImportReport).Methods(http.MethodGet)
+}
@@ -0,0 +1,15 @@
+package routes
+
+import (
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/handlers"
+	"github.com/gorilla/mux"
+	"net/http"
+)
+
+func RegisterInvoiceRoutes(router *mux.Router, invoiceHandler *handlers.InvoiceHandler) {
+	router.HandleFunc("/invoices", invoiceHandler.GenerateInvoice).Methods(http.MethodPost)
+	router.HandleFunc("/invoices", invoiceHandler.GetInvoices).Methods(http.MethodGet)
+	router.HandleFunc("/invoices/{invoice_id}", invoiceHandler.GetInvoiceByID).Methods(http.MethodGet)
+	router.HandleFunc("/invoices/{invoice_id}", invoiceHandler.ChangeInvoiceStatus).Methods(http.MethodPatch)
+	router.HandleFunc("/invoices/{invoice_id}/download", invoiceHandler.DownloadInvoice).Methods(http.MethodGet)
+}
@@ -0,0 +1,11 @@
+package routes
+
+import (
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/handlers"
+	"github.com/gorilla/mux"
+	"net/http"
+)
+
+func RegisterJobRoutes(router *mux.Router, jobHandler *handlers.JobHandler) {
+	router.HandleFunc("/jobs/runs", jobHandler.GetJobRuns).Methods(http.MethodGet)
+}
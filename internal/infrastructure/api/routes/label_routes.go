@@ -0,0 +1,12 @@
+package routes
+
+import (
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/handlers"
+	"github.com/gorilla/mux"
+	"net/http"
+)
+
+func RegisterLabelRoutes(router *mux.Router, labelHandler *handlers.LabelHandler) {
+	router.HandleFunc("/orders/labels", labelHandler.GetOrderLabels).Methods(http.MethodPost)
+	router.HandleFunc("/orders/{order_id}/label", labelHandler.GetOrderLabel).Methods(http.MethodGet)
+}
@@ -0,0 +1,23 @@
+package routes
+
+import (
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/handlers"
+	"github.com/gorilla/mux"
+	"net/http"
+)
+
+func RegisterNotificationRoutes(router *mux.Router, notificationHandler *handlers.NotificationHandler) {
+	router.HandleFunc("/companies/notification-settings", notificationHandler.GetCompanySettings).Methods(http.MethodGet)
+	router.HandleFunc("/companies/notification-settings", notificationHandler.UpdateCompanySettings).Methods(http.MethodPut)
+
+	router.HandleFunc("/notifications", notificationHandler.GetNotifications).Methods(http.MethodGet)
+	router.HandleFunc("/notifications/unread-count", notificationHandler.GetUnreadCounts).Methods(http.MethodGet)
+	router.HandleFunc("/notifications/stream", notificationHandler.StreamNotifications).Methods(http.MethodGet)
+	router.HandleFunc("/notifications/read-all", notificationHandler.MarkAllAsRead).Methods(http.MethodPost)
+	router.HandleFunc("/notifications/preferences", notificationHandler.GetPreferences).Methods(http.MethodGet)
+	router.HandleFunc("/notifications/preferences", notificationHandler.UpdatePreferences).Methods(http.MethodPut)
+	router.HandleFunc("/notifications/devices", notificationHandler.GetDevices).Methods(http.MethodGet)
+	router.HandleFunc("/notifications/devices", notificationHandler.RegisterDevice).Methods(http.MethodPost)
+	router.HandleFunc("/notifications/devices/{device_id}", notificationHandler.DeregisterDevice).Methods(http.MethodDelete)
+	router.HandleFunc("/notifications/{notification_id}/read", notificationHandler.MarkAsRead).Methods(http.MethodPatch)
+}
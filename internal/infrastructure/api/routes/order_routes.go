@@ -13,5 +13,8 @@ func RegisterOrderRoutes(router *mux.Router, orderHandler *handlers.OrderHandler
 	router.HandleFunc("/orders/{order_id}", orderHandler.DeleteOrder).Methods(http.MethodDelete)
 	router.HandleFunc("/orders/{order_id}", orderHandler.ChangeOrderStatus).Methods(http.MethodPatch)
 	router.HandleFunc("/orders/{order_id}", orderHandler.UpdateOrder).Methods(http.MethodPut)
+	router.HandleFunc("/orders/{order_id}/deliver", orderHandler.DeliverOrder).Methods(http.MethodPost)
+	router.HandleFunc("/orders/{order_id}/attempts", orderHandler.RegisterDeliveryAttempt).Methods(http.MethodPost)
+	router.HandleFunc("/orders/{order_id}/attempts", orderHandler.GetDeliveryAttempts).Methods(http.MethodGet)
 	router.HandleFunc("/orders/recovery/{order_id}", orderHandler.RestoreOrder).Methods(http.MethodGet)
 }
@@ -0,0 +1,15 @@
+package routes
+
+import (
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/handlers"
+	"github.com/gorilla/mux"
+	"net/http"
+)
+
+func RegisterPublicPaymentRoutes(router *mux.Router, paymentHandler *handlers.PaymentHandler) {
+	router.HandleFunc("/payments/webhook", paymentHandler.HandleWebhook).Methods(http.MethodPost)
+}
+
+func RegisterPaymentRoutes(router *mux.Router, paymentHandler *handlers.PaymentHandler) {
+	router.HandleFunc("/orders/{order_id}/payment", paymentHandler.GetOrderPayment).Methods(http.MethodGet)
+}
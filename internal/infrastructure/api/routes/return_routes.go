@@ -0,0 +1,15 @@
+package routes
+
+import (
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/handlers"
+	"github.com/gorilla/mux"
+	"net/http"
+)
+
+func RegisterReturnRoutes(router *mux.Router, returnHandler *handlers.ReturnHandler) {
+	router.HandleFunc("/orders/{order_id}/returns", returnHandler.CreateReturn).Methods(http.MethodPost)
+	router.HandleFunc("/orders/{order_id}/returns", returnHandler.GetReturnsByOrder).Methods(http.MethodGet)
+	router.HandleFunc("/returns", returnHandler.GetReturns).Methods(http.MethodGet)
+	router.HandleFunc("/returns/{return_id}", returnHandler.GetReturnByID).Methods(http.MethodGet)
+	router.HandleFunc("/returns/{return_id}", returnHandler.ChangeReturnStatus).Methods(http.MethodPatch)
+}
@@ -0,0 +1,11 @@
+package routes
+
+import (
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/handlers"
+	"github.com/gorilla/mux"
+	"net/http"
+)
+
+func RegisterScanRoutes(router *mux.Router, scanHandler *handlers.ScanHandler) {
+	router.HandleFunc("/orders/scan", scanHandler.ScanOrder).Methods(http.MethodPost)
+}
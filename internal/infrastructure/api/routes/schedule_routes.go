@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/handlers"
+	"github.com/gorilla/mux"
+	"net/http"
+)
+
+func RegisterScheduleRoutes(router *mux.Router, scheduleHandler *handlers.ScheduleHandler) {
+	router.HandleFunc("/schedules", scheduleHandler.CreateSchedule).Methods(http.MethodPost)
+	router.HandleFunc("/schedules", scheduleHandler.GetSchedules).Methods(http.MethodGet)
+	router.HandleFunc("/schedules/{schedule_id}", scheduleHandler.GetScheduleByID).Methods(http.MethodGet)
+	router.HandleFunc("/schedules/{schedule_id}", scheduleHandler.UpdateSchedule).Methods(http.MethodPut)
+	router.HandleFunc("/schedules/{schedule_id}", scheduleHandler.CancelSchedule).Methods(http.MethodDelete)
+	router.HandleFunc("/schedules/{schedule_id}/pause", scheduleHandler.PauseSchedule).Methods(http.MethodPost)
+	router.HandleFunc("/schedules/{schedule_id}/resume", scheduleHandler.ResumeSchedule).Methods(http.MethodPost)
+}
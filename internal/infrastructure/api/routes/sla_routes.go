@@ -0,0 +1,12 @@
+package routes
+
+import (
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/handlers"
+	"github.com/gorilla/mux"
+	"net/http"
+)
+
+// RegisterSLARoutes debe registrarse antes que las rutas de pedidos para que /orders/sla no se interprete como un ID
+func RegisterSLARoutes(router *mux.Router, slaHandler *handlers.SLAHandler) {
+	router.HandleFunc("/orders/sla", slaHandler.GetOrdersSLA).Methods(http.MethodGet)
+}
@@ -0,0 +1,18 @@
+package routes
+
+import (
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/handlers"
+	"github.com/gorilla/mux"
+	"net/http"
+)
+
+func RegisterWebhookRoutes(router *mux.Router, webhookHandler *handlers.WebhookHandler) {
+	router.HandleFunc("/companies/webhooks", webhookHandler.GetWebhooks).Methods(http.MethodGet)
+	router.HandleFunc("/companies/webhooks", webhookHandler.CreateWebhook).Methods(http.MethodPost)
+	router.HandleFunc("/companies/webhooks/deliveries/{delivery_id}/redeliver", webhookHandler.RedeliverWebhook).Methods(http.MethodPost)
+	router.HandleFunc("/companies/webhooks/{webhook_id}", webhookHandler.GetWebhook).Methods(http.MethodGet)
+	router.HandleFunc("/companies/webhooks/{webhook_id}", webhookHandler.UpdateWebhook).Methods(http.MethodPut)
+	router.HandleFunc("/companies/webhooks/{webhook_id}", webhookHandler.DeleteWebhook).Methods(http.MethodDelete)
+	router.HandleFunc("/companies/webhooks/{webhook_id}/rotate-secret", webhookHandler.RotateWebhookSecret).Methods(http.MethodPost)
+	router.HandleFunc("/companies/webhooks/{webhook_id}/deliveries", webhookHandler.GetWebhookDeliveries).Methods(http.MethodGet)
+}
@@ -1,6 +1,7 @@
 package server
 
 import (
+	"context"
 	"github.com/MarlonG1/delivery-backend/configs"
 	"github.com/MarlonG1/delivery-backend/internal/bootstrap"
 	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/routes"
@@ -35,6 +36,12 @@ func (s *Server) Start() error {
 		return err
 	}
 
+	// Los procesos en segundo plano corren en la API salvo que se ejecuten aparte con cmd/worker
+	if !s.config.Server.DisableWorkers {
+		s.container.GetWorkerContainer().Start(context.Background())
+		defer s.container.GetWorkerContainer().Stop()
+	}
+
 	s.configureRoutes()
 	server := &http.Server{
 		Handler:      s.router,
@@ -73,6 +80,7 @@ func (s *Server) configureRoutes() {
 
 func (s *Server) configurePublicRoutes(router *mux.Router) {
 	routes.RegisterPublicAuthRoutes(router, s.container.GetHandlerContainer().GetAuthHandler())
+	routes.RegisterPublicPaymentRoutes(router, s.container.GetHandlerContainer().GetPaymentHandler())
 
 }
 
@@ -81,10 +89,23 @@ func (s *Server) configureProtectedRoutes(router *mux.Router) {
 
 	routes.RegisterProtectedAuthRoutes(router, s.container.GetHandlerContainer().GetAuthHandler())
 	routes.RegisterUserRoutes(router, s.container.GetHandlerContainer().GetUserHandler())
+	routes.RegisterSLARoutes(router, s.container.GetHandlerContainer().GetSLAHandler())
 	routes.RegisterOrderRoutes(router, s.container.GetHandlerContainer().GetOrderHandler())
 	routes.RegisterRoleRoutes(router, s.container.GetHandlerContainer().GetRoleHandler())
 	routes.RegisterCompanyRoutes(router, s.container.GetHandlerContainer().GetCompanyHandler())
 	routes.RegisterBranchRoutes(router, s.container.GetHandlerContainer().GetBranchHandler())
+	routes.RegisterReturnRoutes(router, s.container.GetHandlerContainer().GetReturnHandler())
+	routes.RegisterScheduleRoutes(router, s.container.GetHandlerContainer().GetScheduleHandler())
+	routes.RegisterImportRoutes(router, s.container.GetHandlerContainer().GetImportHandler())
+	routes.RegisterLabelRoutes(router, s.container.GetHandlerContainer().GetLabelHandler())
+	routes.RegisterScanRoutes(router, s.container.GetHandlerContainer().GetScanHandler())
+	routes.RegisterCODRoutes(router, s.container.GetHandlerContainer().GetCODHandler())
+	routes.RegisterInvoiceRoutes(router, s.container.GetHandlerContainer().GetInvoiceHandler())
+	routes.RegisterPaymentRoutes(router, s.container.GetHandlerContainer().GetPaymentHandler())
+	routes.RegisterEarningRoutes(router, s.container.GetHandlerContainer().GetEarningHandler())
+	routes.RegisterNotificationRoutes(router, s.container.GetHandlerContainer().GetNotificationHandler())
+	routes.RegisterWebhookRoutes(router, s.container.GetHandlerContainer().GetWebhookHandler())
+	routes.RegisterJobRoutes(router, s.container.GetHandlerContainer().GetJobHandler())
 }
 
 func (s *Server) configureGlobalOptions() {
@@ -100,4 +121,5 @@ func (s *Server) configureGlobalMiddlewares() {
 func (s *Server) configureProtectedMiddlewares(router *mux.Router) {
 	router.Use(s.container.GetMiddlewareContainer().GetAuthMiddleware().Handle)
 	router.Use(s.container.GetMiddlewareContainer().GetTokenExtractor().ExtractToken)
+	router.Use(s.container.GetMiddlewareContainer().GetIdempotencyMiddleware().Handle)
 }
@@ -0,0 +1,134 @@
+package cli
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/value_objects"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+// minAdminPasswordLength longitud mínima de la contraseña indicada con -password
+const minAdminPasswordLength = 8
+
+var createAdminCommand = command{
+	name:        "create-admin",
+	description: "Create a user with the ADMIN role, a password is generated when none is given",
+	run:         runCreateAdmin,
+}
+
+func runCreateAdmin(a *app, args []string) error {
+	flags := flag.NewFlagSet("create-admin", flag.ContinueOnError)
+	email := flags.String("email", "", "email of the administrator (required)")
+	fullName := flags.String("name", "", "full name of the administrator (required)")
+	password := flags.String("password", "", "password, a random one is generated and printed when empty")
+	phone := flags.String("phone", "", "phone number")
+	companyID := flags.String("company", "", "company of the administrator, the first active company by default")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	// 1. Validar los datos del administrador
+	if !value_objects.NewEmail(*email).IsValid() || strings.TrimSpace(*fullName) == "" {
+		return fmt.Errorf("%w: -email must be a valid email and -name is required", errPackage.ErrInvalidCommandArguments)
+	}
+	if *phone != "" && !value_objects.NewPhoneNumber(*phone).IsValid() {
+		return fmt.Errorf("%w: invalid -phone", errPackage.ErrInvalidCommandArguments)
+	}
+
+	generated := *password == ""
+	if generated {
+		*password = generatePassword()
+	} else if len(*password) < minAdminPasswordLength {
+		return fmt.Errorf("%w: -password must have at least %d characters", errPackage.ErrInvalidCommandArguments, minAdminPasswordLength)
+	}
+
+	container, err := a.initContainer()
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	repositories := container.GetRepositoryContainer()
+	services := container.GetServiceContainer()
+
+	// 2. Verificar que el email no esté en uso
+	_, err = repositories.GetUserRepository().GetByEmail(ctx, value_objects.NewEmail(*email).GetValue())
+	if err == nil {
+		return errPackage.ErrEmailAlreadyInUse
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+
+	// 3. Obtener la empresa del administrador
+	if *companyID == "" {
+		companyIDs, err := repositories.GetCompanyRepository().GetActiveCompanyIDs(ctx)
+		if err != nil {
+			return err
+		}
+		if len(companyIDs) == 0 {
+			return errPackage.ErrNoActiveCompany
+		}
+		*companyID = companyIDs[0]
+	} else if _, err = repositories.GetCompanyRepository().GetCompanyByID(ctx, *companyID); err != nil {
+		return err
+	}
+
+	role, err := services.GetRoleService().GetRoleByIDOrName(ctx, constants.AdminRole)
+	if err != nil {
+		return err
+	}
+
+	// 4. Crear el usuario y asignarle el rol en una sola transacción
+	hash, err := bcrypt.GenerateFromPassword([]byte(*password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+
+	userID := uuid.NewString()
+	user := &entities.User{
+		ID:           userID,
+		CompanyID:    *companyID,
+		Email:        value_objects.NewEmail(*email).GetValue(),
+		PasswordHash: string(hash),
+		FullName:     strings.TrimSpace(*fullName),
+		Phone:        *phone,
+		IsActive:     true,
+		Profile:      &entities.Profile{UserID: userID},
+	}
+
+	err = services.GetTransactionManager().WithTransaction(ctx, func(ctx context.Context) error {
+		if err := services.GetUserService().CreateUser(ctx, user); err != nil {
+			return err
+		}
+		return services.GetUserService().AssignRoleToUser(ctx, user.ID, role.ID, user.ID)
+	})
+	if err != nil {
+		return err
+	}
+
+	// 5. Mostrar el administrador creado
+	fmt.Fprintf(a.out, "Administrator created\nID:       %s\nEmail:    %s\nCompany:  %s\n", user.ID, user.Email, user.CompanyID)
+	if generated {
+		fmt.Fprintf(a.out, "Password: %s\n", *password)
+	}
+
+	return nil
+}
+
+// generatePassword genera una contraseña aleatoria de 24 caracteres
+func generatePassword() string {
+	buf := make([]byte, 18)
+	_, _ = rand.Read(buf)
+	return base64.RawURLEncoding.EncodeToString(buf)
+}
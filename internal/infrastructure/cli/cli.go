@@ -0,0 +1,179 @@
+package cli
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	config "github.com/MarlonG1/delivery-backend/configs"
+	"github.com/MarlonG1/delivery-backend/configs/database"
+	configErr "github.com/MarlonG1/delivery-backend/configs/error"
+	"github.com/MarlonG1/delivery-backend/internal/bootstrap"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+	"gorm.io/gorm"
+)
+
+// command subcomando de la CLI, args son los argumentos que siguen al nombre del subcomando
+type command struct {
+	name        string
+	description string
+	run         func(app *app, args []string) error
+}
+
+// commands subcomandos disponibles en el orden en que se muestran en la ayuda
+var commands = []command{
+	serveCommand,
+	workerCommand,
+	migrateCommand,
+	seedCommand,
+	createAdminCommand,
+	rotateKeysCommand,
+	purgeDeletedCommand,
+	recomputeMetricsCommand,
+}
+
+// app dependencias compartidas por los subcomandos, la base de datos y el contenedor se crean al usarse
+type app struct {
+	config    *config.EnvConfig
+	out       io.Writer
+	db        *database.DbConnection
+	container *bootstrap.Container
+}
+
+// Execute ejecuta el subcomando indicado en args y retorna el código de salida del proceso.
+// Sin subcomando, o cuando el primer argumento es un flag, se inicia la API como con serve
+func Execute(args []string) int {
+	// 1. Obtener el subcomando
+	name := serveCommand.name
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		name, args = args[0], args[1:]
+	}
+
+	if name == "help" {
+		printUsage(os.Stdout)
+		return 0
+	}
+
+	cmd, ok := findCommand(name)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "Error: %s: %s\n\n", errPackage.ErrUnknownCommand.Error(), name)
+		printUsage(os.Stderr)
+		return 2
+	}
+
+	// 2. Cargar la configuración y el logger
+	envConfig, err := config.NewEnvConfig()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error loading environment variables "+err.Error())
+		return 1
+	}
+
+	if err = logs.InitLogger(envConfig); err != nil {
+		fmt.Fprintln(os.Stderr, "Error initializing logger "+err.Error())
+		return 1
+	}
+
+	// 3. Ejecutar el subcomando y liberar la conexión a la base de datos
+	a := &app{config: envConfig, out: os.Stdout}
+	defer a.close()
+
+	if err = cmd.run(a, args); errors.Is(err, flag.ErrHelp) {
+		return 0
+	} else if err != nil {
+		logs.Error("Command failed", map[string]interface{}{
+			"command": cmd.name,
+			"error":   err.Error(),
+		})
+		fmt.Fprintln(os.Stderr, "Error: "+err.Error())
+		return 1
+	}
+
+	return 0
+}
+
+// database abre la conexión a la base de datos configurada la primera vez que se usa
+func (a *app) database() (*gorm.DB, error) {
+	if a.db != nil {
+		return a.db.Db, nil
+	}
+
+	driver := database.SelectDatabaseDriver(a.config)
+	if driver == nil {
+		return nil, configErr.ErrInvalidDatabaseDriver
+	}
+
+	connection := database.NewDatabaseConnection(driver)
+	if err := connection.Open(); err != nil {
+		return nil, err
+	}
+	a.db = connection
+
+	return a.db.Db, nil
+}
+
+// initContainer crea e inicializa el contenedor de dependencias de la API sobre la conexión a la base de datos
+func (a *app) initContainer() (*bootstrap.Container, error) {
+	if a.container != nil {
+		return a.container, nil
+	}
+
+	db, err := a.database()
+	if err != nil {
+		return nil, err
+	}
+
+	container := bootstrap.NewContainer(db, a.config)
+	if err = container.Initialize(); err != nil {
+		return nil, err
+	}
+	a.container = container
+
+	return a.container, nil
+}
+
+func (a *app) close() {
+	if a.db == nil {
+		return
+	}
+
+	if err := a.db.Close(); err != nil {
+		logs.Error("CLI, on close connection", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+}
+
+// table crea un escritor que alinea en columnas las líneas separadas por tabulaciones
+func (a *app) table() *tabwriter.Writer {
+	return tabwriter.NewWriter(a.out, 0, 0, 2, ' ', 0)
+}
+
+func findCommand(name string) (command, bool) {
+	for _, cmd := range commands {
+		if cmd.name == name {
+			return cmd, true
+		}
+	}
+	return command{}, false
+}
+
+func printUsage(out io.Writer) {
+	fmt.Fprintln(out, "Usage: delivery-app <command> [flags]")
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, "Commands:")
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  %s\t%s\n", cmd.name, cmd.description)
+	}
+	fmt.Fprintf(w, "  help\tShow this help\n")
+	_ = w.Flush()
+
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, "Run delivery-app <command> -h to see the flags of a command. Without a command the API is started.")
+}
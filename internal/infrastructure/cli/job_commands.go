@@ -0,0 +1,38 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+)
+
+var purgeDeletedCommand = command{
+	name:        "purge-deleted",
+	description: "Run the purge of the soft-deleted records now, the run is kept in the job history",
+	run:         jobCommand(constants.JobPurgeDeletedRecords),
+}
+
+var recomputeMetricsCommand = command{
+	name:        "recompute-metrics",
+	description: "Recompute the metrics snapshots of the active companies now, the run is kept in the job history",
+	run:         jobCommand(constants.JobRecomputeMetrics),
+}
+
+// jobCommand ejecuta una vez el trabajo programado con el mismo caso de uso que usa el scheduler
+func jobCommand(jobName string) func(a *app, args []string) error {
+	return func(a *app, args []string) error {
+		container, err := a.initContainer()
+		if err != nil {
+			return err
+		}
+
+		result, err := container.GetUseCaseContainer().GetJobUseCase().RunJobNow(context.Background(), jobName)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(a.out, "%s finished: %s\n", jobName, result)
+		return nil
+	}
+}
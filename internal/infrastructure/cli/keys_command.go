@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+)
+
+var rotateKeysCommand = command{
+	name:        "rotate-keys",
+	description: "Rotate the signing secrets of the webhooks of every active company, a company or a single webhook",
+	run:         runRotateKeys,
+}
+
+func runRotateKeys(a *app, args []string) error {
+	flags := flag.NewFlagSet("rotate-keys", flag.ContinueOnError)
+	companyID := flags.String("company", "", "only rotate the webhooks of this company")
+	webhookID := flags.String("webhook", "", "only rotate this webhook")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	container, err := a.initContainer()
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	webhooks := container.GetServiceContainer().GetWebhookService()
+
+	// 1. Obtener los webhooks a rotar
+	var endpoints []entities.WebhookEndpoint
+	switch {
+	case *webhookID != "":
+		endpoint, err := webhooks.GetEndpointByID(ctx, *webhookID)
+		if err != nil {
+			return err
+		}
+		endpoints = append(endpoints, *endpoint)
+
+	default:
+		companyIDs := []string{*companyID}
+		if *companyID == "" {
+			companyIDs, err = container.GetRepositoryContainer().GetCompanyRepository().GetActiveCompanyIDs(ctx)
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, id := range companyIDs {
+			companyEndpoints, err := webhooks.GetEndpoints(ctx, id)
+			if err != nil {
+				return err
+			}
+			endpoints = append(endpoints, companyEndpoints...)
+		}
+	}
+
+	if len(endpoints) == 0 {
+		fmt.Fprintln(a.out, "No webhooks to rotate")
+		return nil
+	}
+
+	// 2. Rotar cada secreto, los nuevos secretos solo se muestran aquí y se deben entregar a los receptores
+	w := a.table()
+	fmt.Fprintln(w, "WEBHOOK\tCOMPANY\tURL\tNEW SECRET")
+	for _, endpoint := range endpoints {
+		rotated, err := webhooks.RotateSecret(ctx, endpoint.ID)
+		if err != nil {
+			_ = w.Flush()
+			return err
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", rotated.ID, rotated.CompanyID, rotated.URL, rotated.Secret)
+	}
+
+	return w.Flush()
+}
@@ -0,0 +1,85 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"time"
+
+	infraDB "github.com/MarlonG1/delivery-backend/internal/infrastructure/database"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+)
+
+var migrateCommand = command{
+	name:        "migrate",
+	description: "up applies the pending migrations, down reverts the last one and status lists them",
+	run:         runMigrate,
+}
+
+func runMigrate(a *app, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("%w: migrate needs up, down or status", errPackage.ErrInvalidCommandArguments)
+	}
+
+	action := args[0]
+	flags := flag.NewFlagSet("migrate "+action, flag.ContinueOnError)
+	steps := flags.Int("steps", 0, "number of migrations to apply (all by default) or revert (1 by default)")
+	if err := flags.Parse(args[1:]); err != nil {
+		return err
+	}
+
+	db, err := a.database()
+	if err != nil {
+		return err
+	}
+
+	switch action {
+	case "up":
+		applied, err := infraDB.MigrateUp(db, *steps)
+		if err != nil {
+			return err
+		}
+		if len(applied) == 0 {
+			fmt.Fprintln(a.out, "No pending migrations")
+		}
+		for _, migration := range applied {
+			fmt.Fprintf(a.out, "Applied %d %s\n", migration.Version, migration.Name)
+		}
+
+	case "down":
+		if *steps <= 0 {
+			*steps = 1
+		}
+		reverted, err := infraDB.MigrateDown(db, *steps)
+		if err != nil {
+			return err
+		}
+		if len(reverted) == 0 {
+			fmt.Fprintln(a.out, "No applied migrations")
+		}
+		for _, migration := range reverted {
+			fmt.Fprintf(a.out, "Reverted %d %s\n", migration.Version, migration.Name)
+		}
+
+	case "status":
+		statuses, err := infraDB.GetMigrationStatus(db)
+		if err != nil {
+			return err
+		}
+
+		w := a.table()
+		fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED AT")
+		for _, status := range statuses {
+			appliedAt := "pending"
+			if status.AppliedAt != nil {
+				appliedAt = status.AppliedAt.Format(time.RFC3339)
+			}
+			fmt.Fprintf(w, "%d\t%s\t%s\n", status.Version, status.Name, appliedAt)
+		}
+		return w.Flush()
+
+	default:
+		return fmt.Errorf("%w: unknown migrate action %s", errPackage.ErrInvalidCommandArguments, action)
+	}
+
+	return nil
+}
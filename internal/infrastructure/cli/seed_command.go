@@ -0,0 +1,28 @@
+package cli
+
+import (
+	"fmt"
+
+	infraDB "github.com/MarlonG1/delivery-backend/internal/infrastructure/database"
+)
+
+var seedCommand = command{
+	name:        "seed",
+	description: "Insert the roles and the development data, existing records are kept",
+	run:         runSeed,
+}
+
+func runSeed(a *app, args []string) error {
+	db, err := a.database()
+	if err != nil {
+		return err
+	}
+
+	inserted, err := infraDB.RunSeeds(db)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(a.out, "Seed finished, %d new records\n", inserted)
+	return nil
+}
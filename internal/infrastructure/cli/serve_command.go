@@ -0,0 +1,62 @@
+package cli
+
+import (
+	"flag"
+
+	"github.com/MarlonG1/delivery-backend/internal/bootstrap"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/server"
+	infraDB "github.com/MarlonG1/delivery-backend/internal/infrastructure/database"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+	"gorm.io/gorm"
+)
+
+var serveCommand = command{
+	name:        "serve",
+	description: "Start the API server, with -migrate the pending migrations are applied first",
+	run:         runServe,
+}
+
+func runServe(a *app, args []string) error {
+	flags := flag.NewFlagSet("serve", flag.ContinueOnError)
+	migrate := flags.Bool("migrate", false, "apply the pending migrations before starting")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	db, err := a.database()
+	if err != nil {
+		return err
+	}
+
+	// 1. Aplicar las migraciones pendientes o advertir que existen
+	if *migrate {
+		if err = infraDB.RunMigrations(db); err != nil {
+			return err
+		}
+	} else {
+		warnPendingMigrations(db)
+	}
+
+	// 2. Iniciar la API, el servidor inicializa el contenedor
+	return server.NewAPIServer(bootstrap.NewContainer(db, a.config), a.config).Start()
+}
+
+// warnPendingMigrations advierte en el log si la base de datos tiene migraciones sin aplicar
+func warnPendingMigrations(db *gorm.DB) {
+	statuses, err := infraDB.GetMigrationStatus(db)
+	if err != nil {
+		return
+	}
+
+	pending := 0
+	for _, status := range statuses {
+		if status.AppliedAt == nil {
+			pending++
+		}
+	}
+	if pending > 0 {
+		logs.Warn("There are pending migrations, run migrate up or start with serve -migrate", map[string]interface{}{
+			"pending": pending,
+		})
+	}
+}
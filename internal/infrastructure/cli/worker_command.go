@@ -0,0 +1,54 @@
+package cli
+
+import (
+	"context"
+	"flag"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+var workerCommand = command{
+	name:        "worker",
+	description: "Run the scheduled jobs and background processes apart from the API, start the API with DISABLE_API_WORKERS=true",
+	run:         runWorker,
+}
+
+func runWorker(a *app, args []string) error {
+	flags := flag.NewFlagSet("worker", flag.ContinueOnError)
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	// 1. El worker siempre corre aparte de la API, así el contenedor rechaza los adaptadores de un solo proceso
+	a.config.Server.DisableWorkers = true
+
+	db, err := a.database()
+	if err != nil {
+		return err
+	}
+
+	// 2. Las migraciones las aplica migrate up o serve -migrate, el worker solo advierte si hay pendientes
+	warnPendingMigrations(db)
+
+	container, err := a.initContainer()
+	if err != nil {
+		return err
+	}
+
+	// 3. Ejecutar los procesos hasta recibir la señal de terminación
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	container.GetWorkerContainer().Start(ctx)
+	logs.Info("Worker started successfully", map[string]interface{}{})
+
+	<-ctx.Done()
+
+	logs.Info("Worker stopping, waiting for running jobs", map[string]interface{}{})
+	container.GetWorkerContainer().Stop()
+
+	return nil
+}
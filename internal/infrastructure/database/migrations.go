@@ -8,112 +8,204 @@ import (
 	"gorm.io/gorm/schema"
 )
 
-// RunMigrations ejecuta todas las migraciones de la base de datos
-func RunMigrations(db *gorm.DB) error {
-	return HandleCircularDependencies(db, func() error {
-		return migrateAllEntities(db)
-	})
+// entityPhase conjunto de modelos que se migran juntos, las fases se migran en orden y se eliminan en orden inverso
+type entityPhase struct {
+	title  string
+	name   string
+	models []schema.Tabler
 }
 
-// migrateAllEntities migra todas las entidades usando un enfoque por fases
+// initialSchemaPhases fases del esquema inicial que crea la migración 1. La lista está congelada, las entidades y
+// columnas posteriores se agregan con su propia migración para que se apliquen en las bases de datos existentes
 // Fase 1 - Modelos base (usuarios, roles, permisos, zonas, empresas)
 // Fase 2 - Modelos de conductores
 // Fase 3 - Modelos de almacén
 // Fase 4 - Modelos de órdenes
 // Fase 5 - Modelos de inventario (dependientes de órdenes)
 // Fase 6 - Modelos de notificaciones y eventos
-func migrateAllEntities(db *gorm.DB) error {
-	// PASO 1: Migrar primero las tablas base (sin relaciones complejas)
-	logs.Info("FASE 1: Migrando modelos base...")
-	baseModels := []schema.Tabler{
-		// Modelos base de usuarios
-		&entities.User{},
-		&entities.Profile{},
-		&entities.Role{},
-		&entities.Permission{},
-		&entities.RolePermission{},
-		&entities.UserRole{},
-		&entities.UserSession{},
-
-		// Modelos base geográficos
-		&entities.Zone{},
-		&entities.Coverage{},
-		&entities.AdjacentZone{},
-
-		// Modelos base de empresas
-		&entities.Company{},
-		&entities.CompanyAddress{},
-		&entities.Branch{},
-		&entities.CompanyUser{},
-	}
+func initialSchemaPhases() []entityPhase {
+	return []entityPhase{
+		{
+			title: "FASE 1: Migrando modelos base...",
+			name:  "base",
+			models: []schema.Tabler{
+				// Modelos base de usuarios
+				&entities.User{},
+				&entities.Profile{},
+				&entities.Role{},
+				&entities.Permission{},
+				&entities.RolePermission{},
+				&entities.UserRole{},
+				&entities.UserSession{},
 
-	if err := migrateModels(db, baseModels, "base"); err != nil {
-		return err
-	}
+				// Modelos base geográficos
+				&entities.Zone{},
+				&entities.Coverage{},
+				&entities.AdjacentZone{},
 
-	// PASO 2: Migrar los modelos de conductores
-	logs.Info("FASE 2: Migrando modelos de conductores...")
-	driverModels := []schema.Tabler{
-		&entities.Driver{},
-		&entities.DriverZone{},
-		&entities.Availability{},
+				// Modelos base de empresas
+				&entities.Company{},
+				&entities.CompanyAddress{},
+				&entities.Branch{},
+				&entities.CompanyUser{},
+			},
+		},
+		{
+			title: "FASE 2: Migrando modelos de conductores...",
+			name:  "conductores",
+			models: []schema.Tabler{
+				&entities.Driver{},
+				&entities.DriverZone{},
+				&entities.Availability{},
+			},
+		},
+		{
+			title: "FASE 3: Migrando modelos de almacén...",
+			name:  "almacén",
+			models: []schema.Tabler{
+				&entities.Warehouse{},
+			},
+		},
+		{
+			title: "FASE 4: Migrando modelos de órdenes...",
+			name:  "órdenes",
+			models: []schema.Tabler{
+				&entities.Order{},
+				&entities.Details{},
+				&entities.PackageDetail{},
+				&entities.DeliveryAddress{},
+				&entities.PickupAddress{},
+				&entities.Tracking{},
+				&entities.QRCode{},
+				&entities.StatusHistory{},
+			},
+		},
+		{
+			title: "FASE 5: Migrando modelos de inventario relacionados con órdenes...",
+			name:  "inventario",
+			models: []schema.Tabler{
+				&entities.Inventory{},
+				&entities.PackageTracking{},
+			},
+		},
+		{
+			title: "FASE 6: Migrando modelos de notificaciones y eventos...",
+			name:  "notificaciones",
+			models: []schema.Tabler{
+				&entities.Notification{},
+				&entities.NotificationTemplate{},
+				&entities.NotificationDevice{},
+				&entities.NotificationPreference{},
+				&entities.AuditLog{},
+				&entities.SystemEvent{},
+				&entities.EventLog{},
+			},
+		},
 	}
+}
 
-	if err := migrateModels(db, driverModels, "conductores"); err != nil {
-		return err
+// migrateInitialSchema migra las entidades del esquema inicial usando un enfoque por fases
+func migrateInitialSchema(db *gorm.DB) error {
+	for _, phase := range initialSchemaPhases() {
+		logs.Info(phase.title)
+		if err := migrateModels(db, phase.models, phase.name); err != nil {
+			return err
+		}
 	}
 
-	// PASO 3: Migrar modelos de almacén
-	logs.Info("FASE 3: Migrando modelos de almacén...")
-	warehouseModels := []schema.Tabler{
-		&entities.Warehouse{},
+	return nil
+}
+
+// dropInitialSchema elimina las tablas del esquema inicial, de la última fase a la primera
+func dropInitialSchema(db *gorm.DB) error {
+	phases := initialSchemaPhases()
+	for i := len(phases) - 1; i >= 0; i-- {
+		models := phases[i].models
+		for j := len(models) - 1; j >= 0; j-- {
+			logs.Info(fmt.Sprintf("Eliminando tabla %s: %s", phases[i].name, models[j].TableName()))
+			if err := db.Migrator().DropTable(models[j]); err != nil {
+				logs.Error("Error al eliminar tabla", map[string]interface{}{
+					"fase":  phases[i].name,
+					"tabla": models[j].TableName(),
+					"error": err.Error(),
+				})
+				return err
+			}
+		}
 	}
 
-	if err := migrateModels(db, warehouseModels, "almacén"); err != nil {
-		return err
+	return nil
+}
+
+// schemaChange tablas nuevas y columnas o índices agregados a tablas existentes por una migración, los campos se
+// indican con el nombre del campo del modelo
+type schemaChange struct {
+	tables  []schema.Tabler
+	columns []modelFields
+	indexes []modelFields
+}
+
+type modelFields struct {
+	model  schema.Tabler
+	fields []string
+}
+
+// schemaMigration crea la migración que aplica el cambio y lo revierte eliminando sus tablas, índices y columnas
+func schemaMigration(version int, name string, change schemaChange) Migration {
+	return Migration{
+		Version: version,
+		Name:    name,
+		Up:      change.apply,
+		Down:    change.revert,
 	}
+}
 
-	// PASO 4: Migrar modelos de órdenes
-	logs.Info("FASE 4: Migrando modelos de órdenes...")
-	orderModels := []schema.Tabler{
-		&entities.Order{},
-		&entities.Details{},
-		&entities.PackageDetail{},
-		&entities.DeliveryAddress{},
-		&entities.PickupAddress{},
-		&entities.Tracking{},
-		&entities.QRCode{},
-		&entities.StatusHistory{},
+// apply agrega las columnas e índices con AutoMigrate, que solo crea lo que falta, y después crea las tablas nuevas
+func (c schemaChange) apply(db *gorm.DB) error {
+	var altered []schema.Tabler
+	for _, change := range append(append([]modelFields{}, c.columns...), c.indexes...) {
+		altered = append(altered, change.model)
 	}
 
-	if err := migrateModels(db, orderModels, "órdenes"); err != nil {
+	if err := migrateModels(db, altered, "cambios"); err != nil {
 		return err
 	}
+	return migrateModels(db, c.tables, "tablas")
+}
 
-	// PASO 5: Migrar modelos de inventario que dependen de órdenes
-	logs.Info("FASE 5: Migrando modelos de inventario relacionados con órdenes...")
-	inventoryModels := []schema.Tabler{
-		&entities.Inventory{},
-		&entities.PackageTracking{},
+// revert elimina las tablas nuevas en orden inverso y los índices y columnas agregados que sigan existiendo
+func (c schemaChange) revert(db *gorm.DB) error {
+	for i := len(c.tables) - 1; i >= 0; i-- {
+		logs.Info(fmt.Sprintf("Eliminando tabla: %s", c.tables[i].TableName()))
+		if err := db.Migrator().DropTable(c.tables[i]); err != nil {
+			return err
+		}
 	}
 
-	if err := migrateModels(db, inventoryModels, "inventario"); err != nil {
-		return err
+	for _, change := range c.indexes {
+		for _, field := range change.fields {
+			if !db.Migrator().HasIndex(change.model, field) {
+				continue
+			}
+			if err := db.Migrator().DropIndex(change.model, field); err != nil {
+				return err
+			}
+		}
 	}
 
-	// PASO 6: Migrar modelos de notificaciones y eventos
-	logs.Info("FASE 6: Migrando modelos de notificaciones y eventos...")
-	notificationModels := []schema.Tabler{
-		&entities.Notification{},
-		&entities.NotificationTemplate{},
-		&entities.NotificationDevice{},
-		&entities.NotificationPreference{},
-		&entities.AuditLog{},
-		&entities.SystemEvent{},
-		&entities.EventLog{},
+	for _, change := range c.columns {
+		for _, field := range change.fields {
+			if !db.Migrator().HasColumn(change.model, field) {
+				continue
+			}
+			logs.Info(fmt.Sprintf("Eliminando columna %s de %s", field, change.model.TableName()))
+			if err := db.Migrator().DropColumn(change.model, field); err != nil {
+				return err
+			}
+		}
 	}
 
-	return migrateModels(db, notificationModels, "notificaciones")
+	return nil
 }
 
 // Función auxiliar para migrar un conjunto de modelos
@@ -0,0 +1,287 @@
+package database
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// companyUsersBranchFK llave foránea errónea de company_users que antes se eliminaba a mano con el script SQL
+const companyUsersBranchFK = "fk_company_users_company_branch"
+
+// deliveryAttemptNumberIndex índice único que impide registrar dos veces el mismo número de intento de un pedido
+const deliveryAttemptNumberIndex = "idx_delivery_attempts_order_attempt"
+
+// SchemaMigration registro de una migración aplicada en la base de datos
+type SchemaMigration struct {
+	Version   int       `gorm:"column:version;primaryKey;autoIncrement:false"`
+	Name      string    `gorm:"column:name;type:varchar(100);not null"`
+	AppliedAt time.Time `gorm:"column:applied_at;type:timestamp;not null"`
+}
+
+func (SchemaMigration) TableName() string {
+	return "schema_migrations"
+}
+
+// Migration cambio versionado del esquema, Down revierte lo que aplica Up
+type Migration struct {
+	Version int
+	Name    string
+	Up      func(db *gorm.DB) error
+	Down    func(db *gorm.DB) error
+}
+
+// MigrationStatus estado de una migración en la base de datos
+type MigrationStatus struct {
+	Version   int
+	Name      string
+	AppliedAt *time.Time
+}
+
+// migrations migraciones ordenadas por versión. Una migración aplicada no se modifica, los cambios de esquema
+// posteriores, como las entidades o columnas nuevas, se agregan al final con la siguiente versión
+var migrations = []Migration{
+	{
+		Version: 1,
+		Name:    "initial_schema",
+		Up:      migrateInitialSchema,
+		Down:    dropInitialSchema,
+	},
+	{
+		Version: 2,
+		Name:    "drop_company_users_branch_fk",
+		Up:      dropCompanyUsersBranchFK,
+		Down:    func(db *gorm.DB) error { return nil },
+	},
+	{
+		Version: 3,
+		Name:    "add_delivery_attempts_order_attempt_unique",
+		Up:      addDeliveryAttemptNumberIndex,
+		Down:    dropDeliveryAttemptNumberIndex,
+	},
+	schemaMigration(4, "add_delivery_pins", schemaChange{
+		tables: []schema.Tabler{&entities.DeliveryPIN{}},
+		columns: []modelFields{
+			{model: &entities.Details{}, fields: []string{"RequiresDeliveryPIN"}},
+			{model: &entities.Order{}, fields: []string{"IsFlagged", "FlagReason"}},
+		},
+	}),
+	schemaMigration(5, "add_delivery_attempts", schemaChange{
+		tables:  []schema.Tabler{&entities.DeliveryAttempt{}},
+		columns: []modelFields{{model: &entities.Company{}, fields: []string{"MaxDeliveryAttempts"}}},
+	}),
+	schemaMigration(6, "add_order_returns", schemaChange{
+		tables: []schema.Tabler{&entities.OrderReturn{}},
+	}),
+	schemaMigration(7, "add_order_schedules", schemaChange{
+		tables: []schema.Tabler{&entities.OrderSchedule{}},
+	}),
+	schemaMigration(8, "add_import_jobs", schemaChange{
+		tables: []schema.Tabler{&entities.ImportJob{}},
+	}),
+	schemaMigration(9, "add_tracking_prefix", schemaChange{
+		columns: []modelFields{{model: &entities.Company{}, fields: []string{"TrackingPrefix"}}},
+		indexes: []modelFields{{model: &entities.Order{}, fields: []string{"TrackingNumber"}}},
+	}),
+	schemaMigration(10, "add_parcels", schemaChange{
+		tables: []schema.Tabler{&entities.Parcel{}},
+	}),
+	schemaMigration(11, "add_cash_on_delivery", schemaChange{
+		tables:  []schema.Tabler{&entities.CashReconciliation{}, &entities.CashLedgerEntry{}},
+		columns: []modelFields{{model: &entities.Details{}, fields: []string{"CODAmount", "CODCurrency"}}},
+	}),
+	schemaMigration(12, "add_invoices", schemaChange{
+		tables: []schema.Tabler{&entities.Invoice{}, &entities.InvoiceLine{}},
+	}),
+	schemaMigration(13, "add_order_payments", schemaChange{
+		tables: []schema.Tabler{&entities.OrderPayment{}, &entities.PaymentWebhookEvent{}},
+	}),
+	schemaMigration(14, "add_driver_earnings", schemaChange{
+		tables: []schema.Tabler{&entities.EarningRule{}, &entities.DriverEarning{}, &entities.PayoutStatement{}},
+	}),
+	schemaMigration(15, "add_order_sla", schemaChange{
+		columns: []modelFields{{model: &entities.Details{}, fields: []string{"SLAStatus", "SLAEvaluatedAt"}}},
+	}),
+	schemaMigration(16, "add_notification_deliveries", schemaChange{
+		tables:  []schema.Tabler{&entities.NotificationDelivery{}},
+		columns: []modelFields{{model: &entities.Notification{}, fields: []string{"Channels", "Status"}}},
+	}),
+	schemaMigration(17, "add_company_notification_settings", schemaChange{
+		tables: []schema.Tabler{&entities.CompanyNotificationSetting{}},
+	}),
+	schemaMigration(18, "add_webhooks", schemaChange{
+		tables:  []schema.Tabler{&entities.WebhookEndpoint{}, &entities.WebhookDelivery{}},
+		indexes: []modelFields{{model: &entities.EventLog{}, fields: []string{"EventID"}}},
+	}),
+	schemaMigration(19, "add_event_outbox", schemaChange{
+		columns: []modelFields{
+			{model: &entities.SystemEvent{}, fields: []string{"PublishedAt", "Attempts", "NextAttemptAt", "LastError"}},
+			{model: &entities.EventLog{}, fields: []string{"Subscriber"}},
+		},
+	}),
+	schemaMigration(20, "add_job_runs_and_metrics_snapshots", schemaChange{
+		tables: []schema.Tabler{&entities.JobRun{}, &entities.CompanyMetricsSnapshot{}},
+	}),
+}
+
+// RunMigrations aplica todas las migraciones pendientes de la base de datos
+func RunMigrations(db *gorm.DB) error {
+	_, err := MigrateUp(db, 0)
+	return err
+}
+
+// MigrateUp aplica las migraciones pendientes en orden de versión, steps limita cuántas se aplican y 0 las aplica todas
+func MigrateUp(db *gorm.DB, steps int) ([]Migration, error) {
+	// 1. Obtener las migraciones aplicadas
+	applied, err := appliedMigrations(db)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Aplicar las pendientes registrando cada una al terminar
+	var done []Migration
+	err = HandleCircularDependencies(db, func() error {
+		for _, migration := range migrations {
+			if _, ok := applied[migration.Version]; ok {
+				continue
+			}
+			if steps > 0 && len(done) >= steps {
+				break
+			}
+
+			logs.Info(fmt.Sprintf("Aplicando migración %d: %s", migration.Version, migration.Name))
+			if err := migration.Up(db); err != nil {
+				logs.Error("Error al aplicar migración", map[string]interface{}{
+					"version": migration.Version,
+					"nombre":  migration.Name,
+					"error":   err.Error(),
+				})
+				return err
+			}
+
+			if err := db.Create(&SchemaMigration{
+				Version:   migration.Version,
+				Name:      migration.Name,
+				AppliedAt: time.Now(),
+			}).Error; err != nil {
+				return err
+			}
+			done = append(done, migration)
+		}
+
+		return nil
+	})
+
+	return done, err
+}
+
+// MigrateDown revierte las últimas migraciones aplicadas, de la versión más alta a la más baja
+func MigrateDown(db *gorm.DB, steps int) ([]Migration, error) {
+	// 1. Obtener las migraciones aplicadas
+	applied, err := appliedMigrations(db)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Revertir las aplicadas eliminando su registro al terminar
+	var done []Migration
+	err = HandleCircularDependencies(db, func() error {
+		for i := len(migrations) - 1; i >= 0 && len(done) < steps; i-- {
+			migration := migrations[i]
+			if _, ok := applied[migration.Version]; !ok {
+				continue
+			}
+
+			logs.Info(fmt.Sprintf("Revirtiendo migración %d: %s", migration.Version, migration.Name))
+			if err := migration.Down(db); err != nil {
+				logs.Error("Error al revertir migración", map[string]interface{}{
+					"version": migration.Version,
+					"nombre":  migration.Name,
+					"error":   err.Error(),
+				})
+				return err
+			}
+
+			if err := db.Delete(&SchemaMigration{}, "version = ?", migration.Version).Error; err != nil {
+				return err
+			}
+			done = append(done, migration)
+		}
+
+		return nil
+	})
+
+	return done, err
+}
+
+// GetMigrationStatus obtiene todas las migraciones con la fecha en que se aplicaron, las pendientes no tienen fecha
+func GetMigrationStatus(db *gorm.DB) ([]MigrationStatus, error) {
+	applied, err := appliedMigrations(db)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]MigrationStatus, 0, len(migrations))
+	for _, migration := range migrations {
+		status := MigrationStatus{Version: migration.Version, Name: migration.Name}
+		if record, ok := applied[migration.Version]; ok {
+			appliedAt := record.AppliedAt
+			status.AppliedAt = &appliedAt
+		}
+		statuses = append(statuses, status)
+	}
+
+	return statuses, nil
+}
+
+// appliedMigrations obtiene las migraciones aplicadas por versión, crea la tabla de registro si no existe
+func appliedMigrations(db *gorm.DB) (map[int]SchemaMigration, error) {
+	if err := db.AutoMigrate(&SchemaMigration{}); err != nil {
+		logs.Error("Error al crear la tabla de migraciones", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, err
+	}
+
+	var records []SchemaMigration
+	if err := db.Order("version").Find(&records).Error; err != nil {
+		return nil, err
+	}
+
+	applied := make(map[int]SchemaMigration, len(records))
+	for _, record := range records {
+		applied[record.Version] = record
+	}
+
+	return applied, nil
+}
+
+func dropCompanyUsersBranchFK(db *gorm.DB) error {
+	if !db.Migrator().HasConstraint(&entities.CompanyUser{}, companyUsersBranchFK) {
+		return nil
+	}
+
+	return db.Migrator().DropConstraint(&entities.CompanyUser{}, companyUsersBranchFK)
+}
+
+// addDeliveryAttemptNumberIndex agrega el índice a la tabla existente, si la tabla aún no existe la crea la migración
+// de los intentos de entrega con el índice del modelo
+func addDeliveryAttemptNumberIndex(db *gorm.DB) error {
+	if !db.Migrator().HasTable(&entities.DeliveryAttempt{}) || db.Migrator().HasIndex(&entities.DeliveryAttempt{}, deliveryAttemptNumberIndex) {
+		return nil
+	}
+
+	return db.Migrator().CreateIndex(&entities.DeliveryAttempt{}, deliveryAttemptNumberIndex)
+}
+
+func dropDeliveryAttemptNumberIndex(db *gorm.DB) error {
+	if !db.Migrator().HasIndex(&entities.DeliveryAttempt{}, deliveryAttemptNumberIndex) {
+		return nil
+	}
+
+	return db.Migrator().DropIndex(&entities.DeliveryAttempt{}, deliveryAttemptNumberIndex)
+}
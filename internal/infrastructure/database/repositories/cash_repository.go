@@ -0,0 +1,129 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type cashRepository struct {
+	db *gorm.DB
+}
+
+func NewCashRepository(db *gorm.DB) ports.CashRepository {
+	return &cashRepository{
+		db: db,
+	}
+}
+
+// GetLedgerEntries obtiene los movimientos de efectivo de un repartidor en un periodo, del más reciente al más antiguo
+func (r *cashRepository) GetLedgerEntries(ctx context.Context, driverID string, start, end time.Time) ([]entities.CashLedgerEntry, error) {
+	var entries []entities.CashLedgerEntry
+	err := dbFromContext(ctx, r.db).
+		Preload("Order").
+		Where("driver_id = ? AND created_at BETWEEN ? AND ?", driverID, start, end).
+		Order("created_at DESC").
+		Find(&entries).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// GetBalances obtiene por moneda el efectivo que el repartidor aún no ha entregado
+func (r *cashRepository) GetBalances(ctx context.Context, driverID string) ([]entities.CashBalance, error) {
+	var balances []entities.CashBalance
+	err := dbFromContext(ctx, r.db).Model(&entities.CashLedgerEntry{}).
+		Select("currency, SUM(amount) AS amount").
+		Where("driver_id = ?", driverID).
+		Group("currency").
+		Scan(&balances).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return balances, nil
+}
+
+// GetPendingBalance obtiene el efectivo pendiente del repartidor en una moneda hasta la fecha indicada, bloquea
+// al repartidor para que dos conciliaciones simultáneas no lean el mismo saldo
+func (r *cashRepository) GetPendingBalance(ctx context.Context, driverID, currency string, until time.Time) (float64, error) {
+	db := dbFromContext(ctx, r.db)
+
+	var driver entities.Driver
+	if err := db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("user_id").
+		Where("user_id = ?", driverID).
+		Take(&driver).Error; err != nil {
+		return 0, err
+	}
+
+	var balance float64
+	err := db.Model(&entities.CashLedgerEntry{}).
+		Select("COALESCE(SUM(amount), 0)").
+		Where("driver_id = ? AND currency = ? AND created_at <= ?", driverID, currency, until).
+		Scan(&balance).Error
+	if err != nil {
+		return 0, err
+	}
+
+	return balance, nil
+}
+
+// CreateReconciliation guarda la conciliación, marca como conciliados los cobros pendientes del repartidor
+// en esa moneda y registra la entrega de efectivo en su libro
+func (r *cashRepository) CreateReconciliation(ctx context.Context, reconciliation *entities.CashReconciliation, handIn *entities.CashLedgerEntry) error {
+	return dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		// 1. Marcar los cobros pendientes hasta el momento de la conciliación
+		result := tx.Model(&entities.CashLedgerEntry{}).
+			Where("driver_id = ? AND currency = ? AND type = ? AND reconciliation_id IS NULL AND created_at <= ?",
+				reconciliation.DriverID, reconciliation.Currency, constants.CashEntryCollection, reconciliation.CreatedAt).
+			Update("reconciliation_id", reconciliation.ID)
+		if result.Error != nil {
+			return result.Error
+		}
+		reconciliation.Collections = int(result.RowsAffected)
+
+		// 2. Guardar la conciliación
+		if err := tx.Create(reconciliation).Error; err != nil {
+			return err
+		}
+
+		// 3. Registrar la entrega de efectivo
+		return tx.Create(handIn).Error
+	})
+}
+
+func (r *cashRepository) GetReconciliations(ctx context.Context, driverID string) ([]entities.CashReconciliation, error) {
+	var reconciliations []entities.CashReconciliation
+	err := dbFromContext(ctx, r.db).
+		Where("driver_id = ?", driverID).
+		Order("created_at DESC").
+		Find(&reconciliations).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return reconciliations, nil
+}
+
+// GetCompanyCollections obtiene los cobros contra entrega de los pedidos de una empresa en un periodo
+func (r *cashRepository) GetCompanyCollections(ctx context.Context, companyID string, start, end time.Time) ([]entities.CashLedgerEntry, error) {
+	var entries []entities.CashLedgerEntry
+	err := dbFromContext(ctx, r.db).
+		Preload("Order").
+		Where("company_id = ? AND type = ? AND created_at BETWEEN ? AND ?", companyID, constants.CashEntryCollection, start, end).
+		Order("created_at ASC").
+		Find(&entries).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
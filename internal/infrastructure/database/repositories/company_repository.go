@@ -21,7 +21,7 @@ func NewCompanyRepository(db *gorm.DB) ports.CompanyRepository {
 
 func (r *CompanyRepository) GetCompanyAddresses(ctx context.Context, companyID string) ([]entities.CompanyAddress, error) {
 	var companyAddresses []entities.CompanyAddress
-	err := r.db.WithContext(ctx).
+	err := dbFromContext(ctx, r.db).
 		Where("company_id = ?", companyID).
 		Find(&companyAddresses).Error
 	if err != nil {
@@ -33,7 +33,7 @@ func (r *CompanyRepository) GetCompanyAddresses(ctx context.Context, companyID s
 
 func (r *CompanyRepository) GetCompanyAddressByID(ctx context.Context, companyID, id string) (*entities.CompanyAddress, error) {
 	var companyAddress entities.CompanyAddress
-	err := r.db.WithContext(ctx).First(&companyAddress, "id = ? AND company_id = ?", id, companyID).Error
+	err := dbFromContext(ctx, r.db).First(&companyAddress, "id = ? AND company_id = ?", id, companyID).Error
 	if err != nil {
 		return nil, err
 	}
@@ -43,7 +43,7 @@ func (r *CompanyRepository) GetCompanyAddressByID(ctx context.Context, companyID
 
 func (r *CompanyRepository) GetCompanyAndBranchForUser(ctx context.Context, userID string) (string, string, error) {
 	var company entities.CompanyUser
-	err := r.db.WithContext(ctx).
+	err := dbFromContext(ctx, r.db).
 		Where("user_id = ?", userID).
 		First(&company).Error
 	if err != nil {
@@ -56,7 +56,7 @@ func (r *CompanyRepository) GetCompanyAndBranchForUser(ctx context.Context, user
 // Nuevos métodos para CRUD de Company
 func (r *CompanyRepository) GetCompanyByID(ctx context.Context, id string) (*entities.Company, error) {
 	var company entities.Company
-	err := r.db.WithContext(ctx).
+	err := dbFromContext(ctx, r.db).
 		Preload("Address").
 		Preload("Branches").
 		First(&company, "id = ?", id).Error
@@ -67,36 +67,55 @@ func (r *CompanyRepository) GetCompanyByID(ctx context.Context, id string) (*ent
 	return &company, nil
 }
 
+// GetTrackingPrefix obtiene solo el prefijo de seguimiento de la empresa
+func (r *CompanyRepository) GetTrackingPrefix(ctx context.Context, companyID string) (string, error) {
+	var company entities.Company
+	err := dbFromContext(ctx, r.db).
+		Select("tracking_prefix").
+		First(&company, "id = ?", companyID).Error
+	if err != nil {
+		return "", err
+	}
+
+	return company.TrackingPrefix, nil
+}
+
 func (r *CompanyRepository) CreateCompany(ctx context.Context, company *entities.Company) error {
-	return r.db.WithContext(ctx).Create(company).Error
+	return dbFromContext(ctx, r.db).Create(company).Error
 }
 
 func (r *CompanyRepository) UpdateCompany(ctx context.Context, company *entities.Company) error {
-	return r.db.WithContext(ctx).Save(company).Error
+	return dbFromContext(ctx, r.db).Save(company).Error
 }
 
-func (r *CompanyRepository) DeactivateCompany(ctx context.Context, id string) error {
-	return r.db.WithContext(ctx).Model(&entities.Company{}).
-		Where("id = ?", id).
-		Updates(map[string]interface{}{
-			"is_active":  false,
-			"updated_at": time.Now(),
-		}).Error
+func (r *CompanyRepository) DeactivateCompany(ctx context.Context, id string, event *entities.SystemEvent) error {
+	return r.setCompanyActive(ctx, id, false, event)
 }
 
-func (r *CompanyRepository) ReactivateCompany(ctx context.Context, id string) error {
-	return r.db.WithContext(ctx).Model(&entities.Company{}).
-		Where("id = ?", id).
-		Updates(map[string]interface{}{
-			"is_active":  true,
-			"updated_at": time.Now(),
-		}).Error
+func (r *CompanyRepository) ReactivateCompany(ctx context.Context, id string, event *entities.SystemEvent) error {
+	return r.setCompanyActive(ctx, id, true, event)
+}
+
+// setCompanyActive cambia el estado de la empresa y guarda su evento en la misma transacción
+func (r *CompanyRepository) setCompanyActive(ctx context.Context, id string, active bool, event *entities.SystemEvent) error {
+	return dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&entities.Company{}).
+			Where("id = ?", id).
+			Updates(map[string]interface{}{
+				"is_active":  active,
+				"updated_at": time.Now(),
+			}).Error; err != nil {
+			return err
+		}
+
+		return saveOutboxEvent(tx, event)
+	})
 }
 
 // Métodos para verificaciones
 func (r *CompanyRepository) ExistsTaxID(ctx context.Context, taxID string, excludeID string) (bool, error) {
 	var count int64
-	query := r.db.WithContext(ctx).Model(&entities.Company{}).
+	query := dbFromContext(ctx, r.db).Model(&entities.Company{}).
 		Where("tax_id = ?", taxID)
 
 	if excludeID != "" {
@@ -113,7 +132,7 @@ func (r *CompanyRepository) ExistsTaxID(ctx context.Context, taxID string, exclu
 
 func (r *CompanyRepository) ExistsCompanyName(ctx context.Context, name string, excludeID string) (bool, error) {
 	var count int64
-	query := r.db.WithContext(ctx).Model(&entities.Company{}).
+	query := dbFromContext(ctx, r.db).Model(&entities.Company{}).
 		Where("name = ?", name)
 
 	if excludeID != "" {
@@ -131,7 +150,7 @@ func (r *CompanyRepository) ExistsCompanyName(ctx context.Context, name string,
 // Métodos para gestión de sucursales (branches)
 func (r *CompanyRepository) GetCompanyBranches(ctx context.Context, companyID string) ([]entities.Branch, error) {
 	var branches []entities.Branch
-	err := r.db.WithContext(ctx).
+	err := dbFromContext(ctx, r.db).
 		Where("company_id = ?", companyID).
 		Find(&branches).Error
 	if err != nil {
@@ -143,7 +162,7 @@ func (r *CompanyRepository) GetCompanyBranches(ctx context.Context, companyID st
 
 func (r *CompanyRepository) GetBranchByID(ctx context.Context, branchID string) (*entities.Branch, error) {
 	var branch entities.Branch
-	err := r.db.WithContext(ctx).
+	err := dbFromContext(ctx, r.db).
 		Preload("Company").
 		Preload("Zone").
 		First(&branch, "id = ?", branchID).Error
@@ -155,15 +174,15 @@ func (r *CompanyRepository) GetBranchByID(ctx context.Context, branchID string)
 }
 
 func (r *CompanyRepository) CreateBranch(ctx context.Context, branch *entities.Branch) error {
-	return r.db.WithContext(ctx).Create(branch).Error
+	return dbFromContext(ctx, r.db).Create(branch).Error
 }
 
 func (r *CompanyRepository) UpdateBranch(ctx context.Context, branch *entities.Branch) error {
-	return r.db.WithContext(ctx).Save(branch).Error
+	return dbFromContext(ctx, r.db).Save(branch).Error
 }
 
 func (r *CompanyRepository) DeactivateBranch(ctx context.Context, branchID string) error {
-	return r.db.WithContext(ctx).Model(&entities.Branch{}).
+	return dbFromContext(ctx, r.db).Model(&entities.Branch{}).
 		Where("id = ?", branchID).
 		Updates(map[string]interface{}{
 			"is_active":  false,
@@ -172,7 +191,7 @@ func (r *CompanyRepository) DeactivateBranch(ctx context.Context, branchID strin
 }
 
 func (r *CompanyRepository) ReactivateBranch(ctx context.Context, branchID string) error {
-	return r.db.WithContext(ctx).Model(&entities.Branch{}).
+	return dbFromContext(ctx, r.db).Model(&entities.Branch{}).
 		Where("id = ?", branchID).
 		Updates(map[string]interface{}{
 			"is_active":  true,
@@ -183,7 +202,7 @@ func (r *CompanyRepository) ReactivateBranch(ctx context.Context, branchID strin
 // Métodos para zonas
 func (r *CompanyRepository) GetZoneByID(ctx context.Context, zoneID string) (*entities.Zone, error) {
 	var zone entities.Zone
-	err := r.db.WithContext(ctx).
+	err := dbFromContext(ctx, r.db).
 		First(&zone, "id = ?", zoneID).Error
 	if err != nil {
 		return nil, err
@@ -194,7 +213,7 @@ func (r *CompanyRepository) GetZoneByID(ctx context.Context, zoneID string) (*en
 
 func (r *CompanyRepository) GetAllActiveZones(ctx context.Context) ([]entities.Zone, error) {
 	var zones []entities.Zone
-	err := r.db.WithContext(ctx).
+	err := dbFromContext(ctx, r.db).
 		Where("is_active = ?", true).
 		Find(&zones).Error
 	if err != nil {
@@ -206,7 +225,7 @@ func (r *CompanyRepository) GetAllActiveZones(ctx context.Context) ([]entities.Z
 
 func (r *CompanyRepository) GetBranchesByZone(ctx context.Context, zoneID string) ([]entities.Branch, error) {
 	var branches []entities.Branch
-	err := r.db.WithContext(ctx).
+	err := dbFromContext(ctx, r.db).
 		Where("zone_id = ?", zoneID).
 		Find(&branches).Error
 	if err != nil {
@@ -217,19 +236,41 @@ func (r *CompanyRepository) GetBranchesByZone(ctx context.Context, zoneID string
 }
 
 func (r *CompanyRepository) AddCompanyAddress(ctx context.Context, address *entities.CompanyAddress) error {
-	return r.db.WithContext(ctx).Create(address).Error
+	return dbFromContext(ctx, r.db).Create(address).Error
 }
 
 func (r *CompanyRepository) UpdateCompanyAddress(ctx context.Context, address *entities.CompanyAddress) error {
-	return r.db.WithContext(ctx).Save(address).Error
+	return dbFromContext(ctx, r.db).Save(address).Error
+}
+
+// UnsetMainAddresses quita la marca de dirección principal a las direcciones de la empresa excepto a la indicada
+func (r *CompanyRepository) UnsetMainAddresses(ctx context.Context, companyID, exceptID string) error {
+	return dbFromContext(ctx, r.db).
+		Model(&entities.CompanyAddress{}).
+		Where("company_id = ? AND id <> ? AND is_main = ?", companyID, exceptID, true).
+		Update("is_main", false).Error
 }
 
 func (r *CompanyRepository) DeleteCompanyAddress(ctx context.Context, addressID string) error {
-	return r.db.WithContext(ctx).Delete(&entities.CompanyAddress{}, "id = ?", addressID).Error
+	return dbFromContext(ctx, r.db).Delete(&entities.CompanyAddress{}, "id = ?", addressID).Error
+}
+
+// GetActiveCompanyIDs obtiene los identificadores de las empresas activas
+func (r *CompanyRepository) GetActiveCompanyIDs(ctx context.Context) ([]string, error) {
+	var ids []string
+	err := dbFromContext(ctx, r.db).
+		Model(&entities.Company{}).
+		Where("is_active = ?", true).
+		Pluck("id", &ids).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
 }
 
 func (r *CompanyRepository) GetCompanies(ctx context.Context, params *entities.CompanyQueryParams) ([]entities.Company, int64, error) {
-	query := r.db.WithContext(ctx).Model(&entities.Company{})
+	query := dbFromContext(ctx, r.db).Model(&entities.Company{})
 
 	// Aplicar filtros
 	if params.Name != "" {
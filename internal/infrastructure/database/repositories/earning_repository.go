@@ -0,0 +1,181 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"gorm.io/gorm"
+)
+
+type earningRepository struct {
+	db *gorm.DB
+}
+
+func NewEarningRepository(db *gorm.DB) ports.EarningRepository {
+	return &earningRepository{
+		db: db,
+	}
+}
+
+func (r *earningRepository) GetEarningRules(ctx context.Context) ([]entities.EarningRule, error) {
+	var rules []entities.EarningRule
+	if err := dbFromContext(ctx, r.db).Preload("Zone").Order("created_at ASC").Find(&rules).Error; err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+func (r *earningRepository) GetEarningRuleByID(ctx context.Context, id string) (*entities.EarningRule, error) {
+	var rule entities.EarningRule
+	if err := dbFromContext(ctx, r.db).Preload("Zone").First(&rule, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+
+	return &rule, nil
+}
+
+// GetEarningRuleForZone obtiene la regla activa de la zona o, si no tiene una propia, la regla por defecto
+func (r *earningRepository) GetEarningRuleForZone(ctx context.Context, zoneID string) (*entities.EarningRule, error) {
+	var rule entities.EarningRule
+	err := dbFromContext(ctx, r.db).
+		Where("is_active = ? AND (zone_id = ? OR zone_id IS NULL)", true, zoneID).
+		Order("zone_id IS NULL ASC").
+		First(&rule).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &rule, nil
+}
+
+func (r *earningRepository) CreateEarningRule(ctx context.Context, rule *entities.EarningRule) error {
+	return dbFromContext(ctx, r.db).Create(rule).Error
+}
+
+// UpdateEarningRule actualiza las tarifas de la regla, la zona no se puede cambiar
+func (r *earningRepository) UpdateEarningRule(ctx context.Context, rule *entities.EarningRule) error {
+	return dbFromContext(ctx, r.db).Model(rule).
+		Select("base_per_delivery", "per_km_rate", "urgent_bonus", "zone_multiplier", "is_active", "updated_at").
+		Updates(map[string]interface{}{
+			"base_per_delivery": rule.BasePerDelivery,
+			"per_km_rate":       rule.PerKmRate,
+			"urgent_bonus":      rule.UrgentBonus,
+			"zone_multiplier":   rule.ZoneMultiplier,
+			"is_active":         rule.IsActive,
+			"updated_at":        time.Now(),
+		}).Error
+}
+
+func (r *earningRepository) ExistsDriver(ctx context.Context, driverID string) (bool, error) {
+	var count int64
+	if err := dbFromContext(ctx, r.db).Model(&entities.Driver{}).Where("user_id = ?", driverID).Count(&count).Error; err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (r *earningRepository) CreateEarning(ctx context.Context, earning *entities.DriverEarning) error {
+	return dbFromContext(ctx, r.db).Create(earning).Error
+}
+
+func (r *earningRepository) GetEarnings(ctx context.Context, driverID string, start, end time.Time) ([]entities.DriverEarning, error) {
+	var earnings []entities.DriverEarning
+	err := dbFromContext(ctx, r.db).
+		Preload("Order").
+		Where("driver_id = ? AND created_at BETWEEN ? AND ?", driverID, start, end).
+		Order("created_at ASC").
+		Find(&earnings).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return earnings, nil
+}
+
+// GetUnsettledEarnings obtiene los movimientos del repartidor anteriores al fin del periodo que aún no se han pagado
+func (r *earningRepository) GetUnsettledEarnings(ctx context.Context, driverID string, end time.Time) ([]entities.DriverEarning, error) {
+	var earnings []entities.DriverEarning
+	err := dbFromContext(ctx, r.db).
+		Where("driver_id = ? AND statement_id IS NULL AND created_at < ?", driverID, end).
+		Order("created_at ASC").
+		Find(&earnings).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return earnings, nil
+}
+
+func (r *earningRepository) GetDriversWithUnsettledEarnings(ctx context.Context, end time.Time) ([]string, error) {
+	var driverIDs []string
+	err := dbFromContext(ctx, r.db).Model(&entities.DriverEarning{}).
+		Where("statement_id IS NULL AND created_at < ?", end).
+		Distinct().
+		Pluck("driver_id", &driverIDs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return driverIDs, nil
+}
+
+// CreatePayoutStatement guarda el estado de pago y le asigna sus movimientos, si alguno ya fue liquidado
+// por otro estado de pago se revierte todo
+func (r *earningRepository) CreatePayoutStatement(ctx context.Context, statement *entities.PayoutStatement) error {
+	return dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		// 1. Crear el estado de pago sin sus movimientos, estos ya existen
+		if err := tx.Omit("Entries").Create(statement).Error; err != nil {
+			return err
+		}
+
+		// 2. Asignar los movimientos que aún no están liquidados
+		ids := make([]string, 0, len(statement.Entries))
+		for _, entry := range statement.Entries {
+			ids = append(ids, entry.ID)
+		}
+
+		result := tx.Model(&entities.DriverEarning{}).
+			Where("id IN ? AND statement_id IS NULL", ids).
+			Update("statement_id", statement.ID)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected != int64(len(ids)) {
+			return errPackage.ErrPayoutStatementConflict
+		}
+
+		return nil
+	})
+}
+
+func (r *earningRepository) GetPayoutStatementByID(ctx context.Context, id string) (*entities.PayoutStatement, error) {
+	var statement entities.PayoutStatement
+	err := dbFromContext(ctx, r.db).
+		Preload("Driver.User").
+		Preload("Entries", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at ASC")
+		}).
+		Preload("Entries.Order").
+		First(&statement, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &statement, nil
+}
+
+// GetPayoutStatementsByDriver obtiene los estados de pago de un repartidor sin sus movimientos
+func (r *earningRepository) GetPayoutStatementsByDriver(ctx context.Context, driverID string) ([]entities.PayoutStatement, error) {
+	var statements []entities.PayoutStatement
+	if err := dbFromContext(ctx, r.db).Where("driver_id = ?", driverID).Order("period_start DESC").Find(&statements).Error; err != nil {
+		return nil, err
+	}
+
+	return statements, nil
+}
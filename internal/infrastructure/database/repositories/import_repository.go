@@ -0,0 +1,98 @@
+package repositories
+
+import (
+	"context"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	"gorm.io/gorm"
+	"time"
+)
+
+type importRepository struct {
+	db *gorm.DB
+}
+
+func NewImportRepository(db *gorm.DB) ports.ImportRepository {
+	return &importRepository{
+		db: db,
+	}
+}
+
+func (r *importRepository) CreateImportJob(ctx context.Context, job *entities.ImportJob) error {
+	return dbFromContext(ctx, r.db).Create(job).Error
+}
+
+func (r *importRepository) GetImportJobByID(ctx context.Context, id string) (*entities.ImportJob, error) {
+	var job entities.ImportJob
+	err := dbFromContext(ctx, r.db).First(&job, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &job, nil
+}
+
+// GetImportJobsByCompany obtiene las importaciones de una empresa sin el contenido del archivo ni el reporte
+func (r *importRepository) GetImportJobsByCompany(ctx context.Context, companyID string) ([]entities.ImportJob, error) {
+	var jobs []entities.ImportJob
+	err := dbFromContext(ctx, r.db).
+		Omit("payload", "report").
+		Where("company_id = ?", companyID).
+		Order("created_at DESC").
+		Find(&jobs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return jobs, nil
+}
+
+func (r *importRepository) GetPendingImportJobs(ctx context.Context, limit int) ([]entities.ImportJob, error) {
+	var jobs []entities.ImportJob
+	err := dbFromContext(ctx, r.db).
+		Where("status = ?", constants.ImportStatusPending).
+		Order("created_at ASC").
+		Limit(limit).
+		Find(&jobs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return jobs, nil
+}
+
+// ClaimImportJob marca la importación como en proceso solo si sigue pendiente,
+// evitando que dos instancias procesen el mismo archivo
+func (r *importRepository) ClaimImportJob(ctx context.Context, id string) (bool, error) {
+	now := time.Now()
+	result := dbFromContext(ctx, r.db).
+		Model(&entities.ImportJob{}).
+		Where("id = ? AND status = ?", id, constants.ImportStatusPending).
+		Updates(map[string]interface{}{
+			"status":     constants.ImportStatusProcessing,
+			"started_at": now,
+			"updated_at": now,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+// FinishImportJob guarda el resultado final de la importación
+func (r *importRepository) FinishImportJob(ctx context.Context, job *entities.ImportJob) error {
+	return dbFromContext(ctx, r.db).
+		Model(&entities.ImportJob{}).
+		Where("id = ?", job.ID).
+		Updates(map[string]interface{}{
+			"status":        job.Status,
+			"report":        job.Report,
+			"created_rows":  job.CreatedRows,
+			"failed_rows":   job.FailedRows,
+			"error_message": job.ErrorMessage,
+			"finished_at":   job.FinishedAt,
+			"updated_at":    time.Now(),
+		}).Error
+}
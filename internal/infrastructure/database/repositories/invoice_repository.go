@@ -0,0 +1,155 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// invoicedOrderCondition excluye los pedidos que ya tienen una línea del tipo indicado en una factura no anulada
+const invoicedOrderCondition = `NOT EXISTS (
+	SELECT 1 FROM invoice_lines il
+	INNER JOIN invoices i ON i.id = il.invoice_id
+	WHERE il.order_id = orders.id AND il.type = ? AND i.status <> ?
+)`
+
+type invoiceRepository struct {
+	db *gorm.DB
+}
+
+func NewInvoiceRepository(db *gorm.DB) ports.InvoiceRepository {
+	return &invoiceRepository{
+		db: db,
+	}
+}
+
+// CreateInvoice guarda la factura junto con sus líneas
+func (r *invoiceRepository) CreateInvoice(ctx context.Context, invoice *entities.Invoice) error {
+	return dbFromContext(ctx, r.db).Create(invoice).Error
+}
+
+func (r *invoiceRepository) GetInvoiceByID(ctx context.Context, id string) (*entities.Invoice, error) {
+	var invoice entities.Invoice
+	err := dbFromContext(ctx, r.db).
+		Preload("Company").
+		Preload("Lines", func(db *gorm.DB) *gorm.DB {
+			return db.Order("line_number ASC")
+		}).
+		Preload("Lines.Order").
+		First(&invoice, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &invoice, nil
+}
+
+// GetInvoicesByCompany obtiene las facturas de una empresa sin sus líneas, opcionalmente filtradas por estado
+func (r *invoiceRepository) GetInvoicesByCompany(ctx context.Context, companyID string, statuses []string) ([]entities.Invoice, error) {
+	query := dbFromContext(ctx, r.db).Where("company_id = ?", companyID)
+	if len(statuses) > 0 {
+		query = query.Where("status IN ?", statuses)
+	}
+
+	var invoices []entities.Invoice
+	if err := query.Order("period_start DESC").Find(&invoices).Error; err != nil {
+		return nil, err
+	}
+
+	return invoices, nil
+}
+
+// UpdateInvoiceStatus actualiza el estado de la factura y las fechas asociadas a él
+func (r *invoiceRepository) UpdateInvoiceStatus(ctx context.Context, invoice *entities.Invoice) error {
+	return dbFromContext(ctx, r.db).Model(invoice).
+		Select("status", "issued_at", "due_date", "paid_at", "voided_at", "void_reason", "updated_at").
+		Updates(map[string]interface{}{
+			"status":      invoice.Status,
+			"issued_at":   invoice.IssuedAt,
+			"due_date":    invoice.DueDate,
+			"paid_at":     invoice.PaidAt,
+			"voided_at":   invoice.VoidedAt,
+			"void_reason": invoice.VoidReason,
+			"updated_at":  time.Now(),
+		}).Error
+}
+
+// ExistsInvoiceForPeriod verifica si la empresa ya tiene una factura no anulada que se solape con el periodo
+func (r *invoiceRepository) ExistsInvoiceForPeriod(ctx context.Context, companyID string, start, end time.Time) (bool, error) {
+	var count int64
+	err := dbFromContext(ctx, r.db).Model(&entities.Invoice{}).
+		Where("company_id = ? AND status <> ? AND period_start < ? AND period_end > ?",
+			companyID, constants.InvoiceStatusVoid, end, start).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// GetBillableOrders obtiene los pedidos entregados en el periodo que aún no han sido facturados. La fecha de entrega
+// la registra MarkOrderDelivered, el único camino por el que un pedido pasa a entregado
+func (r *invoiceRepository) GetBillableOrders(ctx context.Context, companyID string, start, end time.Time) ([]entities.Order, error) {
+	var orders []entities.Order
+	err := dbFromContext(ctx, r.db).
+		Joins("Detail").
+		Preload("PackageDetail").
+		Preload("Parcels").
+		Where("orders.company_id = ? AND orders.deleted_at IS NULL AND orders.status IN ?",
+			companyID, []string{constants.OrderStatusDelivered, constants.OrderStatusCompleted}).
+		Where("Detail.delivered_at >= ? AND Detail.delivered_at < ?", start, end).
+		Where(invoicedOrderCondition, constants.InvoiceLineDelivery, constants.InvoiceStatusVoid).
+		Order("Detail.delivered_at ASC").
+		Find(&orders).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return orders, nil
+}
+
+// GetLostOrders obtiene los pedidos marcados como perdidos en el periodo que aún no han sido acreditados,
+// la fecha de la pérdida se toma del historial de estados del pedido
+func (r *invoiceRepository) GetLostOrders(ctx context.Context, companyID string, start, end time.Time) ([]entities.Order, error) {
+	var orders []entities.Order
+	err := dbFromContext(ctx, r.db).
+		Select("orders.*").
+		Joins("INNER JOIN order_status_history osh ON osh.order_id = orders.id AND osh.status = ?", constants.OrderStatusLost).
+		Where("orders.company_id = ? AND orders.deleted_at IS NULL AND orders.status = ?", companyID, constants.OrderStatusLost).
+		Where("osh.created_at >= ? AND osh.created_at < ?", start, end).
+		Where(invoicedOrderCondition, constants.InvoiceLineCredit, constants.InvoiceStatusVoid).
+		Order("osh.created_at ASC").
+		Find(&orders).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return orders, nil
+}
+
+// LockCompanyBilling bloquea la empresa dentro de la transacción del contexto para que dos procesos no
+// generen al mismo tiempo la factura del mismo periodo
+func (r *invoiceRepository) LockCompanyBilling(ctx context.Context, companyID string) error {
+	var company entities.Company
+	return dbFromContext(ctx, r.db).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id").
+		Where("id = ?", companyID).
+		Take(&company).Error
+}
+
+// GetBillableCompanies obtiene las empresas activas a las que se les genera factura en cada ciclo
+func (r *invoiceRepository) GetBillableCompanies(ctx context.Context) ([]entities.Company, error) {
+	var companies []entities.Company
+	if err := dbFromContext(ctx, r.db).Where("is_active = ?", true).Find(&companies).Error; err != nil {
+		return nil, err
+	}
+
+	return companies, nil
+}
@@ -0,0 +1,49 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	"gorm.io/gorm"
+)
+
+type jobRepository struct {
+	db *gorm.DB
+}
+
+func NewJobRepository(db *gorm.DB) ports.JobRepository {
+	return &jobRepository{
+		db: db,
+	}
+}
+
+func (r *jobRepository) CreateJobRun(ctx context.Context, run *entities.JobRun) error {
+	return dbFromContext(ctx, r.db).Create(run).Error
+}
+
+func (r *jobRepository) UpdateJobRun(ctx context.Context, run *entities.JobRun) error {
+	return dbFromContext(ctx, r.db).Save(run).Error
+}
+
+// GetJobRuns obtiene el historial de ejecuciones, las más recientes primero. Sin nombre incluye todos los trabajos
+func (r *jobRepository) GetJobRuns(ctx context.Context, jobName string, params *entities.PaginationQueryParams) ([]entities.JobRun, int64, error) {
+	var runs []entities.JobRun
+	var total int64
+
+	query := dbFromContext(ctx, r.db).Model(&entities.JobRun{})
+	if jobName != "" {
+		query = query.Where("job_name = ?", jobName)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if params != nil && params.Page > 0 && params.PageSize > 0 {
+		query = query.Offset((params.Page - 1) * params.PageSize).Limit(params.PageSize)
+	}
+
+	err := query.Order("started_at DESC").Find(&runs).Error
+	return runs, total, err
+}
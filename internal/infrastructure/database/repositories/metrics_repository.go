@@ -3,8 +3,10 @@ package repositories
 import (
 	"context"
 	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
 	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"time"
 )
 
@@ -23,7 +25,7 @@ func NewMetricsRepository(db *gorm.DB) ports.MetricsRepository {
 // GetOrderCountByCompany obtiene el conteo de órdenes de una empresa por estado
 func (r *MetricsRepository) GetOrderCountByCompany(ctx context.Context, companyID string, startDate, endDate time.Time) (total, completed, cancelled int64, err error) {
 	// Total de órdenes
-	err = r.db.WithContext(ctx).Model(&struct{}{}).
+	err = dbFromContext(ctx, r.db).Model(&struct{}{}).
 		Table("orders").
 		Where("company_id = ? AND created_at BETWEEN ? AND ?", companyID, startDate, endDate).
 		Count(&total).Error
@@ -32,7 +34,7 @@ func (r *MetricsRepository) GetOrderCountByCompany(ctx context.Context, companyI
 	}
 
 	// Órdenes completadas
-	err = r.db.WithContext(ctx).Model(&struct{}{}).
+	err = dbFromContext(ctx, r.db).Model(&struct{}{}).
 		Table("orders").
 		Where("company_id = ? AND status = ? AND created_at BETWEEN ? AND ?",
 			companyID, constants.OrderStatusDelivered, startDate, endDate).
@@ -42,7 +44,7 @@ func (r *MetricsRepository) GetOrderCountByCompany(ctx context.Context, companyI
 	}
 
 	// Órdenes canceladas
-	err = r.db.WithContext(ctx).Model(&struct{}{}).
+	err = dbFromContext(ctx, r.db).Model(&struct{}{}).
 		Table("orders").
 		Where("company_id = ? AND status = ? AND created_at BETWEEN ? AND ?",
 			companyID, constants.OrderStatusCancelled, startDate, endDate).
@@ -60,7 +62,7 @@ func (r *MetricsRepository) GetAverageDeliveryTimeByCompany(ctx context.Context,
 		AvgDeliveryTime float64
 	}
 
-	err := r.db.WithContext(ctx).
+	err := dbFromContext(ctx, r.db).
 		Table("orders o").
 		Joins("INNER JOIN order_details od ON o.id = od.order_id").
 		Where("o.company_id = ? AND o.status = ? AND o.created_at BETWEEN ? AND ? AND od.delivered_at IS NOT NULL",
@@ -75,13 +77,18 @@ func (r *MetricsRepository) GetAverageDeliveryTimeByCompany(ctx context.Context,
 	return result.AvgDeliveryTime, nil
 }
 
+// GetOnTimeDeliveriesByCompany cuenta las entregas de una empresa y cuántas se realizaron dentro del plazo de entrega
+func (r *MetricsRepository) GetOnTimeDeliveriesByCompany(ctx context.Context, companyID string, startDate, endDate time.Time) (delivered, onTime int64, err error) {
+	return r.countOnTimeDeliveries(ctx, "o.company_id = ?", companyID, startDate, endDate)
+}
+
 // GetTotalRevenueByCompany calcula los ingresos totales para una empresa
 func (r *MetricsRepository) GetTotalRevenueByCompany(ctx context.Context, companyID string, startDate, endDate time.Time) (float64, error) {
 	var result struct {
 		TotalRevenue float64
 	}
 
-	err := r.db.WithContext(ctx).
+	err := dbFromContext(ctx, r.db).
 		Table("orders o").
 		Joins("INNER JOIN order_details od ON o.id = od.order_id").
 		Where("o.company_id = ? AND o.created_at BETWEEN ? AND ? AND o.status != ?",
@@ -100,7 +107,7 @@ func (r *MetricsRepository) GetTotalRevenueByCompany(ctx context.Context, compan
 func (r *MetricsRepository) GetActiveBranchesCountByCompany(ctx context.Context, companyID string) (int, error) {
 	var count int64
 
-	err := r.db.WithContext(ctx).Model(&struct{}{}).
+	err := dbFromContext(ctx, r.db).Model(&struct{}{}).
 		Table("company_branches").
 		Where("company_id = ? AND is_active = ?", companyID, true).
 		Count(&count).Error
@@ -116,7 +123,7 @@ func (r *MetricsRepository) GetActiveBranchesCountByCompany(ctx context.Context,
 func (r *MetricsRepository) GetUniqueCustomersByCompany(ctx context.Context, companyID string, startDate, endDate time.Time) (int, error) {
 	var count int64
 
-	err := r.db.WithContext(ctx).Model(&struct{}{}).
+	err := dbFromContext(ctx, r.db).Model(&struct{}{}).
 		Table("orders").
 		Select("COUNT(DISTINCT client_id)").
 		Where("company_id = ? AND created_at BETWEEN ? AND ?", companyID, startDate, endDate).
@@ -129,12 +136,39 @@ func (r *MetricsRepository) GetUniqueCustomersByCompany(ctx context.Context, com
 	return int(count), nil
 }
 
+// GetReturnsByReasonByCompany cuenta las devoluciones de una empresa agrupadas por motivo
+func (r *MetricsRepository) GetReturnsByReasonByCompany(ctx context.Context, companyID string, startDate, endDate time.Time) (map[string]int64, error) {
+	var rows []struct {
+		ReasonCode string
+		Total      int64
+	}
+
+	err := dbFromContext(ctx, r.db).
+		Table("order_returns").
+		Select("reason_code, COUNT(*) as total").
+		Where("company_id = ? AND status != ? AND created_at BETWEEN ? AND ?",
+			companyID, constants.ReturnStatusCancelled, startDate, endDate).
+		Group("reason_code").
+		Scan(&rows).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		result[row.ReasonCode] = row.Total
+	}
+
+	return result, nil
+}
+
 // Implementación de métricas para Sucursales
 
 // GetOrderCountByBranch obtiene el conteo de órdenes de una sucursal por estado
 func (r *MetricsRepository) GetOrderCountByBranch(ctx context.Context, branchID string, startDate, endDate time.Time) (total, completed, cancelled int64, err error) {
 	// Total de órdenes
-	err = r.db.WithContext(ctx).Model(&struct{}{}).
+	err = dbFromContext(ctx, r.db).Model(&struct{}{}).
 		Table("orders").
 		Where("branch_id = ? AND created_at BETWEEN ? AND ?", branchID, startDate, endDate).
 		Count(&total).Error
@@ -143,7 +177,7 @@ func (r *MetricsRepository) GetOrderCountByBranch(ctx context.Context, branchID
 	}
 
 	// Órdenes completadas
-	err = r.db.WithContext(ctx).Model(&struct{}{}).
+	err = dbFromContext(ctx, r.db).Model(&struct{}{}).
 		Table("orders").
 		Where("branch_id = ? AND status = ? AND created_at BETWEEN ? AND ?",
 			branchID, constants.OrderStatusDelivered, startDate, endDate).
@@ -153,7 +187,7 @@ func (r *MetricsRepository) GetOrderCountByBranch(ctx context.Context, branchID
 	}
 
 	// Órdenes canceladas
-	err = r.db.WithContext(ctx).Model(&struct{}{}).
+	err = dbFromContext(ctx, r.db).Model(&struct{}{}).
 		Table("orders").
 		Where("branch_id = ? AND status = ? AND created_at BETWEEN ? AND ?",
 			branchID, constants.OrderStatusCancelled, startDate, endDate).
@@ -171,7 +205,7 @@ func (r *MetricsRepository) GetAverageDeliveryTimeByBranch(ctx context.Context,
 		AvgDeliveryTime float64
 	}
 
-	err := r.db.WithContext(ctx).
+	err := dbFromContext(ctx, r.db).
 		Table("orders o").
 		Joins("INNER JOIN order_details od ON o.id = od.order_id").
 		Where("o.branch_id = ? AND o.status = ? AND o.created_at BETWEEN ? AND ? AND od.delivered_at IS NOT NULL",
@@ -186,13 +220,18 @@ func (r *MetricsRepository) GetAverageDeliveryTimeByBranch(ctx context.Context,
 	return result.AvgDeliveryTime, nil
 }
 
+// GetOnTimeDeliveriesByBranch cuenta las entregas de una sucursal y cuántas se realizaron dentro del plazo de entrega
+func (r *MetricsRepository) GetOnTimeDeliveriesByBranch(ctx context.Context, branchID string, startDate, endDate time.Time) (delivered, onTime int64, err error) {
+	return r.countOnTimeDeliveries(ctx, "o.branch_id = ?", branchID, startDate, endDate)
+}
+
 // GetTotalRevenueByBranch calcula los ingresos totales para una sucursal
 func (r *MetricsRepository) GetTotalRevenueByBranch(ctx context.Context, branchID string, startDate, endDate time.Time) (float64, error) {
 	var result struct {
 		TotalRevenue float64
 	}
 
-	err := r.db.WithContext(ctx).
+	err := dbFromContext(ctx, r.db).
 		Table("orders o").
 		Joins("INNER JOIN order_details od ON o.id = od.order_id").
 		Where("o.branch_id = ? AND o.created_at BETWEEN ? AND ? AND o.status != ?",
@@ -214,7 +253,7 @@ func (r *MetricsRepository) GetActiveDriversCountByBranch(ctx context.Context, b
 	var count int64
 
 	// Contar drivers únicos que tienen órdenes activas en esta sucursal
-	err := r.db.WithContext(ctx).Model(&struct{}{}).
+	err := dbFromContext(ctx, r.db).Model(&struct{}{}).
 		Table("orders").
 		Select("COUNT(DISTINCT driver_id)").
 		Where("branch_id = ? AND driver_id IS NOT NULL AND status IN (?, ?, ?)",
@@ -232,7 +271,7 @@ func (r *MetricsRepository) GetActiveDriversCountByBranch(ctx context.Context, b
 func (r *MetricsRepository) GetUniqueCustomersByBranch(ctx context.Context, branchID string, startDate, endDate time.Time) (int, error) {
 	var count int64
 
-	err := r.db.WithContext(ctx).Model(&struct{}{}).
+	err := dbFromContext(ctx, r.db).Model(&struct{}{}).
 		Table("orders").
 		Select("COUNT(DISTINCT client_id)").
 		Where("branch_id = ? AND created_at BETWEEN ? AND ?", branchID, startDate, endDate).
@@ -259,7 +298,7 @@ func (r *MetricsRepository) GetPeakHourOrderRateByBranch(ctx context.Context, br
 	startDate := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
 	endDate := startDate.Add(24 * time.Hour)
 
-	err := r.db.WithContext(ctx).
+	err := dbFromContext(ctx, r.db).
 		Table("orders").
 		Select("HOUR(created_at) as hour, COUNT(*) as count").
 		Where("branch_id = ? AND created_at BETWEEN ? AND ?", branchID, startDate, endDate).
@@ -279,3 +318,50 @@ func (r *MetricsRepository) GetPeakHourOrderRateByBranch(ctx context.Context, br
 	// La tasa de pedidos en hora pico es el número máximo de pedidos en una hora
 	return float64(hourlyCounts[0].Count), nil
 }
+
+// Implementación de métricas precalculadas
+
+// SaveCompanyMetricsSnapshot guarda las métricas precalculadas de la empresa, reemplazando las anteriores
+func (r *MetricsRepository) SaveCompanyMetricsSnapshot(ctx context.Context, snapshot *entities.CompanyMetricsSnapshot) error {
+	return dbFromContext(ctx, r.db).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "company_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"metrics", "computed_at"}),
+		}).
+		Create(snapshot).Error
+}
+
+func (r *MetricsRepository) GetCompanyMetricsSnapshot(ctx context.Context, companyID string) (*entities.CompanyMetricsSnapshot, error) {
+	var snapshot entities.CompanyMetricsSnapshot
+	err := dbFromContext(ctx, r.db).
+		Where("company_id = ?", companyID).
+		First(&snapshot).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &snapshot, nil
+}
+
+// countOnTimeDeliveries cuenta los pedidos entregados en el periodo y los entregados antes de su plazo de entrega
+func (r *MetricsRepository) countOnTimeDeliveries(ctx context.Context, scope string, scopeID string, startDate, endDate time.Time) (int64, int64, error) {
+	var result struct {
+		Delivered int64
+		OnTime    int64
+	}
+
+	err := dbFromContext(ctx, r.db).
+		Table("orders o").
+		Joins("INNER JOIN order_details od ON o.id = od.order_id").
+		Where(scope, scopeID).
+		Where("o.status IN ? AND od.delivered_at BETWEEN ? AND ?",
+			[]string{constants.OrderStatusDelivered, constants.OrderStatusCompleted}, startDate, endDate).
+		Select("COUNT(*) as delivered, COALESCE(SUM(CASE WHEN od.delivered_at <= od.delivery_deadline THEN 1 ELSE 0 END), 0) as on_time").
+		Scan(&result).Error
+
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return result.Delivered, result.OnTime, nil
+}
@@ -0,0 +1,347 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type notificationRepository struct {
+	db *gorm.DB
+}
+
+func NewNotificationRepository(db *gorm.DB) ports.NotificationRepository {
+	return &notificationRepository{
+		db: db,
+	}
+}
+
+// GetActiveTemplateByType obtiene la plantilla activa más antigua del tipo de notificación
+func (r *notificationRepository) GetActiveTemplateByType(ctx context.Context, notificationType string) (*entities.NotificationTemplate, error) {
+	var template entities.NotificationTemplate
+	err := dbFromContext(ctx, r.db).
+		Where("type = ? AND is_active = ?", notificationType, true).
+		Order("created_at ASC").
+		First(&template).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &template, nil
+}
+
+func (r *notificationRepository) CreateTemplate(ctx context.Context, template *entities.NotificationTemplate) error {
+	return dbFromContext(ctx, r.db).Create(template).Error
+}
+
+func (r *notificationRepository) GetPreference(ctx context.Context, userID, notificationType string) (*entities.NotificationPreference, error) {
+	var preference entities.NotificationPreference
+	err := dbFromContext(ctx, r.db).
+		Where("user_id = ? AND notification_type = ?", userID, notificationType).
+		First(&preference).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &preference, nil
+}
+
+// GetRecipientUser obtiene el usuario activo que recibirá la notificación con sus roles activos
+func (r *notificationRepository) GetRecipientUser(ctx context.Context, userID string) (*entities.User, error) {
+	var user entities.User
+	err := dbFromContext(ctx, r.db).
+		Preload("Roles", "is_active = ?", true).
+		Preload("Roles.Role").
+		Where("id = ? AND is_active = ? AND deleted_at IS NULL", userID, true).
+		First(&user).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+func (r *notificationRepository) GetActiveDevices(ctx context.Context, userID string) ([]entities.NotificationDevice, error) {
+	var devices []entities.NotificationDevice
+	err := dbFromContext(ctx, r.db).
+		Where("user_id = ? AND is_active = ?", userID, true).
+		Find(&devices).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return devices, nil
+}
+
+// GetPreferences obtiene las preferencias guardadas del usuario
+func (r *notificationRepository) GetPreferences(ctx context.Context, userID string) ([]entities.NotificationPreference, error) {
+	var preferences []entities.NotificationPreference
+	if err := dbFromContext(ctx, r.db).Where("user_id = ?", userID).Find(&preferences).Error; err != nil {
+		return nil, err
+	}
+
+	return preferences, nil
+}
+
+// SeedPreferences crea las preferencias por defecto, las que el usuario ya tiene guardadas no se modifican
+func (r *notificationRepository) SeedPreferences(ctx context.Context, preferences []entities.NotificationPreference) error {
+	return dbFromContext(ctx, r.db).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&preferences).Error
+}
+
+// SavePreferences crea o actualiza las preferencias de cada tipo de notificación del usuario
+func (r *notificationRepository) SavePreferences(ctx context.Context, preferences []entities.NotificationPreference) error {
+	return dbFromContext(ctx, r.db).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}, {Name: "notification_type"}},
+			DoUpdates: clause.AssignmentColumns([]string{"email_enabled", "push_enabled", "sms_enabled", "updated_at"}),
+		}).
+		Create(&preferences).Error
+}
+
+// GetDeviceByToken obtiene el dispositivo registrado con el token, sin importar el usuario ni su estado
+func (r *notificationRepository) GetDeviceByToken(ctx context.Context, deviceToken string) (*entities.NotificationDevice, error) {
+	var device entities.NotificationDevice
+	err := dbFromContext(ctx, r.db).
+		Where("device_token = ?", deviceToken).
+		First(&device).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &device, nil
+}
+
+// GetUserDevice obtiene un dispositivo activo del usuario
+func (r *notificationRepository) GetUserDevice(ctx context.Context, userID, deviceID string) (*entities.NotificationDevice, error) {
+	var device entities.NotificationDevice
+	err := dbFromContext(ctx, r.db).
+		Where("id = ? AND user_id = ? AND is_active = ?", deviceID, userID, true).
+		First(&device).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &device, nil
+}
+
+func (r *notificationRepository) SaveDevice(ctx context.Context, device *entities.NotificationDevice) error {
+	return dbFromContext(ctx, r.db).Save(device).Error
+}
+
+// DeactivateDevice desactiva un dispositivo para que deje de recibir notificaciones push
+func (r *notificationRepository) DeactivateDevice(ctx context.Context, deviceID string) error {
+	return dbFromContext(ctx, r.db).
+		Model(&entities.NotificationDevice{}).
+		Where("id = ?", deviceID).
+		Updates(map[string]interface{}{
+			"is_active":  false,
+			"updated_at": time.Now(),
+		}).Error
+}
+
+// DeactivateUserDeviceToken desactiva el token anterior de un dispositivo del usuario cuando el proveedor lo renueva
+func (r *notificationRepository) DeactivateUserDeviceToken(ctx context.Context, userID, deviceToken string) error {
+	return dbFromContext(ctx, r.db).
+		Model(&entities.NotificationDevice{}).
+		Where("user_id = ? AND device_token = ? AND is_active = ?", userID, deviceToken, true).
+		Updates(map[string]interface{}{
+			"is_active":  false,
+			"updated_at": time.Now(),
+		}).Error
+}
+
+// TouchDevice registra el último envío exitoso al dispositivo
+func (r *notificationRepository) TouchDevice(ctx context.Context, deviceID string, usedAt time.Time) error {
+	return dbFromContext(ctx, r.db).
+		Model(&entities.NotificationDevice{}).
+		Where("id = ?", deviceID).
+		Update("last_used_at", usedAt).Error
+}
+
+// GetUserIDsByRole obtiene los usuarios activos con el rol indicado, filtrando por empresa cuando se indica
+func (r *notificationRepository) GetUserIDsByRole(ctx context.Context, role, companyID string) ([]string, error) {
+	query := dbFromContext(ctx, r.db).
+		Table("users").
+		Joins("JOIN user_roles ON user_roles.user_id = users.id").
+		Joins("JOIN roles ON roles.id = user_roles.role_id").
+		Where("roles.name = ? AND user_roles.is_active = ? AND users.is_active = ? AND users.deleted_at IS NULL", role, true, true)
+
+	if companyID != "" {
+		query = query.Where("users.company_id = ?", companyID)
+	}
+
+	var userIDs []string
+	if err := query.Distinct().Pluck("users.id", &userIDs).Error; err != nil {
+		return nil, err
+	}
+
+	return userIDs, nil
+}
+
+func (r *notificationRepository) CreateNotification(ctx context.Context, notification *entities.Notification) error {
+	return dbFromContext(ctx, r.db).Omit("Deliveries").Create(notification).Error
+}
+
+// SaveDeliveryResult guarda el estado del despacho de la notificación junto con el resultado de cada canal
+func (r *notificationRepository) SaveDeliveryResult(ctx context.Context, notification *entities.Notification) error {
+	return dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		// 1. Guardar el resultado de cada canal
+		if len(notification.Deliveries) > 0 {
+			for i := range notification.Deliveries {
+				notification.Deliveries[i].NotificationID = notification.ID
+			}
+			if err := tx.Create(&notification.Deliveries).Error; err != nil {
+				return err
+			}
+		}
+
+		// 2. Actualizar el estado de la notificación
+		return tx.Model(&entities.Notification{}).
+			Where("id = ?", notification.ID).
+			Updates(map[string]interface{}{
+				"status":  notification.Status,
+				"sent_at": notification.SentAt,
+			}).Error
+	})
+}
+
+// GetUserNotifications obtiene la bandeja del usuario filtrada por tipo y estado de lectura, las más recientes primero
+func (r *notificationRepository) GetUserNotifications(ctx context.Context, userID string, params *entities.NotificationQueryParams) ([]entities.Notification, int64, error) {
+	var notifications []entities.Notification
+	var total int64
+
+	query := dbFromContext(ctx, r.db).Model(&entities.Notification{}).Where("user_id = ?", userID)
+
+	if params != nil {
+		if params.Type != "" {
+			query = query.Where("type = ?", params.Type)
+		}
+		if params.IsRead != nil {
+			query = query.Where("is_read = ?", *params.IsRead)
+		}
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if params != nil {
+		if params.Page > 0 && params.PageSize > 0 {
+			offset := (params.Page - 1) * params.PageSize
+			query = query.Offset(offset).Limit(params.PageSize)
+		}
+
+		if params.SortBy != "" {
+			direction := "ASC"
+			if params.SortDirection == "desc" {
+				direction = "DESC"
+			}
+			query = query.Order(params.SortBy + " " + direction)
+		} else {
+			query = query.Order("created_at DESC")
+		}
+	} else {
+		query = query.Order("created_at DESC")
+	}
+
+	err := query.Find(&notifications).Error
+	return notifications, total, err
+}
+
+// GetUnreadCounts obtiene la cantidad de notificaciones sin leer del usuario agrupadas por tipo
+func (r *notificationRepository) GetUnreadCounts(ctx context.Context, userID string) (map[string]int64, error) {
+	var rows []struct {
+		Type  string
+		Count int64
+	}
+
+	err := dbFromContext(ctx, r.db).
+		Model(&entities.Notification{}).
+		Select("type, COUNT(*) AS count").
+		Where("user_id = ? AND is_read = ?", userID, false).
+		Group("type").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		counts[row.Type] = row.Count
+	}
+
+	return counts, nil
+}
+
+// MarkAsRead marca como leída una notificación del usuario, si ya estaba leída conserva la fecha de lectura
+func (r *notificationRepository) MarkAsRead(ctx context.Context, userID, notificationID string, readAt time.Time) error {
+	var notification entities.Notification
+	err := dbFromContext(ctx, r.db).
+		Where("id = ? AND user_id = ?", notificationID, userID).
+		First(&notification).Error
+	if err != nil {
+		return err
+	}
+
+	if notification.IsRead {
+		return nil
+	}
+
+	return dbFromContext(ctx, r.db).
+		Model(&entities.Notification{}).
+		Where("id = ?", notificationID).
+		Updates(map[string]interface{}{
+			"is_read": true,
+			"read_at": readAt,
+		}).Error
+}
+
+// MarkAllAsRead marca como leídas todas las notificaciones pendientes del usuario, devuelve la cantidad actualizada
+func (r *notificationRepository) MarkAllAsRead(ctx context.Context, userID string, readAt time.Time) (int64, error) {
+	result := dbFromContext(ctx, r.db).
+		Model(&entities.Notification{}).
+		Where("user_id = ? AND is_read = ?", userID, false).
+		Updates(map[string]interface{}{
+			"is_read": true,
+			"read_at": readAt,
+		})
+
+	return result.RowsAffected, result.Error
+}
+
+func (r *notificationRepository) GetCompanyNotificationSettings(ctx context.Context, companyID string) ([]entities.CompanyNotificationSetting, error) {
+	var settings []entities.CompanyNotificationSetting
+	if err := dbFromContext(ctx, r.db).Where("company_id = ?", companyID).Find(&settings).Error; err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+func (r *notificationRepository) GetCompanyNotificationSetting(ctx context.Context, companyID, event string) (*entities.CompanyNotificationSetting, error) {
+	var setting entities.CompanyNotificationSetting
+	err := dbFromContext(ctx, r.db).
+		Where("company_id = ? AND event = ?", companyID, event).
+		First(&setting).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &setting, nil
+}
+
+// SaveCompanyNotificationSettings crea o actualiza la configuración de cada evento de la empresa
+func (r *notificationRepository) SaveCompanyNotificationSettings(ctx context.Context, settings []entities.CompanyNotificationSetting) error {
+	return dbFromContext(ctx, r.db).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "company_id"}, {Name: "event"}},
+			DoUpdates: clause.AssignmentColumns([]string{"notify_recipient", "notify_company", "updated_at"}),
+		}).
+		Create(&settings).Error
+}
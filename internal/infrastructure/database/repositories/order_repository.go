@@ -7,6 +7,7 @@ import (
 	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
 	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
 	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	domainErr "github.com/MarlonG1/delivery-backend/internal/domain/error"
 	errPackage "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
 	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
 	"github.com/google/uuid"
@@ -24,8 +25,8 @@ func NewOrderRepository(db *gorm.DB) ports.OrdererRepository {
 	}
 }
 
-// CreateOrder crea un nuevo pedido
-func (r *orderRepository) CreateOrder(ctx context.Context, order *entities.Order) error {
+// CreateOrder crea un nuevo pedido junto con su evento de creación
+func (r *orderRepository) CreateOrder(ctx context.Context, order *entities.Order, event *entities.SystemEvent) error {
 	if order == nil {
 		return errPackage.ErrNilOrder
 	}
@@ -35,7 +36,7 @@ func (r *orderRepository) CreateOrder(ctx context.Context, order *entities.Order
 		"order": string(jsonMess),
 	})
 
-	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	err := dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
 		// 1. Crear la orden y sus entidades relacionadas normalmente
 		if err := tx.Create(order).Error; err != nil {
 			return err
@@ -61,7 +62,9 @@ func (r *orderRepository) CreateOrder(ctx context.Context, order *entities.Order
 				return err
 			}
 		}
-		return nil
+
+		// 3. Guardar el evento de creación en el outbox
+		return saveOutboxEvent(tx, event)
 	})
 
 	return err
@@ -71,7 +74,7 @@ func (r *orderRepository) GetOrdersByCompany(ctx context.Context, companyID stri
 	var orders []entities.Order
 	var total int64
 
-	query := r.db.WithContext(ctx).Model(&entities.Order{}).Where("company_id = ?", companyID)
+	query := dbFromContext(ctx, r.db).Model(&entities.Order{}).Where("company_id = ?", companyID)
 	if params != nil {
 		if params.Status != "" {
 			query = query.Where("status = ?", params.Status)
@@ -141,7 +144,7 @@ func (r *orderRepository) GetOrdersByCompany(ctx context.Context, companyID stri
 // GetOrderByID obtiene un pedido por ID
 func (r *orderRepository) GetOrderByID(ctx context.Context, id string) (*entities.Order, error) {
 	var order entities.Order
-	err := r.applyOrderPreloads(r.db.WithContext(ctx)).
+	err := r.applyOrderPreloads(dbFromContext(ctx, r.db)).
 		First(&order, "id = ?", id).Error
 	if err != nil {
 		return nil, err
@@ -159,11 +162,12 @@ func (r *orderRepository) GetOrderByID(ctx context.Context, id string) (*entitie
 	return &order, err
 }
 
-// GetOrderByQR obtiene un pedido por QR
+// GetOrderByQR obtiene un pedido por el contenido escaneado de su código QR
 func (r *orderRepository) GetOrderByQR(ctx context.Context, qr *entities.QRCode) (*entities.Order, error) {
 	var order entities.Order
-	err := r.applyOrderPreloads(r.db.WithContext(ctx)).
-		First(&order, "id = ?", qr.OrderID).Error
+	qrOrderID := dbFromContext(ctx, r.db).Model(&entities.QRCode{}).Select("order_id").Where("qr_data = ?", qr.QRData)
+	err := r.applyOrderPreloads(dbFromContext(ctx, r.db)).
+		First(&order, "id = (?)", qrOrderID).Error
 	if err != nil {
 		return nil, err
 	}
@@ -183,7 +187,7 @@ func (r *orderRepository) GetOrderByQR(ctx context.Context, qr *entities.QRCode)
 // GetOrderByTrackingNumber obtiene un pedido por número de seguimiento
 func (r *orderRepository) GetOrderByTrackingNumber(ctx context.Context, trackingNumber string) (*entities.Order, error) {
 	var order entities.Order
-	err := r.applyOrderPreloads(r.db.WithContext(ctx)).
+	err := r.applyOrderPreloads(dbFromContext(ctx, r.db)).
 		First(&order, "tracking_number = ?", trackingNumber).Error
 	if err != nil {
 		return nil, err
@@ -204,7 +208,7 @@ func (r *orderRepository) GetOrderByTrackingNumber(ctx context.Context, tracking
 // GetOrdersByUserID obtiene los pedidos de un usuario
 func (r *orderRepository) GetOrdersByUserID(ctx context.Context, userID string) ([]entities.Order, error) {
 	var orders []entities.Order
-	err := r.applyOrderPreloads(r.db.WithContext(ctx)).
+	err := r.applyOrderPreloads(dbFromContext(ctx, r.db)).
 		Find(&orders, "client_id = ?", userID).Error
 
 	return orders, err
@@ -213,7 +217,7 @@ func (r *orderRepository) GetOrdersByUserID(ctx context.Context, userID string)
 // GetOrders obtiene todos los pedidos
 func (r *orderRepository) GetOrders(ctx context.Context) ([]entities.Order, error) {
 	var orders []entities.Order
-	err := r.applyOrderPreloads(r.db.WithContext(ctx)).
+	err := r.applyOrderPreloads(dbFromContext(ctx, r.db)).
 		Find(&orders).Error
 
 	return orders, err
@@ -225,7 +229,7 @@ func (r *orderRepository) UpdateOrder(ctx context.Context, orderID string, order
 		return errPackage.ErrNilOrder
 	}
 
-	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	return dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
 		// 1. Actualizar la tabla principal orders
 		if err := tx.Model(&entities.Order{}).Where("id = ?", orderID).Updates(map[string]interface{}{
 			"updated_at": order.UpdatedAt,
@@ -267,7 +271,7 @@ func (r *orderRepository) UpdateOrder(ctx context.Context, orderID string, order
 
 // DeleteOrder elimina un pedido
 func (r *orderRepository) DeleteOrder(ctx context.Context, id string) error {
-	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	err := dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
 		if err := tx.Delete(&entities.Order{}, "id = ?", id).Error; err != nil {
 			return err
 		}
@@ -278,31 +282,40 @@ func (r *orderRepository) DeleteOrder(ctx context.Context, id string) error {
 }
 
 // ChangeStatus cambia el estado de un pedido
-func (r *orderRepository) ChangeStatus(ctx context.Context, id string, status string) error {
-	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+func (r *orderRepository) ChangeStatus(ctx context.Context, id string, status string, event *entities.SystemEvent) error {
+	err := dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
 		if err := tx.Model(&entities.Order{}).Where("id = ?", id).Update("status", status).Error; err != nil {
 			return err
 		}
 
+		// Propagar el estado a los bultos del pedido
+		if err := syncParcelStatuses(tx, id, status, time.Now()); err != nil {
+			return err
+		}
+
 		// Guardar historial de estado
 		statusHistory := entities.StatusHistory{
 			ID:      uuid.NewString(),
 			OrderID: id,
 			Status:  status,
 		}
+		if err := tx.Create(&statusHistory).Error; err != nil {
+			return err
+		}
 
-		return tx.Create(&statusHistory).Error
+		// Guardar el evento del cambio de estado en el outbox
+		return saveOutboxEvent(tx, event)
 	})
 
 	return err
 }
 
-func (r *orderRepository) AssignDriverToOrder(ctx context.Context, orderID, driverID string) error {
-	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+func (r *orderRepository) AssignDriverToOrder(ctx context.Context, orderID, driverID string, event *entities.SystemEvent) error {
+	err := dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
 		if err := tx.Model(&entities.Order{}).Where("id = ?", orderID).Update("driver_id", driverID).Error; err != nil {
 			return err
 		}
-		return nil
+		return saveOutboxEvent(tx, event)
 	})
 
 	return err
@@ -313,7 +326,7 @@ func (r *orderRepository) CreateQRData(ctx context.Context, qr *entities.QRCode)
 		return errPackage.ErrNilQR
 	}
 
-	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	err := dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
 		if qr != nil {
 			if err := tx.Create(qr).Error; err != nil {
 				return err
@@ -339,7 +352,7 @@ func (r *orderRepository) GetLocationCoordinates(ctx context.Context, orderID st
 		Lng float64
 	}
 
-	err := r.db.WithContext(ctx).Raw(
+	err := dbFromContext(ctx, r.db).Raw(
 		fmt.Sprintf("SELECT ST_Y(location) as lat, ST_X(location) as lng FROM %s WHERE order_id = ?", tableName),
 		orderID,
 	).Scan(&result).Error
@@ -351,7 +364,7 @@ func (r *orderRepository) GetLocationCoordinates(ctx context.Context, orderID st
 func (r *orderRepository) SoftDeleteOrder(ctx context.Context, id string) error {
 	now := time.Now()
 
-	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	return dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
 
 		// 1. Marcar el pedido como eliminado
 		if err := tx.Model(&entities.Order{}).
@@ -382,7 +395,7 @@ func (r *orderRepository) SoftDeleteOrder(ctx context.Context, id string) error
 
 // RestoreOrder restaura un pedido previamente eliminado lógicamente
 func (r *orderRepository) RestoreOrder(ctx context.Context, id string) error {
-	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	return dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
 		// 1. Restaurar el pedido
 		if err := tx.Model(&entities.Order{}).
 			Where("id = ?", id).
@@ -406,6 +419,443 @@ func (r *orderRepository) RestoreOrder(ctx context.Context, id string) error {
 	})
 }
 
+// purgeableOrderRecords registros que dependen del pedido y se eliminan al purgarlo
+var purgeableOrderRecords = []interface{}{
+	&entities.Details{},
+	&entities.PackageDetail{},
+	&entities.DeliveryAddress{},
+	&entities.PickupAddress{},
+	&entities.Tracking{},
+	&entities.QRCode{},
+	&entities.StatusHistory{},
+	&entities.DeliveryPIN{},
+	&entities.DeliveryAttempt{},
+	&entities.Parcel{},
+	&entities.Inventory{},
+	&entities.PackageTracking{},
+}
+
+// PurgeDeletedOrders elimina definitivamente los pedidos eliminados lógicamente antes de la fecha junto con sus
+// registros dependientes. Se conservan los pedidos con pagos, facturas, ganancias, cobros o devoluciones
+func (r *orderRepository) PurgeDeletedOrders(ctx context.Context, deletedBefore time.Time, limit int) (int64, error) {
+	var purged int64
+	err := dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		// 1. Obtener los pedidos a purgar
+		var ids []string
+		err := tx.Model(&entities.Order{}).
+			Where("deleted_at IS NOT NULL AND deleted_at < ?", deletedBefore).
+			Where("NOT EXISTS (SELECT 1 FROM order_payments WHERE order_payments.order_id = orders.id)").
+			Where("NOT EXISTS (SELECT 1 FROM invoice_lines WHERE invoice_lines.order_id = orders.id)").
+			Where("NOT EXISTS (SELECT 1 FROM driver_earnings WHERE driver_earnings.order_id = orders.id)").
+			Where("NOT EXISTS (SELECT 1 FROM driver_cash_ledger WHERE driver_cash_ledger.order_id = orders.id)").
+			Where("NOT EXISTS (SELECT 1 FROM order_returns WHERE order_returns.order_id = orders.id)").
+			Limit(limit).
+			Pluck("id", &ids).Error
+		if err != nil || len(ids) == 0 {
+			return err
+		}
+
+		// 2. Eliminar los registros dependientes y la referencia de las programaciones que los generaron
+		for _, model := range purgeableOrderRecords {
+			if err = tx.Where("order_id IN ?", ids).Delete(model).Error; err != nil {
+				return err
+			}
+		}
+
+		if err = tx.Model(&entities.OrderSchedule{}).
+			Where("last_order_id IN ?", ids).
+			Update("last_order_id", nil).Error; err != nil {
+			return err
+		}
+
+		// 3. Eliminar los pedidos
+		result := tx.Where("id IN ?", ids).Delete(&entities.Order{})
+		purged = result.RowsAffected
+		return result.Error
+	})
+
+	return purged, err
+}
+
+// MarkOrderDelivered marca el pedido como entregado registrando la fecha de entrega, la ganancia del repartidor y,
+// si el pedido es contra entrega, el cobro en el libro de efectivo del repartidor
+func (r *orderRepository) MarkOrderDelivered(ctx context.Context, orderID string, collection *entities.CashLedgerEntry, earning *entities.DriverEarning, event *entities.SystemEvent) error {
+	now := time.Now()
+
+	return dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		// 1. Actualizar el estado del pedido solo si sigue en un estado entregable, evitando registrar
+		// dos veces el cobro y la ganancia en entregas concurrentes
+		result := tx.Model(&entities.Order{}).
+			Where("id = ? AND status IN ?", orderID, constants.DeliverableOrderStatuses).
+			Updates(map[string]interface{}{
+				"status":     constants.OrderStatusDelivered,
+				"updated_at": now,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return domainErr.ErrOrderDeliveryConflict
+		}
+
+		// 2. Registrar la fecha de entrega
+		if err := tx.Model(&entities.Details{}).
+			Where("order_id = ?", orderID).
+			Update("delivered_at", now).Error; err != nil {
+			return err
+		}
+
+		// 3. Marcar el PIN como verificado si existe
+		if err := tx.Model(&entities.DeliveryPIN{}).
+			Where("order_id = ?", orderID).
+			Update("verified_at", now).Error; err != nil {
+			return err
+		}
+
+		// 4. Marcar los bultos como entregados
+		if err := syncParcelStatuses(tx, orderID, constants.OrderStatusDelivered, now); err != nil {
+			return err
+		}
+
+		// 5. Registrar el cobro contra entrega
+		if collection != nil {
+			if err := tx.Create(collection).Error; err != nil {
+				return err
+			}
+		}
+
+		// 6. Registrar la ganancia del repartidor y actualizar sus entregas completadas
+		if earning != nil {
+			if err := tx.Create(earning).Error; err != nil {
+				return err
+			}
+
+			if err := tx.Model(&entities.Driver{}).
+				Where("user_id = ?", earning.DriverID).
+				Updates(map[string]interface{}{
+					"completed_deliveries": gorm.Expr("completed_deliveries + 1"),
+					"last_delivery":        now,
+				}).Error; err != nil {
+				return err
+			}
+		}
+
+		// 7. Guardar historial de estado
+		statusHistory := entities.StatusHistory{
+			ID:          uuid.NewString(),
+			OrderID:     orderID,
+			Status:      constants.OrderStatusDelivered,
+			Description: "Pedido entregado al destinatario",
+			CreatedAt:   now,
+		}
+		if err := tx.Create(&statusHistory).Error; err != nil {
+			return err
+		}
+
+		// 8. Guardar el evento de la entrega en el outbox
+		return saveOutboxEvent(tx, event)
+	})
+}
+
+// CreateDeliveryPIN guarda el hash del PIN de entrega de un pedido
+func (r *orderRepository) CreateDeliveryPIN(ctx context.Context, pin *entities.DeliveryPIN) error {
+	return dbFromContext(ctx, r.db).Create(pin).Error
+}
+
+// GetDeliveryPIN obtiene el PIN de entrega de un pedido
+func (r *orderRepository) GetDeliveryPIN(ctx context.Context, orderID string) (*entities.DeliveryPIN, error) {
+	var pin entities.DeliveryPIN
+	err := dbFromContext(ctx, r.db).First(&pin, "order_id = ?", orderID).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &pin, nil
+}
+
+// RegisterFailedPINAttempt incrementa los intentos fallidos y bloquea el PIN marcando el pedido
+// cuando se alcanza el máximo de intentos permitidos
+func (r *orderRepository) RegisterFailedPINAttempt(ctx context.Context, orderID string, maxAttempts int) (*entities.DeliveryPIN, error) {
+	var pin entities.DeliveryPIN
+
+	err := dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		// 1. Incrementar los intentos fallidos
+		if err := tx.Model(&entities.DeliveryPIN{}).
+			Where("order_id = ?", orderID).
+			Updates(map[string]interface{}{
+				"failed_attempts": gorm.Expr("failed_attempts + ?", 1),
+				"updated_at":      time.Now(),
+			}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.First(&pin, "order_id = ?", orderID).Error; err != nil {
+			return err
+		}
+
+		if pin.FailedAttempts < maxAttempts || pin.IsLocked {
+			return nil
+		}
+
+		// 2. Bloquear el PIN y marcar el pedido para revisión
+		now := time.Now()
+		pin.IsLocked = true
+		pin.LockedAt = &now
+		if err := tx.Model(&entities.DeliveryPIN{}).
+			Where("order_id = ?", orderID).
+			Updates(map[string]interface{}{
+				"is_locked": true,
+				"locked_at": now,
+			}).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&entities.Order{}).
+			Where("id = ?", orderID).
+			Updates(map[string]interface{}{
+				"is_flagged":  true,
+				"flag_reason": constants.OrderFlagDeliveryPINLocked,
+				"updated_at":  now,
+			}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pin, nil
+}
+
+// RegisterDeliveryAttempt guarda un intento de entrega fallido y actualiza el estado del pedido,
+// devolviéndolo al remitente cuando se agotan los intentos
+func (r *orderRepository) RegisterDeliveryAttempt(ctx context.Context, attempt *entities.DeliveryAttempt, returnToSender bool, event *entities.SystemEvent) error {
+	return dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		status := constants.OrderStatusFailed
+		if returnToSender {
+			status = constants.OrderStatusReturned
+		}
+
+		// 1. Actualizar el estado del pedido solo si sigue en camino, evitando registrar dos veces el mismo
+		// intento en solicitudes concurrentes
+		result := tx.Model(&entities.Order{}).
+			Where("id = ? AND status = ?", attempt.OrderID, constants.OrderStatusInTransit).
+			Updates(map[string]interface{}{
+				"status":     status,
+				"updated_at": attempt.AttemptedAt,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return domainErr.ErrOrderAttemptConflict
+		}
+
+		// 2. Guardar el intento
+		if err := tx.Create(attempt).Error; err != nil {
+			return err
+		}
+
+		if returnToSender {
+			if err := syncParcelStatuses(tx, attempt.OrderID, status, attempt.AttemptedAt); err != nil {
+				return err
+			}
+		}
+
+		// 3. Guardar historial de estado
+		history := []entities.StatusHistory{
+			{
+				ID:          uuid.NewString(),
+				OrderID:     attempt.OrderID,
+				Status:      constants.OrderStatusFailed,
+				Description: fmt.Sprintf("Intento de entrega #%d fallido: %s", attempt.AttemptNumber, attempt.ReasonCode),
+				CreatedAt:   attempt.AttemptedAt,
+			},
+		}
+
+		if returnToSender {
+			history = append(history, entities.StatusHistory{
+				ID:          uuid.NewString(),
+				OrderID:     attempt.OrderID,
+				Status:      constants.OrderStatusReturned,
+				Description: "Intentos de entrega agotados, pedido devuelto al remitente",
+				CreatedAt:   attempt.AttemptedAt,
+			})
+		}
+		if err := tx.Create(&history).Error; err != nil {
+			return err
+		}
+
+		// 4. Guardar el evento del cambio de estado en el outbox
+		return saveOutboxEvent(tx, event)
+	})
+}
+
+// GetDeliveryAttempts obtiene los intentos de entrega de un pedido ordenados cronológicamente
+func (r *orderRepository) GetDeliveryAttempts(ctx context.Context, orderID string) ([]entities.DeliveryAttempt, error) {
+	var attempts []entities.DeliveryAttempt
+	err := dbFromContext(ctx, r.db).
+		Where("order_id = ?", orderID).
+		Order("attempt_number ASC").
+		Find(&attempts).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return attempts, nil
+}
+
+// GetDueRedeliveries obtiene el último intento fallido de los pedidos cuya reprogramación ya llegó, del más antiguo
+// al más reciente
+func (r *orderRepository) GetDueRedeliveries(ctx context.Context, now time.Time, limit int) ([]entities.DeliveryAttempt, error) {
+	var attempts []entities.DeliveryAttempt
+	err := dbFromContext(ctx, r.db).
+		Joins("INNER JOIN orders ON orders.id = order_delivery_attempts.order_id").
+		Where("orders.status = ? AND orders.deleted_at IS NULL", constants.OrderStatusFailed).
+		Where("order_delivery_attempts.next_attempt_at IS NOT NULL AND order_delivery_attempts.next_attempt_at <= ?", now).
+		Where(`NOT EXISTS (
+			SELECT 1 FROM order_delivery_attempts later
+			WHERE later.order_id = order_delivery_attempts.order_id AND later.attempt_number > order_delivery_attempts.attempt_number
+		)`).
+		Order("order_delivery_attempts.next_attempt_at ASC").
+		Limit(limit).
+		Find(&attempts).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return attempts, nil
+}
+
+// RescheduleDelivery vuelve a poner en camino un pedido con la entrega fallida y actualiza su fecha límite de entrega
+func (r *orderRepository) RescheduleDelivery(ctx context.Context, orderID string, deadline time.Time, event *entities.SystemEvent) error {
+	now := time.Now()
+
+	return dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		// 1. Actualizar el estado solo si el pedido sigue con la entrega fallida
+		result := tx.Model(&entities.Order{}).
+			Where("id = ? AND status = ?", orderID, constants.OrderStatusFailed).
+			Updates(map[string]interface{}{
+				"status":     constants.OrderStatusInTransit,
+				"updated_at": now,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return domainErr.ErrOrderRescheduleConflict
+		}
+
+		// 2. Actualizar la fecha límite de entrega
+		if err := tx.Model(&entities.Details{}).
+			Where("order_id = ?", orderID).
+			Update("delivery_deadline", deadline).Error; err != nil {
+			return err
+		}
+
+		// 3. Guardar historial de estado
+		statusHistory := entities.StatusHistory{
+			ID:          uuid.NewString(),
+			OrderID:     orderID,
+			Status:      constants.OrderStatusInTransit,
+			Description: "Entrega reprogramada, pedido nuevamente en camino",
+			CreatedAt:   now,
+		}
+		if err := tx.Create(&statusHistory).Error; err != nil {
+			return err
+		}
+
+		// 4. Guardar el evento del cambio de estado en el outbox
+		return saveOutboxEvent(tx, event)
+	})
+}
+
+// GetParcelByTrackingNumber obtiene un bulto por su número de seguimiento
+func (r *orderRepository) GetParcelByTrackingNumber(ctx context.Context, trackingNumber string) (*entities.Parcel, error) {
+	var parcel entities.Parcel
+	err := dbFromContext(ctx, r.db).First(&parcel, "tracking_number = ?", trackingNumber).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &parcel, nil
+}
+
+// ChangeParcelStatus actualiza el estado de un bulto y, si se indica, el estado derivado del pedido
+func (r *orderRepository) ChangeParcelStatus(ctx context.Context, parcel *entities.Parcel, status, orderStatus string, event *entities.SystemEvent) error {
+	now := time.Now()
+
+	return dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		// 1. Actualizar el estado del bulto
+		if err := tx.Model(&entities.Parcel{}).
+			Where("id = ?", parcel.ID).
+			Updates(map[string]interface{}{
+				"status":     status,
+				"updated_at": now,
+			}).Error; err != nil {
+			return err
+		}
+
+		if orderStatus == "" {
+			return nil
+		}
+
+		// 2. Actualizar el estado del pedido cuando todos sus bultos avanzaron
+		if err := tx.Model(&entities.Order{}).
+			Where("id = ?", parcel.OrderID).
+			Updates(map[string]interface{}{
+				"status":     orderStatus,
+				"updated_at": now,
+			}).Error; err != nil {
+			return err
+		}
+
+		// 3. Guardar historial de estado
+		statusHistory := entities.StatusHistory{
+			ID:          uuid.NewString(),
+			OrderID:     parcel.OrderID,
+			Status:      orderStatus,
+			Description: fmt.Sprintf("Todos los bultos en estado %s, último escaneado: %s", orderStatus, parcel.TrackingNumber),
+			CreatedAt:   now,
+		}
+		if err := tx.Create(&statusHistory).Error; err != nil {
+			return err
+		}
+
+		// 4. Guardar el evento del cambio de estado del pedido en el outbox
+		return saveOutboxEvent(tx, event)
+	})
+}
+
+// syncParcelStatuses propaga el estado del pedido a sus bultos: los estados de avance solo mueven
+// los bultos rezagados y los estados finales se aplican a todos los bultos no entregados
+func syncParcelStatuses(tx *gorm.DB, orderID, status string, now time.Time) error {
+	query := tx.Model(&entities.Parcel{}).Where("order_id = ?", orderID)
+
+	if progress, ok := constants.ParcelStatusProgress[status]; ok {
+		var behind []string
+		for parcelStatus, parcelProgress := range constants.ParcelStatusProgress {
+			if parcelProgress < progress {
+				behind = append(behind, parcelStatus)
+			}
+		}
+		if len(behind) == 0 {
+			return nil
+		}
+		query = query.Where("status IN ?", behind)
+	} else if constants.ParcelTerminalStatuses[status] {
+		query = query.Where("status <> ?", constants.OrderStatusDelivered)
+	} else {
+		return nil
+	}
+
+	return query.Updates(map[string]interface{}{
+		"status":     status,
+		"updated_at": now,
+	}).Error
+}
+
 func (r *orderRepository) applyOrderPreloads(query *gorm.DB) *gorm.DB {
 	return query.
 		Preload("Company").
@@ -418,7 +868,12 @@ func (r *orderRepository) applyOrderPreloads(query *gorm.DB) *gorm.DB {
 		Preload("PickupAddress").
 		Preload("Tracking").
 		Preload("QRCode").
+		Preload("Payment").
+		Preload("Parcels", func(db *gorm.DB) *gorm.DB {
+			return db.Order("sequence ASC")
+		}).
 		Preload("StatusHistory").
+		Preload("DeliveryAttempts").
 		Preload("WarehouseTrackings").
 		Preload("WarehouseInventory")
 }
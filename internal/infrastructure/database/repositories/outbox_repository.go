@@ -0,0 +1,107 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	"gorm.io/gorm"
+)
+
+type outboxRepository struct {
+	db *gorm.DB
+}
+
+func NewOutboxRepository(db *gorm.DB) ports.OutboxRepository {
+	return &outboxRepository{
+		db: db,
+	}
+}
+
+// GetPendingEvents obtiene los eventos sin publicar cuyo siguiente intento ya corresponde, en el orden en que ocurrieron
+func (r *outboxRepository) GetPendingEvents(ctx context.Context, now time.Time, maxAttempts, limit int) ([]entities.SystemEvent, error) {
+	var events []entities.SystemEvent
+	err := dbFromContext(ctx, r.db).
+		Where("published_at IS NULL AND attempts < ?", maxAttempts).
+		Where("next_attempt_at IS NULL OR next_attempt_at <= ?", now).
+		Order("occurred_at ASC").
+		Limit(limit).
+		Find(&events).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// ClaimEvent reserva el evento moviendo su siguiente intento al fin de la reserva, solo un proceso puede reservarlo
+func (r *outboxRepository) ClaimEvent(ctx context.Context, id string, now, leaseUntil time.Time) (bool, error) {
+	result := dbFromContext(ctx, r.db).
+		Model(&entities.SystemEvent{}).
+		Where("id = ? AND published_at IS NULL", id).
+		Where("next_attempt_at IS NULL OR next_attempt_at <= ?", now).
+		Update("next_attempt_at", leaseUntil)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+// GetHandledSubscribers obtiene los suscriptores que ya recibieron el evento
+func (r *outboxRepository) GetHandledSubscribers(ctx context.Context, eventID string) (map[string]bool, error) {
+	var subscribers []string
+	err := dbFromContext(ctx, r.db).
+		Model(&entities.EventLog{}).
+		Where("event_id = ? AND log_level = ?", eventID, constants.EventLogLevelInfo).
+		Pluck("subscriber", &subscribers).Error
+	if err != nil {
+		return nil, err
+	}
+
+	handled := make(map[string]bool, len(subscribers))
+	for _, subscriber := range subscribers {
+		handled[subscriber] = true
+	}
+
+	return handled, nil
+}
+
+func (r *outboxRepository) SaveEventLog(ctx context.Context, log *entities.EventLog) error {
+	return dbFromContext(ctx, r.db).Create(log).Error
+}
+
+func (r *outboxRepository) MarkEventPublished(ctx context.Context, id string, publishedAt time.Time) error {
+	return dbFromContext(ctx, r.db).
+		Model(&entities.SystemEvent{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"published_at":    publishedAt,
+			"next_attempt_at": nil,
+			"last_error":      "",
+		}).Error
+}
+
+// MarkEventFailed guarda el intento fallido y el momento del siguiente reintento
+func (r *outboxRepository) MarkEventFailed(ctx context.Context, event *entities.SystemEvent) error {
+	return dbFromContext(ctx, r.db).
+		Model(&entities.SystemEvent{}).
+		Where("id = ?", event.ID).
+		Updates(map[string]interface{}{
+			"attempts":        event.Attempts,
+			"next_attempt_at": event.NextAttemptAt,
+			"last_error":      event.LastError,
+		}).Error
+}
+
+// saveOutboxEvent guarda el evento de dominio en la transacción del cambio que lo genera, de modo que el evento
+// solo existe si el cambio se confirma
+func saveOutboxEvent(tx *gorm.DB, event *entities.SystemEvent) error {
+	if event == nil {
+		return nil
+	}
+
+	return tx.Omit("Logs").Create(event).Error
+}
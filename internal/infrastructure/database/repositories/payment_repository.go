@@ -0,0 +1,111 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	"gorm.io/gorm"
+)
+
+type paymentRepository struct {
+	db *gorm.DB
+}
+
+func NewPaymentRepository(db *gorm.DB) ports.PaymentRepository {
+	return &paymentRepository{
+		db: db,
+	}
+}
+
+func (r *paymentRepository) GetPaymentByOrderID(ctx context.Context, orderID string) (*entities.OrderPayment, error) {
+	var payment entities.OrderPayment
+	err := dbFromContext(ctx, r.db).
+		Preload("Order").
+		First(&payment, "order_id = ?", orderID).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &payment, nil
+}
+
+func (r *paymentRepository) GetPaymentByProviderID(ctx context.Context, provider, providerPaymentID string) (*entities.OrderPayment, error) {
+	var payment entities.OrderPayment
+	err := dbFromContext(ctx, r.db).
+		Preload("Order").
+		First(&payment, "provider = ? AND provider_payment_id = ?", provider, providerPaymentID).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &payment, nil
+}
+
+// UpdatePayment actualiza el estado del pago y las fechas asociadas a él
+func (r *paymentRepository) UpdatePayment(ctx context.Context, payment *entities.OrderPayment) error {
+	return r.updatePayment(dbFromContext(ctx, r.db), payment)
+}
+
+// GetPaymentsByStatus obtiene los pagos en los estados indicados con su pedido, de la última actualización más antigua
+// a la más reciente
+func (r *paymentRepository) GetPaymentsByStatus(ctx context.Context, statuses []string, limit int) ([]entities.OrderPayment, error) {
+	var payments []entities.OrderPayment
+	err := dbFromContext(ctx, r.db).
+		Preload("Order").
+		Where("status IN ?", statuses).
+		Order("updated_at ASC").
+		Limit(limit).
+		Find(&payments).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return payments, nil
+}
+
+func (r *paymentRepository) ExistsWebhookEvent(ctx context.Context, eventID string) (bool, error) {
+	var count int64
+	err := dbFromContext(ctx, r.db).Model(&entities.PaymentWebhookEvent{}).
+		Where("id = ?", eventID).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// ApplyWebhookEvent registra el evento y actualiza el pago en una sola transacción, si el evento ya
+// fue registrado se devuelve gorm.ErrDuplicatedKey y el pago no se modifica
+func (r *paymentRepository) ApplyWebhookEvent(ctx context.Context, event *entities.PaymentWebhookEvent, payment *entities.OrderPayment) error {
+	return dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		// 1. Registrar el evento
+		if err := tx.Create(event).Error; err != nil {
+			return err
+		}
+
+		// 2. Actualizar el pago
+		if payment == nil {
+			return nil
+		}
+
+		return r.updatePayment(tx, payment)
+	})
+}
+
+func (r *paymentRepository) updatePayment(db *gorm.DB, payment *entities.OrderPayment) error {
+	return db.Model(&entities.OrderPayment{}).
+		Where("id = ?", payment.ID).
+		Updates(map[string]interface{}{
+			"provider_payment_id": payment.ProviderPaymentID,
+			"status":              payment.Status,
+			"refunded_amount":     payment.RefundedAmount,
+			"failure_reason":      payment.FailureReason,
+			"authorized_at":       payment.AuthorizedAt,
+			"captured_at":         payment.CapturedAt,
+			"refunded_at":         payment.RefundedAt,
+			"updated_at":          time.Now(),
+		}).Error
+}
@@ -0,0 +1,196 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	domainErr "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+type returnRepository struct {
+	db *gorm.DB
+}
+
+func NewReturnRepository(db *gorm.DB) ports.ReturnRepository {
+	return &returnRepository{
+		db: db,
+	}
+}
+
+// CreateReturn guarda la devolución bloqueando el pedido original, de modo que dos solicitudes simultáneas no
+// puedan crear dos devoluciones en curso ni devolver un pedido que cambió de estado
+func (r *returnRepository) CreateReturn(ctx context.Context, orderReturn *entities.OrderReturn) error {
+	return dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		// 1. Bloquear el pedido original y verificar que aún se pueda devolver
+		var order entities.Order
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id", "status").
+			Where("id = ?", orderReturn.OrderID).
+			Take(&order).Error; err != nil {
+			return err
+		}
+
+		if !constants.AllowedStatesToReturn[order.Status] {
+			return domainErr.ErrOrderCannotBeReturned
+		}
+
+		// 2. Verificar que no exista otra devolución en curso
+		var count int64
+		if err := tx.Model(&entities.OrderReturn{}).
+			Where("order_id = ? AND status NOT IN ?", orderReturn.OrderID,
+				[]string{constants.ReturnStatusReceived, constants.ReturnStatusCancelled}).
+			Count(&count).Error; err != nil {
+			return err
+		}
+
+		if count > 0 {
+			return domainErr.ErrReturnAlreadyExists
+		}
+
+		// 3. Guardar la devolución
+		return tx.Create(orderReturn).Error
+	})
+}
+
+func (r *returnRepository) GetReturnByID(ctx context.Context, id string) (*entities.OrderReturn, error) {
+	var orderReturn entities.OrderReturn
+	err := dbFromContext(ctx, r.db).
+		Preload("Order").
+		First(&orderReturn, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &orderReturn, nil
+}
+
+func (r *returnRepository) GetReturnsByOrder(ctx context.Context, orderID string) ([]entities.OrderReturn, error) {
+	var returns []entities.OrderReturn
+	err := dbFromContext(ctx, r.db).
+		Where("order_id = ?", orderID).
+		Order("created_at DESC").
+		Find(&returns).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return returns, nil
+}
+
+func (r *returnRepository) GetReturnsByCompany(ctx context.Context, companyID string, status string) ([]entities.OrderReturn, error) {
+	var returns []entities.OrderReturn
+	query := dbFromContext(ctx, r.db).Where("company_id = ?", companyID)
+
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	err := query.Order("created_at DESC").Find(&returns).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return returns, nil
+}
+
+// UpdateReturnStatus actualiza el estado de la devolución solo si conserva el estado leído, registrando las fechas
+// de recogida y recepción y, al recibirse, marca como devuelto el pedido original si sigue entregado
+func (r *returnRepository) UpdateReturnStatus(ctx context.Context, orderReturn *entities.OrderReturn, status string, driverID *string, changedAt time.Time, event *entities.SystemEvent) error {
+	updates := map[string]interface{}{
+		"status":     status,
+		"updated_at": changedAt,
+	}
+
+	switch status {
+	case constants.ReturnStatusPickedUp:
+		updates["picked_up_at"] = changedAt
+		if driverID != nil {
+			updates["driver_id"] = *driverID
+		}
+	case constants.ReturnStatusReceived:
+		updates["received_at"] = changedAt
+	}
+
+	return dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		// 1. Actualizar la devolución solo si nadie la cambió desde que se leyó
+		result := tx.Model(&entities.OrderReturn{}).
+			Where("id = ? AND status = ?", orderReturn.ID, orderReturn.Status).
+			Updates(updates)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return domainErr.ErrReturnStatusConflict
+		}
+
+		if status != constants.ReturnStatusReceived {
+			return nil
+		}
+
+		// 2. Marcar el pedido original como devuelto solo si sigue entregado, un pedido que ya se devolvió,
+		// canceló o perdió conserva su estado
+		result = tx.Model(&entities.Order{}).
+			Where("id = ? AND status = ?", orderReturn.OrderID, constants.OrderStatusDelivered).
+			Updates(map[string]interface{}{
+				"status":     constants.OrderStatusReturned,
+				"updated_at": changedAt,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		if err := syncParcelStatuses(tx, orderReturn.OrderID, constants.OrderStatusReturned, changedAt); err != nil {
+			return err
+		}
+
+		// 3. Guardar historial de estado
+		statusHistory := entities.StatusHistory{
+			ID:          uuid.NewString(),
+			OrderID:     orderReturn.OrderID,
+			Status:      constants.OrderStatusReturned,
+			Description: fmt.Sprintf("Devolución %s recibida: %s", orderReturn.TrackingNumber, orderReturn.ReasonCode),
+			CreatedAt:   changedAt,
+		}
+		if err := tx.Create(&statusHistory).Error; err != nil {
+			return err
+		}
+
+		// 4. Guardar el evento del cambio de estado en el outbox
+		return saveOutboxEvent(tx, event)
+	})
+}
+
+func (r *returnRepository) ExistsActiveBranch(ctx context.Context, companyID, branchID string) (bool, error) {
+	var count int64
+	err := dbFromContext(ctx, r.db).Model(&entities.Branch{}).
+		Where("id = ? AND company_id = ? AND is_active = ?", branchID, companyID, true).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (r *returnRepository) ExistsActiveWarehouse(ctx context.Context, warehouseID string) (bool, error) {
+	var count int64
+	err := dbFromContext(ctx, r.db).Model(&entities.Warehouse{}).
+		Where("id = ? AND is_active = ?", warehouseID, true).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
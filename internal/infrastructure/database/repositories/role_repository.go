@@ -20,7 +20,7 @@ func NewRoleRepository(db *gorm.DB) ports.RolerRepository {
 // GetRoleByID obtiene un rol por su ID incluyendo sus permisos
 func (r *roleRepository) GetRoleByID(ctx context.Context, id string) (*entities.Role, error) {
 	var role entities.Role
-	err := r.db.WithContext(ctx).
+	err := dbFromContext(ctx, r.db).
 		Preload("Permissions").
 		First(&role, "id = ?", id).Error
 	if err != nil {
@@ -32,7 +32,7 @@ func (r *roleRepository) GetRoleByID(ctx context.Context, id string) (*entities.
 // GetRoleByName obtiene un rol por su nombre
 func (r *roleRepository) GetRoleByName(ctx context.Context, name string) (*entities.Role, error) {
 	var role entities.Role
-	err := r.db.WithContext(ctx).
+	err := dbFromContext(ctx, r.db).
 		Where("name = ?", name).
 		First(&role).Error
 	if err != nil {
@@ -43,12 +43,12 @@ func (r *roleRepository) GetRoleByName(ctx context.Context, name string) (*entit
 
 // UpdateRole actualiza un rol
 func (r *roleRepository) UpdateRole(ctx context.Context, role *entities.Role) error {
-	return r.db.WithContext(ctx).Save(role).Error
+	return dbFromContext(ctx, r.db).Save(role).Error
 }
 
 // DeleteRole elimina un rol (soft delete)
 func (r *roleRepository) DeleteRole(ctx context.Context, id string) error {
-	return r.db.WithContext(ctx).
+	return dbFromContext(ctx, r.db).
 		Model(&entities.Role{}).
 		Where("id = ?", id).
 		Update("is_active", false).Error
@@ -57,7 +57,7 @@ func (r *roleRepository) DeleteRole(ctx context.Context, id string) error {
 // ListRoles lista todos los roles activos
 func (r *roleRepository) ListRoles(ctx context.Context) ([]entities.Role, error) {
 	var roles []entities.Role
-	err := r.db.WithContext(ctx).
+	err := dbFromContext(ctx, r.db).
 		Where("is_active = ?", true).
 		Find(&roles).Error
 	if err != nil {
@@ -69,7 +69,7 @@ func (r *roleRepository) ListRoles(ctx context.Context) ([]entities.Role, error)
 // GetRoleByIDOrName obtiene un rol por su ID o nombre
 func (r *roleRepository) GetRoleByIDOrName(ctx context.Context, param string) (*entities.Role, error) {
 	var role entities.Role
-	err := r.db.WithContext(ctx).
+	err := dbFromContext(ctx, r.db).
 		Preload("Permissions").
 		First(&role, "id = ? OR name = ?", param, param).Error
 	if err != nil {
@@ -81,7 +81,7 @@ func (r *roleRepository) GetRoleByIDOrName(ctx context.Context, param string) (*
 // IsRoleExist verifica si un rol existe, ya sea por su ID o nombre
 func (r *roleRepository) IsRoleExist(ctx context.Context, param string) (bool, error) {
 	var role entities.Role
-	err := r.db.WithContext(ctx).
+	err := dbFromContext(ctx, r.db).
 		First(&role, "id = ? OR name = ?", param, param).Error
 	if err != nil {
 		return false, err
@@ -91,7 +91,7 @@ func (r *roleRepository) IsRoleExist(ctx context.Context, param string) (bool, e
 
 // DeactivateRole desactiva un rol
 func (r *roleRepository) DeactivateRole(ctx context.Context, id string) error {
-	return r.db.WithContext(ctx).
+	return dbFromContext(ctx, r.db).
 		Model(&entities.Role{}).
 		Where("id = ?", id).
 		Update("is_active", false).Error
@@ -100,7 +100,7 @@ func (r *roleRepository) DeactivateRole(ctx context.Context, id string) error {
 // IsRoleActive verifica si un rol está activo
 func (r *roleRepository) IsRoleActive(ctx context.Context, param string) (bool, error) {
 	var role entities.Role
-	err := r.db.WithContext(ctx).
+	err := dbFromContext(ctx, r.db).
 		Select("is_active").
 		First(&role, "id = ? OR name = ?", param, param).Error
 	if err != nil {
@@ -111,13 +111,13 @@ func (r *roleRepository) IsRoleActive(ctx context.Context, param string) (bool,
 
 // CreatePermission crea un nuevo permiso
 func (r *roleRepository) CreatePermission(ctx context.Context, permission *entities.Permission) error {
-	return r.db.WithContext(ctx).Create(permission).Error
+	return dbFromContext(ctx, r.db).Create(permission).Error
 }
 
 // GetPermissionByID obtiene un permiso por su ID
 func (r *roleRepository) GetPermissionByID(ctx context.Context, id string) (*entities.Permission, error) {
 	var permission entities.Permission
-	err := r.db.WithContext(ctx).First(&permission, "id = ?", id).Error
+	err := dbFromContext(ctx, r.db).First(&permission, "id = ?", id).Error
 	if err != nil {
 		return nil, err
 	}
@@ -126,18 +126,18 @@ func (r *roleRepository) GetPermissionByID(ctx context.Context, id string) (*ent
 
 // UpdatePermission actualiza un permiso
 func (r *roleRepository) UpdatePermission(ctx context.Context, permission *entities.Permission) error {
-	return r.db.WithContext(ctx).Save(permission).Error
+	return dbFromContext(ctx, r.db).Save(permission).Error
 }
 
 // DeletePermission elimina un permiso
 func (r *roleRepository) DeletePermission(ctx context.Context, id string) error {
-	return r.db.WithContext(ctx).Delete(&entities.Permission{}, "id = ?", id).Error
+	return dbFromContext(ctx, r.db).Delete(&entities.Permission{}, "id = ?", id).Error
 }
 
 // ListPermissions lista todos los permisos
 func (r *roleRepository) ListPermissions(ctx context.Context) ([]entities.Permission, error) {
 	var permissions []entities.Permission
-	err := r.db.WithContext(ctx).Find(&permissions).Error
+	err := dbFromContext(ctx, r.db).Find(&permissions).Error
 	if err != nil {
 		return nil, err
 	}
@@ -146,7 +146,7 @@ func (r *roleRepository) ListPermissions(ctx context.Context) ([]entities.Permis
 
 // AssignPermissionToRole asigna un permiso a un rol
 func (r *roleRepository) AssignPermissionToRole(ctx context.Context, roleID string, permissionID string) error {
-	return r.db.WithContext(ctx).Exec(
+	return dbFromContext(ctx, r.db).Exec(
 		"INSERT INTO role_permissions (role_id, permission_id) VALUES (?, ?)",
 		roleID, permissionID,
 	).Error
@@ -154,7 +154,7 @@ func (r *roleRepository) AssignPermissionToRole(ctx context.Context, roleID stri
 
 // RemovePermissionFromRole remueve un permiso de un rol
 func (r *roleRepository) RemovePermissionFromRole(ctx context.Context, roleID string, permissionID string) error {
-	return r.db.WithContext(ctx).Exec(
+	return dbFromContext(ctx, r.db).Exec(
 		"DELETE FROM role_permissions WHERE role_id = ? AND permission_id = ?",
 		roleID, permissionID,
 	).Error
@@ -163,7 +163,7 @@ func (r *roleRepository) RemovePermissionFromRole(ctx context.Context, roleID st
 // GetRolePermissions obtiene todos los permisos de un rol
 func (r *roleRepository) GetRolePermissions(ctx context.Context, roleID string) ([]entities.Permission, error) {
 	var permissions []entities.Permission
-	err := r.db.WithContext(ctx).
+	err := dbFromContext(ctx, r.db).
 		Table("permissions").
 		Joins("JOIN role_permissions ON role_permissions.permission_id = permissions.id").
 		Where("role_permissions.role_id = ?", roleID).
@@ -0,0 +1,149 @@
+package repositories
+
+import (
+	"context"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	domainErr "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"gorm.io/gorm"
+	"time"
+)
+
+type scheduleRepository struct {
+	db *gorm.DB
+}
+
+func NewScheduleRepository(db *gorm.DB) ports.ScheduleRepository {
+	return &scheduleRepository{
+		db: db,
+	}
+}
+
+func (r *scheduleRepository) CreateSchedule(ctx context.Context, schedule *entities.OrderSchedule) error {
+	return dbFromContext(ctx, r.db).Create(schedule).Error
+}
+
+// UpdateSchedule guarda todos los campos de la programación, incluidos los valores vacíos, solo si conserva el estado
+// y la siguiente ejecución con que se leyó. Así no pisa una ejecución reservada ni otro cambio de estado
+func (r *scheduleRepository) UpdateSchedule(ctx context.Context, schedule *entities.OrderSchedule, currentStatus string, currentRunAt *time.Time) error {
+	result := whereScheduleUnchanged(dbFromContext(ctx, r.db).Model(&entities.OrderSchedule{}), schedule.ID, currentStatus, currentRunAt).
+		Select("name", "order_template", "run_at", "days_of_week", "time_of_day",
+			"delivery_window_minutes", "status", "next_run_at", "updated_at").
+		Updates(schedule)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return domainErr.ErrScheduleConflict
+	}
+
+	return nil
+}
+
+// UpdateScheduleStatus cambia solo el estado y la siguiente ejecución, con la misma condición que UpdateSchedule
+func (r *scheduleRepository) UpdateScheduleStatus(ctx context.Context, id, currentStatus string, currentRunAt *time.Time, status string, nextRunAt *time.Time) error {
+	result := whereScheduleUnchanged(dbFromContext(ctx, r.db).Model(&entities.OrderSchedule{}), id, currentStatus, currentRunAt).
+		Updates(map[string]interface{}{
+			"status":      status,
+			"next_run_at": nextRunAt,
+			"updated_at":  time.Now(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return domainErr.ErrScheduleConflict
+	}
+
+	return nil
+}
+
+func (r *scheduleRepository) GetScheduleByID(ctx context.Context, id string) (*entities.OrderSchedule, error) {
+	var schedule entities.OrderSchedule
+	err := dbFromContext(ctx, r.db).First(&schedule, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &schedule, nil
+}
+
+func (r *scheduleRepository) GetSchedulesByCompany(ctx context.Context, companyID, status string) ([]entities.OrderSchedule, error) {
+	var schedules []entities.OrderSchedule
+	query := dbFromContext(ctx, r.db).Where("company_id = ?", companyID)
+
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	err := query.Order("created_at DESC").Find(&schedules).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return schedules, nil
+}
+
+// GetDueSchedules obtiene las programaciones activas cuya siguiente ejecución ya llegó
+func (r *scheduleRepository) GetDueSchedules(ctx context.Context, now time.Time, limit int) ([]entities.OrderSchedule, error) {
+	var schedules []entities.OrderSchedule
+	err := dbFromContext(ctx, r.db).
+		Preload("Branch").
+		Where("status = ? AND next_run_at IS NOT NULL AND next_run_at <= ?", constants.ScheduleStatusActive, now).
+		Order("next_run_at ASC").
+		Limit(limit).
+		Find(&schedules).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return schedules, nil
+}
+
+// ClaimScheduleRun avanza la siguiente ejecución solo si nadie la tomó antes,
+// evitando que dos instancias materialicen el mismo pedido
+func (r *scheduleRepository) ClaimScheduleRun(ctx context.Context, id string, currentRunAt time.Time, nextRunAt *time.Time, status string) (bool, error) {
+	result := dbFromContext(ctx, r.db).
+		Model(&entities.OrderSchedule{}).
+		Where("id = ? AND status = ? AND next_run_at = ?", id, constants.ScheduleStatusActive, currentRunAt).
+		Updates(map[string]interface{}{
+			"next_run_at": nextRunAt,
+			"status":      status,
+			"updated_at":  time.Now(),
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+// RecordScheduleRun registra el resultado de la última materialización
+func (r *scheduleRepository) RecordScheduleRun(ctx context.Context, id string, orderID *string, lastError string, runAt time.Time) error {
+	updates := map[string]interface{}{
+		"last_run_at": runAt,
+		"last_error":  lastError,
+	}
+
+	if orderID != nil {
+		updates["last_order_id"] = *orderID
+	}
+
+	return dbFromContext(ctx, r.db).
+		Model(&entities.OrderSchedule{}).
+		Where("id = ?", id).
+		Updates(updates).Error
+}
+
+// whereScheduleUnchanged filtra la programación por el estado y la siguiente ejecución con que se leyó
+func whereScheduleUnchanged(query *gorm.DB, id, status string, nextRunAt *time.Time) *gorm.DB {
+	query = query.Where("id = ? AND status = ?", id, status)
+	if nextRunAt == nil {
+		return query.Where("next_run_at IS NULL")
+	}
+
+	return query.Where("next_run_at = ?", *nextRunAt)
+}
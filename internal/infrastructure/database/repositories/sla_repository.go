@@ -0,0 +1,81 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	"gorm.io/gorm"
+)
+
+type slaRepository struct {
+	db *gorm.DB
+}
+
+func NewSLARepository(db *gorm.DB) ports.SLARepository {
+	return &slaRepository{
+		db: db,
+	}
+}
+
+// GetSLAActiveOrders obtiene los pedidos aún no entregados con la zona de su sucursal, filtrando por empresa
+// y sucursal cuando se indican
+func (r *slaRepository) GetSLAActiveOrders(ctx context.Context, companyID, branchID string) ([]entities.Order, error) {
+	query := dbFromContext(ctx, r.db).
+		Joins("Detail").
+		Preload("Branch.Zone").
+		Where("orders.deleted_at IS NULL AND orders.status IN ?", constants.SLAMonitoredStatuses)
+
+	if companyID != "" {
+		query = query.Where("orders.company_id = ?", companyID)
+	}
+
+	if branchID != "" {
+		query = query.Where("orders.branch_id = ?", branchID)
+	}
+
+	var orders []entities.Order
+	if err := query.Order("Detail.delivery_deadline ASC").Find(&orders).Error; err != nil {
+		return nil, err
+	}
+
+	return orders, nil
+}
+
+// UpdateOrderSLAStatus guarda el estado del SLA del pedido y lo marca con el motivo indicado, sin reemplazar
+// una marca previa por otro motivo. Sin motivo se retira la marca del SLA
+func (r *slaRepository) UpdateOrderSLAStatus(ctx context.Context, orderID, status, flagReason string, evaluatedAt time.Time) error {
+	slaFlags := []string{constants.OrderFlagSLAAtRisk, constants.OrderFlagSLABreached}
+
+	return dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		// 1. Guardar el estado del SLA
+		if err := tx.Model(&entities.Details{}).
+			Where("order_id = ?", orderID).
+			Updates(map[string]interface{}{
+				"sla_status":       status,
+				"sla_evaluated_at": evaluatedAt,
+			}).Error; err != nil {
+			return err
+		}
+
+		// 2. Retirar la marca del SLA si el pedido volvió a estar a tiempo
+		if flagReason == "" {
+			return tx.Model(&entities.Order{}).
+				Where("id = ? AND flag_reason IN ?", orderID, slaFlags).
+				Updates(map[string]interface{}{
+					"is_flagged":  false,
+					"flag_reason": "",
+				}).Error
+		}
+
+		// 3. Marcar el pedido si no tiene otra marca
+		return tx.Model(&entities.Order{}).
+			Where("id = ? AND (is_flagged = ? OR flag_reason IN ?)", orderID, false, slaFlags).
+			Updates(map[string]interface{}{
+				"is_flagged":  true,
+				"flag_reason": flagReason,
+			}).Error
+	})
+}
@@ -0,0 +1,38 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	"gorm.io/gorm"
+)
+
+// txContextKey clave del contexto con la transacción en curso
+type txContextKey struct{}
+
+type transactionManager struct {
+	db *gorm.DB
+}
+
+func NewTransactionManager(db *gorm.DB) ports.TransactionManager {
+	return &transactionManager{
+		db: db,
+	}
+}
+
+// WithTransaction ejecuta fn en una transacción que se confirma si fn termina sin error y se revierte en caso
+// contrario. Si el contexto ya tiene una transacción, fn se ejecuta en un punto de guardado de esa transacción
+func (m *transactionManager) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return dbFromContext(ctx, m.db).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txContextKey{}, tx))
+	})
+}
+
+// dbFromContext obtiene la transacción en curso del contexto o, si no hay ninguna, la conexión del repositorio
+func dbFromContext(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(txContextKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+
+	return db.WithContext(ctx)
+}
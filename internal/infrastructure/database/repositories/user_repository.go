@@ -20,7 +20,7 @@ func NewUserRepository(db *gorm.DB) ports.UserRepository {
 
 // Create inserta un nuevo usuario y su perfil si existe
 func (r *userRepository) Create(ctx context.Context, user *entities.User) error {
-	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	return dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
 
 		if err := tx.Create(user).Error; err != nil {
 			return err
@@ -32,7 +32,7 @@ func (r *userRepository) Create(ctx context.Context, user *entities.User) error
 
 func (r *userRepository) IsUserDeleted(ctx context.Context, userID string) (bool, error) {
 	var user entities.User
-	err := r.db.WithContext(ctx).
+	err := dbFromContext(ctx, r.db).
 		Select("deleted_at").
 		Where("deleted_at IS NULL").
 		First(&user, "id = ?", userID).Error
@@ -44,7 +44,7 @@ func (r *userRepository) IsUserDeleted(ctx context.Context, userID string) (bool
 
 func (r *userRepository) IsUserActive(ctx context.Context, userID string) (bool, error) {
 	var user entities.User
-	err := r.db.WithContext(ctx).
+	err := dbFromContext(ctx, r.db).
 		Select("is_active").
 		First(&user, "id = ?", userID).Error
 	if err != nil {
@@ -56,7 +56,7 @@ func (r *userRepository) IsUserActive(ctx context.Context, userID string) (bool,
 // GetByID obtiene un usuario por ID incluyendo su perfil y roles activos
 func (r *userRepository) GetByID(ctx context.Context, id string) (*entities.User, error) {
 	var usr entities.User
-	err := r.db.WithContext(ctx).
+	err := dbFromContext(ctx, r.db).
 		Preload("Profile").
 		Preload("Roles", "is_active = ?", true).
 		Preload("Roles.Role").
@@ -71,7 +71,7 @@ func (r *userRepository) GetByID(ctx context.Context, id string) (*entities.User
 // GetByEmail obtiene un usuario por email
 func (r *userRepository) GetByEmail(ctx context.Context, email string) (*entities.User, error) {
 	var usr entities.User
-	err := r.db.WithContext(ctx).
+	err := dbFromContext(ctx, r.db).
 		Preload("Profile").
 		Preload("Roles", "is_active = ?", true).
 		Preload("Roles.Role").
@@ -86,7 +86,7 @@ func (r *userRepository) GetByEmail(ctx context.Context, email string) (*entitie
 
 // Update actualiza la información del usuario
 func (r *userRepository) Update(ctx context.Context, id string, user *entities.User) error {
-	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	return dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
 		// 1. Actualizar usuario
 		if err := tx.Model(&entities.User{}).Where("id = ?", id).Updates(user).Error; err != nil {
 			return err
@@ -107,7 +107,7 @@ func (r *userRepository) Update(ctx context.Context, id string, user *entities.U
 func (r *userRepository) Delete(ctx context.Context, id string) error {
 	now := time.Now()
 
-	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	return dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
 		if err := tx.Model(&entities.User{}).
 			Where("id = ?", id).
 			Updates(map[string]interface{}{
@@ -122,7 +122,7 @@ func (r *userRepository) Delete(ctx context.Context, id string) error {
 }
 
 func (r *userRepository) Recover(ctx context.Context, id string) error {
-	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	return dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
 		if err := tx.Model(&entities.User{}).
 			Where("id = ?", id).
 			Updates(map[string]interface{}{
@@ -139,7 +139,7 @@ func (r *userRepository) Recover(ctx context.Context, id string) error {
 // GetProfileByUserID obtiene el perfil de un usuario
 func (r *userRepository) GetProfileByUserID(ctx context.Context, userID string) (*entities.Profile, error) {
 	var profile entities.Profile
-	err := r.db.WithContext(ctx).
+	err := dbFromContext(ctx, r.db).
 		Where("user_id = ?", userID).
 		First(&profile).Error
 	if err != nil {
@@ -150,18 +150,18 @@ func (r *userRepository) GetProfileByUserID(ctx context.Context, userID string)
 
 // UpdateProfile actualiza el perfil del usuario
 func (r *userRepository) UpdateProfile(ctx context.Context, profile *entities.Profile) error {
-	return r.db.WithContext(ctx).Save(profile).Error
+	return dbFromContext(ctx, r.db).Save(profile).Error
 }
 
 // CreateSession crea una nueva sesión
 func (r *userRepository) CreateSession(ctx context.Context, session *entities.UserSession) error {
-	return r.db.WithContext(ctx).Create(session).Error
+	return dbFromContext(ctx, r.db).Create(session).Error
 }
 
 // GetSessionByToken obtiene una sesión por su token
 func (r *userRepository) GetSessionByToken(ctx context.Context, token string) (*entities.UserSession, error) {
 	var session entities.UserSession
-	err := r.db.WithContext(ctx).
+	err := dbFromContext(ctx, r.db).
 		Where("token = ? AND expires_at > NOW()", token).
 		First(&session).Error
 	if err != nil {
@@ -173,7 +173,7 @@ func (r *userRepository) GetSessionByToken(ctx context.Context, token string) (*
 // GetActiveSessionsByUserID obtiene todas las sesiones activas de un usuario
 func (r *userRepository) GetActiveSessionsByUserID(ctx context.Context, userID string) ([]entities.UserSession, error) {
 	var sessions []entities.UserSession
-	err := r.db.WithContext(ctx).
+	err := dbFromContext(ctx, r.db).
 		Where("user_id = ? AND expires_at > NOW()", userID).
 		Find(&sessions).Error
 	if err != nil {
@@ -184,18 +184,27 @@ func (r *userRepository) GetActiveSessionsByUserID(ctx context.Context, userID s
 
 // DeleteSession elimina una sesión específica
 func (r *userRepository) DeleteSession(ctx context.Context, sessionID string) error {
-	return r.db.WithContext(ctx).
+	return dbFromContext(ctx, r.db).
 		Delete(&entities.UserSession{}, "id = ?", sessionID).Error
 }
 
 // CleanExpiredSessions elimina todas las sesiones expiradas
 func (r *userRepository) CleanExpiredSessions(ctx context.Context, id string) error {
-	return r.db.WithContext(ctx).
+	return dbFromContext(ctx, r.db).
 		Model(&entities.UserSession{}).
 		Where("user_id = ? AND expires_at < NOW()", id).
 		Update("expires_at", time.Now()).Error
 }
 
+// DeleteExpiredSessions elimina las sesiones de todos los usuarios que expiraron antes de la fecha
+func (r *userRepository) DeleteExpiredSessions(ctx context.Context, before time.Time) (int64, error) {
+	result := dbFromContext(ctx, r.db).
+		Where("expires_at < ?", before).
+		Delete(&entities.UserSession{})
+
+	return result.RowsAffected, result.Error
+}
+
 // AssignRoleToUser asigna un rol a un usuario
 func (r *userRepository) AssignRoleToUser(ctx context.Context, userID string, roleID string, assignedBy string) error {
 	userRole := entities.UserRole{
@@ -205,14 +214,14 @@ func (r *userRepository) AssignRoleToUser(ctx context.Context, userID string, ro
 		AssignedAt: time.Now(),
 		IsActive:   true,
 	}
-	return r.db.WithContext(ctx).Create(&userRole).Error
+	return dbFromContext(ctx, r.db).Create(&userRole).Error
 }
 
 // UpdateRolesToUser actualiza los roles de un usuario
 func (r *userRepository) UpdateRolesToUser(ctx context.Context, userID string, loggedUserID string, roles []entities.Role) error {
 	// Obtenemos TODOS los roles del usuario (tanto activos como inactivos)
 	var allUserRoles []entities.UserRole
-	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).Find(&allUserRoles).Error; err != nil {
+	if err := dbFromContext(ctx, r.db).Where("user_id = ?", userID).Find(&allUserRoles).Error; err != nil {
 		return err
 	}
 
@@ -236,91 +245,84 @@ func (r *userRepository) UpdateRolesToUser(ctx context.Context, userID string, l
 		rolesToKeep[role.ID] = true
 	}
 
-	// Iniciamos una transacción
-	tx := r.db.WithContext(ctx).Begin()
-	if tx.Error != nil {
-		return tx.Error
-	}
-
-	// 1. Desactivar roles que ya no están en la lista
-	for roleID := range activeRoleIDs {
-		if !rolesToKeep[roleID] {
-			// El rol ya no debe estar asignado, lo desactivamos
-			if err := tx.Model(&entities.UserRole{}).
-				Where("user_id = ? AND role_id = ?", userID, roleID).
-				Updates(map[string]interface{}{"is_active": false}).Error; err != nil {
-				tx.Rollback()
-				return err
+	// Iniciamos una transacción, o un punto de guardado si ya hay una en curso
+	return dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		// 1. Desactivar roles que ya no están en la lista
+		for roleID := range activeRoleIDs {
+			if !rolesToKeep[roleID] {
+				// El rol ya no debe estar asignado, lo desactivamos
+				if err := tx.Model(&entities.UserRole{}).
+					Where("user_id = ? AND role_id = ?", userID, roleID).
+					Updates(map[string]interface{}{"is_active": false}).Error; err != nil {
+					return err
+				}
 			}
 		}
-	}
 
-	// 2. Reactivar roles que existían pero estaban inactivos
-	for _, role := range roles {
-		if inactiveRoleIDs[role.ID] {
-			// Reactivamos el rol
-			if err := tx.Model(&entities.UserRole{}).
-				Where("user_id = ? AND role_id = ?", userID, role.ID).
-				Updates(map[string]interface{}{
-					"is_active":   true,
-					"assigned_at": time.Now(),
-					"assigned_by": loggedUserID,
-				}).Error; err != nil {
-				tx.Rollback()
-				return err
+		// 2. Reactivar roles que existían pero estaban inactivos
+		for _, role := range roles {
+			if inactiveRoleIDs[role.ID] {
+				// Reactivamos el rol
+				if err := tx.Model(&entities.UserRole{}).
+					Where("user_id = ? AND role_id = ?", userID, role.ID).
+					Updates(map[string]interface{}{
+						"is_active":   true,
+						"assigned_at": time.Now(),
+						"assigned_by": loggedUserID,
+					}).Error; err != nil {
+					return err
+				}
 			}
 		}
-	}
 
-	// 3. Agregar roles completamente nuevos
-	for _, role := range roles {
-		// Solo si el rol no existe en absoluto (ni activo ni inactivo)
-		if !allExistingRoleIDs[role.ID] {
-			// Creamos un nuevo UserRole
-			newUserRole := entities.UserRole{
-				UserID:     userID,
-				RoleID:     role.ID,
-				AssignedAt: time.Now(),
-				AssignedBy: loggedUserID,
-				IsActive:   true,
-				CreatedAt:  time.Now(),
-			}
-
-			// Insertamos el nuevo registro
-			if err := tx.Create(&newUserRole).Error; err != nil {
-				tx.Rollback()
-				return err
+		// 3. Agregar roles completamente nuevos
+		for _, role := range roles {
+			// Solo si el rol no existe en absoluto (ni activo ni inactivo)
+			if !allExistingRoleIDs[role.ID] {
+				// Creamos un nuevo UserRole
+				newUserRole := entities.UserRole{
+					UserID:     userID,
+					RoleID:     role.ID,
+					AssignedAt: time.Now(),
+					AssignedBy: loggedUserID,
+					IsActive:   true,
+					CreatedAt:  time.Now(),
+				}
+
+				// Insertamos el nuevo registro
+				if err := tx.Create(&newUserRole).Error; err != nil {
+					return err
+				}
 			}
 		}
-	}
 
-	// Confirmamos la transacción
-	return tx.Commit().Error
+		return nil
+	})
 }
 
 func (r *userRepository) UnassignRole(ctx context.Context, userID string, roleID string) error {
-	return r.db.WithContext(ctx).
+	return dbFromContext(ctx, r.db).
 		Model(&entities.UserRole{}).
 		Where("user_id = ? AND role_id = ?", userID, roleID).
 		Update("is_active", false).Error
 }
 
 // ActivateOrDeactivate activa o desactiva un usuario
-func (r *userRepository) ActivateOrDeactivate(ctx context.Context, id string, active bool) error {
-	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+func (r *userRepository) ActivateOrDeactivate(ctx context.Context, id string, active bool, event *entities.SystemEvent) error {
+	return dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
 		if err := tx.Model(&entities.User{}).
 			Where("id = ?", id).
 			Update("is_active", active).Error; err != nil {
 			return err
 		}
 
-		return nil
+		return saveOutboxEvent(tx, event)
 	})
 }
 
 // RemoveRoleFromUser remueve un rol de un usuario
 func (r *userRepository) RemoveRoleFromUser(ctx context.Context, userID string, roleID string) error {
-	return r.db.WithContext(ctx).
+	return dbFromContext(ctx, r.db).
 		Model(&entities.UserRole{}).
 		Where("user_id = ? AND role_id = ?", userID, roleID).
 		Update("is_active", false).Error
@@ -329,7 +331,7 @@ func (r *userRepository) RemoveRoleFromUser(ctx context.Context, userID string,
 // GetAllUsersFromCompany obtiene todos los usuarios de una empresa
 func (r *userRepository) GetAllUsersFromCompany(ctx context.Context, companyID string, params *entities.UserQueryParams) ([]entities.User, int64, error) {
 	var users []entities.User
-	query := r.db.WithContext(ctx).
+	query := dbFromContext(ctx, r.db).
 		Preload("Profile").
 		Preload("Roles", "is_active = ?", true).
 		Preload("Roles.Role").
@@ -381,7 +383,7 @@ func (r *userRepository) GetAllUsersFromCompany(ctx context.Context, companyID s
 // GetUserRoles obtiene todos los roles de un usuario
 func (r *userRepository) GetUserRoles(ctx context.Context, userID string) ([]entities.Role, error) {
 	var roles []entities.Role
-	err := r.db.WithContext(ctx).
+	err := dbFromContext(ctx, r.db).
 		Table("roles").
 		Joins("JOIN user_roles ON user_roles.role_id = roles.id").
 		Where("user_roles.user_id = ? AND user_roles.is_active = ?", userID, true).
@@ -395,7 +397,7 @@ func (r *userRepository) GetUserRoles(ctx context.Context, userID string) ([]ent
 // GetUserPermissions obtiene todos los permisos de un usuario a través de sus roles
 func (r *userRepository) GetUserPermissions(ctx context.Context, userID string) ([]entities.Permission, error) {
 	var permissions []entities.Permission
-	err := r.db.WithContext(ctx).
+	err := dbFromContext(ctx, r.db).
 		Table("permissions").
 		Joins("JOIN role_permissions ON role_permissions.permission_id = permissions.id").
 		Joins("JOIN user_roles ON user_roles.role_id = role_permissions.role_id").
@@ -410,7 +412,7 @@ func (r *userRepository) GetUserPermissions(ctx context.Context, userID string)
 
 // MarkEmailAsVerified marca el email como verificado
 func (r *userRepository) MarkEmailAsVerified(ctx context.Context, userID string) error {
-	return r.db.WithContext(ctx).
+	return dbFromContext(ctx, r.db).
 		Model(&entities.User{}).
 		Where("id = ?", userID).
 		Update("email_verified_at", r.db.NowFunc()).Error
@@ -418,7 +420,7 @@ func (r *userRepository) MarkEmailAsVerified(ctx context.Context, userID string)
 
 // MarkPhoneAsVerified marca el teléfono como verificado
 func (r *userRepository) MarkPhoneAsVerified(ctx context.Context, userID string) error {
-	return r.db.WithContext(ctx).
+	return dbFromContext(ctx, r.db).
 		Model(&entities.User{}).
 		Where("id = ?", userID).
 		Update("phone_verified_at", r.db.NowFunc()).Error
@@ -0,0 +1,216 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	"gorm.io/gorm"
+)
+
+type webhookRepository struct {
+	db *gorm.DB
+}
+
+func NewWebhookRepository(db *gorm.DB) ports.WebhookRepository {
+	return &webhookRepository{
+		db: db,
+	}
+}
+
+func (r *webhookRepository) CreateEndpoint(ctx context.Context, endpoint *entities.WebhookEndpoint) error {
+	return dbFromContext(ctx, r.db).Create(endpoint).Error
+}
+
+func (r *webhookRepository) GetEndpointByID(ctx context.Context, id string) (*entities.WebhookEndpoint, error) {
+	var endpoint entities.WebhookEndpoint
+	err := dbFromContext(ctx, r.db).
+		Where("id = ? AND deleted_at IS NULL", id).
+		First(&endpoint).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &endpoint, nil
+}
+
+func (r *webhookRepository) GetEndpointsByCompany(ctx context.Context, companyID string) ([]entities.WebhookEndpoint, error) {
+	var endpoints []entities.WebhookEndpoint
+	err := dbFromContext(ctx, r.db).
+		Where("company_id = ? AND deleted_at IS NULL", companyID).
+		Order("created_at ASC").
+		Find(&endpoints).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return endpoints, nil
+}
+
+func (r *webhookRepository) GetActiveEndpointsByCompany(ctx context.Context, companyID string) ([]entities.WebhookEndpoint, error) {
+	var endpoints []entities.WebhookEndpoint
+	err := dbFromContext(ctx, r.db).
+		Where("company_id = ? AND is_active = ? AND deleted_at IS NULL", companyID, true).
+		Find(&endpoints).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return endpoints, nil
+}
+
+func (r *webhookRepository) UpdateEndpoint(ctx context.Context, endpoint *entities.WebhookEndpoint) error {
+	return dbFromContext(ctx, r.db).Omit("Company").Save(endpoint).Error
+}
+
+// DeleteEndpoint elimina lógicamente el webhook y lo desactiva para que no reciba más eventos
+func (r *webhookRepository) DeleteEndpoint(ctx context.Context, id string, deletedAt time.Time) error {
+	return dbFromContext(ctx, r.db).
+		Model(&entities.WebhookEndpoint{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"is_active":  false,
+			"deleted_at": deletedAt,
+			"updated_at": deletedAt,
+		}).Error
+}
+
+// PurgeDeletedEndpoints elimina definitivamente los webhooks eliminados lógicamente antes de la fecha y su registro de entregas
+func (r *webhookRepository) PurgeDeletedEndpoints(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	var purged int64
+	err := dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		endpoints := tx.Model(&entities.WebhookEndpoint{}).
+			Select("id").
+			Where("deleted_at IS NOT NULL AND deleted_at < ?", deletedBefore)
+
+		if err := tx.Where("endpoint_id IN (?)", endpoints).Delete(&entities.WebhookDelivery{}).Error; err != nil {
+			return err
+		}
+
+		result := tx.Where("deleted_at IS NOT NULL AND deleted_at < ?", deletedBefore).Delete(&entities.WebhookEndpoint{})
+		purged = result.RowsAffected
+		return result.Error
+	})
+
+	return purged, err
+}
+
+func (r *webhookRepository) CreateDeliveries(ctx context.Context, deliveries []entities.WebhookDelivery) error {
+	return dbFromContext(ctx, r.db).Omit("Endpoint").Create(&deliveries).Error
+}
+
+func (r *webhookRepository) GetDeliveryByID(ctx context.Context, id string) (*entities.WebhookDelivery, error) {
+	var delivery entities.WebhookDelivery
+	err := dbFromContext(ctx, r.db).
+		Preload("Endpoint").
+		Where("id = ?", id).
+		First(&delivery).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &delivery, nil
+}
+
+// GetDeliveriesByEndpoint obtiene el registro de entregas del webhook, las más recientes primero
+func (r *webhookRepository) GetDeliveriesByEndpoint(ctx context.Context, endpointID string, params *entities.PaginationQueryParams) ([]entities.WebhookDelivery, int64, error) {
+	var deliveries []entities.WebhookDelivery
+	var total int64
+
+	query := dbFromContext(ctx, r.db).Model(&entities.WebhookDelivery{}).Where("endpoint_id = ?", endpointID)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if params != nil && params.Page > 0 && params.PageSize > 0 {
+		query = query.Offset((params.Page - 1) * params.PageSize).Limit(params.PageSize)
+	}
+
+	err := query.Order("created_at DESC").Find(&deliveries).Error
+	return deliveries, total, err
+}
+
+// GetDueDeliveries obtiene las entregas pendientes cuyo siguiente intento ya corresponde, las más antiguas primero
+func (r *webhookRepository) GetDueDeliveries(ctx context.Context, now time.Time, limit int) ([]entities.WebhookDelivery, error) {
+	var deliveries []entities.WebhookDelivery
+	err := dbFromContext(ctx, r.db).
+		Preload("Endpoint").
+		Where("status = ? AND next_attempt_at <= ?", constants.WebhookDeliveryStatusPending, now).
+		Order("next_attempt_at ASC").
+		Limit(limit).
+		Find(&deliveries).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+// ClaimDelivery reserva la entrega moviendo su siguiente intento al fin de la reserva, solo un proceso puede reservarla
+func (r *webhookRepository) ClaimDelivery(ctx context.Context, id string, now, leaseUntil time.Time) (bool, error) {
+	result := dbFromContext(ctx, r.db).
+		Model(&entities.WebhookDelivery{}).
+		Where("id = ? AND status = ? AND next_attempt_at <= ?", id, constants.WebhookDeliveryStatusPending, now).
+		Update("next_attempt_at", leaseUntil)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+// SaveDeliveryAttempt guarda el resultado del intento y, si se indica, actualiza en la base de datos el conteo de fallos
+// consecutivos del webhook, desactivándolo al llegar a disableAfter; devuelve si este intento lo desactivó
+func (r *webhookRepository) SaveDeliveryAttempt(ctx context.Context, delivery *entities.WebhookDelivery, trackFailures bool, disableAfter int) (bool, error) {
+	disabled := false
+	err := dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		// 1. Guardar el resultado del intento
+		if err := tx.Omit("Endpoint").Save(delivery).Error; err != nil {
+			return err
+		}
+
+		if !trackFailures {
+			return nil
+		}
+
+		// 2. Un intento exitoso reinicia los fallos consecutivos
+		endpoint := tx.Model(&entities.WebhookEndpoint{}).Where("id = ?", delivery.EndpointID)
+		if delivery.Status == constants.WebhookDeliveryStatusSucceeded {
+			return endpoint.Updates(map[string]interface{}{
+				"consecutive_failures": 0,
+				"updated_at":           delivery.UpdatedAt,
+			}).Error
+		}
+
+		// 3. Sumar el fallo en la base de datos para no perder los de entregas concurrentes
+		if err := endpoint.Updates(map[string]interface{}{
+			"consecutive_failures": gorm.Expr("consecutive_failures + 1"),
+			"updated_at":           delivery.UpdatedAt,
+		}).Error; err != nil {
+			return err
+		}
+
+		// 4. Desactivar el webhook una sola vez al alcanzar el límite de fallos
+		result := tx.Model(&entities.WebhookEndpoint{}).
+			Where("id = ? AND is_active = ? AND consecutive_failures >= ?", delivery.EndpointID, true, disableAfter).
+			Updates(map[string]interface{}{
+				"is_active":       false,
+				"disabled_at":     delivery.UpdatedAt,
+				"disabled_reason": fmt.Sprintf("Desactivado tras %d intentos de entrega fallidos consecutivos", disableAfter),
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		disabled = result.RowsAffected > 0
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return disabled, nil
+}
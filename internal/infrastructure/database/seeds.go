@@ -0,0 +1,348 @@
+package database
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+const (
+	seedAdminUserID       = "a1b2c3d4-e5f6-7890-a1b2-c3d4e5f6g7h8"
+	seedExpressCompanyID  = "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"
+	seedRapidCompanyID    = "b1ffc99-8d0a-4be8-aa6c-7aa8ce481a22"
+	seedNorthZoneID       = "f8c3e8d7-b6a5-4d3c-9f1e-0a2b4c6d8e0f"
+	seedCenterZoneID      = "e7d6c5b4-a3f2-4e1d-8c9b-7a6b5c4d3e2f"
+	seedSouthZoneID       = "d6e5f4c3-b2a1-4d0e-9f8c-7b6a5d4c3e2f"
+	seedNorthBranchID     = "b5f8c3d1-2e59-4c4b-a6e8-e5f3c0c3d1b5"
+	seedDevPasswordHash   = "$2a$10$2o6x9aCZsWM8oRHy/ZJqLuNmDYFzZAbfzUPBLc4pRJrto2VbmlIAq"
+	seedEmpresaUserHash   = "$2a$10$tg2Nk6phZ8/CRVm9RyMTueUKMF0EK7RB2mjpNjoC6Ld4vL8hbMyyW"
+	seedCompanyUserRoleID = "991dfbd6-f89b-11ef-a120-0242ac120003"
+)
+
+// seedRecord registro de los datos iniciales, keys son las columnas que lo identifican para no duplicarlo
+type seedRecord struct {
+	model  schema.Tabler
+	keys   []string
+	values map[string]interface{}
+}
+
+// seedUser usuario de desarrollo con su perfil y su rol
+type seedUser struct {
+	id                    string
+	email                 string
+	passwordHash          string
+	fullName              string
+	phone                 string
+	verified              bool
+	documentType          string
+	documentNumber        string
+	birthDate             string
+	emergencyContactName  string
+	emergencyContactPhone string
+	roleID                string
+}
+
+// RunSeeds inserta los roles y los datos de desarrollo (empresas, usuarios, zonas y sucursales) que antes se
+// cargaban a mano con scripts/database_script.sql. Los registros existentes se omiten, por lo que se puede
+// ejecutar varias veces. Retorna la cantidad de registros insertados
+func RunSeeds(db *gorm.DB) (int, error) {
+	inserted := 0
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		for _, record := range seedRecords(time.Now()) {
+			created, err := record.insert(tx)
+			if err != nil {
+				logs.Error("Error al insertar datos iniciales", map[string]interface{}{
+					"tabla": record.model.TableName(),
+					"error": err.Error(),
+				})
+				return err
+			}
+			if created {
+				inserted++
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	logs.Info(fmt.Sprintf("Datos iniciales cargados, %d registros nuevos", inserted))
+	return inserted, nil
+}
+
+// insert crea el registro si no existe otro con las mismas columnas de identificación
+func (r seedRecord) insert(tx *gorm.DB) (bool, error) {
+	query := tx.Model(r.model)
+	for _, key := range r.keys {
+		query = query.Where(key+" = ?", r.values[key])
+	}
+
+	var count int64
+	if err := query.Count(&count).Error; err != nil {
+		return false, err
+	}
+	if count > 0 {
+		return false, nil
+	}
+
+	return true, tx.Model(r.model).Create(r.values).Error
+}
+
+// seedRecords obtiene los registros en el orden en que se deben insertar por sus llaves foráneas
+func seedRecords(now time.Time) []seedRecord {
+	var records []seedRecord
+
+	// 1. Roles del sistema
+	roles := []struct{ id, name, description string }{
+		{"991c53a8-f89b-11ef-a120-0242ac120003", "ADMIN", "Administrador del sistema"},
+		{seedCompanyUserRoleID, "COMPANY_USER", "Usuario de empresa cliente"},
+		{"991e016f-f89b-11ef-a120-0242ac120003", "DRIVER", "Repartidor"},
+		{"991e01c7-f89b-11ef-a120-0242ac120003", "WAREHOUSE_STAFF", "Personal de almacén"},
+		{"991e01ed-f89b-11ef-a120-0242ac120003", "COLLECTOR", "Recolector de paquetes"},
+		{"991a01ed-f89b-11ef-a120-0242ac120003", "FINAL_USER", "Usuario final de la aplicacion"},
+	}
+	for _, role := range roles {
+		records = append(records, seedRecord{
+			model: &entities.Role{},
+			keys:  []string{"id"},
+			values: map[string]interface{}{
+				"id":          role.id,
+				"name":        role.name,
+				"description": role.description,
+				"is_active":   true,
+				"created_at":  now,
+				"updated_at":  now,
+			},
+		})
+	}
+
+	// 2. Empresas, se insertan antes que los usuarios que las referencian
+	companies := []struct {
+		id, name, legalName, taxID, email, phone string
+		deliveryRate                             float64
+	}{
+		{seedExpressCompanyID, "Express Delivery Co.", "Express Delivery S.A.S", "900123456-7", "contacto@expressdelivery.com", "+573001234567", 20.50},
+		{seedRapidCompanyID, "Rapid Logistics Inc.", "Rapid Logistics International Inc.", "900654321-8", "contacto@rapidlogistics.com", "+573009876543", 25.75},
+	}
+	for _, company := range companies {
+		records = append(records, seedRecord{
+			model: &entities.Company{},
+			keys:  []string{"id"},
+			values: map[string]interface{}{
+				"id":                  company.id,
+				"name":                company.name,
+				"legal_name":          company.legalName,
+				"tax_id":              company.taxID,
+				"contact_email":       company.email,
+				"contact_phone":       company.phone,
+				"is_active":           true,
+				"delivery_rate":       company.deliveryRate,
+				"contract_start_date": now,
+				"created_at":          now,
+				"updated_at":          now,
+			},
+		})
+	}
+
+	// 3. Usuarios de desarrollo, uno por rol más un usuario adicional de empresa
+	users := []seedUser{
+		{seedAdminUserID, "admin@delivery.com", seedDevPasswordHash, "Admin System", "+1234567890", true, "DNI", "12345678", "1990-01-01", "", "", "991c53a8-f89b-11ef-a120-0242ac120003"},
+		{"b2c3d4e5-f6g7-8901-b2c3-d4e5f6g7h8i9", "company@delivery.com", seedDevPasswordHash, "Company User", "+1234567891", true, "DNI", "23456789", "1991-02-02", "", "", seedCompanyUserRoleID},
+		{"c3d4e5f6-g7h8-9012-c3d4-e5f6g7h8i9j0", "driver@delivery.com", seedDevPasswordHash, "Driver User", "+1234567892", true, "DNI", "34567890", "1992-03-03", "", "", "991e016f-f89b-11ef-a120-0242ac120003"},
+		{"d4e5f6g7-h8i9-0123-d4e5-f6g7h8i9j0k1", "warehouse@delivery.com", seedDevPasswordHash, "Warehouse Staff", "+1234567893", true, "DNI", "45678901", "1993-04-04", "", "", "991e01c7-f89b-11ef-a120-0242ac120003"},
+		{"e5f6g7h8-i9j0-1234-e5f6-g7h8i9j0k1l2", "collector@delivery.com", seedDevPasswordHash, "Collector User", "+1234567894", true, "DNI", "56789012", "1994-05-05", "", "", "991e01ed-f89b-11ef-a120-0242ac120003"},
+		{"b2c3d4e5-f6a7-8b9c-0d1e-2f3a4b5c6d7e", "empresauser@empresa.com", seedEmpresaUserHash, "Usuario de empresa", "21212828", false, "DUI", "21212828", "2003-11-25", "Ejemplo de contacto", "21212828", seedCompanyUserRoleID},
+	}
+	for _, user := range users {
+		records = append(records, user.records(now)...)
+	}
+
+	// 4. Zonas de entrega con su cobertura
+	zones := []struct {
+		id, name, code, boundaries, center, coverage, operatingHours string
+		baseRate                                                     float64
+		maxDeliveryTime, priority, maxConcurrentOrders               int
+		surgeMultiplier                                              float64
+	}{
+		{seedNorthZoneID, "Zona Norte", "ZNORTE",
+			"POLYGON((-74.03 4.70, -74.02 4.70, -74.02 4.72, -74.03 4.72, -74.03 4.70))", "POINT(-74.025 4.71)",
+			"POLYGON((-74.04 4.69, -74.01 4.69, -74.01 4.73, -74.04 4.73, -74.04 4.69))",
+			`{"weekdays":{"start":"08:00","end":"20:00"},"weekends":{"start":"09:00","end":"17:00"}}`,
+			25.00, 60, 1, 15, 1.5},
+		{seedCenterZoneID, "Zona Centro", "ZCENTRO",
+			"POLYGON((-74.08 4.60, -74.06 4.60, -74.06 4.62, -74.08 4.62, -74.08 4.60))", "POINT(-74.07 4.61)",
+			"POLYGON((-74.09 4.59, -74.05 4.59, -74.05 4.63, -74.09 4.63, -74.09 4.59))",
+			`{"weekdays":{"start":"07:00","end":"22:00"},"weekends":{"start":"08:00","end":"20:00"}}`,
+			30.00, 45, 2, 25, 1.8},
+		{seedSouthZoneID, "Zona Sur", "ZSUR",
+			"POLYGON((-74.12 4.50, -74.10 4.50, -74.10 4.52, -74.12 4.52, -74.12 4.50))", "POINT(-74.11 4.51)",
+			"POLYGON((-74.13 4.49, -74.09 4.49, -74.09 4.53, -74.13 4.53, -74.13 4.49))",
+			`{"weekdays":{"start":"08:00","end":"21:00"},"weekends":{"start":"09:00","end":"18:00"}}`,
+			35.00, 75, 1, 20, 1.3},
+	}
+	for _, zone := range zones {
+		records = append(records,
+			seedRecord{
+				model: &entities.Zone{},
+				keys:  []string{"id"},
+				values: map[string]interface{}{
+					"id":                zone.id,
+					"name":              zone.name,
+					"code":              zone.code,
+					"boundaries":        gorm.Expr("ST_GeomFromText(?)", zone.boundaries),
+					"center_point":      gorm.Expr("ST_GeomFromText(?)", zone.center),
+					"base_rate":         zone.baseRate,
+					"max_delivery_time": zone.maxDeliveryTime,
+					"is_active":         true,
+					"priority_level":    zone.priority,
+					"created_at":        now,
+					"updated_at":        now,
+				},
+			},
+			seedRecord{
+				model: &entities.Coverage{},
+				keys:  []string{"zone_id"},
+				values: map[string]interface{}{
+					"zone_id":               zone.id,
+					"coverage_area":         gorm.Expr("ST_GeomFromText(?)", zone.coverage),
+					"operating_hours":       zone.operatingHours,
+					"max_concurrent_orders": zone.maxConcurrentOrders,
+					"surge_multiplier":      zone.surgeMultiplier,
+				},
+			},
+		)
+	}
+
+	// 5. Direcciones de las empresas
+	addresses := []struct {
+		id, companyID, line1, line2, postalCode, location string
+		isMain                                            bool
+	}{
+		{"e1b09d38-e71f-415f-b3eb-ffeb8dd3b493", seedExpressCompanyID, "Calle 100 #15-20", "Edificio Centro Empresarial", "110121", "POINT(-74.05 4.68)", true},
+		{"f2c18d47-f81f-416f-c4fc-00fc9ee4c594", seedExpressCompanyID, "Calle 80 #20-30", "Torre Norte", "110111", "POINT(-74.07 4.67)", false},
+		{"03d29d56-091f-417f-d5fd-11fd0ff5d605", seedRapidCompanyID, "Carrera 15 #93-60", "Piso 3", "110221", "POINT(-74.04 4.66)", true},
+	}
+	for _, address := range addresses {
+		records = append(records, seedRecord{
+			model: &entities.CompanyAddress{},
+			keys:  []string{"id"},
+			values: map[string]interface{}{
+				"id":            address.id,
+				"company_id":    address.companyID,
+				"address_line1": address.line1,
+				"address_line2": address.line2,
+				"city":          "Bogotá",
+				"state":         "Cundinamarca",
+				"postal_code":   address.postalCode,
+				"location":      gorm.Expr("ST_GeomFromText(?)", address.location),
+				"is_main":       address.isMain,
+				"created_at":    now,
+			},
+		})
+	}
+
+	// 6. Sucursales asignadas a las zonas
+	branches := []struct{ id, companyID, name, code, contactName, contactPhone, contactEmail, zoneID string }{
+		{seedNorthBranchID, seedExpressCompanyID, "Sucursal Norte", "SUC-NORTE-001", "Gerente Norte", "+573001112233", "norte@expressdelivery.com", seedNorthZoneID},
+		{"c6f9d4e2-3f6a-5d7c-b9f0-f6f4d3c2b1a6", seedExpressCompanyID, "Sucursal Centro", "SUC-CENTRO-001", "Gerente Centro", "+573004445566", "centro@expressdelivery.com", seedCenterZoneID},
+		{"d7a0e5f3-4a7b-6e8d-ca01-a7a5e4d3c2b1", seedRapidCompanyID, "Sucursal Principal", "SUC-PPAL-001", "Gerente Principal", "+573007778899", "principal@rapidlogistics.com", seedSouthZoneID},
+	}
+	for _, branch := range branches {
+		records = append(records, seedRecord{
+			model: &entities.Branch{},
+			keys:  []string{"id"},
+			values: map[string]interface{}{
+				"id":            branch.id,
+				"company_id":    branch.companyID,
+				"name":          branch.name,
+				"code":          branch.code,
+				"contact_name":  branch.contactName,
+				"contact_phone": branch.contactPhone,
+				"contact_email": branch.contactEmail,
+				"is_active":     true,
+				"zone_id":       branch.zoneID,
+				"created_at":    now,
+				"updated_at":    now,
+			},
+		})
+	}
+
+	// 7. Usuarios de empresa asignados a la sucursal norte
+	companyUsers := []struct{ userID, position string }{
+		{"b2c3d4e5-f6a7-8b9c-0d1e-2f3a4b5c6d7e", "Gerente de Operaciones"},
+		{seedAdminUserID, "Jefe de empresa"},
+	}
+	for _, companyUser := range companyUsers {
+		records = append(records, seedRecord{
+			model: &entities.CompanyUser{},
+			keys:  []string{"user_id", "company_id", "branch_id"},
+			values: map[string]interface{}{
+				"user_id":           companyUser.userID,
+				"company_id":        seedExpressCompanyID,
+				"branch_id":         seedNorthBranchID,
+				"position":          companyUser.position,
+				"department":        "Operaciones",
+				"can_create_orders": true,
+				"created_at":        now,
+				"updated_at":        now,
+			},
+		})
+	}
+
+	return records
+}
+
+// records obtiene el usuario, su perfil y la asignación de su rol, los usuarios pertenecen a Express Delivery
+func (u seedUser) records(now time.Time) []seedRecord {
+	user := map[string]interface{}{
+		"id":            u.id,
+		"company_id":    seedExpressCompanyID,
+		"email":         u.email,
+		"password_hash": u.passwordHash,
+		"full_name":     u.fullName,
+		"phone":         u.phone,
+		"is_active":     true,
+		"created_at":    now,
+		"updated_at":    now,
+	}
+	if u.verified {
+		user["email_verified_at"] = now
+	}
+
+	profile := map[string]interface{}{
+		"user_id":         u.id,
+		"document_type":   u.documentType,
+		"document_number": u.documentNumber,
+		"birth_date":      u.birthDate,
+		"created_at":      now,
+		"updated_at":      now,
+	}
+	if u.emergencyContactName != "" {
+		profile["emergency_contact_name"] = u.emergencyContactName
+		profile["emergency_contact_phone"] = u.emergencyContactPhone
+	}
+
+	return []seedRecord{
+		{model: &entities.User{}, keys: []string{"id"}, values: user},
+		{model: &entities.Profile{}, keys: []string{"user_id"}, values: profile},
+		{
+			model: &entities.UserRole{},
+			keys:  []string{"user_id", "role_id"},
+			values: map[string]interface{}{
+				"user_id":     u.id,
+				"role_id":     u.roleID,
+				"assigned_at": now,
+				"assigned_by": seedAdminUserID,
+				"is_active":   true,
+				"created_at":  now,
+			},
+		},
+	}
+}
@@ -36,4 +36,28 @@ var (
 	ErrAuthorizationHeaderNotFound = errors.New("authorization header not found, please provide a valid token")
 	ErrInvalidAuthorizationFormat  = errors.New("invalid authorization format, the format should be 'Bearer <token>'")
 	ErrTokenExpiredOrTampered      = errors.New("token is expired or has been tampered with, please provide a valid token")
+
+	ErrInvalidIdempotencyKey       = errors.New("the Idempotency-Key header must have between 1 and 255 characters")
+	ErrIdempotencyKeyReused        = errors.New("the Idempotency-Key was already used with a different request")
+	ErrIdempotencyRequestInProcess = errors.New("a request with the same Idempotency-Key is still being processed")
+	ErrFailedIdempotencyStore      = errors.New("failed to access the idempotency store")
+
+	ErrFailedToRenderLabel   = errors.New("failed to render the shipping label")
+	ErrFailedToRenderInvoice = errors.New("failed to render the invoice")
+	ErrFailedToRenderPayout  = errors.New("failed to render the payout statement")
+
+	ErrWebhookTargetNotAllowed = errors.New("the webhook address resolves to a private, loopback or link-local IP")
+
+	ErrFailedToPublishEvent  = errors.New("failed to publish the event to the event bus")
+	ErrFailedToConsumeEvents = errors.New("failed to consume events from the event bus")
+	ErrUnknownEventBusDriver = errors.New("unknown event bus driver, use memory or redis")
+	ErrMemoryEventBusShared  = errors.New("the memory event bus only works in a single process, use redis when the worker runs apart from the API")
+
+	ErrJobNotFound            = errors.New("job not found")
+	ErrFailedToAcquireJobLock = errors.New("failed to acquire the job lock")
+
+	ErrUnknownCommand          = errors.New("unknown command, run help to see the available commands")
+	ErrInvalidCommandArguments = errors.New("invalid command arguments")
+	ErrNoActiveCompany         = errors.New("there is no active company to assign the user, run seed or pass -company")
+	ErrEmailAlreadyInUse       = errors.New("a user with the same email already exists")
 )
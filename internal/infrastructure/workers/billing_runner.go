@@ -0,0 +1,69 @@
+package workers
+
+import (
+	"context"
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+	"sync"
+	"time"
+)
+
+// BillingRunner genera periódicamente las facturas del ciclo de facturación que aún no existen
+type BillingRunner struct {
+	useCase  ports.InvoiceUseCase
+	locker   ports.JobLocker
+	interval time.Duration
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewBillingRunner(useCase ports.InvoiceUseCase, locker ports.JobLocker, interval time.Duration) *BillingRunner {
+	return &BillingRunner{
+		useCase:  useCase,
+		locker:   locker,
+		interval: interval,
+	}
+}
+
+// Start inicia el ciclo del runner en segundo plano
+func (r *BillingRunner) Start(ctx context.Context) {
+	ctx, r.cancel = context.WithCancel(ctx)
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+
+		logs.Info("Billing runner started", map[string]interface{}{
+			"interval": r.interval.String(),
+		})
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if !acquireTick(ctx, r.locker, "billing", r.interval) {
+					continue
+				}
+
+				if err := r.useCase.RunBillingCycle(ctx); err != nil {
+					logs.Error("Failed to run billing cycle", map[string]interface{}{
+						"error": err.Error(),
+					})
+				}
+			}
+		}
+	}()
+}
+
+// Stop detiene el runner y espera a que termine la facturación en curso
+func (r *BillingRunner) Stop() {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+}
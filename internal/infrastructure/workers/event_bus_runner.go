@@ -0,0 +1,64 @@
+package workers
+
+import (
+	"context"
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+	"sync"
+	"time"
+)
+
+// EventBusRunner procesa periódicamente los mensajes del bus de eventos de cada grupo de consumidores. Cada instancia
+// consume como un consumidor más del grupo, por eso todas procesan en cada ciclo
+type EventBusRunner struct {
+	useCase  ports.EventBusUseCase
+	interval time.Duration
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewEventBusRunner(useCase ports.EventBusUseCase, interval time.Duration) *EventBusRunner {
+	return &EventBusRunner{
+		useCase:  useCase,
+		interval: interval,
+	}
+}
+
+// Start inicia el ciclo del runner en segundo plano
+func (r *EventBusRunner) Start(ctx context.Context) {
+	ctx, r.cancel = context.WithCancel(ctx)
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+
+		logs.Info("Event bus runner started", map[string]interface{}{
+			"interval": r.interval.String(),
+		})
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := r.useCase.ConsumeEvents(ctx); err != nil {
+					logs.Error("Failed to consume event bus messages", map[string]interface{}{
+						"error": err.Error(),
+					})
+				}
+			}
+		}
+	}()
+}
+
+// Stop detiene el runner y espera a que termine el procesamiento en curso
+func (r *EventBusRunner) Stop() {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+}
@@ -0,0 +1,69 @@
+package workers
+
+import (
+	"context"
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+	"sync"
+	"time"
+)
+
+// ImportRunner procesa periódicamente las importaciones masivas pendientes
+type ImportRunner struct {
+	useCase  ports.ImportUseCase
+	locker   ports.JobLocker
+	interval time.Duration
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewImportRunner(useCase ports.ImportUseCase, locker ports.JobLocker, interval time.Duration) *ImportRunner {
+	return &ImportRunner{
+		useCase:  useCase,
+		locker:   locker,
+		interval: interval,
+	}
+}
+
+// Start inicia el ciclo del runner en segundo plano
+func (r *ImportRunner) Start(ctx context.Context) {
+	ctx, r.cancel = context.WithCancel(ctx)
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+
+		logs.Info("Import runner started", map[string]interface{}{
+			"interval": r.interval.String(),
+		})
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if !acquireTick(ctx, r.locker, "import", r.interval) {
+					continue
+				}
+
+				if err := r.useCase.ProcessPendingImports(ctx); err != nil {
+					logs.Error("Failed to process pending imports", map[string]interface{}{
+						"error": err.Error(),
+					})
+				}
+			}
+		}
+	}()
+}
+
+// Stop detiene el runner y espera a que termine la importación en curso
+func (r *ImportRunner) Stop() {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+}
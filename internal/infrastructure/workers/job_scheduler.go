@@ -0,0 +1,79 @@
+package workers
+
+import (
+	"context"
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+	"sync"
+	"time"
+)
+
+// JobScheduler ejecuta cada trabajo registrado según su programación, el bloqueo del caso de uso evita que
+// varias instancias ejecuten la misma programación
+type JobScheduler struct {
+	useCase ports.JobUseCase
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewJobScheduler(useCase ports.JobUseCase) *JobScheduler {
+	return &JobScheduler{
+		useCase: useCase,
+	}
+}
+
+// Start inicia un ciclo en segundo plano por cada trabajo
+func (s *JobScheduler) Start(ctx context.Context) {
+	ctx, s.cancel = context.WithCancel(ctx)
+
+	for _, name := range s.useCase.GetJobNames() {
+		s.wg.Add(1)
+		go s.schedule(ctx, name)
+	}
+
+	logs.Info("Job scheduler started", map[string]interface{}{
+		"jobs": s.useCase.GetJobNames(),
+	})
+}
+
+// Stop detiene el scheduler y espera a que terminen los trabajos en curso
+func (s *JobScheduler) Stop() {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+}
+
+func (s *JobScheduler) schedule(ctx context.Context, name string) {
+	defer s.wg.Done()
+
+	for {
+		// 1. Calcular la siguiente ejecución programada
+		next, err := s.useCase.NextRun(name, time.Now())
+		if err != nil {
+			logs.Error("Failed to get next job run", map[string]interface{}{
+				"job":   name,
+				"error": err.Error(),
+			})
+			return
+		}
+
+		// 2. Esperar hasta la ejecución o hasta que se detenga el scheduler
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		// 3. Ejecutar el trabajo, los reintentos los maneja el caso de uso
+		if err = s.useCase.RunJob(ctx, name, next); err != nil {
+			logs.Error("Failed to run job", map[string]interface{}{
+				"job":   name,
+				"error": err.Error(),
+			})
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package workers
+
+import (
+	"context"
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+	"sync"
+	"time"
+)
+
+// OutboxRunner publica periódicamente los eventos de dominio pendientes del outbox
+type OutboxRunner struct {
+	useCase  ports.OutboxUseCase
+	locker   ports.JobLocker
+	interval time.Duration
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewOutboxRunner(useCase ports.OutboxUseCase, locker ports.JobLocker, interval time.Duration) *OutboxRunner {
+	return &OutboxRunner{
+		useCase:  useCase,
+		locker:   locker,
+		interval: interval,
+	}
+}
+
+// Start inicia el ciclo del runner en segundo plano
+func (r *OutboxRunner) Start(ctx context.Context) {
+	ctx, r.cancel = context.WithCancel(ctx)
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+
+		logs.Info("Outbox runner started", map[string]interface{}{
+			"interval": r.interval.String(),
+		})
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if !acquireTick(ctx, r.locker, "outbox", r.interval) {
+					continue
+				}
+
+				if err := r.useCase.RunOutboxRelay(ctx); err != nil {
+					logs.Error("Failed to run outbox relay", map[string]interface{}{
+						"error": err.Error(),
+					})
+				}
+			}
+		}
+	}()
+}
+
+// Stop detiene el runner y espera a que termine la publicación en curso
+func (r *OutboxRunner) Stop() {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+}
@@ -0,0 +1,69 @@
+package workers
+
+import (
+	"context"
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+	"sync"
+	"time"
+)
+
+// PayoutRunner genera periódicamente los estados de pago semanales de los repartidores que aún no existen
+type PayoutRunner struct {
+	useCase  ports.EarningUseCase
+	locker   ports.JobLocker
+	interval time.Duration
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewPayoutRunner(useCase ports.EarningUseCase, locker ports.JobLocker, interval time.Duration) *PayoutRunner {
+	return &PayoutRunner{
+		useCase:  useCase,
+		locker:   locker,
+		interval: interval,
+	}
+}
+
+// Start inicia el ciclo del runner en segundo plano
+func (r *PayoutRunner) Start(ctx context.Context) {
+	ctx, r.cancel = context.WithCancel(ctx)
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+
+		logs.Info("Payout runner started", map[string]interface{}{
+			"interval": r.interval.String(),
+		})
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if !acquireTick(ctx, r.locker, "payout", r.interval) {
+					continue
+				}
+
+				if err := r.useCase.RunPayoutCycle(ctx); err != nil {
+					logs.Error("Failed to run payout cycle", map[string]interface{}{
+						"error": err.Error(),
+					})
+				}
+			}
+		}
+	}()
+}
+
+// Stop detiene el runner y espera a que termine la liquidación en curso
+func (r *PayoutRunner) Stop() {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+}
@@ -0,0 +1,26 @@
+package workers
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+)
+
+// acquireTick reserva el ciclo actual del runner para que solo una instancia lo ejecute. La llave incluye el inicio
+// del intervalo en curso, así cada ciclo se reserva una vez y la reserva expira al terminar el intervalo
+func acquireTick(ctx context.Context, locker ports.JobLocker, runner string, interval time.Duration) bool {
+	window := time.Now().Truncate(interval)
+	acquired, err := locker.Acquire(ctx, "runner:"+runner+":"+strconv.FormatInt(window.Unix(), 10), interval)
+	if err != nil {
+		logs.Error("Failed to acquire runner lock", map[string]interface{}{
+			"runner": runner,
+			"error":  err.Error(),
+		})
+		return false
+	}
+
+	return acquired
+}
@@ -0,0 +1,69 @@
+package workers
+
+import (
+	"context"
+	"github.com/MarlonG1/delivery-backend/internal/application/ports"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+	"sync"
+	"time"
+)
+
+// ScheduleRunner revisa periódicamente las programaciones pendientes y crea sus pedidos
+type ScheduleRunner struct {
+	useCase  ports.ScheduleUseCase
+	locker   ports.JobLocker
+	interval time.Duration
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewScheduleRunner(useCase ports.ScheduleUseCase, locker ports.JobLocker, interval time.Duration) *ScheduleRunner {
+	return &ScheduleRunner{
+		useCase:  useCase,
+		locker:   locker,
+		interval: interval,
+	}
+}
+
+// Start inicia el ciclo del runner en segundo plano
+func (r *ScheduleRunner) Start(ctx context.Context) {
+	ctx, r.cancel = context.WithCancel(ctx)
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+
+		logs.Info("Schedule runner started", map[string]interface{}{
+			"interval": r.interval.String(),
+		})
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if !acquireTick(ctx, r.locker, "schedule", r.interval) {
+					continue
+				}
+
+				if err := r.useCase.RunDueSchedules(ctx); err != nil {
+					logs.Error("Failed to run due schedules", map[string]interface{}{
+						"error": err.Error(),
+					})
+				}
+			}
+		}
+	}()
+}
+
+// Stop detiene el runner y espera a que termine la ejecución en curso
+func (r *ScheduleRunner) Stop() {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+}
@@ -3,9 +3,11 @@ package request_mapper
 import (
 	"encoding/json"
 	"fmt"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
 	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
 	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
 	"github.com/google/uuid"
+	"strings"
 	"time"
 )
 
@@ -16,20 +18,22 @@ func CompanyRequestToCompany(req *dto.CompanyCreateRequest) (*entities.Company,
 
 	// Crear objeto base de la empresa
 	company := &entities.Company{
-		ID:                companyID,
-		Name:              req.Name,
-		LegalName:         req.LegalName,
-		TaxID:             req.TaxID,
-		ContactEmail:      req.ContactEmail,
-		ContactPhone:      req.ContactPhone,
-		Website:           req.Website,
-		IsActive:          true,
-		DeliveryRate:      req.DeliveryRate,
-		LogoURL:           req.LogoURL,
-		ContractStartDate: req.ContractStartDate,
-		ContractEndDate:   req.ContractEndDate,
-		CreatedAt:         now,
-		UpdatedAt:         now,
+		ID:                  companyID,
+		Name:                req.Name,
+		LegalName:           req.LegalName,
+		TaxID:               req.TaxID,
+		ContactEmail:        req.ContactEmail,
+		ContactPhone:        req.ContactPhone,
+		Website:             req.Website,
+		IsActive:            true,
+		DeliveryRate:        req.DeliveryRate,
+		MaxDeliveryAttempts: req.MaxDeliveryAttempts,
+		TrackingPrefix:      strings.ToUpper(strings.TrimSpace(req.TrackingPrefix)),
+		LogoURL:             req.LogoURL,
+		ContractStartDate:   req.ContractStartDate,
+		ContractEndDate:     req.ContractEndDate,
+		CreatedAt:           now,
+		UpdatedAt:           now,
 	}
 
 	// Procesar los detalles del contrato a formato JSON
@@ -39,6 +43,16 @@ func CompanyRequestToCompany(req *dto.CompanyCreateRequest) (*entities.Company,
 	}
 	company.ContractDetails = string(contractDetails)
 
+	// Usar el máximo de intentos de entrega por defecto si no se especifica
+	if company.MaxDeliveryAttempts == 0 {
+		company.MaxDeliveryAttempts = constants.DefaultMaxDeliveryAttempts
+	}
+
+	// Usar el prefijo de seguimiento por defecto si no se especifica
+	if company.TrackingPrefix == "" {
+		company.TrackingPrefix = constants.TrackingPrefixOrder
+	}
+
 	// Crear la dirección principal de la empresa
 	mainAddress := &entities.CompanyAddress{
 		ID:           uuid.NewString(),
@@ -94,6 +108,14 @@ func CompanyUpdateRequestToCompany(req *dto.CompanyUpdateRequest) (*entities.Com
 		company.DeliveryRate = *req.DeliveryRate
 	}
 
+	if req.MaxDeliveryAttempts != nil {
+		company.MaxDeliveryAttempts = *req.MaxDeliveryAttempts
+	}
+
+	if req.TrackingPrefix != "" {
+		company.TrackingPrefix = strings.ToUpper(strings.TrimSpace(req.TrackingPrefix))
+	}
+
 	if req.LogoURL != "" {
 		company.LogoURL = req.LogoURL
 	}
@@ -0,0 +1,40 @@
+package request_mapper
+
+import (
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+	"github.com/google/uuid"
+)
+
+// EarningRuleRequestToEarningRule convierte un DTO de regla de ganancias a una entidad de dominio
+func EarningRuleRequestToEarningRule(req *dto.EarningRuleRequest) *entities.EarningRule {
+	rule := &entities.EarningRule{
+		ID:             uuid.NewString(),
+		ZoneID:         req.ZoneID,
+		ZoneMultiplier: constants.DefaultZoneMultiplier,
+		IsActive:       true,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+	ApplyEarningRuleRequest(rule, req)
+
+	return rule
+}
+
+// ApplyEarningRuleRequest aplica las tarifas del DTO a una regla existente, la zona no se modifica
+func ApplyEarningRuleRequest(rule *entities.EarningRule, req *dto.EarningRuleRequest) {
+	rule.BasePerDelivery = req.BasePerDelivery
+	rule.PerKmRate = req.PerKmRate
+	rule.UrgentBonus = req.UrgentBonus
+
+	if req.ZoneMultiplier != nil {
+		rule.ZoneMultiplier = *req.ZoneMultiplier
+	}
+
+	if req.IsActive != nil {
+		rule.IsActive = *req.IsActive
+	}
+}
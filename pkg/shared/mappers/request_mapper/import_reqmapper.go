@@ -0,0 +1,248 @@
+package request_mapper
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// importRequiredColumns columnas que debe incluir un archivo CSV de importación
+var importRequiredColumns = []string{
+	"company_pickup_id", "client_id", "price", "distance", "pickup_time", "delivery_deadline",
+	"recipient_name", "recipient_phone", "address_line1", "city", "state",
+}
+
+// ImportFileToRows convierte el contenido de un archivo CSV o JSON en filas de pedidos,
+// los errores de una fila se guardan en la propia fila para incluirlos en el reporte
+func ImportFileToRows(format string, content []byte) ([]dto.OrderImportRow, error) {
+	// Excel agrega un BOM al inicio de los archivos UTF-8
+	content = bytes.TrimPrefix(content, []byte("\xef\xbb\xbf"))
+
+	switch format {
+	case constants.ImportFormatCSV:
+		return csvToImportRows(content)
+	case constants.ImportFormatJSON:
+		return jsonToImportRows(content)
+	default:
+		return nil, errPackage.ErrInvalidImportFormat
+	}
+}
+
+// ImportRowsToImportJob crea el trabajo de importación guardando las filas normalizadas
+func ImportRowsToImportJob(format string, rows []dto.OrderImportRow) (*entities.ImportJob, error) {
+	payload, err := json.Marshal(rows)
+	if err != nil {
+		return nil, errPackage.ErrFailedToParseJSON
+	}
+
+	return &entities.ImportJob{
+		Format:    format,
+		Payload:   string(payload),
+		TotalRows: len(rows),
+	}, nil
+}
+
+// ImportJobToRows recupera las filas guardadas en un trabajo de importación
+func ImportJobToRows(job *entities.ImportJob) ([]dto.OrderImportRow, error) {
+	var rows []dto.OrderImportRow
+	if err := json.Unmarshal([]byte(job.Payload), &rows); err != nil {
+		return nil, errPackage.ErrFailedToUnparseJSON
+	}
+
+	return rows, nil
+}
+
+func jsonToImportRows(content []byte) ([]dto.OrderImportRow, error) {
+	var items []json.RawMessage
+	if err := json.Unmarshal(content, &items); err != nil {
+		return nil, errPackage.ErrInvalidImportFile
+	}
+
+	rows := make([]dto.OrderImportRow, len(items))
+	for i, item := range items {
+		rows[i].Row = i + 1
+
+		var order dto.OrderCreateRequest
+		if err := json.Unmarshal(item, &order); err != nil {
+			rows[i].Error = err.Error()
+			continue
+		}
+		rows[i].Order = &order
+	}
+
+	return rows, nil
+}
+
+func csvToImportRows(content []byte) ([]dto.OrderImportRow, error) {
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	// 1. Leer la cabecera y ubicar cada columna
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errPackage.ErrEmptyImport
+		}
+		return nil, errPackage.ErrInvalidImportFile
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	var missing []string
+	for _, name := range importRequiredColumns {
+		if _, ok := columns[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("%w: %s", errPackage.ErrMissingImportColumns, strings.Join(missing, ", "))
+	}
+
+	// 2. Convertir cada registro en una fila de pedido
+	var rows []dto.OrderImportRow
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, errPackage.ErrInvalidImportFile
+		}
+
+		row := dto.OrderImportRow{Row: len(rows) + 1}
+		if len(record) != len(header) {
+			row.Error = fmt.Sprintf("expected %d columns, got %d", len(header), len(record))
+		} else if row.Order, err = csvRecordToOrderRequest(record, columns); err != nil {
+			row.Error = err.Error()
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// csvRecordToOrderRequest convierte un registro CSV plano en la solicitud de creación de pedido
+func csvRecordToOrderRequest(record []string, columns map[string]int) (*dto.OrderCreateRequest, error) {
+	p := csvRecord{record: record, columns: columns}
+
+	order := &dto.OrderCreateRequest{
+		CompanyPickUpID:     p.str("company_pickup_id"),
+		ClientID:            p.str("client_id"),
+		Price:               p.float("price"),
+		Distance:            p.float("distance"),
+		PickupTime:          p.time("pickup_time"),
+		DeliveryDeadline:    p.time("delivery_deadline"),
+		RequiresSignature:   p.bool("requires_signature"),
+		RequiresDeliveryPIN: p.bool("requires_delivery_pin"),
+		DeliveryNotes:       p.str("delivery_notes"),
+		PickupContactName:   p.str("pickup_contact_name"),
+		PickupContactPhone:  p.str("pickup_contact_phone"),
+		PickupNotes:         p.str("pickup_notes"),
+		PackageDetails: dto.PackageDetailRequest{
+			IsFragile:           p.bool("is_fragile"),
+			IsUrgent:            p.bool("is_urgent"),
+			Weight:              p.float("weight"),
+			SpecialInstructions: p.str("special_instructions"),
+			Length:              p.float("length"),
+			Width:               p.float("width"),
+			Height:              p.float("height"),
+		},
+		DeliveryAddress: dto.DeliveryAddressRequest{
+			RecipientName:  p.str("recipient_name"),
+			RecipientPhone: p.str("recipient_phone"),
+			AddressLine1:   p.str("address_line1"),
+			AddressLine2:   p.str("address_line2"),
+			City:           p.str("city"),
+			State:          p.str("state"),
+			PostalCode:     p.str("postal_code"),
+			AddressNotes:   p.str("address_notes"),
+		},
+	}
+
+	// Las columnas de cobro contra entrega son opcionales
+	if codAmount := p.float("cod_amount"); codAmount > 0 {
+		order.CashOnDelivery = &dto.CashOnDeliveryRequest{
+			Amount:   codAmount,
+			Currency: p.str("cod_currency"),
+		}
+	}
+
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	return order, nil
+}
+
+// csvRecord lee columnas de un registro CSV guardando el primer error de conversión
+type csvRecord struct {
+	record  []string
+	columns map[string]int
+	err     error
+}
+
+func (p *csvRecord) str(column string) string {
+	i, ok := p.columns[column]
+	if !ok {
+		return ""
+	}
+
+	return strings.TrimSpace(p.record[i])
+}
+
+func (p *csvRecord) float(column string) float64 {
+	value := p.str(column)
+	if value == "" {
+		return 0
+	}
+
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil && p.err == nil {
+		p.err = fmt.Errorf("invalid number in column %s: %s", column, value)
+	}
+
+	return f
+}
+
+func (p *csvRecord) bool(column string) bool {
+	value := p.str(column)
+	if value == "" {
+		return false
+	}
+
+	b, err := strconv.ParseBool(value)
+	if err != nil && p.err == nil {
+		p.err = fmt.Errorf("invalid boolean in column %s: %s", column, value)
+	}
+
+	return b
+}
+
+func (p *csvRecord) time(column string) time.Time {
+	value := p.str(column)
+	if value == "" {
+		return time.Time{}
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil && p.err == nil {
+		p.err = fmt.Errorf("invalid date in column %s, expected RFC3339: %s", column, value)
+	}
+
+	return t
+}
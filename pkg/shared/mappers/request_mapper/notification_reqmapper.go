@@ -0,0 +1,48 @@
+package request_mapper
+
+import (
+	"strings"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+)
+
+// CompanyNotificationSettingsRequestToEntities mapea la configuración de eventos solicitada a sus entidades
+func CompanyNotificationSettingsRequestToEntities(companyID string, req *dto.UpdateCompanyNotificationSettingsRequest) []entities.CompanyNotificationSetting {
+	settings := make([]entities.CompanyNotificationSetting, len(req.Settings))
+	for i, setting := range req.Settings {
+		settings[i] = entities.CompanyNotificationSetting{
+			CompanyID:       companyID,
+			Event:           strings.ToUpper(strings.TrimSpace(setting.Event)),
+			NotifyRecipient: setting.NotifyRecipient,
+			NotifyCompany:   setting.NotifyCompany,
+		}
+	}
+
+	return settings
+}
+
+// NotificationPreferencesRequestToEntities mapea las preferencias solicitadas a sus entidades
+func NotificationPreferencesRequestToEntities(userID string, req *dto.UpdateNotificationPreferencesRequest) []entities.NotificationPreference {
+	preferences := make([]entities.NotificationPreference, len(req.Preferences))
+	for i, preference := range req.Preferences {
+		preferences[i] = entities.NotificationPreference{
+			UserID:           userID,
+			NotificationType: strings.ToUpper(strings.TrimSpace(preference.NotificationType)),
+			EmailEnabled:     preference.EmailEnabled,
+			SMSEnabled:       preference.SMSEnabled,
+			PushEnabled:      preference.PushEnabled,
+		}
+	}
+
+	return preferences
+}
+
+// RegisterNotificationDeviceRequestToEntity mapea el dispositivo solicitado a su entidad
+func RegisterNotificationDeviceRequestToEntity(userID string, req *dto.RegisterNotificationDeviceRequest) *entities.NotificationDevice {
+	return &entities.NotificationDevice{
+		UserID:      userID,
+		DeviceToken: req.DeviceToken,
+		DeviceType:  req.DeviceType,
+	}
+}
@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
 	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/value_objects"
 	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
 	"github.com/google/uuid"
+	"strings"
 	"time"
 )
 
@@ -24,15 +26,35 @@ func OrderRequestToOrder(req *dto.OrderCreateRequest, companyAddress *entities.C
 
 	// Crear detalles del pedido (información esencial)
 	order.Detail = &entities.Details{
-		OrderID:           orderID,
-		Price:             req.Price,
-		Distance:          req.Distance,
-		PickupTime:        req.PickupTime,
-		DeliveryDeadline:  req.DeliveryDeadline,
-		RequiresSignature: req.RequiresSignature,
-		DeliveryNotes:     req.DeliveryNotes,
-		CreatedAt:         time.Now(),
-		UpdatedAt:         time.Now(),
+		OrderID:             orderID,
+		Price:               req.Price,
+		Distance:            req.Distance,
+		PickupTime:          req.PickupTime,
+		DeliveryDeadline:    req.DeliveryDeadline,
+		RequiresSignature:   req.RequiresSignature,
+		RequiresDeliveryPIN: req.RequiresDeliveryPIN,
+		DeliveryNotes:       req.DeliveryNotes,
+		CreatedAt:           time.Now(),
+		UpdatedAt:           time.Now(),
+	}
+
+	// Monto a cobrar contra entrega
+	if req.CashOnDelivery != nil {
+		amount := value_objects.NewMoneyAmount(req.CashOnDelivery.Amount, strings.ToUpper(req.CashOnDelivery.Currency))
+		order.Detail.CODAmount = amount.Amount()
+		order.Detail.CODCurrency = amount.Currency()
+	}
+
+	// Pago anticipado del precio de entrega, se autoriza con el proveedor antes de crear el pedido
+	if req.Payment != nil {
+		order.Payment = &entities.OrderPayment{
+			ID:       uuid.NewString(),
+			OrderID:  orderID,
+			Status:   constants.PaymentStatusPending,
+			Amount:   value_objects.NewMoneyAmount(req.Price, "").Amount(),
+			Currency: strings.ToUpper(req.Payment.Currency),
+			Token:    req.Payment.Token,
+		}
 	}
 
 	var err error
@@ -41,6 +63,12 @@ func OrderRequestToOrder(req *dto.OrderCreateRequest, companyAddress *entities.C
 		return nil, fmt.Errorf("error creating package details: %w", err)
 	}
 
+	// Bultos del envío, el número de seguimiento de cada uno se asigna al crear el pedido
+	order.Parcels, err = createParcels(req, order.PackageDetail)
+	if err != nil {
+		return nil, fmt.Errorf("error creating parcels: %w", err)
+	}
+
 	// Datos de dirección de entrega
 	order.DeliveryAddress = &entities.DeliveryAddress{
 		OrderID:        orderID,
@@ -84,23 +112,9 @@ func OrderRequestToOrder(req *dto.OrderCreateRequest, companyAddress *entities.C
 
 // En el mapper que procesa el DTO
 func createPackageDetail(req dto.PackageDetailRequest, orderID string) (*entities.PackageDetail, error) {
-	dimensionsJSON := ""
-	if req.Length > 0 || req.Width > 0 || req.Height > 0 {
-		dimensions := map[string]interface{}{
-			"length": req.Length,
-			"width":  req.Width,
-			"height": req.Height,
-			"unit":   "cm",
-		}
-
-		// Serializar a JSON
-		dimensionsBytes, err := json.Marshal(dimensions)
-		if err != nil {
-			return nil, fmt.Errorf("error serializing dimensions: %w", err)
-		}
-		dimensionsJSON = string(dimensionsBytes)
-	} else {
-		dimensionsJSON = "{}"
+	dimensionsJSON, err := dimensionsToJSON(req.Length, req.Width, req.Height)
+	if err != nil {
+		return nil, err
 	}
 
 	return &entities.PackageDetail{
@@ -114,6 +128,78 @@ func createPackageDetail(req dto.PackageDetailRequest, orderID string) (*entitie
 	}, nil
 }
 
+// createParcels crea los bultos del pedido, si la solicitud no los incluye el pedido tiene un único bulto
+// con los datos del paquete; si los incluye el paquete resume el peso total y si alguno es frágil
+func createParcels(req *dto.OrderCreateRequest, packageDetail *entities.PackageDetail) ([]entities.Parcel, error) {
+	now := time.Now()
+
+	if len(req.Parcels) == 0 {
+		return []entities.Parcel{{
+			ID:         uuid.NewString(),
+			OrderID:    packageDetail.OrderID,
+			Sequence:   1,
+			Status:     constants.OrderStatusPending,
+			IsFragile:  packageDetail.IsFragile,
+			Weight:     packageDetail.Weight,
+			Dimensions: packageDetail.Dimensions,
+			CreatedAt:  now,
+			UpdatedAt:  now,
+		}}, nil
+	}
+
+	parcels := make([]entities.Parcel, len(req.Parcels))
+	totalWeight := 0.0
+	for i, parcelReq := range req.Parcels {
+		dimensionsJSON, err := dimensionsToJSON(parcelReq.Length, parcelReq.Width, parcelReq.Height)
+		if err != nil {
+			return nil, err
+		}
+
+		parcels[i] = entities.Parcel{
+			ID:          uuid.NewString(),
+			OrderID:     packageDetail.OrderID,
+			Sequence:    i + 1,
+			Status:      constants.OrderStatusPending,
+			IsFragile:   parcelReq.IsFragile,
+			Weight:      parcelReq.Weight,
+			Dimensions:  dimensionsJSON,
+			Description: parcelReq.Description,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		}
+
+		totalWeight += parcelReq.Weight
+		packageDetail.IsFragile = packageDetail.IsFragile || parcelReq.IsFragile
+	}
+
+	if totalWeight > 0 {
+		packageDetail.Weight = totalWeight
+	}
+
+	return parcels, nil
+}
+
+func dimensionsToJSON(length, width, height float64) (string, error) {
+	if length <= 0 && width <= 0 && height <= 0 {
+		return "{}", nil
+	}
+
+	dimensions := map[string]interface{}{
+		"length": length,
+		"width":  width,
+		"height": height,
+		"unit":   "cm",
+	}
+
+	// Serializar a JSON
+	dimensionsBytes, err := json.Marshal(dimensions)
+	if err != nil {
+		return "", fmt.Errorf("error serializing dimensions: %w", err)
+	}
+
+	return string(dimensionsBytes), nil
+}
+
 func UpdateOrderFromRequest(orderID string, req *dto.OrderUpdateRequest) (*entities.Order, error) {
 	// Crear una nueva orden vacía para actualización parcial
 	order := &entities.Order{
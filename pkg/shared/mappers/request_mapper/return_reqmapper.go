@@ -0,0 +1,20 @@
+package request_mapper
+
+import (
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+	"strings"
+)
+
+// ReturnRequestToReturn convierte un DTO de creación de devolución a una entidad de dominio
+func ReturnRequestToReturn(orderID, requestedByID, initiatedBy string, req *dto.ReturnCreateRequest) *entities.OrderReturn {
+	return &entities.OrderReturn{
+		OrderID:         orderID,
+		RequestedByID:   requestedByID,
+		InitiatedBy:     initiatedBy,
+		ReasonCode:      strings.ToUpper(req.ReasonCode),
+		Notes:           req.Notes,
+		DestinationType: strings.ToUpper(req.DestinationType),
+		DestinationID:   req.DestinationID,
+	}
+}
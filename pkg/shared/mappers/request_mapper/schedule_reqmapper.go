@@ -0,0 +1,51 @@
+package request_mapper
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ScheduleRequestToSchedule convierte un DTO de programación a una entidad de dominio
+func ScheduleRequestToSchedule(req *dto.ScheduleRequest) (*entities.OrderSchedule, error) {
+	// Las fechas del pedido se calculan en cada ejecución
+	template := req.Order
+	template.PickupTime = time.Time{}
+	template.DeliveryDeadline = time.Time{}
+
+	orderTemplate, err := json.Marshal(template)
+	if err != nil {
+		return nil, fmt.Errorf("error serializing order template: %w", err)
+	}
+
+	days := make([]string, len(req.DaysOfWeek))
+	for i, day := range req.DaysOfWeek {
+		days[i] = strconv.Itoa(day)
+	}
+
+	return &entities.OrderSchedule{
+		Name:                  req.Name,
+		OrderTemplate:         string(orderTemplate),
+		RunAt:                 req.RunAt,
+		DaysOfWeek:            strings.Join(days, ","),
+		TimeOfDay:             req.TimeOfDay,
+		DeliveryWindowMinutes: req.DeliveryWindowMinutes,
+	}, nil
+}
+
+// ScheduleToOrderRequest reconstruye la solicitud de pedido de una programación para una ejecución concreta
+func ScheduleToOrderRequest(schedule *entities.OrderSchedule, pickupTime time.Time) (*dto.OrderCreateRequest, error) {
+	var req dto.OrderCreateRequest
+	if err := json.Unmarshal([]byte(schedule.OrderTemplate), &req); err != nil {
+		return nil, fmt.Errorf("error deserializing order template: %w", err)
+	}
+
+	req.PickupTime = pickupTime
+	req.DeliveryDeadline = pickupTime.Add(time.Duration(schedule.DeliveryWindowMinutes) * time.Minute)
+
+	return &req, nil
+}
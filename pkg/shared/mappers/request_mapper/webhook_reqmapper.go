@@ -0,0 +1,35 @@
+package request_mapper
+
+import (
+	"strings"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+)
+
+// CreateWebhookRequestToEntity mapea el webhook solicitado a su entidad
+func CreateWebhookRequestToEntity(companyID, userID string, req *dto.CreateWebhookRequest) *entities.WebhookEndpoint {
+	return &entities.WebhookEndpoint{
+		CompanyID:   companyID,
+		URL:         req.URL,
+		Events:      strings.Join(req.Events, ","),
+		Description: req.Description,
+		CreatedBy:   userID,
+	}
+}
+
+// ApplyWebhookUpdateRequest aplica al webhook los campos incluidos en la solicitud
+func ApplyWebhookUpdateRequest(endpoint *entities.WebhookEndpoint, req *dto.UpdateWebhookRequest) {
+	if req.URL != nil {
+		endpoint.URL = *req.URL
+	}
+	if req.Events != nil {
+		endpoint.Events = strings.Join(req.Events, ",")
+	}
+	if req.Description != nil {
+		endpoint.Description = *req.Description
+	}
+	if req.IsActive != nil {
+		endpoint.IsActive = *req.IsActive
+	}
+}
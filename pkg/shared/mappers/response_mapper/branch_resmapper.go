@@ -90,6 +90,7 @@ func BranchToMetricsDTO(metrics *entities.BranchMetrics) *dto.BranchMetricsRespo
 		CancelledOrders:     metrics.CancelledOrders,
 		DeliverySuccessRate: metrics.DeliverySuccessRate,
 		AverageDeliveryTime: metrics.AverageDeliveryTime,
+		OnTimeDeliveryRate:  metrics.OnTimeDeliveryRate,
 		TotalRevenue:        metrics.TotalRevenue,
 		ActiveDrivers:       metrics.ActiveDrivers,
 		UniqueCustomers:     metrics.UniqueCustomers,
@@ -0,0 +1,99 @@
+package response_mapper
+
+import (
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+)
+
+// CashLedgerEntriesToResponseDTO mapea los movimientos del libro de efectivo a sus DTOs de respuesta
+func CashLedgerEntriesToResponseDTO(entries []entities.CashLedgerEntry) []dto.CashLedgerEntryResponse {
+	response := make([]dto.CashLedgerEntryResponse, len(entries))
+	for i, entry := range entries {
+		response[i] = dto.CashLedgerEntryResponse{
+			ID:               entry.ID,
+			Type:             entry.Type,
+			Amount:           entry.Amount,
+			Currency:         entry.Currency,
+			OrderID:          entry.OrderID,
+			ReconciliationID: entry.ReconciliationID,
+			CreatedAt:        entry.CreatedAt,
+		}
+
+		if entry.Order != nil {
+			response[i].TrackingNumber = entry.Order.TrackingNumber
+		}
+	}
+
+	return response
+}
+
+// CashLedgerToResponseDTO mapea el libro de efectivo de un repartidor y sus saldos pendientes
+func CashLedgerToResponseDTO(driverID string, entries []entities.CashLedgerEntry, balances []entities.CashBalance) *dto.CashLedgerResponse {
+	response := &dto.CashLedgerResponse{
+		DriverID: driverID,
+		Balances: make([]dto.CashBalanceResponse, len(balances)),
+		Entries:  CashLedgerEntriesToResponseDTO(entries),
+	}
+
+	for i, balance := range balances {
+		response.Balances[i] = dto.CashBalanceResponse{
+			Currency: balance.Currency,
+			Amount:   balance.Amount,
+		}
+	}
+
+	return response
+}
+
+// CashReconciliationToResponseDTO mapea una conciliación de efectivo a su DTO de respuesta
+func CashReconciliationToResponseDTO(reconciliation *entities.CashReconciliation) *dto.CashReconciliationResponse {
+	return &dto.CashReconciliationResponse{
+		ID:             reconciliation.ID,
+		DriverID:       reconciliation.DriverID,
+		Currency:       reconciliation.Currency,
+		ExpectedAmount: reconciliation.ExpectedAmount,
+		HandedInAmount: reconciliation.HandedInAmount,
+		Difference:     reconciliation.Difference,
+		Status:         reconciliation.Status,
+		Collections:    reconciliation.Collections,
+		ReconciledByID: reconciliation.ReconciledByID,
+		Notes:          reconciliation.Notes,
+		CreatedAt:      reconciliation.CreatedAt,
+	}
+}
+
+// CashReconciliationsToResponseDTO mapea una lista de conciliaciones a sus DTOs de respuesta
+func CashReconciliationsToResponseDTO(reconciliations []entities.CashReconciliation) []dto.CashReconciliationResponse {
+	response := make([]dto.CashReconciliationResponse, len(reconciliations))
+	for i := range reconciliations {
+		response[i] = *CashReconciliationToResponseDTO(&reconciliations[i])
+	}
+
+	return response
+}
+
+// CODSettlementReportToResponseDTO mapea el reporte de liquidación contra entrega de una empresa
+func CODSettlementReportToResponseDTO(companyID string, start, end time.Time, settlements []entities.CODSettlement) *dto.CODSettlementReportResponse {
+	response := &dto.CODSettlementReportResponse{
+		CompanyID:   companyID,
+		StartDate:   start,
+		EndDate:     end,
+		Settlements: make([]dto.CODSettlementResponse, len(settlements)),
+	}
+
+	for i, settlement := range settlements {
+		response.Settlements[i] = dto.CODSettlementResponse{
+			CompanyID:      settlement.CompanyID,
+			Currency:       settlement.Currency,
+			Orders:         settlement.Orders,
+			OwedAmount:     settlement.CollectedAmount,
+			HandedInAmount: settlement.HandedInAmount,
+			PendingAmount:  settlement.PendingAmount,
+			Collections:    CashLedgerEntriesToResponseDTO(settlement.Collections),
+		}
+	}
+
+	return response
+}
@@ -8,21 +8,23 @@ import (
 // CompanyToResponseDTO mapea una entidad de compañía a su DTO de respuesta
 func CompanyToResponseDTO(company *entities.Company, includeDetails bool) *dto.CompanyResponse {
 	response := &dto.CompanyResponse{
-		ID:                company.ID,
-		Name:              company.Name,
-		LegalName:         company.LegalName,
-		TaxID:             company.TaxID,
-		ContactEmail:      company.ContactEmail,
-		ContactPhone:      company.ContactPhone,
-		Website:           company.Website,
-		IsActive:          company.IsActive,
-		ContractDetails:   company.ContractDetails,
-		DeliveryRate:      company.DeliveryRate,
-		LogoURL:           company.LogoURL,
-		ContractStartDate: company.ContractStartDate,
-		ContractEndDate:   company.ContractEndDate,
-		CreatedAt:         company.CreatedAt,
-		UpdatedAt:         company.UpdatedAt,
+		ID:                  company.ID,
+		Name:                company.Name,
+		LegalName:           company.LegalName,
+		TaxID:               company.TaxID,
+		ContactEmail:        company.ContactEmail,
+		ContactPhone:        company.ContactPhone,
+		Website:             company.Website,
+		IsActive:            company.IsActive,
+		ContractDetails:     company.ContractDetails,
+		DeliveryRate:        company.DeliveryRate,
+		MaxDeliveryAttempts: company.MaxDeliveryAttempts,
+		TrackingPrefix:      company.TrackingPrefix,
+		LogoURL:             company.LogoURL,
+		ContractStartDate:   company.ContractStartDate,
+		ContractEndDate:     company.ContractEndDate,
+		CreatedAt:           company.CreatedAt,
+		UpdatedAt:           company.UpdatedAt,
 	}
 
 	// Incluir direcciones si existen y si se solicitan detalles
@@ -106,6 +108,7 @@ func CompanyToMetricsDTO(metrics *entities.CompanyMetrics) *dto.CompanyMetricsRe
 		CancelledOrders:     metrics.CancelledOrders,
 		DeliverySuccessRate: metrics.DeliverySuccessRate,
 		AverageDeliveryTime: metrics.AverageDeliveryTime,
+		OnTimeDeliveryRate:  metrics.OnTimeDeliveryRate,
 		TotalRevenue:        metrics.TotalRevenue,
 		ActiveBranches:      metrics.ActiveBranches,
 		UniqueCustomers:     metrics.UniqueCustomers,
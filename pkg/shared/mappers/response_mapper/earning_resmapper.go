@@ -0,0 +1,134 @@
+package response_mapper
+
+import (
+	"math"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+)
+
+// EarningRulesToResponseDTO mapea las reglas de ganancias a su DTO de respuesta
+func EarningRulesToResponseDTO(rules []entities.EarningRule) []dto.EarningRuleResponse {
+	response := make([]dto.EarningRuleResponse, len(rules))
+	for i := range rules {
+		response[i] = *EarningRuleToResponseDTO(&rules[i])
+	}
+
+	return response
+}
+
+// EarningRuleToResponseDTO mapea una regla de ganancias a su DTO de respuesta
+func EarningRuleToResponseDTO(rule *entities.EarningRule) *dto.EarningRuleResponse {
+	response := &dto.EarningRuleResponse{
+		ID:              rule.ID,
+		ZoneID:          rule.ZoneID,
+		BasePerDelivery: rule.BasePerDelivery,
+		PerKmRate:       rule.PerKmRate,
+		UrgentBonus:     rule.UrgentBonus,
+		ZoneMultiplier:  rule.ZoneMultiplier,
+		IsActive:        rule.IsActive,
+		UpdatedAt:       rule.UpdatedAt,
+	}
+
+	if rule.Zone != nil {
+		response.ZoneName = rule.Zone.Name
+	}
+
+	return response
+}
+
+// DriverEarningToResponseDTO mapea un movimiento del libro de ganancias a su DTO de respuesta
+func DriverEarningToResponseDTO(earning *entities.DriverEarning) dto.DriverEarningResponse {
+	response := dto.DriverEarningResponse{
+		ID:             earning.ID,
+		Type:           earning.Type,
+		OrderID:        earning.OrderID,
+		BaseAmount:     earning.BaseAmount,
+		Distance:       earning.Distance,
+		DistanceAmount: earning.DistanceAmount,
+		UrgentBonus:    earning.UrgentBonus,
+		Amount:         earning.Amount,
+		Currency:       earning.Currency,
+		Reason:         earning.Reason,
+		StatementID:    earning.StatementID,
+		CreatedAt:      earning.CreatedAt,
+	}
+
+	if earning.Type == constants.EarningTypeDelivery {
+		response.ZoneMultiplier = earning.ZoneMultiplier
+	}
+
+	if earning.Order != nil {
+		response.TrackingNumber = earning.Order.TrackingNumber
+	}
+
+	return response
+}
+
+// DriverEarningsToResponseDTO mapea el libro de ganancias de un repartidor con sus totales del periodo
+func DriverEarningsToResponseDTO(driverID string, earnings []entities.DriverEarning) *dto.DriverEarningsResponse {
+	response := &dto.DriverEarningsResponse{
+		DriverID: driverID,
+		Currency: constants.EarningsCurrency,
+		Entries:  make([]dto.DriverEarningResponse, len(earnings)),
+	}
+
+	for i := range earnings {
+		response.Entries[i] = DriverEarningToResponseDTO(&earnings[i])
+
+		switch earnings[i].Type {
+		case constants.EarningTypeDelivery:
+			response.DeliveryCount++
+			response.DeliveryTotal += earnings[i].Amount
+		case constants.EarningTypeAdjustment:
+			response.AdjustmentTotal += earnings[i].Amount
+		}
+	}
+
+	response.DeliveryTotal = math.Round(response.DeliveryTotal*100) / 100
+	response.AdjustmentTotal = math.Round(response.AdjustmentTotal*100) / 100
+	response.Total = math.Round((response.DeliveryTotal+response.AdjustmentTotal)*100) / 100
+
+	return response
+}
+
+// PayoutStatementToResponseDTO mapea un estado de pago y sus movimientos a su DTO de respuesta
+func PayoutStatementToResponseDTO(statement *entities.PayoutStatement) *dto.PayoutStatementResponse {
+	response := &dto.PayoutStatementResponse{
+		ID:              statement.ID,
+		StatementNumber: statement.StatementNumber,
+		DriverID:        statement.DriverID,
+		PeriodStart:     statement.PeriodStart,
+		PeriodEnd:       statement.PeriodEnd,
+		DeliveryCount:   statement.DeliveryCount,
+		DeliveryTotal:   statement.DeliveryTotal,
+		AdjustmentTotal: statement.AdjustmentTotal,
+		Total:           statement.Total,
+		Currency:        statement.Currency,
+		CreatedAt:       statement.CreatedAt,
+	}
+
+	if statement.Driver != nil && statement.Driver.User != nil {
+		response.DriverName = statement.Driver.User.FullName
+	}
+
+	if len(statement.Entries) > 0 {
+		response.Entries = make([]dto.DriverEarningResponse, len(statement.Entries))
+		for i := range statement.Entries {
+			response.Entries[i] = DriverEarningToResponseDTO(&statement.Entries[i])
+		}
+	}
+
+	return response
+}
+
+// PayoutStatementsToResponseDTO mapea los estados de pago sin sus movimientos
+func PayoutStatementsToResponseDTO(statements []entities.PayoutStatement) []dto.PayoutStatementResponse {
+	response := make([]dto.PayoutStatementResponse, len(statements))
+	for i := range statements {
+		response[i] = *PayoutStatementToResponseDTO(&statements[i])
+	}
+
+	return response
+}
@@ -0,0 +1,74 @@
+package response_mapper
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+	"strconv"
+)
+
+// ImportJobToResponseDTO mapea un trabajo de importación a su DTO de respuesta
+func ImportJobToResponseDTO(job *entities.ImportJob) *dto.ImportJobResponse {
+	return &dto.ImportJobResponse{
+		ID:           job.ID,
+		Format:       job.Format,
+		Status:       job.Status,
+		TotalRows:    job.TotalRows,
+		CreatedRows:  job.CreatedRows,
+		FailedRows:   job.FailedRows,
+		ErrorMessage: job.ErrorMessage,
+		StartedAt:    job.StartedAt,
+		FinishedAt:   job.FinishedAt,
+		CreatedAt:    job.CreatedAt,
+	}
+}
+
+// ImportJobsToResponseDTO mapea una lista de trabajos de importación a sus DTOs de respuesta
+func ImportJobsToResponseDTO(jobs []entities.ImportJob) []dto.ImportJobResponse {
+	response := make([]dto.ImportJobResponse, len(jobs))
+	for i := range jobs {
+		response[i] = *ImportJobToResponseDTO(&jobs[i])
+	}
+
+	return response
+}
+
+// ImportReportToResponseDTO obtiene el resultado por fila guardado en el trabajo de importación
+func ImportReportToResponseDTO(job *entities.ImportJob) ([]dto.OrderImportRowResult, error) {
+	results := make([]dto.OrderImportRowResult, 0)
+	if job.Report == "" {
+		return results, nil
+	}
+
+	if err := json.Unmarshal([]byte(job.Report), &results); err != nil {
+		return nil, errPackage.ErrFailedToUnparseJSON
+	}
+
+	return results, nil
+}
+
+// ImportReportToCSV genera el reporte de importación en formato CSV para su descarga
+func ImportReportToCSV(results []dto.OrderImportRowResult) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+
+	records := [][]string{{"row", "status", "order_id", "tracking_number", "error"}}
+	for _, result := range results {
+		records = append(records, []string{
+			strconv.Itoa(result.Row),
+			result.Status,
+			result.OrderID,
+			result.TrackingNumber,
+			result.Error,
+		})
+	}
+
+	if err := writer.WriteAll(records); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
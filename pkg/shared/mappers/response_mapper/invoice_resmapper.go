@@ -0,0 +1,67 @@
+package response_mapper
+
+import (
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+)
+
+// InvoiceToResponseDTO mapea una factura y sus líneas a su DTO de respuesta
+func InvoiceToResponseDTO(invoice *entities.Invoice) *dto.InvoiceResponse {
+	response := &dto.InvoiceResponse{
+		ID:             invoice.ID,
+		InvoiceNumber:  invoice.InvoiceNumber,
+		CompanyID:      invoice.CompanyID,
+		Status:         invoice.Status,
+		Currency:       invoice.Currency,
+		PeriodStart:    invoice.PeriodStart,
+		PeriodEnd:      invoice.PeriodEnd,
+		Subtotal:       invoice.Subtotal,
+		SurchargeTotal: invoice.SurchargeTotal,
+		CreditTotal:    invoice.CreditTotal,
+		TaxRate:        invoice.TaxRate,
+		TaxAmount:      invoice.TaxAmount,
+		Total:          invoice.Total,
+		PaymentTerms:   invoice.PaymentTerms,
+		IssuedAt:       invoice.IssuedAt,
+		DueDate:        invoice.DueDate,
+		PaidAt:         invoice.PaidAt,
+		VoidedAt:       invoice.VoidedAt,
+		VoidReason:     invoice.VoidReason,
+		CreatedAt:      invoice.CreatedAt,
+	}
+
+	if invoice.Company != nil {
+		response.CompanyName = invoice.Company.LegalName
+	}
+
+	if len(invoice.Lines) > 0 {
+		response.Lines = make([]dto.InvoiceLineResponse, len(invoice.Lines))
+		for i, line := range invoice.Lines {
+			response.Lines[i] = dto.InvoiceLineResponse{
+				LineNumber:  line.LineNumber,
+				Type:        line.Type,
+				Description: line.Description,
+				OrderID:     line.OrderID,
+				Quantity:    line.Quantity,
+				UnitPrice:   line.UnitPrice,
+				Amount:      line.Amount,
+			}
+
+			if line.Order != nil {
+				response.Lines[i].TrackingNumber = line.Order.TrackingNumber
+			}
+		}
+	}
+
+	return response
+}
+
+// InvoicesToResponseDTO mapea una lista de facturas a sus DTOs de respuesta
+func InvoicesToResponseDTO(invoices []entities.Invoice) []dto.InvoiceResponse {
+	response := make([]dto.InvoiceResponse, len(invoices))
+	for i := range invoices {
+		response[i] = *InvoiceToResponseDTO(&invoices[i])
+	}
+
+	return response
+}
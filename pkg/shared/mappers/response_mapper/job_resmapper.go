@@ -0,0 +1,33 @@
+package response_mapper
+
+import (
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+)
+
+// MapJobRunsToResponse mapea el historial de ejecuciones de los trabajos a DTOs de respuesta
+func MapJobRunsToResponse(runs []entities.JobRun, params *entities.PaginationQueryParams, total int64) *dto.PaginatedResponse {
+	response := make([]dto.JobRunResponse, len(runs))
+	for i, run := range runs {
+		response[i] = dto.JobRunResponse{
+			ID:          run.ID,
+			JobName:     run.JobName,
+			Status:      run.Status,
+			Attempt:     run.Attempt,
+			Instance:    run.Instance,
+			ScheduledAt: run.ScheduledAt,
+			StartedAt:   run.StartedAt,
+			FinishedAt:  run.FinishedAt,
+			Result:      run.Result,
+			Error:       run.Error,
+		}
+	}
+
+	return &dto.PaginatedResponse{
+		Data:       response,
+		TotalItems: total,
+		Page:       params.Page,
+		PageSize:   params.PageSize,
+		TotalPages: calculateTotalPages(total, params.PageSize),
+	}
+}
@@ -0,0 +1,124 @@
+package response_mapper
+
+import (
+	"encoding/json"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+)
+
+// CompanyNotificationSettingsToResponseDTO mapea la configuración de eventos de una empresa a su DTO de respuesta
+func CompanyNotificationSettingsToResponseDTO(companyID string, settings []entities.CompanyNotificationSetting) *dto.CompanyNotificationSettingsResponse {
+	response := &dto.CompanyNotificationSettingsResponse{
+		CompanyID: companyID,
+		Settings:  make([]dto.CompanyNotificationSettingResponse, len(settings)),
+	}
+
+	for i, setting := range settings {
+		response.Settings[i] = dto.CompanyNotificationSettingResponse{
+			Event:           setting.Event,
+			NotifyRecipient: setting.NotifyRecipient,
+			NotifyCompany:   setting.NotifyCompany,
+		}
+	}
+
+	return response
+}
+
+// NotificationToResponseDTO mapea una notificación de la bandeja a su DTO de respuesta
+func NotificationToResponseDTO(notification *entities.Notification) *dto.NotificationResponse {
+	response := &dto.NotificationResponse{
+		ID:        notification.ID,
+		Type:      notification.Type,
+		Title:     notification.Title,
+		Content:   notification.Content,
+		IsRead:    notification.IsRead,
+		ReadAt:    notification.ReadAt,
+		CreatedAt: notification.CreatedAt,
+	}
+
+	if notification.Metadata != "" && json.Valid([]byte(notification.Metadata)) {
+		response.Metadata = json.RawMessage(notification.Metadata)
+	}
+
+	return response
+}
+
+// MapNotificationsToResponse mapea la bandeja del usuario a DTOs de respuesta
+func MapNotificationsToResponse(notifications []entities.Notification, params *entities.NotificationQueryParams, total int64) *dto.PaginatedResponse {
+	response := make([]dto.NotificationResponse, len(notifications))
+	for i := range notifications {
+		response[i] = *NotificationToResponseDTO(&notifications[i])
+	}
+
+	return &dto.PaginatedResponse{
+		Data:       response,
+		TotalItems: total,
+		Page:       params.Page,
+		PageSize:   params.PageSize,
+		TotalPages: calculateTotalPages(total, params.PageSize),
+	}
+}
+
+// InboxEventToResponseDTO mapea un evento de la bandeja al DTO enviado por el stream
+func InboxEventToResponseDTO(event *entities.InboxEvent) *dto.InboxEventResponse {
+	response := &dto.InboxEventResponse{
+		Type:        event.Type,
+		UnreadCount: event.UnreadCount,
+	}
+
+	if event.Notification != nil {
+		response.Notification = NotificationToResponseDTO(event.Notification)
+	}
+
+	return response
+}
+
+// NotificationPreferencesToResponseDTO mapea las preferencias del usuario a su DTO de respuesta
+func NotificationPreferencesToResponseDTO(preferences []entities.NotificationPreference) *dto.NotificationPreferencesResponse {
+	response := &dto.NotificationPreferencesResponse{
+		Preferences: make([]dto.NotificationPreferenceResponse, len(preferences)),
+	}
+
+	for i, preference := range preferences {
+		response.Preferences[i] = dto.NotificationPreferenceResponse{
+			NotificationType: preference.NotificationType,
+			EmailEnabled:     preference.EmailEnabled,
+			SMSEnabled:       preference.SMSEnabled,
+			PushEnabled:      preference.PushEnabled,
+		}
+	}
+
+	return response
+}
+
+// NotificationDeviceToResponseDTO mapea un dispositivo a su DTO de respuesta con el token enmascarado
+func NotificationDeviceToResponseDTO(device *entities.NotificationDevice) *dto.NotificationDeviceResponse {
+	return &dto.NotificationDeviceResponse{
+		ID:          device.ID,
+		DeviceType:  device.DeviceType,
+		DeviceToken: maskDeviceToken(device.DeviceToken),
+		IsActive:    device.IsActive,
+		LastUsedAt:  device.LastUsedAt,
+		CreatedAt:   device.CreatedAt,
+		UpdatedAt:   device.UpdatedAt,
+	}
+}
+
+// NotificationDevicesToResponseDTO mapea los dispositivos del usuario a sus DTOs de respuesta
+func NotificationDevicesToResponseDTO(devices []entities.NotificationDevice) []dto.NotificationDeviceResponse {
+	response := make([]dto.NotificationDeviceResponse, len(devices))
+	for i := range devices {
+		response[i] = *NotificationDeviceToResponseDTO(&devices[i])
+	}
+
+	return response
+}
+
+func maskDeviceToken(token string) string {
+	if len(token) <= 8 {
+		return "****"
+	}
+
+	return token[:4] + "****" + token[len(token)-4:]
+}
@@ -17,6 +17,8 @@ func OrderToResponseDTO(order *entities.Order) *dto.OrderResponse {
 		DriverID:       order.DriverID,
 		TrackingNumber: order.TrackingNumber,
 		Status:         order.Status,
+		IsFlagged:      order.IsFlagged,
+		FlagReason:     order.FlagReason,
 		CreatedAt:      order.CreatedAt,
 		UpdatedAt:      order.UpdatedAt,
 	}
@@ -37,13 +39,16 @@ func OrderToResponseDTO(order *entities.Order) *dto.OrderResponse {
 	// Mapear detalles esenciales del pedido
 	if order.Detail != nil {
 		response.Detail = dto.OrderDetailResponse{
-			Price:             order.Detail.Price,
-			Distance:          order.Detail.Distance,
-			PickupTime:        order.Detail.PickupTime,
-			DeliveryDeadline:  order.Detail.DeliveryDeadline,
-			DeliveredAt:       order.Detail.DeliveredAt,
-			RequiresSignature: order.Detail.RequiresSignature,
-			DeliveryNotes:     order.Detail.DeliveryNotes,
+			Price:               order.Detail.Price,
+			Distance:            order.Detail.Distance,
+			PickupTime:          order.Detail.PickupTime,
+			DeliveryDeadline:    order.Detail.DeliveryDeadline,
+			DeliveredAt:         order.Detail.DeliveredAt,
+			RequiresSignature:   order.Detail.RequiresSignature,
+			RequiresDeliveryPIN: order.Detail.RequiresDeliveryPIN,
+			DeliveryNotes:       order.Detail.DeliveryNotes,
+			CODAmount:           order.Detail.CODAmount,
+			CODCurrency:         order.Detail.CODCurrency,
 		}
 	}
 
@@ -57,6 +62,14 @@ func OrderToResponseDTO(order *entities.Order) *dto.OrderResponse {
 		}
 	}
 
+	if order.Parcels != nil {
+		response.Parcels = ParcelsToResponseDTO(order.Parcels)
+	}
+
+	if order.Payment != nil {
+		response.Payment = OrderPaymentToResponseDTO(order.Payment)
+	}
+
 	if order.StatusHistory != nil {
 		response.StatusHistory = make([]dto.OrderStatusHistoryResponse, len(order.StatusHistory))
 		for i, status := range order.StatusHistory {
@@ -68,6 +81,10 @@ func OrderToResponseDTO(order *entities.Order) *dto.OrderResponse {
 		}
 	}
 
+	if order.DeliveryAttempts != nil {
+		response.DeliveryAttempts = DeliveryAttemptsToResponseDTO(order.DeliveryAttempts)
+	}
+
 	// Mapear información esencial de direcciones
 	if order.DeliveryAddress != nil {
 		response.DeliveryAddress = dto.DeliveryAddressResponse{
@@ -183,3 +200,60 @@ func calculateTotalPages(totalItems int64, pageSize int) int {
 
 	return pages
 }
+
+// DeliveryAttemptsToResponseDTO mapea los intentos de entrega a sus DTOs de respuesta
+func DeliveryAttemptsToResponseDTO(attempts []entities.DeliveryAttempt) []dto.DeliveryAttemptResponse {
+	response := make([]dto.DeliveryAttemptResponse, len(attempts))
+	for i, attempt := range attempts {
+		response[i] = dto.DeliveryAttemptResponse{
+			ID:            attempt.ID,
+			AttemptNumber: attempt.AttemptNumber,
+			ReasonCode:    attempt.ReasonCode,
+			Notes:         attempt.Notes,
+			AttemptedAt:   attempt.AttemptedAt,
+			NextAttemptAt: attempt.NextAttemptAt,
+		}
+	}
+
+	return response
+}
+
+// ParcelToResponseDTO mapea un bulto a su DTO de respuesta
+func ParcelToResponseDTO(parcel *entities.Parcel) *dto.ParcelResponse {
+	return &dto.ParcelResponse{
+		ID:             parcel.ID,
+		Sequence:       parcel.Sequence,
+		TrackingNumber: parcel.TrackingNumber,
+		Status:         parcel.Status,
+		IsFragile:      parcel.IsFragile,
+		Weight:         parcel.Weight,
+		Dimensions:     parcel.Dimensions,
+		Description:    parcel.Description,
+	}
+}
+
+// ParcelsToResponseDTO mapea los bultos de un pedido a sus DTOs de respuesta
+func ParcelsToResponseDTO(parcels []entities.Parcel) []dto.ParcelResponse {
+	response := make([]dto.ParcelResponse, len(parcels))
+	for i := range parcels {
+		response[i] = *ParcelToResponseDTO(&parcels[i])
+	}
+
+	return response
+}
+
+// OrderPaymentToResponseDTO mapea el pago anticipado de un pedido a su DTO de respuesta
+func OrderPaymentToResponseDTO(payment *entities.OrderPayment) *dto.OrderPaymentResponse {
+	return &dto.OrderPaymentResponse{
+		Provider:          payment.Provider,
+		ProviderPaymentID: payment.ProviderPaymentID,
+		Status:            payment.Status,
+		Amount:            payment.Amount,
+		Currency:          payment.Currency,
+		RefundedAmount:    payment.RefundedAmount,
+		FailureReason:     payment.FailureReason,
+		AuthorizedAt:      payment.AuthorizedAt,
+		CapturedAt:        payment.CapturedAt,
+		RefundedAt:        payment.RefundedAt,
+	}
+}
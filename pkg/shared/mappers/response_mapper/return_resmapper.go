@@ -0,0 +1,45 @@
+package response_mapper
+
+import (
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+)
+
+// ReturnToResponseDTO mapea una entidad de devolución a su DTO de respuesta
+func ReturnToResponseDTO(orderReturn *entities.OrderReturn) *dto.ReturnResponse {
+	response := &dto.ReturnResponse{
+		ID:              orderReturn.ID,
+		OrderID:         orderReturn.OrderID,
+		TrackingNumber:  orderReturn.TrackingNumber,
+		Status:          orderReturn.Status,
+		InitiatedBy:     orderReturn.InitiatedBy,
+		ReasonCode:      orderReturn.ReasonCode,
+		Notes:           orderReturn.Notes,
+		DriverID:        orderReturn.DriverID,
+		DestinationType: orderReturn.DestinationType,
+		DestinationID:   orderReturn.DestinationID,
+		PickupName:      orderReturn.PickupName,
+		PickupPhone:     orderReturn.PickupPhone,
+		PickupAddress:   orderReturn.PickupAddress,
+		PickedUpAt:      orderReturn.PickedUpAt,
+		ReceivedAt:      orderReturn.ReceivedAt,
+		CreatedAt:       orderReturn.CreatedAt,
+		UpdatedAt:       orderReturn.UpdatedAt,
+	}
+
+	if orderReturn.Order != nil {
+		response.OrderTrackingNumber = orderReturn.Order.TrackingNumber
+	}
+
+	return response
+}
+
+// ReturnsToResponseDTO mapea una lista de devoluciones a sus DTOs de respuesta
+func ReturnsToResponseDTO(returns []entities.OrderReturn) []dto.ReturnResponse {
+	response := make([]dto.ReturnResponse, len(returns))
+	for i := range returns {
+		response[i] = *ReturnToResponseDTO(&returns[i])
+	}
+
+	return response
+}
@@ -0,0 +1,19 @@
+package response_mapper
+
+import (
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+)
+
+// ScanToResponseDTO mapea el pedido escaneado y sus acciones disponibles a su DTO de respuesta
+func ScanToResponseDTO(order *entities.Order, appliedStatus string, actions []string) *dto.ScanResponse {
+	if actions == nil {
+		actions = []string{}
+	}
+
+	return &dto.ScanResponse{
+		AppliedStatus:    appliedStatus,
+		AvailableActions: actions,
+		Order:            OrderToResponseDTO(order),
+	}
+}
@@ -0,0 +1,51 @@
+package response_mapper
+
+import (
+	"encoding/json"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+	"strconv"
+	"strings"
+)
+
+// ScheduleToResponseDTO mapea una entidad de programación a su DTO de respuesta
+func ScheduleToResponseDTO(schedule *entities.OrderSchedule) *dto.ScheduleResponse {
+	response := &dto.ScheduleResponse{
+		ID:                    schedule.ID,
+		Name:                  schedule.Name,
+		BranchID:              schedule.BranchID,
+		Status:                schedule.Status,
+		RunAt:                 schedule.RunAt,
+		TimeOfDay:             schedule.TimeOfDay,
+		DeliveryWindowMinutes: schedule.DeliveryWindowMinutes,
+		NextRunAt:             schedule.NextRunAt,
+		LastRunAt:             schedule.LastRunAt,
+		LastOrderID:           schedule.LastOrderID,
+		LastError:             schedule.LastError,
+		CreatedAt:             schedule.CreatedAt,
+		UpdatedAt:             schedule.UpdatedAt,
+	}
+
+	if schedule.DaysOfWeek != "" {
+		for _, part := range strings.Split(schedule.DaysOfWeek, ",") {
+			if day, err := strconv.Atoi(part); err == nil {
+				response.DaysOfWeek = append(response.DaysOfWeek, day)
+			}
+		}
+	}
+
+	// La plantilla se guardó desde el mismo DTO, un error aquí solo deja la plantilla vacía
+	_ = json.Unmarshal([]byte(schedule.OrderTemplate), &response.Order)
+
+	return response
+}
+
+// SchedulesToResponseDTO mapea una lista de programaciones a sus DTOs de respuesta
+func SchedulesToResponseDTO(schedules []entities.OrderSchedule) []dto.ScheduleResponse {
+	response := make([]dto.ScheduleResponse, len(schedules))
+	for i := range schedules {
+		response[i] = *ScheduleToResponseDTO(&schedules[i])
+	}
+
+	return response
+}
@@ -0,0 +1,61 @@
+package response_mapper
+
+import (
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+)
+
+// OrdersSLAToResponseDTO mapea las evaluaciones de SLA a su DTO de respuesta, el resumen considera todos los
+// pedidos activos y el listado solo los del estado de SLA indicado
+func OrdersSLAToResponseDTO(companyID, branchID, slaStatus string, evaluations []entities.SLAEvaluation) *dto.OrdersSLAResponse {
+	response := &dto.OrdersSLAResponse{
+		CompanyID: companyID,
+		BranchID:  branchID,
+		Orders:    make([]dto.OrderSLAResponse, 0, len(evaluations)),
+	}
+
+	for i := range evaluations {
+		evaluation := &evaluations[i]
+
+		switch evaluation.Status {
+		case constants.SLAStatusOnTrack:
+			response.OnTrack++
+		case constants.SLAStatusAtRisk:
+			response.AtRisk++
+		case constants.SLAStatusBreached:
+			response.Breached++
+		}
+
+		if slaStatus != "" && evaluation.Status != slaStatus {
+			continue
+		}
+
+		response.Orders = append(response.Orders, *OrderSLAToResponseDTO(evaluation))
+	}
+
+	return response
+}
+
+// OrderSLAToResponseDTO mapea la evaluación de SLA de un pedido a su DTO de respuesta
+func OrderSLAToResponseDTO(evaluation *entities.SLAEvaluation) *dto.OrderSLAResponse {
+	order := evaluation.Order
+	response := &dto.OrderSLAResponse{
+		OrderID:           order.ID,
+		TrackingNumber:    order.TrackingNumber,
+		CompanyID:         order.CompanyID,
+		BranchID:          order.BranchID,
+		OrderStatus:       order.Status,
+		SLAStatus:         evaluation.Status,
+		EstimatedDelivery: evaluation.EstimatedDelivery,
+		DelayMinutes:      evaluation.DelayMinutes,
+		IsFlagged:         order.IsFlagged,
+	}
+
+	if order.Detail != nil {
+		response.PickupTime = order.Detail.PickupTime
+		response.DeliveryDeadline = order.Detail.DeliveryDeadline
+	}
+
+	return response
+}
@@ -0,0 +1,84 @@
+package response_mapper
+
+import (
+	"encoding/json"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+)
+
+// WebhookEndpointToResponseDTO mapea un webhook a su DTO de respuesta, el secreto solo se incluye cuando se indica
+func WebhookEndpointToResponseDTO(endpoint *entities.WebhookEndpoint, includeSecret bool) *dto.WebhookEndpointResponse {
+	response := &dto.WebhookEndpointResponse{
+		ID:                  endpoint.ID,
+		CompanyID:           endpoint.CompanyID,
+		URL:                 endpoint.URL,
+		Events:              endpoint.EventList(),
+		Description:         endpoint.Description,
+		IsActive:            endpoint.IsActive,
+		ConsecutiveFailures: endpoint.ConsecutiveFailures,
+		DisabledAt:          endpoint.DisabledAt,
+		DisabledReason:      endpoint.DisabledReason,
+		CreatedAt:           endpoint.CreatedAt,
+		UpdatedAt:           endpoint.UpdatedAt,
+	}
+
+	if includeSecret {
+		response.Secret = endpoint.Secret
+	}
+
+	return response
+}
+
+// WebhookEndpointsToResponseDTO mapea los webhooks de una empresa a sus DTOs de respuesta sin sus secretos
+func WebhookEndpointsToResponseDTO(endpoints []entities.WebhookEndpoint) []dto.WebhookEndpointResponse {
+	response := make([]dto.WebhookEndpointResponse, len(endpoints))
+	for i := range endpoints {
+		response[i] = *WebhookEndpointToResponseDTO(&endpoints[i], false)
+	}
+
+	return response
+}
+
+// WebhookDeliveryToResponseDTO mapea una entrega a su DTO de respuesta
+func WebhookDeliveryToResponseDTO(delivery *entities.WebhookDelivery) *dto.WebhookDeliveryResponse {
+	response := &dto.WebhookDeliveryResponse{
+		ID:            delivery.ID,
+		EndpointID:    delivery.EndpointID,
+		EventID:       delivery.EventID,
+		Event:         delivery.Event,
+		Status:        delivery.Status,
+		Attempts:      delivery.Attempts,
+		ResponseCode:  delivery.ResponseCode,
+		ResponseBody:  delivery.ResponseBody,
+		ErrorMessage:  delivery.ErrorMessage,
+		DurationMs:    delivery.DurationMs,
+		NextAttemptAt: delivery.NextAttemptAt,
+		LastAttemptAt: delivery.LastAttemptAt,
+		DeliveredAt:   delivery.DeliveredAt,
+		RedeliveryOf:  delivery.RedeliveryOf,
+		CreatedAt:     delivery.CreatedAt,
+	}
+
+	if json.Valid([]byte(delivery.Payload)) {
+		response.Payload = json.RawMessage(delivery.Payload)
+	}
+
+	return response
+}
+
+// MapWebhookDeliveriesToResponse mapea el registro de entregas de un webhook a DTOs de respuesta
+func MapWebhookDeliveriesToResponse(deliveries []entities.WebhookDelivery, params *entities.PaginationQueryParams, total int64) *dto.PaginatedResponse {
+	response := make([]dto.WebhookDeliveryResponse, len(deliveries))
+	for i := range deliveries {
+		response[i] = *WebhookDeliveryToResponseDTO(&deliveries[i])
+	}
+
+	return &dto.PaginatedResponse{
+		Data:       response,
+		TotalItems: total,
+		Page:       params.Page,
+		PageSize:   params.PageSize,
+		TotalPages: calculateTotalPages(total, params.PageSize),
+	}
+}
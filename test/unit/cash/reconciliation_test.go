@@ -0,0 +1,155 @@
+package cash
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/services"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/test/unit/testutil"
+)
+
+type txKey struct{}
+
+// fakeTxManager ejecuta la función marcando el contexto como transaccional
+type fakeTxManager struct{}
+
+func (m *fakeTxManager) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(context.WithValue(ctx, txKey{}, true))
+}
+
+// fakeCashRepo devuelve un saldo pendiente fijo y registra la conciliación guardada,
+// los métodos no sobrescritos hacen panic si el servicio los usa
+type fakeCashRepo struct {
+	ports.CashRepository
+
+	balance        float64
+	balanceInTx    bool
+	reconciliation *entities.CashReconciliation
+	handIn         *entities.CashLedgerEntry
+}
+
+func (r *fakeCashRepo) GetPendingBalance(ctx context.Context, _, _ string, _ time.Time) (float64, error) {
+	r.balanceInTx = ctx.Value(txKey{}) != nil
+	return r.balance, nil
+}
+
+func (r *fakeCashRepo) CreateReconciliation(_ context.Context, reconciliation *entities.CashReconciliation, handIn *entities.CashLedgerEntry) error {
+	r.reconciliation = reconciliation
+	r.handIn = handIn
+	return nil
+}
+
+func TestReconcileDriverCash(t *testing.T) {
+	testCases := []struct {
+		name               string
+		balance            float64
+		handedIn           float64
+		expectedStatus     string
+		expectedDifference float64
+	}{
+		{
+			name:               "Balanced hand-in",
+			balance:            150.25,
+			handedIn:           150.25,
+			expectedStatus:     constants.ReconciliationBalanced,
+			expectedDifference: 0,
+		},
+		{
+			name:               "Driver hands in less than collected",
+			balance:            150.25,
+			handedIn:           100,
+			expectedStatus:     constants.ReconciliationShort,
+			expectedDifference: -50.25,
+		},
+		{
+			name:               "Driver hands in more than collected",
+			balance:            80.1,
+			handedIn:           100,
+			expectedStatus:     constants.ReconciliationOver,
+			expectedDifference: 19.9,
+		},
+		{
+			name:               "Driver hands in cash without pending collections",
+			balance:            0,
+			handedIn:           20,
+			expectedStatus:     constants.ReconciliationOver,
+			expectedDifference: 20,
+		},
+		{
+			name:               "Rounding noise is balanced",
+			balance:            0.1 + 0.2,
+			handedIn:           0.3,
+			expectedStatus:     constants.ReconciliationBalanced,
+			expectedDifference: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := &fakeCashRepo{balance: tc.balance}
+			service := services.NewCashService(repo, &fakeTxManager{})
+
+			reconciliation, err := service.ReconcileDriverCash(context.Background(), "driver-1", "usd", tc.handedIn, "admin-1", "")
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			if !repo.balanceInTx {
+				t.Error("expected the pending balance to be read inside the transaction")
+			}
+			if reconciliation.Status != tc.expectedStatus {
+				t.Errorf("expected status %s, got %s", tc.expectedStatus, reconciliation.Status)
+			}
+			if reconciliation.Difference != tc.expectedDifference {
+				t.Errorf("expected difference %v, got %v", tc.expectedDifference, reconciliation.Difference)
+			}
+			if reconciliation.Currency != "USD" {
+				t.Errorf("expected currency USD, got %s", reconciliation.Currency)
+			}
+
+			// La entrega descuenta lo recibido, la diferencia queda pendiente en el libro del repartidor
+			if repo.handIn == nil || repo.handIn.Amount != -tc.handedIn {
+				t.Fatalf("expected a hand-in entry of %v, got %+v", -tc.handedIn, repo.handIn)
+			}
+			if repo.handIn.Type != constants.CashEntryHandIn || *repo.handIn.ReconciliationID != reconciliation.ID {
+				t.Errorf("expected the hand-in to be linked to the reconciliation, got %+v", repo.handIn)
+			}
+			if remaining := tc.balance + repo.handIn.Amount; math.Round(remaining*100) != math.Round(-tc.expectedDifference*100) {
+				t.Errorf("expected the remaining balance to be %v, got %v", -tc.expectedDifference, remaining)
+			}
+		})
+	}
+}
+
+func TestReconcileDriverCashRejectsInvalidHandIns(t *testing.T) {
+	testCases := []struct {
+		name     string
+		balance  float64
+		handedIn float64
+		expected error
+	}{
+		{name: "Negative hand-in", balance: 100, handedIn: -1, expected: errPackage.ErrInvalidHandInAmount},
+		{name: "Nothing pending and nothing handed in", balance: 0, handedIn: 0, expected: errPackage.ErrNothingToReconcile},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := &fakeCashRepo{balance: tc.balance}
+			service := services.NewCashService(repo, &fakeTxManager{})
+
+			_, err := service.ReconcileDriverCash(context.Background(), "driver-1", "USD", tc.handedIn, "admin-1", "")
+			if testutil.DomainCause(err) != tc.expected {
+				t.Fatalf("expected %v, got %v", tc.expected, err)
+			}
+			if repo.reconciliation != nil {
+				t.Error("expected no reconciliation to be saved")
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package earning
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/services"
+	"gorm.io/gorm"
+)
+
+// fakeEarningRepo devuelve la regla de la zona indicada o ErrRecordNotFound si no existe
+type fakeEarningRepo struct {
+	ports.EarningRepository
+
+	rules map[string]*entities.EarningRule
+}
+
+func (r *fakeEarningRepo) GetEarningRuleForZone(_ context.Context, zoneID string) (*entities.EarningRule, error) {
+	rule, ok := r.rules[zoneID]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return rule, nil
+}
+
+func newOrder(zoneID string, distance float64, urgent bool) *entities.Order {
+	driverID := "d0000000-0000-0000-0000-000000000001"
+	return &entities.Order{
+		ID:            "o0000000-0000-0000-0000-000000000001",
+		DriverID:      &driverID,
+		Branch:        &entities.Branch{ZoneID: zoneID},
+		Detail:        &entities.Details{Distance: distance},
+		PackageDetail: &entities.PackageDetail{IsUrgent: urgent},
+	}
+}
+
+func TestCalculateDeliveryEarning(t *testing.T) {
+	repo := &fakeEarningRepo{rules: map[string]*entities.EarningRule{
+		"zone-north": {BasePerDelivery: 2, PerKmRate: 0.5, UrgentBonus: 1.25, ZoneMultiplier: 1.5},
+	}}
+	service := services.NewEarningService(repo, nil)
+
+	testCases := []struct {
+		name     string
+		order    *entities.Order
+		expected float64
+	}{
+		{
+			name:     "Zone rule with distance",
+			order:    newOrder("zone-north", 4.3, false),
+			expected: 6.23, // (2 + 2.15) * 1.5
+		},
+		{
+			name:     "Zone rule with urgent bonus",
+			order:    newOrder("zone-north", 4, true),
+			expected: 7.88, // (2 + 2 + 1.25) * 1.5
+		},
+		{
+			name:     "Default rule when the zone has none",
+			order:    newOrder("zone-south", 10, false),
+			expected: 6, // (2.50 + 3.50) * 1.0
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			earning, err := service.CalculateDeliveryEarning(context.Background(), tc.order)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			if earning.Amount != tc.expected {
+				t.Errorf("expected amount %.2f, got %.2f", tc.expected, earning.Amount)
+			}
+			if earning.DriverID != *tc.order.DriverID || earning.OrderID == nil || *earning.OrderID != tc.order.ID {
+				t.Errorf("expected the earning to belong to the driver and the order, got %+v", earning)
+			}
+			if earning.Type != constants.EarningTypeDelivery {
+				t.Errorf("expected type %s, got %s", constants.EarningTypeDelivery, earning.Type)
+			}
+		})
+	}
+}
+
+func TestCalculateDeliveryEarningWithoutDriver(t *testing.T) {
+	service := services.NewEarningService(&fakeEarningRepo{}, nil)
+
+	order := newOrder("zone-north", 4, false)
+	order.DriverID = nil
+
+	earning, err := service.CalculateDeliveryEarning(context.Background(), order)
+	if err != nil || earning != nil {
+		t.Errorf("expected no earning for an order without driver, got %+v, %v", earning, err)
+	}
+}
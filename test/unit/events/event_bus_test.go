@@ -0,0 +1,222 @@
+package events
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/adapters/eventbus"
+)
+
+// testAckTimeout tiempo de confirmación corto para probar las nuevas entregas sin esperar el de producción
+const testAckTimeout = 20 * time.Millisecond
+
+// busAdapter crea un bus limpio del adaptador, el servidor de Redis es nil para el bus en memoria
+type busAdapter struct {
+	name string
+	new  func(t *testing.T) (interfaces.EventBus, *fakeRedis)
+}
+
+var busAdapters = []busAdapter{
+	{
+		name: "memory",
+		new: func(t *testing.T) (interfaces.EventBus, *fakeRedis) {
+			return eventbus.NewMemoryEventBus(testAckTimeout), nil
+		},
+	},
+	{
+		name: "redis",
+		new: func(t *testing.T) (interfaces.EventBus, *fakeRedis) {
+			server, client := newFakeRedis(t)
+			return eventbus.NewRedisEventBus(client, testAckTimeout), server
+		},
+	},
+}
+
+func TestEventBusAcknowledgesHandledEvents(t *testing.T) {
+	for _, adapter := range busAdapters {
+		t.Run(adapter.name, func(t *testing.T) {
+			bus, server := adapter.new(t)
+			subscriber := &fakeSubscriber{name: "test.subscriber"}
+			publish(t, bus, constants.SystemEventOrderCreated)
+
+			processed := consume(t, bus, subscriber)
+			if processed != 1 || subscriber.handled != 1 {
+				t.Fatalf("expected the event to be handled once, got %d processed and %d handled", processed, subscriber.handled)
+			}
+
+			// Un mensaje confirmado no se vuelve a entregar aunque venza su tiempo de confirmación
+			time.Sleep(2 * testAckTimeout)
+			consume(t, bus, subscriber)
+			if subscriber.handled != 1 {
+				t.Errorf("expected an acknowledged event not to be delivered again, got %d deliveries", subscriber.handled)
+			}
+			if server != nil && server.pendingCount(constants.EventBusStream, subscriber.name) != 0 {
+				t.Error("expected no pending messages in the consumer group")
+			}
+		})
+	}
+}
+
+func TestEventBusDeliversEachEventToEveryGroup(t *testing.T) {
+	for _, adapter := range busAdapters {
+		t.Run(adapter.name, func(t *testing.T) {
+			bus, _ := adapter.new(t)
+			first := &fakeSubscriber{name: "test.first"}
+			second := &fakeSubscriber{name: "test.second"}
+			publish(t, bus, constants.SystemEventOrderCreated)
+
+			consume(t, bus, first)
+			consume(t, bus, second)
+
+			if first.handled != 1 || second.handled != 1 {
+				t.Errorf("expected each group to receive the event, got %d and %d", first.handled, second.handled)
+			}
+		})
+	}
+}
+
+func TestEventBusAcknowledgesEventsTheGroupDoesNotSubscribeTo(t *testing.T) {
+	for _, adapter := range busAdapters {
+		t.Run(adapter.name, func(t *testing.T) {
+			bus, server := adapter.new(t)
+			subscriber := &fakeSubscriber{name: "test.subscriber", types: []string{constants.SystemEventUserDeactivated}}
+			publish(t, bus, constants.SystemEventOrderCreated)
+
+			consume(t, bus, subscriber)
+
+			if subscriber.handled != 0 {
+				t.Errorf("expected the event not to be handled, got %d", subscriber.handled)
+			}
+			if server != nil && server.pendingCount(constants.EventBusStream, subscriber.name) != 0 {
+				t.Error("expected the event to be acknowledged without handling it")
+			}
+		})
+	}
+}
+
+func TestEventBusRedeliversAfterTheAckTimeout(t *testing.T) {
+	for _, adapter := range busAdapters {
+		t.Run(adapter.name, func(t *testing.T) {
+			bus, server := adapter.new(t)
+			subscriber := &fakeSubscriber{name: "test.subscriber", err: errSubscriberDown}
+			publish(t, bus, constants.SystemEventOrderCreated)
+
+			if processed := consume(t, bus, subscriber); processed != 0 {
+				t.Fatalf("expected the failed event not to count as processed, got %d", processed)
+			}
+
+			// Antes del tiempo de confirmación el mensaje sigue reservado para el consumidor que lo recibió
+			consume(t, bus, subscriber)
+			if subscriber.handled != 1 {
+				t.Fatalf("expected no delivery before the ack timeout, got %d deliveries", subscriber.handled)
+			}
+
+			time.Sleep(2 * testAckTimeout)
+			subscriber.err = nil
+
+			if processed := consume(t, bus, subscriber); processed != 1 || subscriber.handled != 2 {
+				t.Fatalf("expected the event to be claimed again after the ack timeout, got %d processed and %d deliveries", processed, subscriber.handled)
+			}
+			if server != nil && server.pendingCount(constants.EventBusStream, subscriber.name) != 0 {
+				t.Error("expected the claimed event to be acknowledged")
+			}
+		})
+	}
+}
+
+func TestEventBusDeadLettersAfterTheMaxDeliveries(t *testing.T) {
+	for _, adapter := range busAdapters {
+		t.Run(adapter.name, func(t *testing.T) {
+			bus, server := adapter.new(t)
+			subscriber := &fakeSubscriber{name: "test.subscriber", err: errSubscriberDown}
+			publish(t, bus, constants.SystemEventOrderCreated)
+
+			for i := 0; i <= constants.EventBusMaxDeliveries; i++ {
+				consume(t, bus, subscriber)
+				time.Sleep(2 * testAckTimeout)
+			}
+
+			if subscriber.handled != constants.EventBusMaxDeliveries {
+				t.Fatalf("expected %d deliveries before the dead letter, got %d", constants.EventBusMaxDeliveries, subscriber.handled)
+			}
+
+			consume(t, bus, subscriber)
+			if subscriber.handled != constants.EventBusMaxDeliveries {
+				t.Errorf("expected a dead lettered event not to be delivered again, got %d deliveries", subscriber.handled)
+			}
+
+			if server == nil {
+				return
+			}
+			if server.pendingCount(constants.EventBusStream, subscriber.name) != 0 {
+				t.Error("expected the dead lettered event to be acknowledged")
+			}
+
+			deadLetters := server.entries(constants.EventBusDeadLetterStream + subscriber.name)
+			if len(deadLetters) != 1 {
+				t.Fatal("expected the event in the dead letter stream of the group")
+			}
+			fields := entryFields(deadLetters[0])
+			if fields["event_type"] != constants.SystemEventOrderCreated || fields["last_error"] != errSubscriberDown.Error() {
+				t.Errorf("expected the event and its last error in the dead letter, got %v", fields)
+			}
+			if fields["deliveries"] != strconv.Itoa(constants.EventBusMaxDeliveries) {
+				t.Errorf("expected the deliveries in the dead letter, got %q", fields["deliveries"])
+			}
+		})
+	}
+}
+
+func TestRedisEventBusDeadLettersMessagesTrimmedFromTheStream(t *testing.T) {
+	server, client := newFakeRedis(t)
+	bus := eventbus.NewRedisEventBus(client, testAckTimeout)
+	subscriber := &fakeSubscriber{name: "test.subscriber", err: errSubscriberDown}
+	publish(t, bus, constants.SystemEventOrderCreated)
+
+	for i := 0; i < constants.EventBusMaxDeliveries; i++ {
+		consume(t, bus, subscriber)
+		time.Sleep(2 * testAckTimeout)
+	}
+
+	// El mensaje se descartó del stream antes de pasar al dead letter, solo se confirma con su identificador
+	server.trim(constants.EventBusStream)
+	consume(t, bus, subscriber)
+
+	if server.pendingCount(constants.EventBusStream, subscriber.name) != 0 {
+		t.Error("expected the trimmed message to be acknowledged")
+	}
+	deadLetters := server.entries(constants.EventBusDeadLetterStream + subscriber.name)
+	if len(deadLetters) != 1 || entryFields(deadLetters[0])["message_id"] == "" {
+		t.Error("expected the trimmed message id in the dead letter stream")
+	}
+}
+
+func publish(t *testing.T, bus interfaces.EventBus, eventType string) {
+	t.Helper()
+
+	if err := bus.Publish(context.Background(), newOrderEvent(eventType, map[string]interface{}{"status": constants.OrderStatusPending})); err != nil {
+		t.Fatalf("expected no error publishing, got %v", err)
+	}
+}
+
+func consume(t *testing.T, bus interfaces.EventBus, subscriber interfaces.EventSubscriber) int {
+	t.Helper()
+
+	processed, err := bus.Consume(context.Background(), subscriber, constants.EventBusBatchSize)
+	if err != nil {
+		t.Fatalf("expected no error consuming, got %v", err)
+	}
+	return processed
+}
+
+func entryFields(entry fakeEntry) map[string]string {
+	fields := make(map[string]string)
+	for i := 0; i+1 < len(entry.fields); i += 2 {
+		fields[entry.fields[i]] = entry.fields[i+1]
+	}
+	return fields
+}
@@ -0,0 +1,416 @@
+package events
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// fakeRedis servidor RESP en memoria con los comandos de streams, grupos de consumidores y hashes que usa el bus
+// de eventos, lo suficiente para probar el adaptador sin un Redis real
+type fakeRedis struct {
+	mu       sync.Mutex
+	listener net.Listener
+	lastID   int64
+	streams  map[string]*fakeStream
+	hashes   map[string]map[string]string
+}
+
+type fakeEntry struct {
+	id     string
+	fields []string
+}
+
+type fakeStream struct {
+	entries []fakeEntry
+	groups  map[string]*fakeStreamGroup
+}
+
+type fakeStreamGroup struct {
+	lastDelivered int64
+	pending       map[string]*fakePendingEntry
+	order         []string
+}
+
+type fakePendingEntry struct {
+	consumer    string
+	deliveredAt time.Time
+	deliveries  int64
+}
+
+// newFakeRedis inicia el servidor y devuelve un cliente conectado a él, ambos se cierran al terminar la prueba
+func newFakeRedis(t *testing.T) (*fakeRedis, *redis.Client) {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to start fake redis: %v", err)
+	}
+
+	server := &fakeRedis{
+		listener: listener,
+		streams:  make(map[string]*fakeStream),
+		hashes:   make(map[string]map[string]string),
+	}
+	go server.serve()
+
+	client := redis.NewClient(&redis.Options{Addr: listener.Addr().String()})
+	t.Cleanup(func() {
+		_ = client.Close()
+		_ = listener.Close()
+	})
+
+	return server, client
+}
+
+func (s *fakeRedis) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *fakeRedis) handle(conn net.Conn) {
+	defer conn.Close()
+
+	reader := bufio.NewReader(conn)
+	for {
+		args, err := readCommand(reader)
+		if err != nil {
+			return
+		}
+
+		s.mu.Lock()
+		reply := s.execute(args)
+		s.mu.Unlock()
+
+		if _, err = io.WriteString(conn, reply); err != nil {
+			return
+		}
+	}
+}
+
+func readCommand(reader *bufio.Reader) ([]string, error) {
+	header, err := reader.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := strconv.Atoi(strings.TrimSpace(header[1:]))
+	if err != nil {
+		return nil, err
+	}
+
+	args := make([]string, count)
+	for i := range args {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+
+		size, err := strconv.Atoi(strings.TrimSpace(line[1:]))
+		if err != nil {
+			return nil, err
+		}
+
+		value := make([]byte, size+2)
+		if _, err = io.ReadFull(reader, value); err != nil {
+			return nil, err
+		}
+		args[i] = string(value[:size])
+	}
+
+	return args, nil
+}
+
+func (s *fakeRedis) execute(args []string) string {
+	switch strings.ToLower(args[0]) {
+	case "xadd":
+		return s.xadd(args)
+	case "xgroup":
+		return s.xgroupCreate(args)
+	case "xreadgroup":
+		return s.xreadgroup(args)
+	case "xpending":
+		return s.xpending(args)
+	case "xclaim":
+		return s.xclaim(args)
+	case "xack":
+		return s.xack(args)
+	case "xrange":
+		return s.xrange(args)
+	case "hset":
+		hash := s.hash(args[1])
+		for i := 2; i+1 < len(args); i += 2 {
+			hash[args[i]] = args[i+1]
+		}
+		return respInt(int64((len(args) - 2) / 2))
+	case "hget":
+		value, ok := s.hash(args[1])[args[2]]
+		if !ok {
+			return "$-1\r\n"
+		}
+		return respBulk(value)
+	case "hdel":
+		deleted := 0
+		for _, field := range args[2:] {
+			if _, ok := s.hash(args[1])[field]; ok {
+				delete(s.hash(args[1]), field)
+				deleted++
+			}
+		}
+		return respInt(int64(deleted))
+	default:
+		return fmt.Sprintf("-ERR unknown command '%s'\r\n", args[0])
+	}
+}
+
+// xadd XADD stream [MAXLEN [~] n] * field value...
+func (s *fakeRedis) xadd(args []string) string {
+	stream := s.stream(args[1])
+
+	i, maxLen := 2, 0
+	if strings.ToLower(args[i]) == "maxlen" {
+		i++
+		if args[i] == "~" {
+			i++
+		}
+		maxLen, _ = strconv.Atoi(args[i])
+		i++
+	}
+	i++ // ID *
+
+	s.lastID++
+	entry := fakeEntry{id: fmt.Sprintf("%d-0", s.lastID), fields: append([]string(nil), args[i:]...)}
+	stream.entries = append(stream.entries, entry)
+	if maxLen > 0 && len(stream.entries) > maxLen {
+		stream.entries = stream.entries[len(stream.entries)-maxLen:]
+	}
+
+	return respBulk(entry.id)
+}
+
+// xgroupCreate XGROUP CREATE stream group 0 MKSTREAM
+func (s *fakeRedis) xgroupCreate(args []string) string {
+	stream := s.stream(args[2])
+	if _, ok := stream.groups[args[3]]; ok {
+		return "-BUSYGROUP Consumer Group name already exists\r\n"
+	}
+
+	stream.groups[args[3]] = &fakeStreamGroup{pending: make(map[string]*fakePendingEntry)}
+	return "+OK\r\n"
+}
+
+// xreadgroup XREADGROUP GROUP group consumer [COUNT n] STREAMS stream >
+func (s *fakeRedis) xreadgroup(args []string) string {
+	group, consumer := args[2], args[3]
+	count := len(args)
+	for i := 4; i < len(args); i++ {
+		if strings.ToLower(args[i]) == "count" {
+			count, _ = strconv.Atoi(args[i+1])
+		}
+	}
+
+	name := args[len(args)-2]
+	stream := s.stream(name)
+	state, ok := stream.groups[group]
+	if !ok {
+		return "-NOGROUP No such consumer group\r\n"
+	}
+
+	var delivered []fakeEntry
+	for _, entry := range stream.entries {
+		if len(delivered) >= count {
+			break
+		}
+		if entryNumber(entry.id) <= state.lastDelivered {
+			continue
+		}
+
+		state.lastDelivered = entryNumber(entry.id)
+		state.pending[entry.id] = &fakePendingEntry{consumer: consumer, deliveredAt: time.Now(), deliveries: 1}
+		state.order = append(state.order, entry.id)
+		delivered = append(delivered, entry)
+	}
+
+	if len(delivered) == 0 {
+		return "*-1\r\n"
+	}
+	return "*1\r\n*2\r\n" + respBulk(name) + respEntries(delivered)
+}
+
+// xpending XPENDING stream group - + count
+func (s *fakeRedis) xpending(args []string) string {
+	state, ok := s.stream(args[1]).groups[args[2]]
+	if !ok {
+		return "-NOGROUP No such consumer group\r\n"
+	}
+	count, _ := strconv.Atoi(args[5])
+
+	var reply strings.Builder
+	listed := 0
+	for _, id := range state.order {
+		pending, ok := state.pending[id]
+		if !ok || listed >= count {
+			continue
+		}
+
+		listed++
+		reply.WriteString("*4\r\n" + respBulk(id) + respBulk(pending.consumer))
+		reply.WriteString(respInt(time.Since(pending.deliveredAt).Milliseconds()) + respInt(pending.deliveries))
+	}
+
+	return fmt.Sprintf("*%d\r\n", listed) + reply.String()
+}
+
+// xclaim XCLAIM stream group consumer min-idle-ms id...
+func (s *fakeRedis) xclaim(args []string) string {
+	stream := s.stream(args[1])
+	state := stream.groups[args[2]]
+	minIdle, _ := strconv.ParseInt(args[4], 10, 64)
+
+	var claimed []fakeEntry
+	for _, id := range args[5:] {
+		pending, ok := state.pending[id]
+		if !ok || time.Since(pending.deliveredAt).Milliseconds() < minIdle {
+			continue
+		}
+
+		pending.consumer = args[3]
+		pending.deliveredAt = time.Now()
+		pending.deliveries++
+		if entry, ok := stream.find(id); ok {
+			claimed = append(claimed, entry)
+		}
+	}
+
+	return respEntries(claimed)
+}
+
+// xack XACK stream group id...
+func (s *fakeRedis) xack(args []string) string {
+	state, ok := s.stream(args[1]).groups[args[2]]
+	if !ok {
+		return respInt(0)
+	}
+
+	acked := 0
+	for _, id := range args[3:] {
+		if _, ok := state.pending[id]; ok {
+			delete(state.pending, id)
+			acked++
+		}
+	}
+	return respInt(int64(acked))
+}
+
+// xrange XRANGE stream start end
+func (s *fakeRedis) xrange(args []string) string {
+	start, end := rangeBound(args[2], 0), rangeBound(args[3], 1<<62)
+
+	var entries []fakeEntry
+	for _, entry := range s.stream(args[1]).entries {
+		if number := entryNumber(entry.id); number >= start && number <= end {
+			entries = append(entries, entry)
+		}
+	}
+	return respEntries(entries)
+}
+
+func (s *fakeRedis) stream(name string) *fakeStream {
+	stream, ok := s.streams[name]
+	if !ok {
+		stream = &fakeStream{groups: make(map[string]*fakeStreamGroup)}
+		s.streams[name] = stream
+	}
+	return stream
+}
+
+func (s *fakeRedis) hash(key string) map[string]string {
+	hash, ok := s.hashes[key]
+	if !ok {
+		hash = make(map[string]string)
+		s.hashes[key] = hash
+	}
+	return hash
+}
+
+// pendingCount mensajes entregados sin confirmar del grupo
+func (s *fakeRedis) pendingCount(stream, group string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state, ok := s.stream(stream).groups[group]
+	if !ok {
+		return 0
+	}
+	return len(state.pending)
+}
+
+// entries mensajes que quedan en el stream
+func (s *fakeRedis) entries(stream string) []fakeEntry {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]fakeEntry(nil), s.stream(stream).entries...)
+}
+
+// trim descarta los mensajes más antiguos del stream como lo haría MAXLEN
+func (s *fakeRedis) trim(stream string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.stream(stream).entries = nil
+}
+
+func (f *fakeStream) find(id string) (fakeEntry, bool) {
+	for _, entry := range f.entries {
+		if entry.id == id {
+			return entry, true
+		}
+	}
+	return fakeEntry{}, false
+}
+
+func entryNumber(id string) int64 {
+	number, _ := strconv.ParseInt(strings.SplitN(id, "-", 2)[0], 10, 64)
+	return number
+}
+
+func rangeBound(bound string, fallback int64) int64 {
+	if bound == "-" || bound == "+" {
+		return fallback
+	}
+	return entryNumber(bound)
+}
+
+func respBulk(value string) string {
+	return fmt.Sprintf("$%d\r\n%s\r\n", len(value), value)
+}
+
+func respInt(value int64) string {
+	return fmt.Sprintf(":%d\r\n", value)
+}
+
+func respEntries(entries []fakeEntry) string {
+	var reply strings.Builder
+	reply.WriteString(fmt.Sprintf("*%d\r\n", len(entries)))
+	for _, entry := range entries {
+		reply.WriteString("*2\r\n" + respBulk(entry.id) + fmt.Sprintf("*%d\r\n", len(entry.fields)))
+		for _, field := range entry.fields {
+			reply.WriteString(respBulk(field))
+		}
+	}
+	return reply.String()
+}
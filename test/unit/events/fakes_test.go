@@ -0,0 +1,212 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+)
+
+// fakeOutboxRepo guarda los eventos en memoria y reserva, publica y reintenta con las mismas condiciones que el repositorio
+type fakeOutboxRepo struct {
+	mu     sync.Mutex
+	events []*entities.SystemEvent
+	logs   []*entities.EventLog
+	claims int
+}
+
+func (r *fakeOutboxRepo) GetPendingEvents(_ context.Context, now time.Time, maxAttempts, limit int) ([]entities.SystemEvent, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	pending := make([]entities.SystemEvent, 0)
+	for _, event := range r.events {
+		if event.PublishedAt == nil && event.Attempts < maxAttempts && isDue(event, now) && len(pending) < limit {
+			pending = append(pending, *event)
+		}
+	}
+	return pending, nil
+}
+
+func (r *fakeOutboxRepo) ClaimEvent(_ context.Context, id string, now, leaseUntil time.Time) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	event := r.find(id)
+	if event == nil || event.PublishedAt != nil || !isDue(event, now) {
+		return false, nil
+	}
+
+	event.NextAttemptAt = &leaseUntil
+	r.claims++
+	return true, nil
+}
+
+func (r *fakeOutboxRepo) GetHandledSubscribers(_ context.Context, eventID string) (map[string]bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	handled := make(map[string]bool)
+	for _, log := range r.logs {
+		if log.EventID == eventID && log.LogLevel == constants.EventLogLevelInfo {
+			handled[log.Subscriber] = true
+		}
+	}
+	return handled, nil
+}
+
+func (r *fakeOutboxRepo) SaveEventLog(_ context.Context, log *entities.EventLog) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.logs = append(r.logs, log)
+	return nil
+}
+
+func (r *fakeOutboxRepo) MarkEventPublished(_ context.Context, id string, publishedAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	event := r.find(id)
+	event.PublishedAt = &publishedAt
+	event.NextAttemptAt = nil
+	return nil
+}
+
+func (r *fakeOutboxRepo) MarkEventFailed(_ context.Context, failed *entities.SystemEvent) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	event := r.find(failed.ID)
+	event.Attempts = failed.Attempts
+	event.NextAttemptAt = failed.NextAttemptAt
+	event.LastError = failed.LastError
+	return nil
+}
+
+func (r *fakeOutboxRepo) find(id string) *entities.SystemEvent {
+	for _, event := range r.events {
+		if event.ID == id {
+			return event
+		}
+	}
+	return nil
+}
+
+// expire adelanta el siguiente intento del evento como si hubiera pasado su reserva o su espera de reintento
+func (r *fakeOutboxRepo) expire(id string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	past := time.Now().Add(-time.Second)
+	r.find(id).NextAttemptAt = &past
+}
+
+func isDue(event *entities.SystemEvent, now time.Time) bool {
+	return event.NextAttemptAt == nil || !event.NextAttemptAt.After(now)
+}
+
+// fakeSubscriber cuenta los eventos recibidos y falla mientras tenga un error configurado
+type fakeSubscriber struct {
+	name    string
+	types   []string
+	err     error
+	handled int
+}
+
+func (s *fakeSubscriber) Name() string         { return s.name }
+func (s *fakeSubscriber) EventTypes() []string { return s.types }
+
+func (s *fakeSubscriber) Handle(_ context.Context, _ *entities.SystemEvent) error {
+	s.handled++
+	return s.err
+}
+
+var errSubscriberDown = errors.New("subscriber down")
+
+// fakeOrderRepo devuelve siempre el pedido de la prueba
+type fakeOrderRepo struct {
+	ports.OrdererRepository
+
+	order *entities.Order
+}
+
+func (r *fakeOrderRepo) GetOrderByID(_ context.Context, _ string) (*entities.Order, error) {
+	order := *r.order
+	return &order, nil
+}
+
+// fakeWebhookPublisher guarda los eventos publicados a los webhooks por tipo de evento
+type fakeWebhookPublisher struct {
+	published map[string]publishedWebhook
+}
+
+type publishedWebhook struct {
+	eventID string
+	data    map[string]interface{}
+}
+
+func (p *fakeWebhookPublisher) Publish(_ context.Context, eventID, _, event string, data map[string]interface{}) error {
+	if p.published == nil {
+		p.published = make(map[string]publishedWebhook)
+	}
+	p.published[event] = publishedWebhook{eventID: eventID, data: data}
+	return nil
+}
+
+// fakeOrderNotifier guarda la última notificación pedida
+type fakeOrderNotifier struct {
+	interfaces.OrderNotifier
+
+	status         string
+	attempt        *entities.DeliveryAttempt
+	returnToSender bool
+	dispatched     int
+	dispatchErr    error
+}
+
+func (n *fakeOrderNotifier) NotifyDriverDispatch(_ context.Context, _ *entities.Order) error {
+	n.dispatched++
+	return n.dispatchErr
+}
+
+func (n *fakeOrderNotifier) NotifyStatusChange(_ context.Context, _ *entities.Order, status string) {
+	n.status = status
+}
+
+func (n *fakeOrderNotifier) NotifyDeliveryAttempt(_ context.Context, _ *entities.Order, attempt *entities.DeliveryAttempt, returnToSender bool) {
+	n.attempt = attempt
+	n.returnToSender = returnToSender
+}
+
+func newOrderEvent(eventType string, data map[string]interface{}) *entities.SystemEvent {
+	return entities.NewSystemEvent(eventType, constants.EventSourceOrder, "o0000000-0000-0000-0000-000000000001", constants.EventSeverityInfo, data)
+}
+
+func newEventOrder() *entities.Order {
+	return &entities.Order{
+		ID:             "o0000000-0000-0000-0000-000000000001",
+		CompanyID:      "c0000000-0000-0000-0000-000000000001",
+		TrackingNumber: "TRK-0001",
+		Status:         constants.OrderStatusInTransit,
+	}
+}
+
+// fakeMetricsService guarda las empresas cuyas métricas se pidió actualizar
+type fakeMetricsService struct {
+	interfaces.MetricsService
+
+	refreshed []string
+	since     time.Time
+}
+
+func (m *fakeMetricsService) RefreshCompanyMetrics(_ context.Context, companyID string, since time.Time) error {
+	m.refreshed = append(m.refreshed, companyID)
+	m.since = since
+	return nil
+}
@@ -0,0 +1,174 @@
+package events
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/services"
+)
+
+func TestWebhookSubscriberPublishesTheStatusEvents(t *testing.T) {
+	publisher := &fakeWebhookPublisher{}
+	subscriber := services.NewWebhookEventSubscriber(&fakeOrderRepo{order: newEventOrder()}, publisher, "https://track.example.com")
+
+	event := newOrderEvent(constants.SystemEventOrderStatusChanged, map[string]interface{}{
+		"previous_status": constants.OrderStatusInTransit,
+		"status":          constants.OrderStatusDelivered,
+	})
+	if err := subscriber.Handle(context.Background(), event); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	changed, ok := publisher.published[constants.WebhookEventOrderStatusChanged]
+	if !ok || changed.data["status"] != constants.OrderStatusDelivered {
+		t.Fatalf("expected the status change with the event status, got %v", publisher.published)
+	}
+	if _, ok = publisher.published[constants.WebhookEventOrderDelivered]; !ok {
+		t.Errorf("expected the delivered event, got %v", publisher.published)
+	}
+	if changed.data["tracking_url"] != "https://track.example.com/TRK-0001" {
+		t.Errorf("expected the tracking url, got %v", changed.data["tracking_url"])
+	}
+
+	// Un reintento del mismo evento publica con los mismos identificadores para que el receptor descarte el duplicado
+	firstID := changed.eventID
+	if err := subscriber.Handle(context.Background(), event); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if publisher.published[constants.WebhookEventOrderStatusChanged].eventID != firstID {
+		t.Error("expected a retried event to keep its webhook event id")
+	}
+	if publisher.published[constants.WebhookEventOrderDelivered].eventID == firstID {
+		t.Error("expected each webhook event to have its own id")
+	}
+}
+
+func TestWebhookSubscriberPublishesTheFailedAttempt(t *testing.T) {
+	publisher := &fakeWebhookPublisher{}
+	subscriber := services.NewWebhookEventSubscriber(&fakeOrderRepo{order: newEventOrder()}, publisher, "")
+
+	event := newOrderEvent(constants.SystemEventOrderStatusChanged, map[string]interface{}{
+		"status":           constants.OrderStatusFailed,
+		"attempt_number":   2,
+		"reason":           constants.DeliveryFailureRecipientAbsent,
+		"return_to_sender": false,
+	})
+	if err := subscriber.Handle(context.Background(), event); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	attempt, ok := publisher.published[constants.WebhookEventOrderAttemptFailed]
+	if !ok {
+		t.Fatalf("expected the failed attempt event, got %v", publisher.published)
+	}
+	if attempt.data["attempt_number"] != 2 || attempt.data["reason"] != constants.DeliveryFailureRecipientAbsent {
+		t.Errorf("expected the attempt details, got %v", attempt.data)
+	}
+}
+
+func TestNotificationSubscriberNotifiesTheFailedAttempt(t *testing.T) {
+	notifier := &fakeOrderNotifier{}
+	subscriber := services.NewOrderNotificationSubscriber(&fakeOrderRepo{order: newEventOrder()}, notifier)
+
+	nextAttemptAt := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+	event := newOrderEvent(constants.SystemEventOrderStatusChanged, map[string]interface{}{
+		"status":           constants.OrderStatusFailed,
+		"attempt_number":   1,
+		"reason":           constants.DeliveryFailureRecipientAbsent,
+		"return_to_sender": false,
+		"next_attempt_at":  nextAttemptAt.Format(time.RFC3339),
+	})
+	if err := subscriber.Handle(context.Background(), event); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if notifier.attempt == nil || notifier.attempt.AttemptNumber != 1 || notifier.returnToSender {
+		t.Fatalf("expected the first attempt to be notified, got %+v", notifier.attempt)
+	}
+	if notifier.attempt.NextAttemptAt == nil || !notifier.attempt.NextAttemptAt.Equal(nextAttemptAt) {
+		t.Errorf("expected the next attempt %v, got %v", nextAttemptAt, notifier.attempt.NextAttemptAt)
+	}
+	if notifier.status != "" {
+		t.Errorf("expected no generic status notification, got %s", notifier.status)
+	}
+}
+
+func TestNotificationSubscriberNotifiesTheStatusOfTheEvent(t *testing.T) {
+	notifier := &fakeOrderNotifier{}
+	subscriber := services.NewOrderNotificationSubscriber(&fakeOrderRepo{order: newEventOrder()}, notifier)
+
+	event := newOrderEvent(constants.SystemEventOrderStatusChanged, map[string]interface{}{
+		"status": constants.OrderStatusCancelled,
+	})
+	if err := subscriber.Handle(context.Background(), event); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if notifier.status != constants.OrderStatusCancelled {
+		t.Errorf("expected the cancellation to be notified, got %q", notifier.status)
+	}
+}
+
+func TestDispatchSubscriberNotifiesTheAssignedDriver(t *testing.T) {
+	driverID := "d0000000-0000-0000-0000-000000000001"
+	order := newEventOrder()
+	order.DriverID = &driverID
+
+	notifier := &fakeOrderNotifier{dispatchErr: errSubscriberDown}
+	subscriber := services.NewDriverDispatchSubscriber(&fakeOrderRepo{order: order}, notifier)
+
+	event := newOrderEvent(constants.SystemEventOrderDriverAssigned, map[string]interface{}{"driver_id": driverID})
+
+	// Si el aviso falla el evento se reintenta
+	if err := subscriber.Handle(context.Background(), event); err == nil {
+		t.Fatal("expected the failed dispatch to be retried")
+	}
+
+	notifier.dispatchErr = nil
+	if err := subscriber.Handle(context.Background(), event); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if notifier.dispatched != 2 {
+		t.Errorf("expected the driver to be notified, got %d dispatches", notifier.dispatched)
+	}
+}
+
+func TestDispatchSubscriberSkipsAReassignedOrder(t *testing.T) {
+	currentDriverID := "d0000000-0000-0000-0000-000000000002"
+	order := newEventOrder()
+	order.DriverID = &currentDriverID
+
+	notifier := &fakeOrderNotifier{}
+	subscriber := services.NewDriverDispatchSubscriber(&fakeOrderRepo{order: order}, notifier)
+
+	event := newOrderEvent(constants.SystemEventOrderDriverAssigned, map[string]interface{}{"driver_id": "d0000000-0000-0000-0000-000000000001"})
+	if err := subscriber.Handle(context.Background(), event); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if notifier.dispatched != 0 {
+		t.Errorf("expected no dispatch for a previous assignment, got %d", notifier.dispatched)
+	}
+}
+
+func TestMetricsSubscriberRefreshesTheCompanyOfTheOrder(t *testing.T) {
+	metrics := &fakeMetricsService{}
+	subscriber := services.NewCompanyMetricsSubscriber(metrics)
+
+	event := newOrderEvent(constants.SystemEventOrderStatusChanged, map[string]interface{}{
+		"company_id": "c0000000-0000-0000-0000-000000000001",
+		"status":     constants.OrderStatusDelivered,
+	})
+	if err := subscriber.Handle(context.Background(), event); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(metrics.refreshed) != 1 || metrics.refreshed[0] != "c0000000-0000-0000-0000-000000000001" {
+		t.Fatalf("expected the company metrics to be refreshed, got %v", metrics.refreshed)
+	}
+	if !metrics.since.Equal(event.OccurredAt) {
+		t.Errorf("expected the refresh to include the event at %v, got %v", event.OccurredAt, metrics.since)
+	}
+}
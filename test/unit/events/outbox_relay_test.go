@@ -0,0 +1,173 @@
+package events
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/services"
+)
+
+func TestRelaySkipsEventsLeasedByAnotherInstance(t *testing.T) {
+	event := newOrderEvent(constants.SystemEventOrderCreated, nil)
+	leaseUntil := time.Now().Add(constants.OutboxLease)
+	event.NextAttemptAt = &leaseUntil
+
+	repo := &fakeOutboxRepo{events: []*entities.SystemEvent{event}}
+	subscriber := &fakeSubscriber{name: "test.subscriber"}
+	relay := services.NewOutboxRelayService(repo)
+	relay.Subscribe(subscriber)
+
+	published, err := relay.RelayPendingEvents(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if published != 0 || subscriber.handled != 0 {
+		t.Errorf("expected the leased event not to be relayed, got %d published and %d handled", published, subscriber.handled)
+	}
+}
+
+func TestRelayTakesOverAnExpiredLease(t *testing.T) {
+	event := newOrderEvent(constants.SystemEventOrderCreated, nil)
+	expiredLease := time.Now().Add(-time.Second)
+	event.NextAttemptAt = &expiredLease
+
+	repo := &fakeOutboxRepo{events: []*entities.SystemEvent{event}}
+	subscriber := &fakeSubscriber{name: "test.subscriber"}
+	relay := services.NewOutboxRelayService(repo)
+	relay.Subscribe(subscriber)
+
+	published, err := relay.RelayPendingEvents(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if published != 1 || subscriber.handled != 1 {
+		t.Fatalf("expected the event to be relayed once, got %d published and %d handled", published, subscriber.handled)
+	}
+	if event.PublishedAt == nil {
+		t.Error("expected the event to be marked as published")
+	}
+}
+
+func TestRelayClaimsTheEventBeforeHandlingIt(t *testing.T) {
+	event := newOrderEvent(constants.SystemEventOrderCreated, nil)
+	repo := &fakeOutboxRepo{events: []*entities.SystemEvent{event}}
+
+	// El suscriptor comprueba que el evento ya está reservado cuando lo recibe
+	var leasedUntil *time.Time
+	subscriber := &claimCheckingSubscriber{onHandle: func() { leasedUntil = event.NextAttemptAt }}
+	relay := services.NewOutboxRelayService(repo)
+	relay.Subscribe(subscriber)
+
+	before := time.Now()
+	if _, err := relay.RelayPendingEvents(context.Background()); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if repo.claims != 1 {
+		t.Fatalf("expected one claim, got %d", repo.claims)
+	}
+	if leasedUntil == nil || leasedUntil.Before(before.Add(constants.OutboxLease)) {
+		t.Errorf("expected the event to be leased for %s while handled, got %v", constants.OutboxLease, leasedUntil)
+	}
+}
+
+func TestRelayRetriesAFailedSubscriberWithBackoff(t *testing.T) {
+	event := newOrderEvent(constants.SystemEventOrderCreated, nil)
+	repo := &fakeOutboxRepo{events: []*entities.SystemEvent{event}}
+	subscriber := &fakeSubscriber{name: "test.subscriber", err: errSubscriberDown}
+	relay := services.NewOutboxRelayService(repo)
+	relay.Subscribe(subscriber)
+
+	before := time.Now()
+	published, err := relay.RelayPendingEvents(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if published != 0 || event.PublishedAt != nil {
+		t.Fatal("expected the event not to be published")
+	}
+	if event.Attempts != 1 || event.LastError == "" {
+		t.Errorf("expected one failed attempt with its error, got %d attempts and %q", event.Attempts, event.LastError)
+	}
+	if event.NextAttemptAt == nil || event.NextAttemptAt.Before(before.Add(constants.OutboxRetryBaseDelay)) {
+		t.Errorf("expected the retry after %s, got %v", constants.OutboxRetryBaseDelay, event.NextAttemptAt)
+	}
+
+	// Antes de que venza la espera el evento no se vuelve a entregar
+	if _, err = relay.RelayPendingEvents(context.Background()); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if subscriber.handled != 1 {
+		t.Errorf("expected no delivery before the retry, got %d", subscriber.handled)
+	}
+}
+
+func TestRelayOnlyRetriesSubscribersThatFailed(t *testing.T) {
+	event := newOrderEvent(constants.SystemEventOrderCreated, nil)
+	repo := &fakeOutboxRepo{events: []*entities.SystemEvent{event}}
+	healthy := &fakeSubscriber{name: "test.healthy"}
+	failing := &fakeSubscriber{name: "test.failing", err: errSubscriberDown}
+	relay := services.NewOutboxRelayService(repo)
+	relay.Subscribe(healthy)
+	relay.Subscribe(failing)
+
+	if _, err := relay.RelayPendingEvents(context.Background()); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	failing.err = nil
+	repo.expire(event.ID)
+
+	published, err := relay.RelayPendingEvents(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if published != 1 || event.PublishedAt == nil {
+		t.Fatal("expected the event to be published once every subscriber handled it")
+	}
+	if healthy.handled != 1 {
+		t.Errorf("expected the healthy subscriber to receive the event once, got %d", healthy.handled)
+	}
+	if failing.handled != 2 {
+		t.Errorf("expected the failing subscriber to receive the event again, got %d", failing.handled)
+	}
+}
+
+func TestRelayOnlyDeliversSubscribedEventTypes(t *testing.T) {
+	event := newOrderEvent(constants.SystemEventOrderCreated, nil)
+	repo := &fakeOutboxRepo{events: []*entities.SystemEvent{event}}
+	other := &fakeSubscriber{name: "test.other", types: []string{constants.SystemEventUserDeactivated}}
+	relay := services.NewOutboxRelayService(repo)
+	relay.Subscribe(other)
+
+	if _, err := relay.RelayPendingEvents(context.Background()); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if other.handled != 0 {
+		t.Errorf("expected the subscriber not to receive other event types, got %d", other.handled)
+	}
+	if event.PublishedAt == nil {
+		t.Error("expected an event without subscribers to be marked as published")
+	}
+}
+
+// claimCheckingSubscriber ejecuta onHandle al recibir el evento
+type claimCheckingSubscriber struct {
+	onHandle func()
+}
+
+func (s *claimCheckingSubscriber) Name() string         { return "test.claim_checking" }
+func (s *claimCheckingSubscriber) EventTypes() []string { return nil }
+
+func (s *claimCheckingSubscriber) Handle(_ context.Context, _ *entities.SystemEvent) error {
+	s.onHandle()
+	return nil
+}
@@ -0,0 +1,11 @@
+package events
+
+import (
+	"testing"
+
+	"github.com/MarlonG1/delivery-backend/test/unit/testutil"
+)
+
+func TestMain(m *testing.M) {
+	testutil.Main(m)
+}
@@ -0,0 +1,192 @@
+package imports
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/usecases/order"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/mappers/request_mapper"
+	"github.com/MarlonG1/delivery-backend/pkg/shared/mappers/response_mapper"
+)
+
+const importHeader = "company_pickup_id,client_id,price,distance,pickup_time,delivery_deadline,recipient_name,recipient_phone,address_line1,city,state"
+
+// fakeImporter entrega el trabajo de la prueba como pendiente y guarda el trabajo finalizado
+type fakeImporter struct {
+	interfaces.OrderImporter
+
+	job      entities.ImportJob
+	finished *entities.ImportJob
+}
+
+func (i *fakeImporter) GetPendingImportJobs(context.Context) ([]entities.ImportJob, error) {
+	return []entities.ImportJob{i.job}, nil
+}
+
+func (i *fakeImporter) ClaimImportJob(context.Context, string) (bool, error) { return true, nil }
+
+func (i *fakeImporter) FinishImportJob(_ context.Context, job *entities.ImportJob, _ error) error {
+	i.finished = job
+	return nil
+}
+
+// fakeOrders crea los pedidos asignándoles un número de seguimiento
+type fakeOrders struct {
+	interfaces.Orderer
+
+	created []*entities.Order
+}
+
+func (o *fakeOrders) CreateOrder(_ context.Context, order *entities.Order) error {
+	order.TrackingNumber = "DEL" + order.ID[:8]
+	o.created = append(o.created, order)
+	return nil
+}
+
+// fakeCompanies conoce una sola dirección de recogida y cuenta las consultas
+type fakeCompanies struct {
+	interfaces.Companyrer
+
+	lookups int
+}
+
+func (c *fakeCompanies) GetAddressByID(_ context.Context, id, _ string) (*entities.CompanyAddress, error) {
+	c.lookups++
+	if id != "addr-1" {
+		return nil, errPackage.NewDomainErrorWithCause("fakeCompanies", "GetAddressByID", "address not found", errPackage.ErrAddressNotFound)
+	}
+	return &entities.CompanyAddress{ID: id}, nil
+}
+
+func TestImportFileToRowsRejectsCSVWithoutRequiredColumns(t *testing.T) {
+	_, err := request_mapper.ImportFileToRows(constants.ImportFormatCSV, []byte("client_id,price\nc-1,10\n"))
+
+	if !errors.Is(err, errPackage.ErrMissingImportColumns) {
+		t.Fatalf("expected missing columns error, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "company_pickup_id") {
+		t.Errorf("expected the missing columns to be listed, got %q", err.Error())
+	}
+}
+
+func TestImportFileToRowsKeepsParseErrorsPerRow(t *testing.T) {
+	content := "\xef\xbb\xbf" + importHeader + "\n" +
+		"addr-1,c-1,10,5,2025-01-15T10:00:00Z,2025-01-15T18:00:00Z,Ana,555,Street 1,City,State\n" +
+		"addr-1,c-1,ten,5,2025-01-15T10:00:00Z,2025-01-15T18:00:00Z,Ana,555,Street 1,City,State\n" +
+		"addr-1,c-1,10,5,tomorrow,2025-01-15T18:00:00Z,Ana,555,Street 1,City,State\n" +
+		"addr-1,c-1\n"
+
+	rows, err := request_mapper.ImportFileToRows(constants.ImportFormatCSV, []byte(content))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(rows) != 4 {
+		t.Fatalf("expected 4 rows, got %d", len(rows))
+	}
+
+	if rows[0].Error != "" || rows[0].Order == nil || rows[0].Order.Price != 10 {
+		t.Errorf("expected the first row to be parsed, got %+v", rows[0])
+	}
+	expected := []string{"invalid number in column price", "invalid date in column pickup_time", "expected 11 columns, got 2"}
+	for i, message := range expected {
+		if row := rows[i+1]; row.Row != i+2 || !strings.Contains(row.Error, message) {
+			t.Errorf("expected row %d to fail with %q, got %+v", i+2, message, row)
+		}
+	}
+}
+
+func TestImportFileToRowsKeepsInvalidJSONItemsPerRow(t *testing.T) {
+	rows, err := request_mapper.ImportFileToRows(constants.ImportFormatJSON, []byte(`[{"client_id":"c-1"},{"price":"ten"}]`))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(rows) != 2 || rows[0].Order == nil || rows[0].Order.ClientID != "c-1" {
+		t.Fatalf("expected the first item to be parsed, got %+v", rows)
+	}
+	if rows[1].Row != 2 || rows[1].Error == "" {
+		t.Errorf("expected the second item to keep its decode error, got %+v", rows[1])
+	}
+
+	if _, err = request_mapper.ImportFileToRows(constants.ImportFormatJSON, []byte(`{"client_id":"c-1"}`)); !errors.Is(err, errPackage.ErrInvalidImportFile) {
+		t.Errorf("expected a non array file to be rejected, got %v", err)
+	}
+}
+
+func TestProcessPendingImportsReportsEachRow(t *testing.T) {
+	rows := []dto.OrderImportRow{
+		{Row: 1, Order: &dto.OrderCreateRequest{CompanyPickUpID: "addr-1", ClientID: "c-1", Price: 10}},
+		{Row: 2, Order: &dto.OrderCreateRequest{CompanyPickUpID: "addr-1"}},
+		{Row: 3, Error: "invalid number in column price: ten"},
+		{Row: 4, Order: &dto.OrderCreateRequest{CompanyPickUpID: "addr-2", ClientID: "c-1"}},
+		{Row: 5, Order: &dto.OrderCreateRequest{CompanyPickUpID: "addr-1", ClientID: "c-2", Price: 20}},
+	}
+	job, err := request_mapper.ImportRowsToImportJob(constants.ImportFormatJSON, rows)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	job.ID, job.CompanyID, job.BranchID = "job-1", "company-1", "branch-1"
+
+	importer := &fakeImporter{job: *job}
+	orders := &fakeOrders{}
+	companies := &fakeCompanies{}
+	useCase := order.NewImportUseCase(importer, orders, companies)
+
+	if err = useCase.ProcessPendingImports(context.Background()); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	finished := importer.finished
+	if finished == nil || finished.CreatedRows != 2 || finished.FailedRows != 3 {
+		t.Fatalf("expected 2 created and 3 failed rows, got %+v", finished)
+	}
+	if len(orders.created) != 2 || orders.created[0].CompanyID != "company-1" || orders.created[0].BranchID != "branch-1" {
+		t.Errorf("expected the orders to be created for the company of the import, got %+v", orders.created)
+	}
+	// La dirección de recogida se reutiliza entre filas, la desconocida se consulta una vez
+	if companies.lookups != 2 {
+		t.Errorf("expected one address lookup per pickup address, got %d", companies.lookups)
+	}
+
+	var report []dto.OrderImportRowResult
+	if err = json.Unmarshal([]byte(finished.Report), &report); err != nil {
+		t.Fatalf("expected a JSON report, got %v", err)
+	}
+	statuses := []string{constants.ImportRowCreated, constants.ImportRowFailed, constants.ImportRowFailed, constants.ImportRowFailed, constants.ImportRowCreated}
+	for i, status := range statuses {
+		if report[i].Row != i+1 || report[i].Status != status {
+			t.Errorf("expected row %d to be %s, got %+v", i+1, status, report[i])
+		}
+	}
+	if report[0].TrackingNumber != orders.created[0].TrackingNumber || report[0].OrderID != orders.created[0].ID {
+		t.Errorf("expected the created order in the report, got %+v", report[0])
+	}
+	if report[2].Error != "invalid number in column price: ten" {
+		t.Errorf("expected the parse error in the report, got %q", report[2].Error)
+	}
+}
+
+func TestImportReportToCSV(t *testing.T) {
+	report, err := response_mapper.ImportReportToCSV([]dto.OrderImportRowResult{
+		{Row: 1, Status: constants.ImportRowCreated, OrderID: "o-1", TrackingNumber: "DEL1"},
+		{Row: 2, Status: constants.ImportRowFailed, Error: "client ID is required, check the file"},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	expected := "row,status,order_id,tracking_number,error\n" +
+		"1,CREATED,o-1,DEL1,\n" +
+		"2,FAILED,,,\"client ID is required, check the file\"\n"
+	if string(report) != expected {
+		t.Errorf("expected report %q, got %q", expected, string(report))
+	}
+}
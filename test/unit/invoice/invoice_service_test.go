@@ -0,0 +1,211 @@
+package invoice
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/services"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/test/unit/testutil"
+)
+
+// fakeTxManager ejecuta la función en el mismo contexto
+type fakeTxManager struct{}
+
+func (m *fakeTxManager) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// fakeInvoiceRepo devuelve los pedidos indicados del periodo y guarda la factura creada,
+// los métodos no sobrescritos hacen panic si el servicio los usa
+type fakeInvoiceRepo struct {
+	ports.InvoiceRepository
+
+	exists    bool
+	locked    bool
+	delivered []entities.Order
+	lost      []entities.Order
+	created   *entities.Invoice
+}
+
+func (r *fakeInvoiceRepo) LockCompanyBilling(_ context.Context, _ string) error {
+	r.locked = true
+	return nil
+}
+
+func (r *fakeInvoiceRepo) ExistsInvoiceForPeriod(_ context.Context, _ string, _, _ time.Time) (bool, error) {
+	return r.exists, nil
+}
+
+func (r *fakeInvoiceRepo) GetBillableOrders(_ context.Context, _ string, _, _ time.Time) ([]entities.Order, error) {
+	return r.delivered, nil
+}
+
+func (r *fakeInvoiceRepo) GetLostOrders(_ context.Context, _ string, _, _ time.Time) ([]entities.Order, error) {
+	return r.lost, nil
+}
+
+func (r *fakeInvoiceRepo) CreateInvoice(_ context.Context, invoice *entities.Invoice) error {
+	r.created = invoice
+	return nil
+}
+
+// fakeCompanyRepo devuelve una empresa con la tarifa de entrega indicada
+type fakeCompanyRepo struct {
+	ports.CompanyRepository
+
+	rate float64
+}
+
+func (r *fakeCompanyRepo) GetCompanyByID(_ context.Context, id string) (*entities.Company, error) {
+	return &entities.Company{ID: id, DeliveryRate: r.rate}, nil
+}
+
+type expectedLine struct {
+	orderID  string
+	lineType string
+	quantity int
+	amount   float64
+}
+
+func TestGenerateInvoiceLinesAndTotals(t *testing.T) {
+	repo := &fakeInvoiceRepo{
+		delivered: []entities.Order{
+			{ID: "order-1", TrackingNumber: "DEL1", Parcels: []entities.Parcel{{}}},
+			{
+				ID:             "order-2",
+				TrackingNumber: "DEL2",
+				PackageDetail:  &entities.PackageDetail{IsUrgent: true, IsFragile: true},
+				Parcels:        []entities.Parcel{{}, {}, {}},
+			},
+		},
+		lost: []entities.Order{{ID: "order-3", TrackingNumber: "DEL3"}},
+	}
+	service := services.NewInvoiceService(repo, &fakeCompanyRepo{rate: 10}, &fakeTxManager{})
+	start, end := billingPeriod()
+
+	invoice, err := service.GenerateInvoice(context.Background(), "company-1", start, end, 0.1)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	expectedLines := []expectedLine{
+		{orderID: "order-1", lineType: constants.InvoiceLineDelivery, quantity: 1, amount: 10},
+		{orderID: "order-2", lineType: constants.InvoiceLineDelivery, quantity: 1, amount: 10},
+		{orderID: "order-2", lineType: constants.InvoiceLineSurcharge, quantity: 1, amount: 2.5},
+		{orderID: "order-2", lineType: constants.InvoiceLineSurcharge, quantity: 1, amount: 1},
+		{orderID: "order-2", lineType: constants.InvoiceLineSurcharge, quantity: 2, amount: 10},
+		{orderID: "order-3", lineType: constants.InvoiceLineCredit, quantity: 1, amount: -10},
+	}
+
+	if len(invoice.Lines) != len(expectedLines) {
+		t.Fatalf("expected %d lines, got %d", len(expectedLines), len(invoice.Lines))
+	}
+	for i, expected := range expectedLines {
+		line := invoice.Lines[i]
+		if line.LineNumber != i+1 || *line.OrderID != expected.orderID || line.Type != expected.lineType ||
+			line.Quantity != expected.quantity || line.Amount != expected.amount || line.InvoiceID != invoice.ID {
+			t.Errorf("line %d: expected %+v, got %+v", i+1, expected, line)
+		}
+	}
+
+	if invoice.Subtotal != 20 || invoice.SurchargeTotal != 13.5 || invoice.CreditTotal != -10 {
+		t.Errorf("expected subtotal 20, surcharges 13.5 and credits -10, got %v, %v and %v",
+			invoice.Subtotal, invoice.SurchargeTotal, invoice.CreditTotal)
+	}
+	if invoice.TaxAmount != 2.35 || invoice.Total != 25.85 {
+		t.Errorf("expected tax 2.35 and total 25.85, got %v and %v", invoice.TaxAmount, invoice.Total)
+	}
+	if invoice.Status != constants.InvoiceStatusDraft {
+		t.Errorf("expected a draft invoice, got %s", invoice.Status)
+	}
+	if !repo.locked || repo.created != invoice {
+		t.Error("expected the invoice to be created with the company billing locked")
+	}
+}
+
+func TestGenerateInvoiceCreditsNeverMakeTheTotalNegative(t *testing.T) {
+	repo := &fakeInvoiceRepo{
+		lost: []entities.Order{{ID: "order-1"}, {ID: "order-2"}},
+	}
+	service := services.NewInvoiceService(repo, &fakeCompanyRepo{rate: 10}, &fakeTxManager{})
+	start, end := billingPeriod()
+
+	invoice, err := service.GenerateInvoice(context.Background(), "company-1", start, end, 0.13)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if invoice.CreditTotal != -20 {
+		t.Errorf("expected credits -20, got %v", invoice.CreditTotal)
+	}
+	if invoice.TaxAmount != 0 || invoice.Total != 0 {
+		t.Errorf("expected tax and total 0, got %v and %v", invoice.TaxAmount, invoice.Total)
+	}
+}
+
+func TestGenerateInvoiceRejections(t *testing.T) {
+	start, end := billingPeriod()
+
+	testCases := []struct {
+		name     string
+		repo     *fakeInvoiceRepo
+		start    time.Time
+		end      time.Time
+		taxRate  float64
+		expected error
+	}{
+		{
+			name:     "Period already invoiced",
+			repo:     &fakeInvoiceRepo{exists: true, delivered: []entities.Order{{ID: "order-1"}}},
+			start:    start,
+			end:      end,
+			expected: errPackage.ErrInvoiceAlreadyExists,
+		},
+		{
+			name:     "Nothing to invoice",
+			repo:     &fakeInvoiceRepo{},
+			start:    start,
+			end:      end,
+			expected: errPackage.ErrNothingToInvoice,
+		},
+		{
+			name:     "Period end before start",
+			repo:     &fakeInvoiceRepo{},
+			start:    end,
+			end:      start,
+			expected: errPackage.ErrInvalidBillingPeriod,
+		},
+		{
+			name:     "Tax rate above one",
+			repo:     &fakeInvoiceRepo{},
+			start:    start,
+			end:      end,
+			taxRate:  1.5,
+			expected: errPackage.ErrInvalidTaxRate,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			service := services.NewInvoiceService(tc.repo, &fakeCompanyRepo{rate: 10}, &fakeTxManager{})
+
+			_, err := service.GenerateInvoice(context.Background(), "company-1", tc.start, tc.end, tc.taxRate)
+			if testutil.DomainCause(err) != tc.expected {
+				t.Fatalf("expected %v, got %v", tc.expected, err)
+			}
+			if tc.repo.created != nil {
+				t.Error("expected no invoice to be created")
+			}
+		})
+	}
+}
+
+func billingPeriod() (time.Time, time.Time) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	return start, start.AddDate(0, 1, 0)
+}
@@ -0,0 +1,185 @@
+package labels
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/usecases/order"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/auth"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/adapters/label"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+	infraErr "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+)
+
+// pdfPage coincide con cada página del documento, sin contar el nodo raíz /Pages
+var pdfPage = regexp.MustCompile(`/Type /Page\b[^s]`)
+
+// fakeOrders devuelve los pedidos de la prueba por identificador y cuenta las consultas
+type fakeOrders struct {
+	interfaces.Orderer
+
+	orders  map[string]*entities.Order
+	lookups int
+}
+
+func (o *fakeOrders) OrderIsDeleted(context.Context, string) bool { return false }
+
+func (o *fakeOrders) GetOrderByID(_ context.Context, id string) (*entities.Order, error) {
+	o.lookups++
+	return o.orders[id], nil
+}
+
+func newLabelOrder() *entities.Order {
+	return &entities.Order{
+		ID:             "o-1",
+		CompanyID:      "company-1",
+		TrackingNumber: "DEL250115TE68JBQF14V",
+		Company:        &entities.Company{Name: "Acme"},
+		Branch:         &entities.Branch{Name: "Centro", ContactPhone: "2222-0000"},
+		QRCode:         &entities.QRCode{QRData: "qr^payload"},
+		PickupAddress:  &entities.PickupAddress{AddressLine1: "Calle 1", City: "San Salvador", State: "SS"},
+		DeliveryAddress: &entities.DeliveryAddress{
+			RecipientName:  "Ana López",
+			RecipientPhone: "7777-0000",
+			AddressLine1:   "Avenida 2",
+			AddressLine2:   "Apto 3",
+			City:           "Santa Tecla",
+			State:          "LL",
+			PostalCode:     "1501",
+		},
+		PackageDetail: &entities.PackageDetail{
+			IsFragile:  true,
+			IsUrgent:   true,
+			Weight:     2.5,
+			Dimensions: `{"length":30,"width":20,"height":15}`,
+		},
+		Detail: &entities.Details{RequiresDeliveryPIN: true},
+	}
+}
+
+func labelContext(role, userID, companyID string) context.Context {
+	return context.WithValue(context.Background(), "claims", &auth.AuthClaims{UserID: userID, Role: role, CompanyID: companyID})
+}
+
+func TestZPLLabelIncludesSenderRecipientFlagsAndCodes(t *testing.T) {
+	content, err := label.NewZPLLabelRenderer().Render([]entities.Order{*newLabelOrder()})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	zpl := string(content)
+
+	if strings.Count(zpl, "^XA") != 1 || !strings.HasSuffix(zpl, "^XZ\n") {
+		t.Fatalf("expected a single label, got %q", zpl)
+	}
+	expected := []string{
+		"^FDAcme^FS",
+		"^FDSucursal: Centro",
+		"^FDAna López^FS",
+		"^FDApto 3^FS",
+		"^FDSanta Tecla, LL 1501^FS",
+		"^FDFRAGIL^FS", "^FDURGENTE^FS", "^FDPIN^FS",
+		"Peso: 2.50 kg", "Dim: 30 x 20 x 15 cm",
+		"^BCN,120,Y,N,N^FDDEL250115TE68JBQF14V^FS",
+		"^FDMA,qrpayload^FS",
+	}
+	for _, fragment := range expected {
+		if !strings.Contains(zpl, fragment) {
+			t.Errorf("expected the label to contain %q", fragment)
+		}
+	}
+}
+
+func TestZPLLabelPrintsOneLabelPerParcel(t *testing.T) {
+	labelOrder := newLabelOrder()
+	labelOrder.Parcels = []entities.Parcel{
+		{Sequence: 1, TrackingNumber: "DEL250115TE68JBQF14V-01", Weight: 1},
+		{Sequence: 2, TrackingNumber: "DEL250115TE68JBQF14V-02", IsFragile: false},
+	}
+
+	content, err := label.NewZPLLabelRenderer().Render([]entities.Order{*labelOrder})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	labels := strings.Split(strings.TrimSuffix(string(content), "^XZ\n"), "^XZ\n")
+	if len(labels) != 2 {
+		t.Fatalf("expected one label per parcel, got %d", len(labels))
+	}
+	if !strings.Contains(labels[0], "^FDDEL250115TE68JBQF14V-01^FS") || !strings.Contains(labels[0], "Bulto 1 de 2") {
+		t.Errorf("expected the first parcel tracking number and caption, got %q", labels[0])
+	}
+	if !strings.Contains(labels[1], "^FDMA,DEL250115TE68JBQF14V-02^FS") || !strings.Contains(labels[1], "Pedido DEL250115TE68JBQF14V") {
+		t.Errorf("expected the parcel QR and the order tracking number, got %q", labels[1])
+	}
+	// Las marcas del bulto reemplazan a las del paquete, el segundo bulto no es frágil
+	if strings.Contains(labels[1], "^FDFRAGIL^FS") {
+		t.Error("expected the second parcel not to be marked as fragile")
+	}
+}
+
+func TestPDFLabelsRenderOnePagePerOrder(t *testing.T) {
+	second := newLabelOrder()
+	second.ID, second.TrackingNumber = "o-2", "DEL250115AAAAAAAAAAA"
+
+	content, err := label.NewPDFLabelRenderer().Render([]entities.Order{*newLabelOrder(), *second})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if !strings.HasPrefix(string(content), "%PDF-") {
+		t.Fatal("expected a PDF document")
+	}
+	if pages := len(pdfPage.FindAll(content, -1)); pages != 2 {
+		t.Errorf("expected one page per order, got %d", pages)
+	}
+}
+
+func TestGenerateLabelsSkipsRepeatedOrders(t *testing.T) {
+	orders := &fakeOrders{orders: map[string]*entities.Order{"o-1": newLabelOrder()}}
+	useCase := order.NewLabelUseCase(orders, label.NewPDFLabelRenderer(), label.NewZPLLabelRenderer())
+
+	document, err := useCase.GenerateLabels(labelContext(constants.CompanyUser, "u-1", "company-1"), &dto.LabelBatchRequest{
+		OrderIDs: []string{"o-1", "o-1"},
+		Format:   "zpl",
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if orders.lookups != 1 || strings.Count(string(document.Content), "^XA") != 1 {
+		t.Errorf("expected the repeated order to be printed once, got %d lookups", orders.lookups)
+	}
+	if document.ContentType != "application/x-zpl" || document.FileName != "labels-1.zpl" {
+		t.Errorf("expected a ZPL document, got %s %s", document.ContentType, document.FileName)
+	}
+}
+
+func TestGenerateLabelRejectsUnknownFormatsAndOtherCompanies(t *testing.T) {
+	orders := &fakeOrders{orders: map[string]*entities.Order{"o-1": newLabelOrder()}}
+	useCase := order.NewLabelUseCase(orders, label.NewPDFLabelRenderer(), label.NewZPLLabelRenderer())
+
+	_, err := useCase.GenerateLabel(labelContext(constants.AdminRole, "u-1", ""), "o-1", "png")
+	var serviceErr *infraErr.ServiceError
+	if !errors.As(err, &serviceErr) || !errors.Is(serviceErr.Err, errPackage.ErrInvalidLabelFormat) {
+		t.Errorf("expected an invalid format error, got %v", err)
+	}
+
+	if _, err = useCase.GenerateLabel(labelContext(constants.CompanyUser, "u-2", "company-2"), "o-1", ""); err == nil {
+		t.Error("expected a user of another company not to print the label")
+	}
+	if _, err = useCase.GenerateLabel(labelContext(constants.Driver, "driver-1", ""), "o-1", ""); err == nil {
+		t.Error("expected a driver not assigned to the order not to print the label")
+	}
+
+	document, err := useCase.GenerateLabel(labelContext(constants.CompanyUser, "u-1", "company-1"), "o-1", "")
+	if err != nil || document.FileName != "label-DEL250115TE68JBQF14V.pdf" {
+		t.Errorf("expected the company user to get the PDF label, got %v", err)
+	}
+}
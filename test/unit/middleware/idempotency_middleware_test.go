@@ -0,0 +1,228 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/middleware"
+)
+
+// memoryIdempotencyStore guarda los registros en memoria con la misma semántica que el almacén de Redis
+type memoryIdempotencyStore struct {
+	mu      sync.Mutex
+	records map[string][]byte
+	err     error
+}
+
+func newMemoryIdempotencyStore() *memoryIdempotencyStore {
+	return &memoryIdempotencyStore{records: map[string][]byte{}}
+}
+
+func (s *memoryIdempotencyStore) Reserve(_ context.Context, key string, record []byte, _ time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.err != nil {
+		return false, s.err
+	}
+	if _, ok := s.records[key]; ok {
+		return false, nil
+	}
+	s.records[key] = record
+	return true, nil
+}
+
+func (s *memoryIdempotencyStore) Get(_ context.Context, key string) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	record, ok := s.records[key]
+	if !ok {
+		return nil, errors.New("key not found")
+	}
+	return record, nil
+}
+
+func (s *memoryIdempotencyStore) Save(_ context.Context, key string, record []byte, _ time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.records[key] = record
+	return nil
+}
+
+func (s *memoryIdempotencyStore) Release(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.records, key)
+	return nil
+}
+
+// countingHandler responde con el número de veces que se ejecutó y el estado indicado
+type countingHandler struct {
+	calls  int
+	status int
+}
+
+func (h *countingHandler) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	h.calls++
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(h.status)
+	_, _ = w.Write([]byte(`{"call":` + strconv.Itoa(h.calls) + `}`))
+}
+
+func newIdempotentRequest(method, path, key, body string) *http.Request {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	if key != "" {
+		req.Header.Set(middleware.IdempotencyKeyHeader, key)
+	}
+	return req
+}
+
+func serve(handler http.Handler, req *http.Request) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestIdempotencyReplaysTheStoredResponse(t *testing.T) {
+	next := &countingHandler{status: http.StatusCreated}
+	handler := middleware.NewIdempotencyMiddleware(newMemoryIdempotencyStore(), time.Hour).Handle(next)
+
+	first := serve(handler, newIdempotentRequest(http.MethodPost, "/api/v1/orders", "key-1", `{"a":1}`))
+	second := serve(handler, newIdempotentRequest(http.MethodPost, "/api/v1/orders", "key-1", `{"a":1}`))
+
+	if next.calls != 1 {
+		t.Fatalf("expected the handler to run once, got %d", next.calls)
+	}
+	if second.Code != http.StatusCreated {
+		t.Errorf("expected replayed status %d, got %d", http.StatusCreated, second.Code)
+	}
+	if second.Body.String() != first.Body.String() {
+		t.Errorf("expected replayed body %q, got %q", first.Body.String(), second.Body.String())
+	}
+	if second.Header().Get(middleware.IdempotencyReplayedHeader) != "true" {
+		t.Error("expected the replayed header to be set")
+	}
+	if second.Header().Get("Content-Type") != "application/json" {
+		t.Errorf("expected the stored content type, got %q", second.Header().Get("Content-Type"))
+	}
+	if first.Header().Get(middleware.IdempotencyReplayedHeader) != "" {
+		t.Error("expected the original response not to be marked as replayed")
+	}
+}
+
+func TestIdempotencyRejectsReusedKeyWithAnotherRequest(t *testing.T) {
+	testCases := []struct {
+		name string
+		path string
+		body string
+	}{
+		{name: "Different body", path: "/api/v1/orders", body: `{"a":2}`},
+		{name: "Different path", path: "/api/v1/companies", body: `{"a":1}`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			next := &countingHandler{status: http.StatusCreated}
+			handler := middleware.NewIdempotencyMiddleware(newMemoryIdempotencyStore(), time.Hour).Handle(next)
+
+			serve(handler, newIdempotentRequest(http.MethodPost, "/api/v1/orders", "key-1", `{"a":1}`))
+			rec := serve(handler, newIdempotentRequest(http.MethodPost, tc.path, "key-1", tc.body))
+
+			if rec.Code != http.StatusUnprocessableEntity {
+				t.Errorf("expected status %d, got %d", http.StatusUnprocessableEntity, rec.Code)
+			}
+			if next.calls != 1 {
+				t.Errorf("expected the handler to run once, got %d", next.calls)
+			}
+		})
+	}
+}
+
+func TestIdempotencyRejectsRequestInProgress(t *testing.T) {
+	store := newMemoryIdempotencyStore()
+	m := middleware.NewIdempotencyMiddleware(store, time.Hour)
+
+	var retry *httptest.ResponseRecorder
+	var handler http.Handler
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// El reintento llega mientras la solicitud original sigue en proceso
+		retry = serve(handler, newIdempotentRequest(http.MethodPost, "/api/v1/orders", "key-1", `{"a":1}`))
+		w.WriteHeader(http.StatusCreated)
+	})
+	handler = m.Handle(next)
+
+	serve(handler, newIdempotentRequest(http.MethodPost, "/api/v1/orders", "key-1", `{"a":1}`))
+
+	if retry == nil || retry.Code != http.StatusConflict {
+		t.Fatalf("expected the concurrent retry to get %d, got %v", http.StatusConflict, retry)
+	}
+}
+
+func TestIdempotencyReleasesTheKeyOnServerErrors(t *testing.T) {
+	next := &countingHandler{status: http.StatusInternalServerError}
+	handler := middleware.NewIdempotencyMiddleware(newMemoryIdempotencyStore(), time.Hour).Handle(next)
+
+	serve(handler, newIdempotentRequest(http.MethodPost, "/api/v1/orders", "key-1", `{"a":1}`))
+	next.status = http.StatusCreated
+	rec := serve(handler, newIdempotentRequest(http.MethodPost, "/api/v1/orders", "key-1", `{"a":1}`))
+
+	if next.calls != 2 {
+		t.Errorf("expected the handler to run again after a server error, got %d calls", next.calls)
+	}
+	if rec.Code != http.StatusCreated {
+		t.Errorf("expected status %d, got %d", http.StatusCreated, rec.Code)
+	}
+}
+
+func TestIdempotencySkipsRequestsWithoutProtection(t *testing.T) {
+	testCases := []struct {
+		name   string
+		method string
+		key    string
+		store  error
+	}{
+		{name: "Without key", method: http.MethodPost, key: ""},
+		{name: "Not a POST", method: http.MethodPut, key: "key-1"},
+		{name: "Store unavailable", method: http.MethodPost, key: "key-1", store: errors.New("connection refused")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := newMemoryIdempotencyStore()
+			store.err = tc.store
+			next := &countingHandler{status: http.StatusOK}
+			handler := middleware.NewIdempotencyMiddleware(store, time.Hour).Handle(next)
+
+			serve(handler, newIdempotentRequest(tc.method, "/api/v1/orders", tc.key, `{"a":1}`))
+			serve(handler, newIdempotentRequest(tc.method, "/api/v1/orders", tc.key, `{"a":1}`))
+
+			if next.calls != 2 {
+				t.Errorf("expected the handler to run twice, got %d", next.calls)
+			}
+		})
+	}
+}
+
+func TestIdempotencyRejectsTooLongKeys(t *testing.T) {
+	next := &countingHandler{status: http.StatusCreated}
+	handler := middleware.NewIdempotencyMiddleware(newMemoryIdempotencyStore(), time.Hour).Handle(next)
+
+	rec := serve(handler, newIdempotentRequest(http.MethodPost, "/api/v1/orders", strings.Repeat("k", 256), `{"a":1}`))
+
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, rec.Code)
+	}
+	if next.calls != 0 {
+		t.Errorf("expected the handler not to run, got %d", next.calls)
+	}
+}
@@ -0,0 +1,245 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+)
+
+// fakeNotificationRepo guarda en memoria las plantillas, preferencias, dispositivos y notificaciones de la prueba,
+// los métodos no sobrescritos hacen panic si el servicio los usa
+type fakeNotificationRepo struct {
+	ports.NotificationRepository
+
+	user          *entities.User
+	templates     map[string]*entities.NotificationTemplate
+	created       []*entities.NotificationTemplate
+	preferences   map[string]*entities.NotificationPreference
+	seeded        []entities.NotificationPreference
+	devices       []*entities.NotificationDevice
+	notifications []*entities.Notification
+}
+
+func newFakeNotificationRepo(role string) *fakeNotificationRepo {
+	return &fakeNotificationRepo{
+		user: &entities.User{
+			ID:    "user-1",
+			Email: "ana@example.com",
+			Phone: "7777-0000",
+			Roles: []entities.UserRole{{Role: &entities.Role{Name: role}}},
+		},
+		templates:   map[string]*entities.NotificationTemplate{},
+		preferences: map[string]*entities.NotificationPreference{},
+	}
+}
+
+func (r *fakeNotificationRepo) GetRecipientUser(_ context.Context, userID string) (*entities.User, error) {
+	if userID != r.user.ID {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return r.user, nil
+}
+
+func (r *fakeNotificationRepo) GetActiveTemplateByType(_ context.Context, notificationType string) (*entities.NotificationTemplate, error) {
+	template, ok := r.templates[notificationType]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return template, nil
+}
+
+func (r *fakeNotificationRepo) CreateTemplate(_ context.Context, template *entities.NotificationTemplate) error {
+	r.created = append(r.created, template)
+	r.templates[template.Type] = template
+	return nil
+}
+
+func (r *fakeNotificationRepo) GetPreference(_ context.Context, _, notificationType string) (*entities.NotificationPreference, error) {
+	preference, ok := r.preferences[notificationType]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return preference, nil
+}
+
+func (r *fakeNotificationRepo) GetPreferences(_ context.Context, userID string) ([]entities.NotificationPreference, error) {
+	var preferences []entities.NotificationPreference
+	for _, preference := range r.preferences {
+		if preference.UserID == userID {
+			preferences = append(preferences, *preference)
+		}
+	}
+	return preferences, nil
+}
+
+func (r *fakeNotificationRepo) SeedPreferences(_ context.Context, preferences []entities.NotificationPreference) error {
+	r.seeded = append(r.seeded, preferences...)
+	for i := range preferences {
+		preference := preferences[i]
+		r.preferences[preference.NotificationType] = &preference
+	}
+	return nil
+}
+
+func (r *fakeNotificationRepo) SavePreferences(_ context.Context, preferences []entities.NotificationPreference) error {
+	for i := range preferences {
+		preference := preferences[i]
+		r.preferences[preference.NotificationType] = &preference
+	}
+	return nil
+}
+
+func (r *fakeNotificationRepo) GetDeviceByToken(_ context.Context, deviceToken string) (*entities.NotificationDevice, error) {
+	for _, device := range r.devices {
+		if device.DeviceToken == deviceToken {
+			found := *device
+			return &found, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (r *fakeNotificationRepo) GetUserDevice(_ context.Context, userID, deviceID string) (*entities.NotificationDevice, error) {
+	for _, device := range r.devices {
+		if device.ID == deviceID && device.UserID == userID && device.IsActive {
+			found := *device
+			return &found, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+// SaveDevice reemplaza el dispositivo con el mismo identificador o lo agrega, igual que Save de GORM
+func (r *fakeNotificationRepo) SaveDevice(_ context.Context, device *entities.NotificationDevice) error {
+	saved := *device
+	for i, existing := range r.devices {
+		if existing.ID == device.ID {
+			r.devices[i] = &saved
+			return nil
+		}
+	}
+	r.devices = append(r.devices, &saved)
+	return nil
+}
+
+func (r *fakeNotificationRepo) DeactivateUserDeviceToken(_ context.Context, userID, deviceToken string) error {
+	for _, device := range r.devices {
+		if device.UserID == userID && device.DeviceToken == deviceToken {
+			device.IsActive = false
+		}
+	}
+	return nil
+}
+
+func (r *fakeNotificationRepo) GetActiveDevices(_ context.Context, userID string) ([]entities.NotificationDevice, error) {
+	var devices []entities.NotificationDevice
+	for _, device := range r.devices {
+		if device.UserID == userID && device.IsActive {
+			devices = append(devices, *device)
+		}
+	}
+	return devices, nil
+}
+
+func (r *fakeNotificationRepo) DeactivateDevice(_ context.Context, deviceID string) error {
+	for _, device := range r.devices {
+		if device.ID == deviceID {
+			device.IsActive = false
+		}
+	}
+	return nil
+}
+
+func (r *fakeNotificationRepo) TouchDevice(_ context.Context, deviceID string, usedAt time.Time) error {
+	for _, device := range r.devices {
+		if device.ID == deviceID {
+			device.LastUsedAt = &usedAt
+		}
+	}
+	return nil
+}
+
+func (r *fakeNotificationRepo) CreateNotification(_ context.Context, notification *entities.Notification) error {
+	r.notifications = append(r.notifications, notification)
+	return nil
+}
+
+func (r *fakeNotificationRepo) SaveDeliveryResult(context.Context, *entities.Notification) error {
+	return nil
+}
+
+func (r *fakeNotificationRepo) GetUnreadCounts(_ context.Context, userID string) (map[string]int64, error) {
+	counts := map[string]int64{}
+	for _, notification := range r.notifications {
+		if notification.UserID == userID && !notification.IsRead {
+			counts[notification.Type]++
+		}
+	}
+	return counts, nil
+}
+
+// MarkAsRead marca la notificación del usuario, una ajena o inexistente no se encuentra igual que en el repositorio
+func (r *fakeNotificationRepo) MarkAsRead(_ context.Context, userID, notificationID string, readAt time.Time) error {
+	for _, notification := range r.notifications {
+		if notification.ID == notificationID && notification.UserID == userID {
+			if !notification.IsRead {
+				notification.IsRead = true
+				notification.ReadAt = &readAt
+			}
+			return nil
+		}
+	}
+	return gorm.ErrRecordNotFound
+}
+
+func (r *fakeNotificationRepo) MarkAllAsRead(_ context.Context, userID string, readAt time.Time) (int64, error) {
+	var updated int64
+	for _, notification := range r.notifications {
+		if notification.UserID == userID && !notification.IsRead {
+			notification.IsRead = true
+			notification.ReadAt = &readAt
+			updated++
+		}
+	}
+	return updated, nil
+}
+
+// fakeSender registra los mensajes enviados por cada canal, los tokens en unregistered se rechazan como lo haría
+// el proveedor push
+type fakeSender struct {
+	sent         []string
+	unregistered map[string]bool
+}
+
+func (s *fakeSender) SendEmail(_ context.Context, to, subject, body string) error {
+	s.sent = append(s.sent, fmt.Sprintf("email:%s:%s:%s", to, subject, body))
+	return nil
+}
+
+func (s *fakeSender) SendSMS(_ context.Context, phone, message string) error {
+	s.sent = append(s.sent, fmt.Sprintf("sms:%s:%s", phone, message))
+	return nil
+}
+
+func (s *fakeSender) SendPush(_ context.Context, deviceToken, title, _ string, _ map[string]string) error {
+	if s.unregistered[deviceToken] {
+		return fmt.Errorf("push rejected: %w", errPackage.ErrPushTokenUnregistered)
+	}
+	s.sent = append(s.sent, fmt.Sprintf("push:%s:%s", deviceToken, title))
+	return nil
+}
+
+// fakePublisher registra los eventos enviados a la bandeja del usuario
+type fakePublisher struct {
+	events []*entities.InboxEvent
+}
+
+func (p *fakePublisher) PublishInboxEvent(_ string, event *entities.InboxEvent) {
+	p.events = append(p.events, event)
+}
@@ -0,0 +1,161 @@
+package notification
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/services"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/adapters/realtime"
+	"github.com/MarlonG1/delivery-backend/test/unit/testutil"
+)
+
+// newInboxRepo crea una bandeja con dos notificaciones sin leer del usuario y una de otro usuario
+func newInboxRepo() *fakeNotificationRepo {
+	repo := newFakeNotificationRepo(constants.FinalUser)
+	repo.notifications = []*entities.Notification{
+		{ID: "n-1", UserID: "user-1", Type: constants.NotificationTypeOrderDelivered},
+		{ID: "n-2", UserID: "user-1", Type: constants.NotificationTypeOrderCancelled},
+		{ID: "n-3", UserID: "user-2", Type: constants.NotificationTypeOrderDelivered},
+	}
+	return repo
+}
+
+func TestMarkAsReadPublishesTheRemainingUnreadCount(t *testing.T) {
+	repo := newInboxRepo()
+	publisher := &fakePublisher{}
+	inbox := services.NewNotificationInboxService(repo, publisher)
+
+	if err := inbox.MarkAsRead(context.Background(), "user-1", "n-1"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if !repo.notifications[0].IsRead || repo.notifications[0].ReadAt == nil {
+		t.Error("expected the notification to be read")
+	}
+	if len(publisher.events) != 1 || publisher.events[0].Type != constants.InboxEventUnreadCount || publisher.events[0].UnreadCount != 1 {
+		t.Fatalf("expected the unread count to drop to 1, got %+v", publisher.events)
+	}
+
+	// Leer de nuevo la misma notificación no cambia el contador ni la fecha de lectura
+	readAt := *repo.notifications[0].ReadAt
+	if err := inbox.MarkAsRead(context.Background(), "user-1", "n-1"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !repo.notifications[0].ReadAt.Equal(readAt) || publisher.events[1].UnreadCount != 1 {
+		t.Errorf("expected an already read notification to stay the same, got count %d", publisher.events[1].UnreadCount)
+	}
+}
+
+func TestMarkAsReadRejectsNotificationsOfOtherUsers(t *testing.T) {
+	repo := newInboxRepo()
+	publisher := &fakePublisher{}
+
+	err := services.NewNotificationInboxService(repo, publisher).MarkAsRead(context.Background(), "user-1", "n-3")
+	if testutil.DomainCause(err) != errPackage.ErrNotificationNotFound {
+		t.Fatalf("expected %v, got %v", errPackage.ErrNotificationNotFound, err)
+	}
+
+	if repo.notifications[2].IsRead || len(publisher.events) != 0 {
+		t.Error("expected the notification of the other user to stay unread")
+	}
+}
+
+func TestMarkAllAsReadOnlyReadsTheUserNotifications(t *testing.T) {
+	repo := newInboxRepo()
+	publisher := &fakePublisher{}
+	inbox := services.NewNotificationInboxService(repo, publisher)
+
+	updated, err := inbox.MarkAllAsRead(context.Background(), "user-1")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if updated != 2 || repo.notifications[2].IsRead {
+		t.Fatalf("expected only the 2 notifications of the user to be read, got %d", updated)
+	}
+	if len(publisher.events) != 1 || publisher.events[0].UnreadCount != 0 {
+		t.Errorf("expected the unread count to drop to 0, got %+v", publisher.events)
+	}
+
+	// Sin notificaciones pendientes no se avisa a los clientes conectados
+	if updated, err = inbox.MarkAllAsRead(context.Background(), "user-1"); err != nil || updated != 0 {
+		t.Fatalf("expected nothing to update, got %d %v", updated, err)
+	}
+	if len(publisher.events) != 1 {
+		t.Errorf("expected no event when nothing changed, got %d events", len(publisher.events))
+	}
+}
+
+func TestGetUnreadCountsTotalsEveryType(t *testing.T) {
+	repo := newInboxRepo()
+	repo.notifications[1].IsRead = true
+	repo.notifications = append(repo.notifications, &entities.Notification{ID: "n-4", UserID: "user-1", Type: constants.NotificationTypeOrderDelivered})
+
+	counts, total, err := services.NewNotificationInboxService(repo, &fakePublisher{}).GetUnreadCounts(context.Background(), "user-1")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if total != 2 || counts[constants.NotificationTypeOrderDelivered] != 2 || counts[constants.NotificationTypeOrderCancelled] != 0 {
+		t.Errorf("expected 2 unread deliveries, got %v (total %d)", counts, total)
+	}
+}
+
+func TestInboxHubDeliversEventsToTheUserClients(t *testing.T) {
+	hub := realtime.NewInboxHub()
+	first, unsubscribeFirst := hub.Subscribe("user-1")
+	second, unsubscribeSecond := hub.Subscribe("user-1")
+	other, unsubscribeOther := hub.Subscribe("user-2")
+	defer unsubscribeSecond()
+	defer unsubscribeOther()
+
+	hub.PublishInboxEvent("user-1", &entities.InboxEvent{Type: constants.InboxEventUnreadCount, UnreadCount: 3})
+
+	for _, events := range []<-chan entities.InboxEvent{first, second} {
+		select {
+		case event := <-events:
+			if event.UnreadCount != 3 {
+				t.Errorf("expected the unread count, got %d", event.UnreadCount)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("expected every client of the user to receive the event")
+		}
+	}
+	select {
+	case <-other:
+		t.Error("expected the clients of other users not to receive the event")
+	default:
+	}
+
+	// Un cliente desconectado no vuelve a recibir eventos
+	unsubscribeFirst()
+	unsubscribeFirst()
+	if _, open := <-first; open {
+		t.Error("expected the channel of a disconnected client to be closed")
+	}
+	hub.PublishInboxEvent("user-1", &entities.InboxEvent{Type: constants.InboxEventUnreadCount})
+}
+
+func TestInboxHubDoesNotBlockOnSlowClients(t *testing.T) {
+	hub := realtime.NewInboxHub()
+	_, unsubscribe := hub.Subscribe("user-1")
+	defer unsubscribe()
+
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i < 100; i++ {
+			hub.PublishInboxEvent("user-1", &entities.InboxEvent{Type: constants.InboxEventUnreadCount})
+		}
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("expected the publisher not to block on a client that does not read")
+	}
+}
@@ -0,0 +1,191 @@
+package notification
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/services"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/test/unit/testutil"
+)
+
+func TestRegisterDeviceReusesAnExistingToken(t *testing.T) {
+	repo := newFakeNotificationRepo(constants.Driver)
+	repo.devices = []*entities.NotificationDevice{
+		{ID: "device-1", UserID: "user-2", DeviceToken: "token-1", DeviceType: constants.DeviceTypeAndroid, IsActive: false},
+	}
+	service := services.NewNotificationPreferenceService(repo)
+
+	device := &entities.NotificationDevice{UserID: "user-1", DeviceToken: " token-1 ", DeviceType: "ios"}
+	if err := service.RegisterDevice(context.Background(), device, ""); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	// El token se reasigna al nuevo usuario en lugar de duplicarse
+	if len(repo.devices) != 1 || device.ID != "device-1" {
+		t.Fatalf("expected the token to keep a single device, got %d devices", len(repo.devices))
+	}
+	saved := repo.devices[0]
+	if saved.UserID != "user-1" || saved.DeviceType != constants.DeviceTypeIOS || !saved.IsActive {
+		t.Errorf("expected the device to be active for the new user, got %+v", saved)
+	}
+}
+
+func TestRegisterDeviceDeactivatesTheRefreshedToken(t *testing.T) {
+	repo := newFakeNotificationRepo(constants.Driver)
+	repo.devices = []*entities.NotificationDevice{
+		{ID: "device-1", UserID: "user-1", DeviceToken: "token-old", DeviceType: constants.DeviceTypeAndroid, IsActive: true},
+	}
+	service := services.NewNotificationPreferenceService(repo)
+
+	device := &entities.NotificationDevice{UserID: "user-1", DeviceToken: "token-new", DeviceType: constants.DeviceTypeAndroid}
+	if err := service.RegisterDevice(context.Background(), device, "token-old"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	active, _ := repo.GetActiveDevices(context.Background(), "user-1")
+	if len(active) != 1 || active[0].DeviceToken != "token-new" || active[0].ID == "device-1" {
+		t.Errorf("expected only the refreshed token to stay active, got %+v", active)
+	}
+}
+
+func TestRegisterDeviceValidatesTheTokenAndType(t *testing.T) {
+	testCases := []struct {
+		name     string
+		device   *entities.NotificationDevice
+		expected error
+	}{
+		{"empty token", &entities.NotificationDevice{UserID: "user-1", DeviceToken: "  ", DeviceType: constants.DeviceTypeWeb}, errPackage.ErrInvalidDeviceToken},
+		{"unknown type", &entities.NotificationDevice{UserID: "user-1", DeviceToken: "token-1", DeviceType: "TV"}, errPackage.ErrInvalidDeviceType},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := newFakeNotificationRepo(constants.Driver)
+
+			err := services.NewNotificationPreferenceService(repo).RegisterDevice(context.Background(), tc.device, "")
+			if testutil.DomainCause(err) != tc.expected {
+				t.Fatalf("expected %v, got %v", tc.expected, err)
+			}
+			if len(repo.devices) != 0 {
+				t.Error("expected no device to be saved")
+			}
+		})
+	}
+}
+
+func TestDeregisterDeviceOnlyDeactivatesTheUserDevices(t *testing.T) {
+	repo := newFakeNotificationRepo(constants.Driver)
+	repo.devices = []*entities.NotificationDevice{
+		{ID: "device-1", UserID: "user-2", DeviceToken: "token-1", IsActive: true},
+	}
+	service := services.NewNotificationPreferenceService(repo)
+
+	err := service.DeregisterDevice(context.Background(), "user-1", "device-1")
+	if testutil.DomainCause(err) != errPackage.ErrNotificationDeviceNotFound {
+		t.Fatalf("expected %v, got %v", errPackage.ErrNotificationDeviceNotFound, err)
+	}
+	if !repo.devices[0].IsActive {
+		t.Fatal("expected the device of the other user to stay active")
+	}
+
+	if err = service.DeregisterDevice(context.Background(), "user-2", "device-1"); err != nil || repo.devices[0].IsActive {
+		t.Errorf("expected the owner to deactivate the device, got %v", err)
+	}
+}
+
+func TestGetPreferencesSeedsTheRoleDefaults(t *testing.T) {
+	repo := newFakeNotificationRepo(constants.Driver)
+	repo.preferences[constants.NotificationTypeOrderDelivered] = &entities.NotificationPreference{
+		UserID:           "user-1",
+		NotificationType: constants.NotificationTypeOrderDelivered,
+		EmailEnabled:     true,
+	}
+	service := services.NewNotificationPreferenceService(repo)
+
+	preferences, err := service.GetPreferences(context.Background(), "user-1", constants.Driver)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(preferences) != len(constants.NotificationTypes) || len(repo.seeded) != len(constants.NotificationTypes)-1 {
+		t.Fatalf("expected every type and only the missing ones seeded, got %d preferences and %d seeded", len(preferences), len(repo.seeded))
+	}
+	for _, preference := range preferences {
+		switch preference.NotificationType {
+		case constants.NotificationTypeOrderDelivered:
+			if !preference.EmailEnabled || preference.PushEnabled {
+				t.Errorf("expected the saved preference to be kept, got %+v", preference)
+			}
+		case constants.NotificationTypeDeliveryPIN:
+			if preference.EmailEnabled || !preference.PushEnabled || !preference.SMSEnabled {
+				t.Errorf("expected the PIN to be sent by push and SMS by default, got %+v", preference)
+			}
+		default:
+			if preference.EmailEnabled || !preference.PushEnabled || preference.SMSEnabled {
+				t.Errorf("expected the driver defaults for %s, got %+v", preference.NotificationType, preference)
+			}
+		}
+	}
+
+	// Las preferencias ya sembradas no se vuelven a guardar
+	if _, err = service.GetPreferences(context.Background(), "user-1", constants.Driver); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(repo.seeded) != len(constants.NotificationTypes)-1 {
+		t.Errorf("expected the defaults to be seeded once, got %d seeded", len(repo.seeded))
+	}
+}
+
+func TestUpdatePreferencesRejectsUnknownAndRepeatedTypes(t *testing.T) {
+	testCases := []struct {
+		name        string
+		preferences []entities.NotificationPreference
+	}{
+		{"unknown type", []entities.NotificationPreference{{NotificationType: "UNKNOWN"}}},
+		{"repeated type", []entities.NotificationPreference{
+			{NotificationType: constants.NotificationTypeOrderDelivered},
+			{NotificationType: constants.NotificationTypeOrderDelivered, SMSEnabled: true},
+		}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := newFakeNotificationRepo(constants.FinalUser)
+
+			_, err := services.NewNotificationPreferenceService(repo).UpdatePreferences(context.Background(), "user-1", constants.FinalUser, tc.preferences)
+			if testutil.DomainCause(err) != errPackage.ErrInvalidNotificationType {
+				t.Fatalf("expected %v, got %v", errPackage.ErrInvalidNotificationType, err)
+			}
+			if len(repo.preferences) != 0 {
+				t.Error("expected no preference to be saved")
+			}
+		})
+	}
+}
+
+func TestUpdatePreferencesKeepsTheOtherTypes(t *testing.T) {
+	repo := newFakeNotificationRepo(constants.FinalUser)
+	service := services.NewNotificationPreferenceService(repo)
+
+	preferences, err := service.UpdatePreferences(context.Background(), "user-1", constants.FinalUser, []entities.NotificationPreference{
+		{UserID: "user-2", NotificationType: constants.NotificationTypeOrderDelivered, SMSEnabled: true},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	for _, preference := range preferences {
+		if preference.UserID != "user-1" {
+			t.Fatalf("expected the preferences to belong to the user, got %s", preference.UserID)
+		}
+		if preference.NotificationType == constants.NotificationTypeOrderDelivered && (preference.EmailEnabled || !preference.SMSEnabled) {
+			t.Errorf("expected the updated channels, got %+v", preference)
+		}
+		if preference.NotificationType == constants.NotificationTypeOrderCancelled && (!preference.EmailEnabled || !preference.PushEnabled) {
+			t.Errorf("expected the other types to keep the role defaults, got %+v", preference)
+		}
+	}
+}
@@ -0,0 +1,203 @@
+package notification
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/services"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/test/unit/testutil"
+)
+
+func newNotificationService(repo *fakeNotificationRepo, sender *fakeSender, publisher *fakePublisher) interfaces.Notifier {
+	return services.NewNotificationService(repo, sender, sender, sender, publisher)
+}
+
+func TestNotifyRendersTheDefaultTemplateAndMasksSecrets(t *testing.T) {
+	repo := newFakeNotificationRepo(constants.FinalUser)
+	sender := &fakeSender{}
+
+	notification, err := newNotificationService(repo, sender, &fakePublisher{}).Notify(context.Background(), &entities.NotificationRequest{
+		UserID:  "user-1",
+		Type:    constants.NotificationTypeDeliveryPIN,
+		Data:    map[string]string{"tracking_number": "DEL1", "recipient_name": "Ana"},
+		Secrets: map[string]string{"pin": "482913"},
+		Phone:   "7000-1111",
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	// La plantilla por defecto se guarda la primera vez que se usa el tipo
+	if len(repo.created) != 1 || notification.TemplateID != repo.created[0].ID {
+		t.Fatalf("expected the default template to be created and used, got %d templates", len(repo.created))
+	}
+	if notification.Title != "PIN de entrega del pedido DEL1" {
+		t.Errorf("expected the rendered title, got %q", notification.Title)
+	}
+	if strings.Contains(notification.Content, "482913") || !strings.Contains(notification.Content, constants.NotificationSecretMask) {
+		t.Errorf("expected the stored content to mask the PIN, got %q", notification.Content)
+	}
+
+	// El SMS se envía al teléfono de la solicitud con el PIN sin enmascarar
+	var sms string
+	for _, sent := range sender.sent {
+		if strings.HasPrefix(sent, "sms:") {
+			sms = sent
+		}
+	}
+	if !strings.HasPrefix(sms, "sms:7000-1111:") || !strings.Contains(sms, "482913") {
+		t.Errorf("expected the PIN by SMS to the requested phone, got %q", sms)
+	}
+}
+
+func TestNotifyUsesTheSavedTemplate(t *testing.T) {
+	repo := newFakeNotificationRepo(constants.FinalUser)
+	repo.templates[constants.NotificationTypeOrderDelivered] = &entities.NotificationTemplate{
+		ID:              "template-1",
+		Type:            constants.NotificationTypeOrderDelivered,
+		TitleTemplate:   "Entregado {{.tracking_number}}",
+		ContentTemplate: "Hola {{.recipient_name}}{{.missing}}",
+	}
+
+	notification, err := newNotificationService(repo, &fakeSender{}, &fakePublisher{}).Notify(context.Background(), &entities.NotificationRequest{
+		UserID: "user-1",
+		Type:   constants.NotificationTypeOrderDelivered,
+		Data:   map[string]string{"tracking_number": "DEL1", "recipient_name": "Ana"},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(repo.created) != 0 || notification.TemplateID != "template-1" {
+		t.Errorf("expected the saved template to be used, got %s", notification.TemplateID)
+	}
+	if notification.Title != "Entregado DEL1" || notification.Content != "Hola Ana" {
+		t.Errorf("expected the missing variables to render empty, got %q %q", notification.Title, notification.Content)
+	}
+}
+
+func TestNotifyRejectsTemplatesThatCannotBeRendered(t *testing.T) {
+	repo := newFakeNotificationRepo(constants.FinalUser)
+	repo.templates[constants.NotificationTypeOrderDelivered] = &entities.NotificationTemplate{
+		Type:            constants.NotificationTypeOrderDelivered,
+		TitleTemplate:   "Entregado {{.tracking_number",
+		ContentTemplate: "Hola",
+	}
+	service := newNotificationService(repo, &fakeSender{}, &fakePublisher{})
+
+	_, err := service.Notify(context.Background(), &entities.NotificationRequest{UserID: "user-1", Type: constants.NotificationTypeOrderDelivered})
+	if testutil.DomainCause(err) != errPackage.ErrInvalidNotificationTemplate {
+		t.Errorf("expected %v, got %v", errPackage.ErrInvalidNotificationTemplate, err)
+	}
+
+	_, err = service.Notify(context.Background(), &entities.NotificationRequest{UserID: "user-1", Type: "UNKNOWN"})
+	if testutil.DomainCause(err) != errPackage.ErrNotificationTemplateNotFound {
+		t.Errorf("expected %v, got %v", errPackage.ErrNotificationTemplateNotFound, err)
+	}
+	if len(repo.notifications) != 0 {
+		t.Error("expected no notification to be saved")
+	}
+}
+
+func TestNotifyResolvesTheChannelsFromThePreferences(t *testing.T) {
+	testCases := []struct {
+		name       string
+		role       string
+		preference *entities.NotificationPreference
+		expected   string
+	}{
+		{"saved preference", constants.FinalUser, &entities.NotificationPreference{SMSEnabled: true, PushEnabled: true}, "SMS,PUSH"},
+		{"driver defaults", constants.Driver, nil, "PUSH"},
+		{"admin defaults", constants.AdminRole, nil, "EMAIL"},
+		{"unknown role defaults", "", nil, "EMAIL,PUSH"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := newFakeNotificationRepo(tc.role)
+			if tc.preference != nil {
+				repo.preferences[constants.NotificationTypeOrderDelivered] = tc.preference
+			}
+
+			notification, err := newNotificationService(repo, &fakeSender{}, &fakePublisher{}).Notify(context.Background(), &entities.NotificationRequest{
+				UserID: "user-1",
+				Type:   constants.NotificationTypeOrderDelivered,
+			})
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			if notification.Channels != tc.expected {
+				t.Errorf("expected channels %s, got %s", tc.expected, notification.Channels)
+			}
+		})
+	}
+}
+
+func TestNotifyRecordsTheResultOfEachChannel(t *testing.T) {
+	repo := newFakeNotificationRepo(constants.FinalUser)
+	repo.devices = []*entities.NotificationDevice{
+		{ID: "device-1", UserID: "user-1", DeviceToken: "token-ok", IsActive: true},
+		{ID: "device-2", UserID: "user-1", DeviceToken: "token-stale", IsActive: true},
+	}
+	sender := &fakeSender{unregistered: map[string]bool{"token-stale": true}}
+	publisher := &fakePublisher{}
+
+	notification, err := newNotificationService(repo, sender, publisher).Notify(context.Background(), &entities.NotificationRequest{
+		UserID: "user-1",
+		Type:   constants.NotificationTypeOrderDelivered,
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	statuses := map[string]string{}
+	for _, delivery := range notification.Deliveries {
+		statuses[delivery.Channel+":"+delivery.Recipient] = delivery.Status
+	}
+	expected := map[string]string{
+		"EMAIL:ana@example.com": constants.NotificationStatusSent,
+		"PUSH:token-ok":         constants.NotificationStatusSent,
+		"PUSH:token-stale":      constants.NotificationStatusFailed,
+	}
+	for key, status := range expected {
+		if statuses[key] != status {
+			t.Errorf("expected %s to be %s, got %q", key, status, statuses[key])
+		}
+	}
+
+	if notification.Status != constants.NotificationStatusPartial || notification.SentAt == nil {
+		t.Errorf("expected the notification to be partially sent, got %s", notification.Status)
+	}
+	// El proveedor ya no acepta el token, el dispositivo deja de recibir notificaciones
+	if repo.devices[1].IsActive || repo.devices[0].LastUsedAt == nil {
+		t.Error("expected the stale device to be deactivated and the other one touched")
+	}
+	if len(publisher.events) != 1 || publisher.events[0].UnreadCount != 1 {
+		t.Errorf("expected the inbox to receive the notification with its unread count, got %v", publisher.events)
+	}
+}
+
+func TestNotifySkipsChannelsWithoutRecipient(t *testing.T) {
+	repo := newFakeNotificationRepo(constants.Driver)
+
+	notification, err := newNotificationService(repo, &fakeSender{}, &fakePublisher{}).Notify(context.Background(), &entities.NotificationRequest{
+		UserID: "user-1",
+		Type:   constants.NotificationTypeOrderDelivered,
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(notification.Deliveries) != 1 || notification.Deliveries[0].FailureReason != errPackage.ErrNoRecipientAddress.Error() {
+		t.Fatalf("expected the push channel to be skipped without devices, got %+v", notification.Deliveries)
+	}
+	if notification.Status != constants.NotificationStatusSkipped || notification.SentAt != nil {
+		t.Errorf("expected the notification to be skipped, got %s", notification.Status)
+	}
+}
@@ -0,0 +1,52 @@
+package notification
+
+import (
+	"testing"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/value_objects"
+)
+
+// reachableStatuses recorre las transiciones del pedido desde PENDING y devuelve los estados alcanzables
+func reachableStatuses() map[string]bool {
+	reached := map[string]bool{constants.OrderStatusPending: true}
+	pending := []string{constants.OrderStatusPending}
+	for len(pending) > 0 {
+		status := pending[0]
+		pending = pending[1:]
+		for _, next := range value_objects.NewOrderStatus(status).NextStatuses() {
+			if !reached[next] {
+				reached[next] = true
+				pending = append(pending, next)
+			}
+		}
+	}
+	return reached
+}
+
+func TestEveryOrderEventStatusIsReachable(t *testing.T) {
+	reached := reachableStatuses()
+	for status, event := range constants.OrderEventsByStatus {
+		if !reached[status] {
+			t.Errorf("event %s is mapped to status %s, which no order can reach", event, status)
+		}
+	}
+}
+
+func TestLostIsReachableFromEveryStatusOnTheRoad(t *testing.T) {
+	lost := value_objects.NewOrderStatus(constants.OrderStatusLost)
+	for _, status := range []string{
+		constants.OrderStatusPickedUp,
+		constants.OrderStatusInWarehouse,
+		constants.OrderStatusInTransit,
+		constants.OrderStatusFailed,
+	} {
+		if !value_objects.NewOrderStatus(status).CanTransitionTo(lost) {
+			t.Errorf("expected %s to transition to %s", status, constants.OrderStatusLost)
+		}
+	}
+
+	if len(lost.NextStatuses()) != 0 {
+		t.Errorf("expected %s to be a final status, got %v", constants.OrderStatusLost, lost.NextStatuses())
+	}
+}
@@ -0,0 +1,62 @@
+package order
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/test/unit/testutil"
+)
+
+func TestChangeStatusRejectsDeliveredSoEarningsAreNotSkipped(t *testing.T) {
+	repo := &fakeOrderRepo{order: newInTransitOrder()}
+	earner := &fakeEarner{}
+	service := newOrderService(repo, earner)
+
+	err := service.ChangeStatus(context.Background(), repo.order.ID, constants.OrderStatusDelivered)
+	if testutil.DomainCause(err) != errPackage.ErrDeliveryRequiresDeliverFlow {
+		t.Fatalf("expected %v, got %v", errPackage.ErrDeliveryRequiresDeliverFlow, err)
+	}
+
+	if len(repo.statusChanges) != 0 {
+		t.Errorf("expected the status not to change, got %v", repo.statusChanges)
+	}
+	if earner.calls != 0 {
+		t.Errorf("expected no earning to be calculated, got %d", earner.calls)
+	}
+}
+
+func TestChangeStatusRejectsDeliveryFailedSoAttemptsAreRecorded(t *testing.T) {
+	repo := &fakeOrderRepo{order: newInTransitOrder()}
+	service := newOrderService(repo, &fakeEarner{})
+
+	err := service.ChangeStatus(context.Background(), repo.order.ID, constants.OrderStatusFailed)
+	if testutil.DomainCause(err) != errPackage.ErrFailureRequiresAttemptFlow {
+		t.Fatalf("expected %v, got %v", errPackage.ErrFailureRequiresAttemptFlow, err)
+	}
+
+	if len(repo.statusChanges) != 0 {
+		t.Errorf("expected the status not to change, got %v", repo.statusChanges)
+	}
+}
+
+func TestDeliverOrderRecordsTheDriverEarning(t *testing.T) {
+	repo := &fakeOrderRepo{order: newInTransitOrder()}
+	earner := &fakeEarner{}
+	service := newOrderService(repo, earner)
+
+	if err := service.DeliverOrder(context.Background(), repo.order.ID, *repo.order.DriverID, "", nil); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if !repo.delivered {
+		t.Fatal("expected the order to be marked as delivered")
+	}
+	if earner.calls != 1 {
+		t.Errorf("expected the earning to be calculated once, got %d", earner.calls)
+	}
+	if repo.earning == nil || repo.earning.DriverID != *repo.order.DriverID {
+		t.Errorf("expected the earning of the driver to be saved with the delivery, got %+v", repo.earning)
+	}
+}
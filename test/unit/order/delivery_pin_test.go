@@ -0,0 +1,143 @@
+package order
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/value_objects"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/test/unit/testutil"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestGenerateDeliveryPIN(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		pin, err := value_objects.GenerateDeliveryPIN()
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if !pin.IsValid() {
+			t.Fatalf("expected a valid 6 digit pin, got %q", pin.GetValue())
+		}
+	}
+}
+
+func TestDeliveryPINIsValid(t *testing.T) {
+	testCases := []struct {
+		name     string
+		pin      string
+		expected bool
+	}{
+		{name: "Six digits", pin: "012345", expected: true},
+		{name: "Six digits with spaces", pin: " 012345 ", expected: true},
+		{name: "Five digits", pin: "12345", expected: false},
+		{name: "Seven digits", pin: "1234567", expected: false},
+		{name: "Letters", pin: "12a456", expected: false},
+		{name: "Empty", pin: "", expected: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := value_objects.NewDeliveryPIN(tc.pin).IsValid(); got != tc.expected {
+				t.Errorf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestDeliverOrderVerifiesThePIN(t *testing.T) {
+	testCases := []struct {
+		name      string
+		pin       string
+		locked    bool
+		expected  error
+		delivered bool
+	}{
+		{
+			name:      "Correct pin delivers the order",
+			pin:       "123456",
+			expected:  nil,
+			delivered: true,
+		},
+		{
+			name:     "Wrong pin is rejected",
+			pin:      "654321",
+			expected: errPackage.ErrInvalidDeliveryPIN,
+		},
+		{
+			name:     "Malformed pin is rejected",
+			pin:      "12345",
+			expected: errPackage.ErrInvalidDeliveryPIN,
+		},
+		{
+			name:     "Locked pin rejects even the correct pin",
+			pin:      "123456",
+			locked:   true,
+			expected: errPackage.ErrDeliveryPINLocked,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := &fakeOrderRepo{order: newPINOrder(), pin: newDeliveryPIN(t, "123456")}
+			repo.pin.IsLocked = tc.locked
+			service := newOrderService(repo, &fakeEarner{})
+
+			err := service.DeliverOrder(context.Background(), repo.order.ID, *repo.order.DriverID, tc.pin, nil)
+			if testutil.DomainCause(err) != tc.expected {
+				t.Fatalf("expected %v, got %v", tc.expected, err)
+			}
+
+			if repo.delivered != tc.delivered {
+				t.Errorf("expected delivered %v, got %v", tc.delivered, repo.delivered)
+			}
+		})
+	}
+}
+
+func TestDeliveryPINLocksAfterMaxAttempts(t *testing.T) {
+	repo := &fakeOrderRepo{order: newPINOrder(), pin: newDeliveryPIN(t, "123456")}
+	service := newOrderService(repo, &fakeEarner{})
+	ctx := context.Background()
+
+	for attempt := 1; attempt < constants.MaxDeliveryPINAttempts; attempt++ {
+		err := service.DeliverOrder(ctx, repo.order.ID, *repo.order.DriverID, "000000", nil)
+		if testutil.DomainCause(err) != errPackage.ErrInvalidDeliveryPIN {
+			t.Fatalf("attempt %d: expected %v, got %v", attempt, errPackage.ErrInvalidDeliveryPIN, err)
+		}
+	}
+
+	err := service.DeliverOrder(ctx, repo.order.ID, *repo.order.DriverID, "000000", nil)
+	if testutil.DomainCause(err) != errPackage.ErrDeliveryPINLocked {
+		t.Fatalf("expected the last attempt to lock the pin, got %v", err)
+	}
+
+	err = service.DeliverOrder(ctx, repo.order.ID, *repo.order.DriverID, "123456", nil)
+	if testutil.DomainCause(err) != errPackage.ErrDeliveryPINLocked {
+		t.Fatalf("expected the correct pin to be rejected once locked, got %v", err)
+	}
+
+	if repo.pin.FailedAttempts != constants.MaxDeliveryPINAttempts {
+		t.Errorf("expected %d failed attempts, got %d", constants.MaxDeliveryPINAttempts, repo.pin.FailedAttempts)
+	}
+	if repo.delivered {
+		t.Error("expected the order not to be delivered")
+	}
+}
+
+func newPINOrder() *entities.Order {
+	order := newInTransitOrder()
+	order.Detail.RequiresDeliveryPIN = true
+	return order
+}
+
+func newDeliveryPIN(t *testing.T, pin string) *entities.DeliveryPIN {
+	hash, err := bcrypt.GenerateFromPassword([]byte(pin), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("failed to hash pin: %v", err)
+	}
+	return &entities.DeliveryPIN{PINHash: string(hash)}
+}
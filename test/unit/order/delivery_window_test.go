@@ -0,0 +1,182 @@
+package order
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/value_objects"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/test/unit/testutil"
+)
+
+const branchOperatingHours = `{"weekdays":{"start":"08:00","end":"18:00"},"weekends":{"start":"10:00","end":"14:00"}}`
+
+func TestOperatingHoursNextOpening(t *testing.T) {
+	operatingHours, err := value_objects.NewOperatingHoursFromJSON(branchOperatingHours)
+	if err != nil {
+		t.Fatalf("failed to parse operating hours: %v", err)
+	}
+
+	// 2025-01-06 es lunes
+	testCases := []struct {
+		name     string
+		from     time.Time
+		expected time.Time
+	}{
+		{
+			name:     "Before opening opens the same day",
+			from:     time.Date(2025, 1, 6, 6, 30, 0, 0, time.UTC),
+			expected: time.Date(2025, 1, 6, 8, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "During opening hours opens the next day",
+			from:     time.Date(2025, 1, 6, 12, 0, 0, 0, time.UTC),
+			expected: time.Date(2025, 1, 7, 8, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "Exactly at opening opens the next day",
+			from:     time.Date(2025, 1, 6, 8, 0, 0, 0, time.UTC),
+			expected: time.Date(2025, 1, 7, 8, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "Friday evening opens Saturday with weekend hours",
+			from:     time.Date(2025, 1, 10, 19, 0, 0, 0, time.UTC),
+			expected: time.Date(2025, 1, 11, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "Sunday afternoon opens Monday with weekday hours",
+			from:     time.Date(2025, 1, 12, 15, 0, 0, 0, time.UTC),
+			expected: time.Date(2025, 1, 13, 8, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := operatingHours.NextOpening(tc.from)
+			if !ok {
+				t.Fatal("expected an opening to be found")
+			}
+			if !got.Equal(tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestRegisterDeliveryAttemptSchedulesNextWindow(t *testing.T) {
+	testCases := []struct {
+		name           string
+		operatingHours string
+	}{
+		{name: "Branch with operating hours", operatingHours: branchOperatingHours},
+		{name: "Branch without operating hours", operatingHours: ""},
+		{name: "Branch with invalid operating hours", operatingHours: `{"weekdays":{"start":"18:00","end":"08:00"}}`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			order := newInTransitOrder()
+			order.Branch = &entities.Branch{OperatingHours: tc.operatingHours}
+			repo := &fakeOrderRepo{order: order}
+			service := newOrderService(repo, &fakeEarner{})
+
+			attempt, err := service.RegisterDeliveryAttempt(context.Background(), order.ID, *order.DriverID, constants.DeliveryFailureRecipientAbsent, "")
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			if attempt.NextAttemptAt == nil {
+				t.Fatal("expected the next attempt to be scheduled")
+			}
+
+			expected := attempt.AttemptedAt.AddDate(0, 0, 1)
+			if tc.operatingHours == branchOperatingHours {
+				operatingHours, _ := value_objects.NewOperatingHoursFromJSON(branchOperatingHours)
+				expected, _ = operatingHours.NextOpening(attempt.AttemptedAt)
+			}
+			if !attempt.NextAttemptAt.Equal(expected) {
+				t.Errorf("expected next attempt at %v, got %v", expected, *attempt.NextAttemptAt)
+			}
+		})
+	}
+}
+
+func TestRegisterDeliveryAttemptDoesNotScheduleTheLastAttempt(t *testing.T) {
+	order := newInTransitOrder()
+	order.DeliveryAttempts = make([]entities.DeliveryAttempt, constants.DefaultMaxDeliveryAttempts-1)
+	repo := &fakeOrderRepo{order: order}
+	service := newOrderService(repo, &fakeEarner{})
+
+	attempt, err := service.RegisterDeliveryAttempt(context.Background(), order.ID, *order.DriverID, constants.DeliveryFailureRecipientAbsent, "")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if attempt.NextAttemptAt != nil {
+		t.Errorf("expected the last attempt not to be scheduled, got %v", *attempt.NextAttemptAt)
+	}
+}
+
+func TestRegisterDeliveryAttemptReportsAConcurrentStatusChange(t *testing.T) {
+	order := newInTransitOrder()
+	repo := &fakeOrderRepo{order: order, attemptErr: errPackage.ErrOrderAttemptConflict}
+	service := newOrderService(repo, &fakeEarner{})
+
+	_, err := service.RegisterDeliveryAttempt(context.Background(), order.ID, *order.DriverID, constants.DeliveryFailureRecipientAbsent, "")
+	if testutil.DomainCause(err) != errPackage.ErrOrderAttemptConflict {
+		t.Fatalf("expected %v, got %v", errPackage.ErrOrderAttemptConflict, err)
+	}
+}
+
+func TestRescheduleDueDeliveriesExtendsTheDeadline(t *testing.T) {
+	nextAttemptAt := time.Now().Add(-time.Minute)
+	windowDeadline := nextAttemptAt.Add(constants.RedeliveryDeadlineWindow)
+
+	testCases := []struct {
+		name     string
+		deadline time.Time
+		expected time.Time
+	}{
+		{
+			name:     "Past deadline is moved to the end of the window",
+			deadline: nextAttemptAt.Add(-24 * time.Hour),
+			expected: windowDeadline,
+		},
+		{
+			name:     "Later deadline is kept",
+			deadline: windowDeadline.Add(24 * time.Hour),
+			expected: windowDeadline.Add(24 * time.Hour),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			order := newInTransitOrder()
+			order.Status = constants.OrderStatusFailed
+			order.Detail.DeliveryDeadline = tc.deadline
+			repo := &fakeOrderRepo{
+				order:       order,
+				dueAttempts: []entities.DeliveryAttempt{{OrderID: order.ID, AttemptNumber: 1, NextAttemptAt: &nextAttemptAt}},
+			}
+			service := newOrderService(repo, &fakeEarner{})
+
+			rescheduled, err := service.RescheduleDueDeliveries(context.Background())
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			if rescheduled != 1 {
+				t.Fatalf("expected 1 rescheduled order, got %d", rescheduled)
+			}
+			if got := repo.deadlines[order.ID]; !got.Equal(tc.expected) {
+				t.Errorf("expected deadline %v, got %v", tc.expected, got)
+			}
+			if order.Status != constants.OrderStatusInTransit {
+				t.Errorf("expected the order to be back in transit, got %s", order.Status)
+			}
+		})
+	}
+}
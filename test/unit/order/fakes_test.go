@@ -0,0 +1,134 @@
+package order
+
+import (
+	"context"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/services"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+)
+
+// fakeOrderRepo guarda en memoria el pedido de la prueba y registra las escrituras que recibe,
+// los métodos no sobrescritos hacen panic si el servicio los usa
+type fakeOrderRepo struct {
+	ports.OrdererRepository
+
+	order         *entities.Order
+	statusChanges []string
+	delivered     bool
+	collection    *entities.CashLedgerEntry
+	earning       *entities.DriverEarning
+	pin           *entities.DeliveryPIN
+	attempts      []*entities.DeliveryAttempt
+	attemptErr    error
+	dueAttempts   []entities.DeliveryAttempt
+	deadlines     map[string]time.Time
+	parcelStatus  string
+	derivedStatus string
+}
+
+func (r *fakeOrderRepo) GetOrderByID(_ context.Context, _ string) (*entities.Order, error) {
+	return r.order, nil
+}
+
+func (r *fakeOrderRepo) RestoreOrder(_ context.Context, _ string) error {
+	r.order.DeletedAt = nil
+	return nil
+}
+
+func (r *fakeOrderRepo) ChangeStatus(_ context.Context, _ string, status string, _ *entities.SystemEvent) error {
+	r.statusChanges = append(r.statusChanges, status)
+	r.order.Status = status
+	return nil
+}
+
+func (r *fakeOrderRepo) MarkOrderDelivered(_ context.Context, _ string, collection *entities.CashLedgerEntry, earning *entities.DriverEarning, _ *entities.SystemEvent) error {
+	r.delivered = true
+	r.collection = collection
+	r.earning = earning
+	return nil
+}
+
+func (r *fakeOrderRepo) GetDeliveryPIN(_ context.Context, _ string) (*entities.DeliveryPIN, error) {
+	return r.pin, nil
+}
+
+// RegisterFailedPINAttempt suma el intento fallido y bloquea el PIN al llegar al máximo, igual que el repositorio
+func (r *fakeOrderRepo) RegisterFailedPINAttempt(_ context.Context, _ string, maxAttempts int) (*entities.DeliveryPIN, error) {
+	r.pin.FailedAttempts++
+	if r.pin.FailedAttempts >= maxAttempts {
+		r.pin.IsLocked = true
+	}
+	return r.pin, nil
+}
+
+func (r *fakeOrderRepo) RegisterDeliveryAttempt(_ context.Context, attempt *entities.DeliveryAttempt, _ bool, _ *entities.SystemEvent) error {
+	if r.attemptErr != nil {
+		return r.attemptErr
+	}
+	r.attempts = append(r.attempts, attempt)
+	return nil
+}
+
+func (r *fakeOrderRepo) GetDueRedeliveries(_ context.Context, _ time.Time, _ int) ([]entities.DeliveryAttempt, error) {
+	return r.dueAttempts, nil
+}
+
+func (r *fakeOrderRepo) RescheduleDelivery(_ context.Context, orderID string, deadline time.Time, _ *entities.SystemEvent) error {
+	if r.deadlines == nil {
+		r.deadlines = map[string]time.Time{}
+	}
+	r.deadlines[orderID] = deadline
+	r.order.Status = constants.OrderStatusInTransit
+	return nil
+}
+
+func (r *fakeOrderRepo) ChangeParcelStatus(_ context.Context, _ *entities.Parcel, status, orderStatus string, _ *entities.SystemEvent) error {
+	r.parcelStatus = status
+	r.derivedStatus = orderStatus
+	return nil
+}
+
+// fakeEarner devuelve una ganancia fija y cuenta cuántas veces se calculó
+type fakeEarner struct {
+	interfaces.DriverEarner
+
+	calls int
+}
+
+func (e *fakeEarner) CalculateDeliveryEarning(_ context.Context, order *entities.Order) (*entities.DriverEarning, error) {
+	e.calls++
+	return &entities.DriverEarning{DriverID: *order.DriverID, OrderID: &order.ID, Amount: 3.5}, nil
+}
+
+// fakePayments devuelve el pago indicado del pedido o ErrPaymentNotFound si no tiene
+type fakePayments struct {
+	interfaces.PaymentProcessor
+
+	payment *entities.OrderPayment
+}
+
+func (p *fakePayments) GetOrderPayment(_ context.Context, _ string) (*entities.OrderPayment, error) {
+	if p.payment == nil {
+		return nil, errPackage.NewDomainErrorWithCause("fakePayments", "GetOrderPayment", "payment not found", errPackage.ErrPaymentNotFound)
+	}
+	return p.payment, nil
+}
+
+func newOrderService(repo *fakeOrderRepo, earner *fakeEarner) interfaces.Orderer {
+	return services.NewOrderService(repo, nil, nil, nil, earner, nil)
+}
+
+func newInTransitOrder() *entities.Order {
+	driverID := "d0000000-0000-0000-0000-000000000001"
+	return &entities.Order{
+		ID:       "o0000000-0000-0000-0000-000000000001",
+		Status:   "IN_TRANSIT",
+		DriverID: &driverID,
+		Detail:   &entities.Details{OrderID: "o0000000-0000-0000-0000-000000000001"},
+	}
+}
@@ -0,0 +1,154 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/test/unit/testutil"
+)
+
+func TestChangeParcelStatusDerivesTheOrderStatus(t *testing.T) {
+	testCases := []struct {
+		name          string
+		orderStatus   string
+		parcels       []string
+		scanned       int
+		status        string
+		expectedOrder string
+	}{
+		{
+			name:          "Single parcel moves the order",
+			orderStatus:   constants.OrderStatusAccepted,
+			parcels:       []string{constants.OrderStatusPending},
+			status:        constants.OrderStatusPickedUp,
+			expectedOrder: constants.OrderStatusPickedUp,
+		},
+		{
+			name:          "Order waits for the least advanced parcel",
+			orderStatus:   constants.OrderStatusAccepted,
+			parcels:       []string{constants.OrderStatusPending, constants.OrderStatusPending},
+			status:        constants.OrderStatusPickedUp,
+			expectedOrder: "",
+		},
+		{
+			name:          "Last parcel picked up moves the order",
+			orderStatus:   constants.OrderStatusAccepted,
+			parcels:       []string{constants.OrderStatusPending, constants.OrderStatusPickedUp},
+			status:        constants.OrderStatusPickedUp,
+			expectedOrder: constants.OrderStatusPickedUp,
+		},
+		{
+			name:          "Order follows the least advanced parcel into the warehouse",
+			orderStatus:   constants.OrderStatusPickedUp,
+			parcels:       []string{constants.OrderStatusPickedUp, constants.OrderStatusInWarehouse},
+			status:        constants.OrderStatusInTransit,
+			expectedOrder: constants.OrderStatusInWarehouse,
+		},
+		{
+			name:          "Order stays while a parcel is still picked up",
+			orderStatus:   constants.OrderStatusPickedUp,
+			parcels:       []string{constants.OrderStatusPickedUp, constants.OrderStatusPickedUp},
+			status:        constants.OrderStatusInTransit,
+			expectedOrder: "",
+		},
+		{
+			name:          "Every parcel in transit moves the order in transit",
+			orderStatus:   constants.OrderStatusInWarehouse,
+			parcels:       []string{constants.OrderStatusInWarehouse, constants.OrderStatusInTransit},
+			status:        constants.OrderStatusInTransit,
+			expectedOrder: constants.OrderStatusInTransit,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			order := newParcelOrder(tc.orderStatus, tc.parcels...)
+			repo := &fakeOrderRepo{order: order}
+			service := newOrderService(repo, &fakeEarner{})
+
+			if err := service.ChangeParcelStatus(context.Background(), &order.Parcels[tc.scanned], tc.status); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			if repo.parcelStatus != tc.status {
+				t.Errorf("expected parcel status %s, got %s", tc.status, repo.parcelStatus)
+			}
+			if repo.derivedStatus != tc.expectedOrder {
+				t.Errorf("expected derived order status %q, got %q", tc.expectedOrder, repo.derivedStatus)
+			}
+		})
+	}
+}
+
+func TestChangeParcelStatusRejectsInvalidScans(t *testing.T) {
+	testCases := []struct {
+		name        string
+		orderStatus string
+		parcel      string
+		status      string
+		expected    error
+	}{
+		{
+			name:        "Order in transit does not allow scans",
+			orderStatus: constants.OrderStatusInTransit,
+			parcel:      constants.OrderStatusInTransit,
+			status:      constants.OrderStatusDelivered,
+			expected:    errPackage.ErrParcelScanNotAllowed,
+		},
+		{
+			name:        "Pending order does not allow scans",
+			orderStatus: constants.OrderStatusPending,
+			parcel:      constants.OrderStatusPending,
+			status:      constants.OrderStatusPickedUp,
+			expected:    errPackage.ErrParcelScanNotAllowed,
+		},
+		{
+			name:        "Parcel cannot skip the pickup",
+			orderStatus: constants.OrderStatusAccepted,
+			parcel:      constants.OrderStatusPending,
+			status:      constants.OrderStatusInTransit,
+		},
+		{
+			name:        "Parcel cannot be delivered by scan",
+			orderStatus: constants.OrderStatusInWarehouse,
+			parcel:      constants.OrderStatusInWarehouse,
+			status:      constants.OrderStatusDelivered,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			order := newParcelOrder(tc.orderStatus, tc.parcel)
+			repo := &fakeOrderRepo{order: order}
+			service := newOrderService(repo, &fakeEarner{})
+
+			err := service.ChangeParcelStatus(context.Background(), &order.Parcels[0], tc.status)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if tc.expected != nil && testutil.DomainCause(err) != tc.expected {
+				t.Errorf("expected %v, got %v", tc.expected, err)
+			}
+			if repo.parcelStatus != "" {
+				t.Errorf("expected the parcel not to change, got %s", repo.parcelStatus)
+			}
+		})
+	}
+}
+
+func newParcelOrder(status string, parcelStatuses ...string) *entities.Order {
+	order := newInTransitOrder()
+	order.Status = status
+	for i, parcelStatus := range parcelStatuses {
+		order.Parcels = append(order.Parcels, entities.Parcel{
+			ID:      fmt.Sprintf("parcel-%d", i+1),
+			OrderID: order.ID,
+			Status:  parcelStatus,
+		})
+	}
+	return order
+}
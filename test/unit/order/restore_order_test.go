@@ -0,0 +1,65 @@
+package order
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/services"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/test/unit/testutil"
+)
+
+func TestRestoreOrderWithPayment(t *testing.T) {
+	testCases := []struct {
+		name     string
+		payment  *entities.OrderPayment
+		expected error
+	}{
+		{
+			name:     "Order without payment is restored",
+			payment:  nil,
+			expected: nil,
+		},
+		{
+			name:     "Order with a captured payment is restored",
+			payment:  &entities.OrderPayment{Status: constants.PaymentStatusCaptured},
+			expected: nil,
+		},
+		{
+			name:     "Order with a refunded payment is not restored",
+			payment:  &entities.OrderPayment{Status: constants.PaymentStatusRefunded},
+			expected: errPackage.ErrOrderPaymentRefunded,
+		},
+		{
+			name:     "Order with a pending refund is not restored",
+			payment:  &entities.OrderPayment{Status: constants.PaymentStatusRefundPending},
+			expected: errPackage.ErrOrderPaymentRefunded,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			deletedAt := time.Now()
+			order := newInTransitOrder()
+			order.DeletedAt = &deletedAt
+
+			repo := &fakeOrderRepo{order: order}
+			service := services.NewOrderService(repo, nil, nil, &fakePayments{payment: tc.payment}, &fakeEarner{}, nil)
+
+			err := service.RestoreOrder(context.Background(), order.ID)
+			if tc.expected == nil && err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if tc.expected != nil && testutil.DomainCause(err) != tc.expected {
+				t.Fatalf("expected %v, got %v", tc.expected, err)
+			}
+
+			if restored := order.DeletedAt == nil; restored != (tc.expected == nil) {
+				t.Errorf("expected restored %v, got %v", tc.expected == nil, restored)
+			}
+		})
+	}
+}
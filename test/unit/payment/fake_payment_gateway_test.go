@@ -0,0 +1,50 @@
+package payment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/adapters/payment"
+)
+
+func TestFakeGatewayCapturesPaymentsAuthorizedByAnotherProcess(t *testing.T) {
+	authorized, err := payment.NewFakePaymentGateway("secret").Authorize(context.Background(), &entities.OrderPayment{
+		OrderID: "o0000000-0000-0000-0000-000000000001",
+		Token:   "tok_visa",
+		Amount:  25,
+	})
+	if err != nil {
+		t.Fatalf("expected no error authorizing, got %v", err)
+	}
+
+	// El worker reintenta el cobro con otra instancia de la pasarela, sin el estado en memoria de la API
+	worker := payment.NewFakePaymentGateway("secret")
+	captured, err := worker.Capture(context.Background(), authorized.ProviderPaymentID, 25)
+	if err != nil {
+		t.Fatalf("expected the retry to capture the payment, got %v", err)
+	}
+	if captured.Status != constants.PaymentStatusCaptured {
+		t.Errorf("expected the payment to be captured, got %s", captured.Status)
+	}
+
+	refunded, err := worker.Refund(context.Background(), authorized.ProviderPaymentID, 25)
+	if err != nil || refunded.Status != constants.PaymentStatusRefunded {
+		t.Fatalf("expected the captured payment to be refunded, got %v", err)
+	}
+	if _, err = worker.Refund(context.Background(), authorized.ProviderPaymentID, 25); err == nil {
+		t.Error("expected a refunded payment not to be refunded again")
+	}
+}
+
+func TestFakeGatewayRejectsPaymentsItDidNotIssue(t *testing.T) {
+	gateway := payment.NewFakePaymentGateway("secret")
+
+	if _, err := gateway.Capture(context.Background(), "pi_unknown", 25); err == nil {
+		t.Error("expected an unknown payment not to be captured")
+	}
+	if _, err := gateway.Refund(context.Background(), "pi_unknown", 25); err == nil {
+		t.Error("expected an unknown payment not to be refunded")
+	}
+}
@@ -0,0 +1,219 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/services"
+)
+
+var errGatewayDown = errors.New("gateway down")
+
+// fakePaymentRepo guarda en memoria el pago de la prueba y registra las actualizaciones que recibe
+type fakePaymentRepo struct {
+	ports.PaymentRepository
+
+	payment  *entities.OrderPayment
+	updates  []string
+	applied  *entities.OrderPayment
+	recorded *entities.PaymentWebhookEvent
+}
+
+func (r *fakePaymentRepo) GetPaymentByOrderID(context.Context, string) (*entities.OrderPayment, error) {
+	return r.payment, nil
+}
+
+func (r *fakePaymentRepo) GetPaymentByProviderID(context.Context, string, string) (*entities.OrderPayment, error) {
+	return r.payment, nil
+}
+
+func (r *fakePaymentRepo) GetPaymentsByStatus(_ context.Context, statuses []string, _ int) ([]entities.OrderPayment, error) {
+	for _, status := range statuses {
+		if r.payment.Status == status {
+			return []entities.OrderPayment{*r.payment}, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakePaymentRepo) UpdatePayment(_ context.Context, payment *entities.OrderPayment) error {
+	r.updates = append(r.updates, payment.Status)
+	return nil
+}
+
+func (r *fakePaymentRepo) ExistsWebhookEvent(context.Context, string) (bool, error) {
+	return false, nil
+}
+
+func (r *fakePaymentRepo) ApplyWebhookEvent(_ context.Context, event *entities.PaymentWebhookEvent, payment *entities.OrderPayment) error {
+	r.recorded = event
+	r.applied = payment
+	return nil
+}
+
+// fakeGateway responde con el estado indicado o falla si down está activo
+type fakeGateway struct {
+	down  bool
+	event *entities.PaymentWebhookEvent
+}
+
+func (g *fakeGateway) Name() string { return constants.PaymentProviderFake }
+
+func (g *fakeGateway) Authorize(context.Context, *entities.OrderPayment) (*entities.GatewayResult, error) {
+	return &entities.GatewayResult{Status: constants.PaymentStatusAuthorized}, nil
+}
+
+func (g *fakeGateway) Capture(_ context.Context, _ string, amount float64) (*entities.GatewayResult, error) {
+	if g.down {
+		return nil, errGatewayDown
+	}
+	return &entities.GatewayResult{Status: constants.PaymentStatusCaptured, Amount: amount}, nil
+}
+
+func (g *fakeGateway) Refund(_ context.Context, _ string, amount float64) (*entities.GatewayResult, error) {
+	if g.down {
+		return nil, errGatewayDown
+	}
+	return &entities.GatewayResult{Status: constants.PaymentStatusRefunded, Amount: amount}, nil
+}
+
+func (g *fakeGateway) VerifyWebhook([]byte, string) (*entities.PaymentWebhookEvent, error) {
+	return g.event, nil
+}
+
+func newPayment(status string) *entities.OrderPayment {
+	return &entities.OrderPayment{
+		ID:                "p0000000-0000-0000-0000-000000000001",
+		OrderID:           "o0000000-0000-0000-0000-000000000001",
+		ProviderPaymentID: "pay_1",
+		Status:            status,
+		Amount:            25,
+		Currency:          "USD",
+		Order:             &entities.Order{ID: "o0000000-0000-0000-0000-000000000001", Status: constants.OrderStatusPending},
+	}
+}
+
+func TestCapturePaymentFailureLeavesItPendingAndTheRetryCapturesIt(t *testing.T) {
+	repo := &fakePaymentRepo{payment: newPayment(constants.PaymentStatusAuthorized)}
+	gateway := &fakeGateway{down: true}
+	service := services.NewPaymentService(repo, gateway)
+
+	if err := service.CapturePayment(context.Background(), repo.payment); err == nil {
+		t.Fatal("expected the capture to fail")
+	}
+	if repo.payment.Status != constants.PaymentStatusCapturePending {
+		t.Fatalf("expected status %s, got %s", constants.PaymentStatusCapturePending, repo.payment.Status)
+	}
+
+	gateway.down = false
+	completed, err := service.RetryPendingPayments(context.Background())
+	if err != nil || completed != 1 {
+		t.Fatalf("expected one payment to be completed, got %d, %v", completed, err)
+	}
+	if last := repo.updates[len(repo.updates)-1]; last != constants.PaymentStatusCaptured {
+		t.Errorf("expected the retry to save the payment as %s, got %s", constants.PaymentStatusCaptured, last)
+	}
+}
+
+func TestRetryRefundsPendingCapturesOfCancelledOrders(t *testing.T) {
+	repo := &fakePaymentRepo{payment: newPayment(constants.PaymentStatusCapturePending)}
+	repo.payment.Order.Status = constants.OrderStatusCancelled
+	service := services.NewPaymentService(repo, &fakeGateway{})
+
+	if _, err := service.RetryPendingPayments(context.Background()); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if last := repo.updates[len(repo.updates)-1]; last != constants.PaymentStatusRefunded {
+		t.Errorf("expected the cancelled order payment to be refunded, got %s", last)
+	}
+}
+
+func TestRefundFailureLeavesItPending(t *testing.T) {
+	repo := &fakePaymentRepo{payment: newPayment(constants.PaymentStatusCaptured)}
+	service := services.NewPaymentService(repo, &fakeGateway{down: true})
+
+	if err := service.RefundOrderPayment(context.Background(), repo.payment.OrderID); err == nil {
+		t.Fatal("expected the refund to fail")
+	}
+	if repo.payment.Status != constants.PaymentStatusRefundPending {
+		t.Errorf("expected status %s, got %s", constants.PaymentStatusRefundPending, repo.payment.Status)
+	}
+}
+
+func TestHandleWebhookAppliesTheEventStatus(t *testing.T) {
+	testCases := []struct {
+		name     string
+		from     string
+		event    string
+		applied  bool
+		expected string
+	}{
+		{
+			name:     "Authorized payment is captured",
+			from:     constants.PaymentStatusAuthorized,
+			event:    constants.PaymentEventCaptured,
+			applied:  true,
+			expected: constants.PaymentStatusCaptured,
+		},
+		{
+			name:     "Pending capture is confirmed",
+			from:     constants.PaymentStatusCapturePending,
+			event:    constants.PaymentEventCaptured,
+			applied:  true,
+			expected: constants.PaymentStatusCaptured,
+		},
+		{
+			name:     "Pending refund is confirmed",
+			from:     constants.PaymentStatusRefundPending,
+			event:    constants.PaymentEventRefunded,
+			applied:  true,
+			expected: constants.PaymentStatusRefunded,
+		},
+		{
+			name:     "Captured payment cannot go back to authorized",
+			from:     constants.PaymentStatusCaptured,
+			event:    constants.PaymentEventAuthorized,
+			applied:  false,
+			expected: constants.PaymentStatusCaptured,
+		},
+		{
+			name:     "Unknown events are ignored",
+			from:     constants.PaymentStatusAuthorized,
+			event:    "payment.disputed",
+			applied:  false,
+			expected: constants.PaymentStatusAuthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := &fakePaymentRepo{payment: newPayment(tc.from)}
+			gateway := &fakeGateway{event: &entities.PaymentWebhookEvent{
+				ID:                "evt_1",
+				Provider:          constants.PaymentProviderFake,
+				Type:              tc.event,
+				ProviderPaymentID: "pay_1",
+				Amount:            25,
+			}}
+			service := services.NewPaymentService(repo, gateway)
+
+			if err := service.HandleWebhook(context.Background(), []byte("{}"), "signature"); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			if repo.recorded == nil {
+				t.Error("expected the event to be recorded")
+			}
+			if (repo.applied != nil) != tc.applied {
+				t.Errorf("expected applied %v, got %v", tc.applied, repo.applied != nil)
+			}
+			if repo.payment.Status != tc.expected {
+				t.Errorf("expected status %s, got %s", tc.expected, repo.payment.Status)
+			}
+		})
+	}
+}
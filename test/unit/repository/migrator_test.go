@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/database"
+	"gorm.io/gorm"
+)
+
+// withAppliedMigrations hace que la base de datos falsa tenga registradas las versiones indicadas
+func withAppliedMigrations(rec *recorder, versions ...int) {
+	result := fakeResultSet{columns: []string{"version", "name", "applied_at"}}
+	for _, version := range versions {
+		result.values = append(result.values, []driver.Value{int64(version), fmt.Sprintf("migration_%d", version), time.Now()})
+	}
+
+	rec.results = map[string]fakeResultSet{"FROM `schema_migrations`": result}
+}
+
+// migrationVersions versiones de todas las migraciones en el orden en que se listan
+func migrationVersions(t *testing.T, db *gorm.DB) []int {
+	t.Helper()
+
+	statuses, err := database.GetMigrationStatus(db)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	versions := make([]int, 0, len(statuses))
+	for _, status := range statuses {
+		versions = append(versions, status.Version)
+	}
+	return versions
+}
+
+func appliedVersions(done []database.Migration) []int {
+	versions := make([]int, 0, len(done))
+	for _, migration := range done {
+		versions = append(versions, migration.Version)
+	}
+	return versions
+}
+
+func TestMigrationsAreAppliedInVersionOrder(t *testing.T) {
+	db, rec := newFakeDB(t, "")
+	versions := migrationVersions(t, db)
+
+	for i, version := range versions {
+		if version != i+1 {
+			t.Fatalf("expected consecutive versions starting at 1, got %v", versions)
+		}
+	}
+
+	done, err := database.MigrateUp(db, 2)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got := appliedVersions(done); len(got) != 2 || got[0] != 1 || got[1] != 2 {
+		t.Errorf("expected the steps to apply the lowest versions first, got %v", got)
+	}
+	if got := rec.countContaining("INSERT INTO `schema_migrations`"); got != 2 {
+		t.Errorf("expected each applied migration to be recorded, got %d", got)
+	}
+}
+
+func TestInitialSchemaMigrationOnlyCreatesTheInitialTables(t *testing.T) {
+	db, rec := newFakeDB(t, "")
+
+	if _, err := database.MigrateUp(db, 1); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if !rec.contains("CREATE TABLE `" + (entities.Order{}).TableName() + "`") {
+		t.Error("expected the initial schema to create the orders table")
+	}
+	for _, later := range []string{(entities.DeliveryAttempt{}).TableName(), (entities.WebhookEndpoint{}).TableName(), (entities.JobRun{}).TableName()} {
+		if rec.contains("CREATE TABLE `" + later + "`") {
+			t.Errorf("expected %s to be created by its own migration, not by the initial schema", later)
+		}
+	}
+}
+
+func TestMigrateUpSkipsAppliedVersions(t *testing.T) {
+	db, rec := newFakeDB(t, "")
+	versions := migrationVersions(t, db)
+	latest := versions[len(versions)-1]
+	withAppliedMigrations(rec, versions[:len(versions)-1]...)
+
+	done, err := database.MigrateUp(db, 0)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if got := appliedVersions(done); len(got) != 1 || got[0] != latest {
+		t.Fatalf("expected only the pending version %d to be applied, got %v", latest, got)
+	}
+	if rec.contains("CREATE TABLE `" + (entities.User{}).TableName() + "`") {
+		t.Error("expected the applied initial schema not to run again")
+	}
+	if !rec.contains("CREATE TABLE `" + (entities.JobRun{}).TableName() + "`") {
+		t.Error("expected the pending migration to create its tables")
+	}
+}
+
+func TestMigrateDownRevertsTheLatestVersionsFirst(t *testing.T) {
+	db, rec := newFakeDB(t, "")
+	versions := migrationVersions(t, db)
+	withAppliedMigrations(rec, versions...)
+
+	done, err := database.MigrateDown(db, 2)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	latest := versions[len(versions)-1]
+	if got := appliedVersions(done); len(got) != 2 || got[0] != latest || got[1] != latest-1 {
+		t.Fatalf("expected the two latest versions in reverse order, got %v", got)
+	}
+	if got := rec.countContaining("DELETE FROM `schema_migrations`"); got != 2 {
+		t.Errorf("expected each reverted migration to be unrecorded, got %d", got)
+	}
+	if !rec.contains("DROP TABLE IF EXISTS `" + (entities.JobRun{}).TableName() + "`") {
+		t.Error("expected the rollback to drop the tables of the migration")
+	}
+}
+
+func TestMigrateDownKeepsTheRecordOfAFailedRollback(t *testing.T) {
+	db, rec := newFakeDB(t, "DROP TABLE IF EXISTS `"+(entities.CompanyMetricsSnapshot{}).TableName()+"`")
+	withAppliedMigrations(rec, migrationVersions(t, db)...)
+
+	done, err := database.MigrateDown(db, 1)
+	if err == nil {
+		t.Fatal("expected the failed rollback to return an error")
+	}
+
+	if len(done) != 0 {
+		t.Errorf("expected no migration to be reverted, got %v", appliedVersions(done))
+	}
+	if rec.contains("DELETE FROM `schema_migrations`") {
+		t.Error("expected the failed migration to stay recorded as applied")
+	}
+}
@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/database/repositories"
+)
+
+func TestGetUserNotificationsFiltersByTypeAndReadState(t *testing.T) {
+	db, rec := newFakeDB(t, "")
+	repo := repositories.NewNotificationRepository(db)
+
+	unread := false
+	_, _, err := repo.GetUserNotifications(context.Background(), "user-1", &entities.NotificationQueryParams{
+		Type:   constants.NotificationTypeOrderDelivered,
+		IsRead: &unread,
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if got := rec.countContaining("WHERE user_id = ? AND type = ? AND is_read = ?"); got != 2 {
+		t.Errorf("expected the count and the page to be filtered by type and read state, got %v", rec.statements)
+	}
+}
+
+func TestMarkAllAsReadOnlyUpdatesUnreadNotificationsOfTheUser(t *testing.T) {
+	db, rec := newFakeDB(t, "")
+	repo := repositories.NewNotificationRepository(db)
+
+	if _, err := repo.MarkAllAsRead(context.Background(), "user-1", time.Now()); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if !rec.contains("UPDATE `notifications` SET") || !rec.contains("WHERE user_id = ? AND is_read = ?") {
+		t.Errorf("expected the update to be limited to the unread notifications of the user, got %v", rec.statements)
+	}
+}
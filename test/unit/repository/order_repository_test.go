@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/database/repositories"
+)
+
+func TestRegisterDeliveryAttemptOnlyUpdatesOrdersInTransit(t *testing.T) {
+	db, rec := newFakeDB(t, "")
+	repo := repositories.NewOrderRepository(db)
+
+	attempt := &entities.DeliveryAttempt{
+		ID:            "a0000000-0000-0000-0000-000000000001",
+		OrderID:       "o0000000-0000-0000-0000-000000000001",
+		AttemptNumber: 1,
+		ReasonCode:    "RECIPIENT_ABSENT",
+		AttemptedAt:   time.Now(),
+	}
+
+	if err := repo.RegisterDeliveryAttempt(context.Background(), attempt, false, nil); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if !rec.contains("WHERE id = ? AND status = ?") {
+		t.Errorf("expected the order update to be guarded by its status, got %v", rec.statements)
+	}
+}
@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/database/repositories"
+)
+
+func TestUpdateReturnStatusGuardsTheReturnAndTheOrder(t *testing.T) {
+	db, rec := newFakeDB(t, "")
+	repo := repositories.NewReturnRepository(db)
+
+	orderReturn := &entities.OrderReturn{
+		ID:      "r0000000-0000-0000-0000-000000000001",
+		OrderID: "o0000000-0000-0000-0000-000000000001",
+		Status:  constants.ReturnStatusInTransit,
+	}
+
+	if err := repo.UpdateReturnStatus(context.Background(), orderReturn, constants.ReturnStatusReceived, nil, time.Now(), nil); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if !rec.contains("UPDATE `order_returns` SET") || !rec.contains("WHERE id = ? AND status = ?") {
+		t.Errorf("expected the return update to be guarded by its prior status, got %v", rec.statements)
+	}
+	if got := rec.countContaining("WHERE id = ? AND status = ?"); got != 2 {
+		t.Errorf("expected the return and the order updates to be guarded, got %d guarded statements", got)
+	}
+}
+
+func TestCreateReturnLocksTheOrder(t *testing.T) {
+	db, rec := newFakeDB(t, "")
+	repo := repositories.NewReturnRepository(db)
+
+	// La base de datos falsa no devuelve filas, la devolución no se crea pero el bloqueo se solicita
+	_ = repo.CreateReturn(context.Background(), &entities.OrderReturn{
+		ID:      "r0000000-0000-0000-0000-000000000001",
+		OrderID: "o0000000-0000-0000-0000-000000000001",
+	})
+
+	if !rec.contains("FOR UPDATE") {
+		t.Errorf("expected the order to be locked, got %v", rec.statements)
+	}
+	if rec.count("INSERT") != 0 {
+		t.Errorf("expected no return to be created for a missing order, got %v", rec.statements)
+	}
+}
@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/database/repositories"
+)
+
+func TestUpdateScheduleGuardsTheReadStatusAndNextRun(t *testing.T) {
+	db, rec := newFakeDB(t, "")
+	repo := repositories.NewScheduleRepository(db)
+
+	nextRunAt := time.Now().Add(time.Hour)
+	schedule := &entities.OrderSchedule{
+		ID:     "s0000000-0000-0000-0000-000000000001",
+		Status: constants.ScheduleStatusActive,
+	}
+
+	if err := repo.UpdateSchedule(context.Background(), schedule, constants.ScheduleStatusActive, &nextRunAt); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if !rec.contains("WHERE (id = ? AND status = ?) AND next_run_at = ?") {
+		t.Errorf("expected the update to be guarded by the read status and next run, got %v", rec.statements)
+	}
+}
+
+func TestUpdateScheduleStatusGuardsSchedulesWithoutNextRun(t *testing.T) {
+	db, rec := newFakeDB(t, "")
+	repo := repositories.NewScheduleRepository(db)
+
+	err := repo.UpdateScheduleStatus(context.Background(), "s0000000-0000-0000-0000-000000000001",
+		constants.ScheduleStatusPaused, nil, constants.ScheduleStatusCancelled, nil)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if !rec.contains("WHERE (id = ? AND status = ?) AND next_run_at IS NULL") {
+		t.Errorf("expected the status change to be guarded by the read status and next run, got %v", rec.statements)
+	}
+	if rec.contains("`order_template`") {
+		t.Errorf("expected only the status columns to be written, got %v", rec.statements)
+	}
+}
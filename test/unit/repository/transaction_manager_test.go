@@ -0,0 +1,285 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/database/repositories"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+var errFakeExec = errors.New("fake exec failure")
+
+// recorder registra las sentencias que recibe la base de datos falsa y falla las que contienen failOn. Las consultas
+// que contienen una llave de results devuelven sus filas, el resto no devuelve filas
+type recorder struct {
+	mu         sync.Mutex
+	statements []string
+	failOn     string
+	results    map[string]fakeResultSet
+}
+
+type fakeResultSet struct {
+	columns []string
+	values  [][]driver.Value
+}
+
+func (r *recorder) add(statement string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.statements = append(r.statements, statement)
+}
+
+func (r *recorder) count(prefix string) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	total := 0
+	for _, statement := range r.statements {
+		if strings.HasPrefix(statement, prefix) {
+			total++
+		}
+	}
+	return total
+}
+
+func (r *recorder) contains(fragment string) bool {
+	return r.countContaining(fragment) > 0
+}
+
+func (r *recorder) countContaining(fragment string) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	total := 0
+	for _, statement := range r.statements {
+		if strings.Contains(statement, fragment) {
+			total++
+		}
+	}
+	return total
+}
+
+func (r *recorder) last() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.statements[len(r.statements)-1]
+}
+
+type fakeConnector struct{ rec *recorder }
+
+func (c fakeConnector) Connect(context.Context) (driver.Conn, error) {
+	return &fakeConn{rec: c.rec}, nil
+}
+func (c fakeConnector) Driver() driver.Driver { return fakeDriver{rec: c.rec} }
+
+type fakeDriver struct{ rec *recorder }
+
+func (d fakeDriver) Open(string) (driver.Conn, error) { return &fakeConn{rec: d.rec}, nil }
+
+type fakeConn struct{ rec *recorder }
+
+func (c *fakeConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("prepare not supported")
+}
+func (c *fakeConn) Close() error { return nil }
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	c.rec.add("BEGIN")
+	return &fakeTx{rec: c.rec}, nil
+}
+
+func (c *fakeConn) ExecContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Result, error) {
+	c.rec.add(query)
+	if c.rec.failOn != "" && strings.Contains(query, c.rec.failOn) {
+		return nil, errFakeExec
+	}
+	return fakeResult{}, nil
+}
+
+func (c *fakeConn) QueryContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Rows, error) {
+	c.rec.add(query)
+	for fragment, result := range c.rec.results {
+		if strings.Contains(query, fragment) {
+			return &fakeRows{columns: result.columns, values: result.values}, nil
+		}
+	}
+	return &fakeRows{}, nil
+}
+
+type fakeResult struct{}
+
+func (fakeResult) LastInsertId() (int64, error) { return 0, nil }
+func (fakeResult) RowsAffected() (int64, error) { return 1, nil }
+
+type fakeTx struct{ rec *recorder }
+
+func (t *fakeTx) Commit() error   { t.rec.add("COMMIT"); return nil }
+func (t *fakeTx) Rollback() error { t.rec.add("ROLLBACK"); return nil }
+
+type fakeRows struct {
+	columns []string
+	values  [][]driver.Value
+	next    int
+}
+
+func (r *fakeRows) Columns() []string { return r.columns }
+func (r *fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.next >= len(r.values) {
+		return io.EOF
+	}
+	copy(dest, r.values[r.next])
+	r.next++
+	return nil
+}
+
+func newFakeDB(t *testing.T, failOn string) (*gorm.DB, *recorder) {
+	t.Helper()
+
+	rec := &recorder{failOn: failOn}
+	db, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      sql.OpenDB(fakeConnector{rec: rec}),
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		t.Fatalf("failed to open fake database: %v", err)
+	}
+
+	return db, rec
+}
+
+func newMainAddress() *entities.CompanyAddress {
+	return &entities.CompanyAddress{
+		ID:           "a0000000-0000-0000-0000-000000000001",
+		CompanyID:    "c0000000-0000-0000-0000-000000000001",
+		AddressLine1: "Calle 100 #15-20",
+		City:         "Bogotá",
+		State:        "Cundinamarca",
+		Location:     []byte("POINT(0 0)"),
+		IsMain:       true,
+	}
+}
+
+func TestWithTransactionCommitsAllRepositoryCalls(t *testing.T) {
+	db, rec := newFakeDB(t, "")
+	txManager := repositories.NewTransactionManager(db)
+	companyRepo := repositories.NewCompanyRepository(db)
+	userRepo := repositories.NewUserRepository(db)
+
+	address := newMainAddress()
+	err := txManager.WithTransaction(context.Background(), func(ctx context.Context) error {
+		if err := companyRepo.AddCompanyAddress(ctx, address); err != nil {
+			return err
+		}
+		if err := companyRepo.UnsetMainAddresses(ctx, address.CompanyID, address.ID); err != nil {
+			return err
+		}
+		return userRepo.AssignRoleToUser(ctx, "u0000000-0000-0000-0000-000000000001", "r0000000-0000-0000-0000-000000000001", "admin")
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if got := rec.count("BEGIN"); got != 1 {
+		t.Errorf("expected the three calls to share one transaction, got %d BEGIN", got)
+	}
+	if got := rec.count("INSERT"); got != 2 {
+		t.Errorf("expected 2 inserts, got %d", got)
+	}
+	if got := rec.count("UPDATE"); got != 1 {
+		t.Errorf("expected 1 update, got %d", got)
+	}
+	if got := rec.count("ROLLBACK"); got != 0 {
+		t.Errorf("expected no rollback, got %d", got)
+	}
+	if rec.last() != "COMMIT" {
+		t.Errorf("expected the transaction to end with COMMIT, got %q", rec.last())
+	}
+}
+
+func TestWithTransactionRollsBackWhenARepositoryCallFails(t *testing.T) {
+	db, rec := newFakeDB(t, "user_roles")
+	txManager := repositories.NewTransactionManager(db)
+	companyRepo := repositories.NewCompanyRepository(db)
+	userRepo := repositories.NewUserRepository(db)
+
+	err := txManager.WithTransaction(context.Background(), func(ctx context.Context) error {
+		if err := companyRepo.AddCompanyAddress(ctx, newMainAddress()); err != nil {
+			return err
+		}
+		return userRepo.AssignRoleToUser(ctx, "u0000000-0000-0000-0000-000000000001", "r0000000-0000-0000-0000-000000000001", "admin")
+	})
+	if !errors.Is(err, errFakeExec) {
+		t.Fatalf("expected the repository error, got %v", err)
+	}
+
+	if got := rec.count("BEGIN"); got != 1 {
+		t.Errorf("expected one transaction, got %d BEGIN", got)
+	}
+	if got := rec.count("COMMIT"); got != 0 {
+		t.Errorf("expected the address insert not to be committed, got %d COMMIT", got)
+	}
+	if rec.last() != "ROLLBACK" {
+		t.Errorf("expected the transaction to end with ROLLBACK, got %q", rec.last())
+	}
+}
+
+func TestWithTransactionRollsBackWhenTheUseCaseFails(t *testing.T) {
+	db, rec := newFakeDB(t, "")
+	txManager := repositories.NewTransactionManager(db)
+	companyRepo := repositories.NewCompanyRepository(db)
+
+	errValidation := errors.New("validation failed")
+	err := txManager.WithTransaction(context.Background(), func(ctx context.Context) error {
+		if err := companyRepo.AddCompanyAddress(ctx, newMainAddress()); err != nil {
+			return err
+		}
+		return errValidation
+	})
+	if !errors.Is(err, errValidation) {
+		t.Fatalf("expected the use case error, got %v", err)
+	}
+
+	if got := rec.count("COMMIT"); got != 0 {
+		t.Errorf("expected no commit, got %d", got)
+	}
+	if rec.last() != "ROLLBACK" {
+		t.Errorf("expected the transaction to end with ROLLBACK, got %q", rec.last())
+	}
+}
+
+func TestWithTransactionNestsInTheTransactionOfTheContext(t *testing.T) {
+	db, rec := newFakeDB(t, "")
+	txManager := repositories.NewTransactionManager(db)
+	companyRepo := repositories.NewCompanyRepository(db)
+
+	err := txManager.WithTransaction(context.Background(), func(ctx context.Context) error {
+		return txManager.WithTransaction(ctx, func(ctx context.Context) error {
+			return companyRepo.AddCompanyAddress(ctx, newMainAddress())
+		})
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if got := rec.count("BEGIN"); got != 1 {
+		t.Errorf("expected the nested call to reuse the transaction, got %d BEGIN", got)
+	}
+	if got := rec.count("SAVEPOINT"); got != 1 {
+		t.Errorf("expected the nested call to use a savepoint, got %d", got)
+	}
+	if rec.last() != "COMMIT" {
+		t.Errorf("expected the transaction to end with COMMIT, got %q", rec.last())
+	}
+}
@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/database/repositories"
+)
+
+func TestSaveDeliveryAttemptCountsFailuresInTheDatabase(t *testing.T) {
+	db, rec := newFakeDB(t, "")
+	repo := repositories.NewWebhookRepository(db)
+
+	delivery := &entities.WebhookDelivery{
+		ID:         "d0000000-0000-0000-0000-000000000001",
+		EndpointID: "e0000000-0000-0000-0000-000000000001",
+		Status:     constants.WebhookDeliveryStatusFailed,
+		UpdatedAt:  time.Now(),
+	}
+
+	if _, err := repo.SaveDeliveryAttempt(context.Background(), delivery, true, constants.WebhookDisableAfterFailures); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if !rec.contains("`consecutive_failures`=consecutive_failures + 1") {
+		t.Errorf("expected the failure to be added in SQL, got %v", rec.statements)
+	}
+	if !rec.contains("is_active = ? AND consecutive_failures >= ?") {
+		t.Errorf("expected the endpoint to be disabled with a guarded update, got %v", rec.statements)
+	}
+}
+
+func TestSaveDeliveryAttemptResetsFailuresOnSuccess(t *testing.T) {
+	db, rec := newFakeDB(t, "")
+	repo := repositories.NewWebhookRepository(db)
+
+	delivery := &entities.WebhookDelivery{
+		ID:         "d0000000-0000-0000-0000-000000000001",
+		EndpointID: "e0000000-0000-0000-0000-000000000001",
+		Status:     constants.WebhookDeliveryStatusSucceeded,
+		UpdatedAt:  time.Now(),
+	}
+
+	disabled, err := repo.SaveDeliveryAttempt(context.Background(), delivery, true, constants.WebhookDisableAfterFailures)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if disabled {
+		t.Error("expected a successful attempt not to disable the endpoint")
+	}
+	if !rec.contains("SET `consecutive_failures`=?,") || rec.contains("consecutive_failures + 1") {
+		t.Errorf("expected the failures to be reset, got %v", rec.statements)
+	}
+}
@@ -0,0 +1,73 @@
+package returns
+
+import (
+	"context"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/services"
+)
+
+// fakeReturnRepo guarda en memoria la devolución de la prueba y aplica sobre el pedido original
+// el mismo efecto que el repositorio real, los métodos no sobrescritos hacen panic si el servicio los usa
+type fakeReturnRepo struct {
+	ports.ReturnRepository
+
+	order       *entities.Order
+	orderReturn *entities.OrderReturn
+	events      []*entities.SystemEvent
+	updateErr   error
+}
+
+func (r *fakeReturnRepo) CreateReturn(_ context.Context, orderReturn *entities.OrderReturn) error {
+	r.orderReturn = orderReturn
+	return nil
+}
+
+func (r *fakeReturnRepo) GetReturnByID(_ context.Context, _ string) (*entities.OrderReturn, error) {
+	r.orderReturn.Order = r.order
+	return r.orderReturn, nil
+}
+
+func (r *fakeReturnRepo) ExistsActiveBranch(_ context.Context, _, _ string) (bool, error) {
+	return true, nil
+}
+
+func (r *fakeReturnRepo) UpdateReturnStatus(_ context.Context, orderReturn *entities.OrderReturn, status string, _ *string, _ time.Time, event *entities.SystemEvent) error {
+	if r.updateErr != nil {
+		return r.updateErr
+	}
+
+	orderReturn.Status = status
+	if event != nil {
+		orderReturn.Order.Status = event.Data()["status"].(string)
+		r.events = append(r.events, event)
+	}
+	return nil
+}
+
+// fakeOrderRepo devuelve siempre el pedido original de la prueba
+type fakeOrderRepo struct {
+	ports.OrdererRepository
+
+	order *entities.Order
+}
+
+func (r *fakeOrderRepo) GetOrderByID(_ context.Context, _ string) (*entities.Order, error) {
+	return r.order, nil
+}
+
+// fakeTrackingGenerator devuelve un número de seguimiento fijo
+type fakeTrackingGenerator struct {
+	interfaces.TrackingNumberGenerator
+}
+
+func (g *fakeTrackingGenerator) Generate(prefix string) (string, error) {
+	return prefix + "-TEST0001", nil
+}
+
+func newReturnService(repo *fakeReturnRepo) interfaces.Returner {
+	return services.NewReturnService(repo, &fakeOrderRepo{order: repo.order}, &fakeTrackingGenerator{})
+}
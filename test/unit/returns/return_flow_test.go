@@ -0,0 +1,147 @@
+package returns
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/test/unit/testutil"
+)
+
+func TestReturnFlowMarksOrderReturnedOnReception(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeReturnRepo{order: &entities.Order{ID: "order-1", CompanyID: "company-1", BranchID: "branch-1", Status: constants.OrderStatusDelivered}}
+	service := newReturnService(repo)
+
+	orderReturn := &entities.OrderReturn{
+		OrderID:     "order-1",
+		InitiatedBy: constants.ReturnInitiatedByCompany,
+		ReasonCode:  constants.ReturnReasonRecipientRefused,
+	}
+	if err := service.CreateReturn(ctx, orderReturn); err != nil {
+		t.Fatalf("CreateReturn() error = %v", err)
+	}
+
+	for _, status := range []string{constants.ReturnStatusPickedUp, constants.ReturnStatusInTransit} {
+		if err := service.ChangeReturnStatus(ctx, orderReturn.ID, status, nil); err != nil {
+			t.Fatalf("ChangeReturnStatus(%s) error = %v", status, err)
+		}
+
+		if repo.order.Status != constants.OrderStatusDelivered {
+			t.Fatalf("order status after %s = %s, want %s", status, repo.order.Status, constants.OrderStatusDelivered)
+		}
+	}
+
+	if err := service.ChangeReturnStatus(ctx, orderReturn.ID, constants.ReturnStatusReceived, nil); err != nil {
+		t.Fatalf("ChangeReturnStatus(RECEIVED) error = %v", err)
+	}
+
+	if repo.order.Status != constants.OrderStatusReturned {
+		t.Errorf("order status after reception = %s, want %s", repo.order.Status, constants.OrderStatusReturned)
+	}
+
+	if len(repo.events) != 1 {
+		t.Errorf("order events = %d, want 1", len(repo.events))
+	}
+}
+
+func TestOnlyDeliveredOrdersCanBeReturned(t *testing.T) {
+	for _, status := range []string{constants.OrderStatusFailed, constants.OrderStatusReturned, constants.OrderStatusCancelled, constants.OrderStatusLost} {
+		t.Run(status, func(t *testing.T) {
+			repo := &fakeReturnRepo{order: &entities.Order{ID: "order-1", CompanyID: "company-1", BranchID: "branch-1", Status: status}}
+			service := newReturnService(repo)
+
+			err := service.CreateReturn(context.Background(), &entities.OrderReturn{
+				OrderID:     "order-1",
+				InitiatedBy: constants.ReturnInitiatedByCompany,
+				ReasonCode:  constants.ReturnReasonDamaged,
+			})
+			if testutil.DomainCause(err) != errPackage.ErrOrderCannotBeReturned {
+				t.Fatalf("CreateReturn() error = %v, want %v", err, errPackage.ErrOrderCannotBeReturned)
+			}
+
+			if repo.orderReturn != nil {
+				t.Error("return was saved, want none")
+			}
+		})
+	}
+}
+
+func TestReceivedReturnKeepsTheStatusOfAnOrderThatIsNoLongerDelivered(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeReturnRepo{order: &entities.Order{ID: "order-1", CompanyID: "company-1", BranchID: "branch-1", Status: constants.OrderStatusDelivered}}
+	service := newReturnService(repo)
+
+	orderReturn := &entities.OrderReturn{
+		OrderID:     "order-1",
+		InitiatedBy: constants.ReturnInitiatedByCompany,
+		ReasonCode:  constants.ReturnReasonDamaged,
+	}
+	if err := service.CreateReturn(ctx, orderReturn); err != nil {
+		t.Fatalf("CreateReturn() error = %v", err)
+	}
+
+	for _, status := range []string{constants.ReturnStatusPickedUp, constants.ReturnStatusInTransit} {
+		if err := service.ChangeReturnStatus(ctx, orderReturn.ID, status, nil); err != nil {
+			t.Fatalf("ChangeReturnStatus(%s) error = %v", status, err)
+		}
+	}
+
+	repo.order.Status = constants.OrderStatusLost
+	if err := service.ChangeReturnStatus(ctx, orderReturn.ID, constants.ReturnStatusReceived, nil); err != nil {
+		t.Fatalf("ChangeReturnStatus(RECEIVED) error = %v", err)
+	}
+
+	if repo.order.Status != constants.OrderStatusLost {
+		t.Errorf("order status = %s, want %s", repo.order.Status, constants.OrderStatusLost)
+	}
+	if len(repo.events) != 0 {
+		t.Errorf("order events = %d, want 0", len(repo.events))
+	}
+}
+
+func TestChangeReturnStatusReportsAConcurrentChange(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeReturnRepo{order: &entities.Order{ID: "order-1", CompanyID: "company-1", BranchID: "branch-1", Status: constants.OrderStatusDelivered}}
+	service := newReturnService(repo)
+
+	orderReturn := &entities.OrderReturn{
+		OrderID:     "order-1",
+		InitiatedBy: constants.ReturnInitiatedByCompany,
+		ReasonCode:  constants.ReturnReasonDamaged,
+	}
+	if err := service.CreateReturn(ctx, orderReturn); err != nil {
+		t.Fatalf("CreateReturn() error = %v", err)
+	}
+
+	repo.updateErr = errPackage.ErrReturnStatusConflict
+	err := service.ChangeReturnStatus(ctx, orderReturn.ID, constants.ReturnStatusPickedUp, nil)
+	if testutil.DomainCause(err) != errPackage.ErrReturnStatusConflict {
+		t.Fatalf("ChangeReturnStatus() error = %v, want %v", err, errPackage.ErrReturnStatusConflict)
+	}
+}
+
+func TestReturnCannotSkipPickup(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeReturnRepo{order: &entities.Order{ID: "order-1", CompanyID: "company-1", BranchID: "branch-1", Status: constants.OrderStatusDelivered}}
+	service := newReturnService(repo)
+
+	orderReturn := &entities.OrderReturn{
+		OrderID:     "order-1",
+		InitiatedBy: constants.ReturnInitiatedByRecipient,
+		ReasonCode:  constants.ReturnReasonDamaged,
+	}
+	if err := service.CreateReturn(ctx, orderReturn); err != nil {
+		t.Fatalf("CreateReturn() error = %v", err)
+	}
+
+	if err := service.ChangeReturnStatus(ctx, orderReturn.ID, constants.ReturnStatusReceived, nil); err == nil {
+		t.Fatal("ChangeReturnStatus(RECEIVED) from REQUESTED succeeded, want error")
+	}
+
+	if repo.order.Status != constants.OrderStatusDelivered {
+		t.Errorf("order status = %s, want %s", repo.order.Status, constants.OrderStatusDelivered)
+	}
+}
@@ -0,0 +1,212 @@
+package scan
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/MarlonG1/delivery-backend/internal/application/usecases/order"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/interfaces"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/auth"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/api/dto"
+	"github.com/MarlonG1/delivery-backend/test/unit/testutil"
+)
+
+// fakeOrders devuelve el pedido o el bulto escaneado y registra la acción que aplica el caso de uso
+type fakeOrders struct {
+	interfaces.Orderer
+
+	order  *entities.Order
+	parcel *entities.Parcel
+	action string
+	pin    string
+	driver string
+}
+
+func (o *fakeOrders) GetParcelByQRData(context.Context, string) (*entities.Parcel, error) {
+	return o.parcel, nil
+}
+
+func (o *fakeOrders) GetOrderByQRData(context.Context, string) (*entities.Order, error) {
+	return o.order, nil
+}
+
+func (o *fakeOrders) GetOrderByID(context.Context, string) (*entities.Order, error) {
+	return o.order, nil
+}
+
+func (o *fakeOrders) ChangeStatus(_ context.Context, _ string, status string) error {
+	o.action = "status:" + status
+	o.order.Status = status
+	return nil
+}
+
+func (o *fakeOrders) DeliverOrder(_ context.Context, _ string, driverID, pin string, _ *float64) error {
+	o.action, o.driver, o.pin = "deliver", driverID, pin
+	o.order.Status = constants.OrderStatusDelivered
+	return nil
+}
+
+func (o *fakeOrders) RegisterDeliveryAttempt(_ context.Context, _ string, driverID, _, _ string) (*entities.DeliveryAttempt, error) {
+	o.action, o.driver = "attempt", driverID
+	o.order.Status = constants.OrderStatusFailed
+	return &entities.DeliveryAttempt{}, nil
+}
+
+func (o *fakeOrders) ChangeParcelStatus(_ context.Context, parcel *entities.Parcel, status string) error {
+	o.action = "parcel:" + status
+	parcel.Status = status
+	o.order.Parcels[0].Status = status
+	return nil
+}
+
+func newScannedOrder(status string) *entities.Order {
+	driverID := "driver-1"
+	return &entities.Order{ID: "o-1", Status: status, DriverID: &driverID, TrackingNumber: "DEL250115TE68JBQF14V"}
+}
+
+func scanContext(role, userID string) context.Context {
+	return context.WithValue(context.Background(), "claims", &auth.AuthClaims{UserID: userID, Role: role})
+}
+
+func TestScanOrderListsTheActionsOfEachRole(t *testing.T) {
+	testCases := []struct {
+		name     string
+		role     string
+		status   string
+		expected []string
+	}{
+		{"driver in transit", constants.Driver, constants.OrderStatusInTransit, []string{constants.OrderStatusDelivered, constants.OrderStatusFailed}},
+		{"driver accepted", constants.Driver, constants.OrderStatusAccepted, []string{constants.OrderStatusPickedUp}},
+		{"collector picked up", constants.Collector, constants.OrderStatusPickedUp, []string{constants.OrderStatusInWarehouse}},
+		{"collector in transit", constants.Collector, constants.OrderStatusInTransit, []string{}},
+		{"warehouse in warehouse", constants.WarehouseStaff, constants.OrderStatusInWarehouse, []string{constants.OrderStatusInTransit}},
+		{"warehouse failed", constants.WarehouseStaff, constants.OrderStatusFailed, []string{constants.OrderStatusInTransit, constants.OrderStatusReturned}},
+		{"admin pending", constants.AdminRole, constants.OrderStatusPending, []string{constants.OrderStatusAccepted, constants.OrderStatusCancelled}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			orders := &fakeOrders{order: newScannedOrder(tc.status)}
+			useCase := order.NewScanUseCase(orders)
+
+			response, err := useCase.ScanOrder(scanContext(tc.role, "driver-1"), &dto.ScanRequest{QRData: "qr"})
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			if !reflect.DeepEqual(response.AvailableActions, tc.expected) {
+				t.Errorf("expected actions %v, got %v", tc.expected, response.AvailableActions)
+			}
+			if orders.action != "" || response.AppliedStatus != "" {
+				t.Errorf("expected a lookup not to change the order, got %q", orders.action)
+			}
+		})
+	}
+}
+
+func TestScanOrderRejectsRolesAndOrdersOutsideTheScanFlow(t *testing.T) {
+	testCases := []struct {
+		name     string
+		role     string
+		userID   string
+		expected error
+	}{
+		{"final user", constants.FinalUser, "user-1", errPackage.ErrScanNotAllowed},
+		{"company user", constants.CompanyUser, "user-1", errPackage.ErrScanNotAllowed},
+		{"driver not assigned", constants.Driver, "driver-2", errPackage.ErrOrderNotAssignedToDriver},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			useCase := order.NewScanUseCase(&fakeOrders{order: newScannedOrder(constants.OrderStatusInTransit)})
+
+			_, err := useCase.ScanOrder(scanContext(tc.role, tc.userID), &dto.ScanRequest{QRData: "qr"})
+			if testutil.DomainCause(err) != tc.expected {
+				t.Fatalf("expected %v, got %v", tc.expected, err)
+			}
+		})
+	}
+}
+
+func TestScanOrderRejectsStatusesOutsideTheRoleActions(t *testing.T) {
+	orders := &fakeOrders{order: newScannedOrder(constants.OrderStatusInTransit)}
+	useCase := order.NewScanUseCase(orders)
+
+	_, err := useCase.ScanOrder(scanContext(constants.Collector, "collector-1"), &dto.ScanRequest{QRData: "qr", Status: constants.OrderStatusDelivered})
+	if testutil.DomainCause(err) != errPackage.ErrScanActionNotAllowed {
+		t.Fatalf("expected %v, got %v", errPackage.ErrScanActionNotAllowed, err)
+	}
+	if orders.action != "" {
+		t.Errorf("expected the order not to change, got %q", orders.action)
+	}
+}
+
+func TestScanOrderAppliesTheRequestedAction(t *testing.T) {
+	testCases := []struct {
+		name     string
+		role     string
+		userID   string
+		status   string
+		request  string
+		expected string
+	}{
+		{"driver delivers", constants.Driver, "driver-1", constants.OrderStatusInTransit, "delivered", "deliver"},
+		{"driver fails the delivery", constants.Driver, "driver-1", constants.OrderStatusInTransit, constants.OrderStatusFailed, "attempt"},
+		{"collector stores", constants.Collector, "collector-1", constants.OrderStatusPickedUp, constants.OrderStatusInWarehouse, "status:" + constants.OrderStatusInWarehouse},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			orders := &fakeOrders{order: newScannedOrder(tc.status)}
+			useCase := order.NewScanUseCase(orders)
+
+			response, err := useCase.ScanOrder(scanContext(tc.role, tc.userID), &dto.ScanRequest{QRData: "qr", Status: tc.request, PIN: "482913"})
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			if orders.action != tc.expected {
+				t.Errorf("expected action %q, got %q", tc.expected, orders.action)
+			}
+			if response.AppliedStatus != orders.order.Status {
+				t.Errorf("expected the applied status %s, got %s", orders.order.Status, response.AppliedStatus)
+			}
+			// Solo el repartidor registra la entrega a su nombre
+			if tc.role == constants.Driver && orders.driver != tc.userID {
+				t.Errorf("expected the driver to be %s, got %s", tc.userID, orders.driver)
+			}
+		})
+	}
+}
+
+func TestScanParcelAppliesTheActionToTheParcel(t *testing.T) {
+	scanned := newScannedOrder(constants.OrderStatusAccepted)
+	scanned.Parcels = []entities.Parcel{{ID: "p-1", OrderID: "o-1", Status: constants.OrderStatusPending}}
+	parcel := scanned.Parcels[0]
+	orders := &fakeOrders{order: scanned, parcel: &parcel}
+	useCase := order.NewScanUseCase(orders)
+
+	response, err := useCase.ScanOrder(scanContext(constants.Driver, "driver-1"), &dto.ScanRequest{QRData: "qr"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !reflect.DeepEqual(response.AvailableActions, []string{constants.OrderStatusPickedUp}) || response.Parcel == nil {
+		t.Fatalf("expected the parcel with its pickup action, got %+v", response)
+	}
+
+	response, err = useCase.ScanOrder(scanContext(constants.Driver, "driver-1"), &dto.ScanRequest{QRData: "qr", Status: constants.OrderStatusPickedUp})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if orders.action != "parcel:"+constants.OrderStatusPickedUp || response.Parcel.Status != constants.OrderStatusPickedUp {
+		t.Errorf("expected the parcel to be picked up, got %q", orders.action)
+	}
+	// El repartidor no puede llevar el bulto al almacén
+	if !reflect.DeepEqual(response.AvailableActions, []string{constants.OrderStatusInTransit}) {
+		t.Errorf("expected only the driver actions of the parcel, got %v", response.AvailableActions)
+	}
+}
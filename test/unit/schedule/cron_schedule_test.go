@@ -0,0 +1,143 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/value_objects"
+)
+
+func TestCronScheduleNext(t *testing.T) {
+	// 2025-01-06 es lunes
+	testCases := []struct {
+		name       string
+		expression string
+		from       time.Time
+		expected   time.Time
+	}{
+		{
+			name:       "Every fifteen minutes",
+			expression: "*/15 * * * *",
+			from:       time.Date(2025, 1, 6, 10, 7, 0, 0, time.UTC),
+			expected:   time.Date(2025, 1, 6, 10, 15, 0, 0, time.UTC),
+		},
+		{
+			name:       "Exactly on an occurrence moves to the next one",
+			expression: "*/15 * * * *",
+			from:       time.Date(2025, 1, 6, 10, 15, 0, 0, time.UTC),
+			expected:   time.Date(2025, 1, 6, 10, 30, 0, 0, time.UTC),
+		},
+		{
+			name:       "Seconds are ignored when moving to the next minute",
+			expression: "*/15 * * * *",
+			from:       time.Date(2025, 1, 6, 10, 59, 30, 0, time.UTC),
+			expected:   time.Date(2025, 1, 6, 11, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "Value with step starts at the value",
+			expression: "5/20 * * * *",
+			from:       time.Date(2025, 1, 6, 10, 7, 0, 0, time.UTC),
+			expected:   time.Date(2025, 1, 6, 10, 25, 0, 0, time.UTC),
+		},
+		{
+			name:       "Weekdays at three runs the next day",
+			expression: "0 3 * * 1-5",
+			from:       time.Date(2025, 1, 6, 10, 7, 0, 0, time.UTC),
+			expected:   time.Date(2025, 1, 7, 3, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "Weekdays at three skips the weekend",
+			expression: "0 3 * * 1-5",
+			from:       time.Date(2025, 1, 10, 4, 0, 0, 0, time.UTC),
+			expected:   time.Date(2025, 1, 13, 3, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "Seven is also Sunday",
+			expression: "0 0 * * 7",
+			from:       time.Date(2025, 1, 6, 10, 7, 0, 0, time.UTC),
+			expected:   time.Date(2025, 1, 12, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "First day of the month",
+			expression: "0 0 1 * *",
+			from:       time.Date(2025, 1, 6, 10, 7, 0, 0, time.UTC),
+			expected:   time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "Lists of hours",
+			expression: "30 8,20 * * *",
+			from:       time.Date(2025, 1, 6, 10, 7, 0, 0, time.UTC),
+			expected:   time.Date(2025, 1, 6, 20, 30, 0, 0, time.UTC),
+		},
+		{
+			name:       "Day of month or day of week matches",
+			expression: "0 12 13 * 5",
+			from:       time.Date(2025, 1, 6, 10, 7, 0, 0, time.UTC),
+			expected:   time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "Leap day waits for the next leap year",
+			expression: "0 0 29 2 *",
+			from:       time.Date(2025, 1, 6, 10, 7, 0, 0, time.UTC),
+			expected:   time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "End of year rolls over",
+			expression: "0 0 1 1 *",
+			from:       time.Date(2025, 12, 31, 23, 59, 0, 0, time.UTC),
+			expected:   time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			schedule, err := value_objects.NewCronSchedule(tc.expression)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			got, ok := schedule.Next(tc.from)
+			if !ok {
+				t.Fatal("expected a next occurrence")
+			}
+			if !got.Equal(tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestCronScheduleNextWithoutOccurrences(t *testing.T) {
+	schedule, err := value_objects.NewCronSchedule("0 0 31 2 *")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if next, ok := schedule.Next(time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)); ok {
+		t.Errorf("expected no next occurrence, got %v", next)
+	}
+}
+
+func TestNewCronScheduleRejectsInvalidExpressions(t *testing.T) {
+	testCases := []struct {
+		name       string
+		expression string
+	}{
+		{name: "Missing a field", expression: "* * * *"},
+		{name: "Too many fields", expression: "* * * * * *"},
+		{name: "Minute out of range", expression: "60 * * * *"},
+		{name: "Hour out of range", expression: "* 24 * * *"},
+		{name: "Day out of range", expression: "* * 0 * *"},
+		{name: "Zero step", expression: "*/0 * * * *"},
+		{name: "Reversed range", expression: "5-1 * * * *"},
+		{name: "Not a number", expression: "a * * * *"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := value_objects.NewCronSchedule(tc.expression); err == nil {
+				t.Errorf("expected %q to be rejected", tc.expression)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/value_objects"
+)
+
+func TestNewRecurrence(t *testing.T) {
+	testCases := []struct {
+		name      string
+		days      string
+		timeOfDay string
+		expected  string
+		valid     bool
+		wantErr   bool
+	}{
+		{name: "Weekdays", days: "1,2,3,4,5", timeOfDay: "17:00", expected: "1,2,3,4,5", valid: true},
+		{name: "Unordered days with duplicates and spaces", days: "5, 1,5,3", timeOfDay: "08:30", expected: "1,3,5", valid: true},
+		{name: "Sunday only", days: "0", timeOfDay: "10:00", expected: "0", valid: true},
+		{name: "No days", days: "", timeOfDay: "10:00", expected: "", valid: false},
+		{name: "Invalid time", days: "1", timeOfDay: "25:00", expected: "1", valid: false},
+		{name: "Day out of range", days: "1,7", timeOfDay: "10:00", wantErr: true},
+		{name: "Day is not a number", days: "mon", timeOfDay: "10:00", wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			recurrence, err := value_objects.NewRecurrence(tc.days, tc.timeOfDay)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			if got := recurrence.ToString(); got != tc.expected {
+				t.Errorf("expected days %q, got %q", tc.expected, got)
+			}
+			if got := recurrence.IsValid(); got != tc.valid {
+				t.Errorf("expected valid %v, got %v", tc.valid, got)
+			}
+		})
+	}
+}
+
+func TestRecurrenceNext(t *testing.T) {
+	weekdays, err := value_objects.NewRecurrence("1,2,3,4,5", "17:00")
+	if err != nil {
+		t.Fatalf("failed to create recurrence: %v", err)
+	}
+
+	// 2025-01-06 es lunes
+	testCases := []struct {
+		name     string
+		from     time.Time
+		expected time.Time
+	}{
+		{
+			name:     "Before the time runs the same day",
+			from:     time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC),
+			expected: time.Date(2025, 1, 6, 17, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "Exactly at the time runs the next day",
+			from:     time.Date(2025, 1, 6, 17, 0, 0, 0, time.UTC),
+			expected: time.Date(2025, 1, 7, 17, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "Friday after the time runs on Monday",
+			from:     time.Date(2025, 1, 10, 18, 0, 0, 0, time.UTC),
+			expected: time.Date(2025, 1, 13, 17, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "Saturday runs on Monday",
+			from:     time.Date(2025, 1, 11, 9, 0, 0, 0, time.UTC),
+			expected: time.Date(2025, 1, 13, 17, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "Keeps the location of the reference time",
+			from:     time.Date(2025, 1, 6, 9, 0, 0, 0, time.FixedZone("CST", -6*3600)),
+			expected: time.Date(2025, 1, 6, 17, 0, 0, 0, time.FixedZone("CST", -6*3600)),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := weekdays.Next(tc.from)
+			if !ok {
+				t.Fatal("expected a next occurrence")
+			}
+			if !got.Equal(tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestRecurrenceNextSameWeekdayNextWeek(t *testing.T) {
+	mondays, err := value_objects.NewRecurrence("1", "08:00")
+	if err != nil {
+		t.Fatalf("failed to create recurrence: %v", err)
+	}
+
+	got, ok := mondays.Next(time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC))
+	if !ok {
+		t.Fatal("expected a next occurrence")
+	}
+
+	expected := time.Date(2025, 1, 13, 8, 0, 0, 0, time.UTC)
+	if !got.Equal(expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestRecurrenceNextWithoutDays(t *testing.T) {
+	recurrence, err := value_objects.NewRecurrence("", "08:00")
+	if err != nil {
+		t.Fatalf("failed to create recurrence: %v", err)
+	}
+
+	if _, ok := recurrence.Next(time.Now()); ok {
+		t.Error("expected no next occurrence")
+	}
+}
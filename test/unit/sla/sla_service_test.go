@@ -0,0 +1,161 @@
+package sla
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/services"
+)
+
+var errNotifierDown = errors.New("notifier down")
+
+// fakeSLARepo devuelve los pedidos activos de la prueba y registra los cambios de estado del SLA
+type fakeSLARepo struct {
+	orders  []entities.Order
+	updates map[string]string
+	flags   map[string]string
+}
+
+func (r *fakeSLARepo) GetSLAActiveOrders(context.Context, string, string) ([]entities.Order, error) {
+	return r.orders, nil
+}
+
+func (r *fakeSLARepo) UpdateOrderSLAStatus(_ context.Context, orderID, status, flagReason string, _ time.Time) error {
+	if r.updates == nil {
+		r.updates, r.flags = map[string]string{}, map[string]string{}
+	}
+	r.updates[orderID] = status
+	r.flags[orderID] = flagReason
+	return nil
+}
+
+// fakeSLANotifier registra los pedidos alertados, err simula un canal caído
+type fakeSLANotifier struct {
+	alerted []string
+	err     error
+}
+
+func (n *fakeSLANotifier) NotifySLAAlert(_ context.Context, evaluation *entities.SLAEvaluation) error {
+	if n.err != nil {
+		return n.err
+	}
+	n.alerted = append(n.alerted, evaluation.Order.ID)
+	return nil
+}
+
+// newSLAOrder crea un pedido de una sucursal cuya zona entrega en 60 minutos
+func newSLAOrder(id, status string, pickup, deadline time.Time, slaStatus string) entities.Order {
+	return entities.Order{
+		ID:     id,
+		Status: status,
+		Branch: &entities.Branch{Zone: &entities.Zone{MaxDeliveryTime: 60}},
+		Detail: &entities.Details{PickupTime: pickup, DeliveryDeadline: deadline, SLAStatus: slaStatus},
+	}
+}
+
+func TestEvaluateOrderComparesTheEstimatedDeliveryWithTheDeadline(t *testing.T) {
+	now := time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name      string
+		status    string
+		pickup    time.Time
+		deadline  time.Time
+		expected  string
+		estimated time.Time
+		delay     int
+	}{
+		{"pending with time to spare", constants.OrderStatusPending, now.Add(time.Hour), now.Add(3 * time.Hour), constants.SLAStatusOnTrack, now.Add(2 * time.Hour), 0},
+		{"pending late pickup runs from now", constants.OrderStatusAccepted, now.Add(-2 * time.Hour), now.Add(30 * time.Minute), constants.SLAStatusAtRisk, now.Add(time.Hour), 30},
+		{"picked up on time", constants.OrderStatusInTransit, now.Add(-30 * time.Minute), now.Add(time.Hour), constants.SLAStatusOnTrack, now.Add(30 * time.Minute), 0},
+		{"picked up past its estimate", constants.OrderStatusInTransit, now.Add(-3 * time.Hour), now.Add(time.Hour), constants.SLAStatusOnTrack, now, 0},
+		{"picked up beyond the deadline", constants.OrderStatusPickedUp, now, now.Add(45 * time.Minute), constants.SLAStatusAtRisk, now.Add(time.Hour), 15},
+		{"deadline passed", constants.OrderStatusFailed, now.Add(-3 * time.Hour), now.Add(-time.Hour), constants.SLAStatusBreached, now, 60},
+	}
+
+	service := services.NewSLAService(&fakeSLARepo{}, &fakeSLANotifier{})
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			order := newSLAOrder("o-1", tc.status, tc.pickup, tc.deadline, "")
+
+			evaluation := service.EvaluateOrder(&order, now)
+
+			if evaluation.Status != tc.expected {
+				t.Errorf("expected status %s, got %s", tc.expected, evaluation.Status)
+			}
+			if !evaluation.EstimatedDelivery.Equal(tc.estimated) {
+				t.Errorf("expected the delivery to be estimated at %v, got %v", tc.estimated, evaluation.EstimatedDelivery)
+			}
+			if evaluation.DelayMinutes != tc.delay {
+				t.Errorf("expected a delay of %d minutes, got %d", tc.delay, evaluation.DelayMinutes)
+			}
+		})
+	}
+}
+
+func TestEvaluateOrderUsesTheDefaultDeliveryTimeWithoutZone(t *testing.T) {
+	now := time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC)
+	order := newSLAOrder("o-1", constants.OrderStatusPending, now, now.Add(3*time.Hour), "")
+	order.Branch = nil
+
+	evaluation := services.NewSLAService(&fakeSLARepo{}, &fakeSLANotifier{}).EvaluateOrder(&order, now)
+
+	expected := now.Add(time.Duration(constants.DefaultZoneDeliveryMinutes) * time.Minute)
+	if !evaluation.EstimatedDelivery.Equal(expected) {
+		t.Errorf("expected the default delivery time, got %v", evaluation.EstimatedDelivery)
+	}
+}
+
+func TestMonitorActiveOrdersAlertsOnlyOnSLAChanges(t *testing.T) {
+	now := time.Now()
+	repo := &fakeSLARepo{orders: []entities.Order{
+		newSLAOrder("on-track", constants.OrderStatusPending, now.Add(time.Hour), now.Add(5*time.Hour), constants.SLAStatusOnTrack),
+		newSLAOrder("new-risk", constants.OrderStatusPending, now, now.Add(30*time.Minute), constants.SLAStatusOnTrack),
+		newSLAOrder("still-risk", constants.OrderStatusPending, now, now.Add(30*time.Minute), constants.SLAStatusAtRisk),
+		newSLAOrder("new-breach", constants.OrderStatusInTransit, now.Add(-3*time.Hour), now.Add(-time.Hour), constants.SLAStatusAtRisk),
+		newSLAOrder("recovered", constants.OrderStatusInTransit, now, now.Add(5*time.Hour), constants.SLAStatusAtRisk),
+	}}
+	notifier := &fakeSLANotifier{}
+
+	alerts, err := services.NewSLAService(repo, notifier).MonitorActiveOrders(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if alerts != 2 || len(notifier.alerted) != 2 || notifier.alerted[0] != "new-risk" || notifier.alerted[1] != "new-breach" {
+		t.Errorf("expected alerts only for the orders that became at risk or breached, got %v", notifier.alerted)
+	}
+	if len(repo.updates) != 3 {
+		t.Errorf("expected only the changed orders to be updated, got %v", repo.updates)
+	}
+	if repo.flags["new-risk"] != constants.OrderFlagSLAAtRisk || repo.flags["new-breach"] != constants.OrderFlagSLABreached {
+		t.Errorf("expected the alerted orders to be flagged, got %v", repo.flags)
+	}
+	// Un pedido que vuelve a estar a tiempo se actualiza sin alertar ni marcarlo
+	if repo.updates["recovered"] != constants.SLAStatusOnTrack || repo.flags["recovered"] != "" {
+		t.Errorf("expected the recovered order back on track without a flag, got %q", repo.flags["recovered"])
+	}
+}
+
+func TestMonitorActiveOrdersDoesNotCountFailedAlerts(t *testing.T) {
+	now := time.Now()
+	repo := &fakeSLARepo{orders: []entities.Order{
+		newSLAOrder("new-breach", constants.OrderStatusInTransit, now.Add(-3*time.Hour), now.Add(-time.Hour), constants.SLAStatusOnTrack),
+	}}
+
+	alerts, err := services.NewSLAService(repo, &fakeSLANotifier{err: errNotifierDown}).MonitorActiveOrders(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if alerts != 0 {
+		t.Errorf("expected the failed alert not to be counted, got %d", alerts)
+	}
+	if repo.updates["new-breach"] != constants.SLAStatusBreached {
+		t.Errorf("expected the breach to be saved even if the alert failed, got %v", repo.updates)
+	}
+}
@@ -0,0 +1,16 @@
+package testutil
+
+import (
+	"errors"
+
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+)
+
+// DomainCause obtiene el error de dominio que causó err, nil si err no es un error de dominio
+func DomainCause(err error) error {
+	var domainErr *errPackage.DomainError
+	if !errors.As(err, &domainErr) {
+		return nil
+	}
+	return domainErr.Err
+}
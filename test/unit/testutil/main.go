@@ -0,0 +1,19 @@
+package testutil
+
+import (
+	"io"
+	"os"
+	"testing"
+
+	"github.com/MarlonG1/delivery-backend/pkg/shared/logs"
+	"github.com/sirupsen/logrus"
+)
+
+// Main inicializa un logger silencioso y ejecuta las pruebas del paquete, los servicios registran sus errores
+// con el logger global. Cada paquete de pruebas lo llama desde su TestMain
+func Main(m *testing.M) {
+	logs.Logger = logrus.New()
+	logs.Logger.SetOutput(io.Discard)
+
+	os.Exit(m.Run())
+}
@@ -0,0 +1,128 @@
+package tracking
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/value_objects"
+)
+
+const (
+	trackingCheckAlphabet  = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	trackingRandomAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+)
+
+func TestGenerateTrackingNumber(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	for i := 0; i < 200; i++ {
+		trackingNumber, err := value_objects.GenerateTrackingNumber("del", now)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		value := trackingNumber.GetValue()
+		if !trackingNumber.IsValid() {
+			t.Fatalf("expected %s to be valid", value)
+		}
+		if !strings.HasPrefix(value, "DEL250115") {
+			t.Fatalf("expected %s to start with the prefix and the date", value)
+		}
+		for _, c := range value[len("DEL250115") : len(value)-1] {
+			if !strings.ContainsRune(trackingRandomAlphabet, c) {
+				t.Fatalf("expected %s to only use the Crockford alphabet, got %q", value, c)
+			}
+		}
+	}
+}
+
+func TestTrackingNumberIsValid(t *testing.T) {
+	testCases := []struct {
+		name     string
+		value    string
+		expected bool
+	}{
+		{name: "Documented example", value: "DEL250115TE68JBQF14V", expected: true},
+		{name: "Lowercase with spaces", value: " del250115te68jbqf14v ", expected: true},
+		{name: "Wrong check character", value: "DEL250115TE68JBQF14W", expected: false},
+		{name: "Missing check character", value: "DEL250115TE68JBQF14", expected: false},
+		{name: "Prefix too short", value: "D250115TE68JBQF14V", expected: false},
+		{name: "Date with letters", value: "DEL2501A5TE68JBQF14V", expected: false},
+		{name: "Empty", value: "", expected: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := value_objects.NewTrackingNumber(tc.value).IsValid(); got != tc.expected {
+				t.Errorf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestTrackingCheckCharacterDetectsSingleCharacterErrors(t *testing.T) {
+	value := generateTrackingNumber(t)
+
+	for i := len("DEL"); i < len(value); i++ {
+		for _, c := range trackingCheckAlphabet {
+			if byte(c) == value[i] {
+				continue
+			}
+
+			mutated := value[:i] + string(c) + value[i+1:]
+			if value_objects.NewTrackingNumber(mutated).IsValid() {
+				t.Errorf("expected %s (position %d changed) to be invalid", mutated, i)
+			}
+		}
+	}
+}
+
+func TestTrackingCheckCharacterDetectsAdjacentTranspositions(t *testing.T) {
+	for n := 0; n < 50; n++ {
+		value := generateTrackingNumber(t)
+
+		for i := len("DEL"); i < len(value)-1; i++ {
+			a, b := value[i], value[i+1]
+			// Luhn mod N no detecta el intercambio del primer y el último carácter del alfabeto
+			if a == b || (a == '0' && b == 'Z') || (a == 'Z' && b == '0') {
+				continue
+			}
+
+			swapped := value[:i] + string(b) + string(a) + value[i+2:]
+			if value_objects.NewTrackingNumber(swapped).IsValid() {
+				t.Errorf("expected %s (positions %d and %d swapped) to be invalid", swapped, i, i+1)
+			}
+		}
+	}
+}
+
+func TestIsValidTrackingPrefix(t *testing.T) {
+	testCases := []struct {
+		prefix   string
+		expected bool
+	}{
+		{prefix: "DE", expected: true},
+		{prefix: "DELIV", expected: true},
+		{prefix: "D", expected: false},
+		{prefix: "DELIVE", expected: false},
+		{prefix: "de", expected: false},
+		{prefix: "D1", expected: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.prefix, func(t *testing.T) {
+			if got := value_objects.IsValidTrackingPrefix(tc.prefix); got != tc.expected {
+				t.Errorf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func generateTrackingNumber(t *testing.T) string {
+	trackingNumber, err := value_objects.GenerateTrackingNumber("DEL", time.Now())
+	if err != nil {
+		t.Fatalf("failed to generate tracking number: %v", err)
+	}
+	return trackingNumber.GetValue()
+}
@@ -0,0 +1,193 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/ports"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/services"
+)
+
+const webhookSecret = "whsec_test_secret"
+
+// fakeWebhookRepo entrega una sola entrega pendiente y guarda el resultado del intento,
+// los métodos no sobrescritos hacen panic si el servicio los usa
+type fakeWebhookRepo struct {
+	ports.WebhookRepository
+
+	delivery *entities.WebhookDelivery
+	saved    *entities.WebhookDelivery
+	created  *entities.WebhookEndpoint
+}
+
+func (r *fakeWebhookRepo) CreateEndpoint(_ context.Context, endpoint *entities.WebhookEndpoint) error {
+	r.created = endpoint
+	return nil
+}
+
+func (r *fakeWebhookRepo) GetDueDeliveries(_ context.Context, _ time.Time, _ int) ([]entities.WebhookDelivery, error) {
+	return []entities.WebhookDelivery{*r.delivery}, nil
+}
+
+func (r *fakeWebhookRepo) ClaimDelivery(_ context.Context, _ string, _, _ time.Time) (bool, error) {
+	return true, nil
+}
+
+func (r *fakeWebhookRepo) SaveDeliveryAttempt(_ context.Context, delivery *entities.WebhookDelivery, _ bool, _ int) (bool, error) {
+	r.saved = delivery
+	return false, nil
+}
+
+// fakeWebhookSender guarda la solicitud enviada y responde con el estado indicado
+type fakeWebhookSender struct {
+	status  int
+	request *entities.WebhookRequest
+}
+
+func (s *fakeWebhookSender) Send(_ context.Context, request *entities.WebhookRequest) (*entities.WebhookResponse, error) {
+	s.request = request
+	return &entities.WebhookResponse{StatusCode: s.status}, nil
+}
+
+func TestWebhookRequestIsSigned(t *testing.T) {
+	repo := &fakeWebhookRepo{delivery: newWebhookDelivery(`{"event":"order.delivered","order_id":"order-1"}`)}
+	sender := &fakeWebhookSender{status: 200}
+	service := services.NewWebhookService(repo, sender, true)
+
+	before := time.Now().Unix()
+	if _, err := service.ProcessDueDeliveries(context.Background()); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	request := sender.request
+	if request == nil {
+		t.Fatal("expected the delivery to be sent")
+	}
+	if request.Headers[constants.WebhookEventHeader] != constants.WebhookEventOrderDelivered ||
+		request.Headers[constants.WebhookDeliveryHeader] != "delivery-1" {
+		t.Errorf("expected the event and delivery headers, got %v", request.Headers)
+	}
+
+	timestamp, signature := parseSignatureHeader(t, request.Headers[constants.WebhookSignatureHeader])
+	if timestamp < before || timestamp > time.Now().Unix() {
+		t.Errorf("expected the signature timestamp to be the send time, got %d", timestamp)
+	}
+	if !verifySignature(webhookSecret, timestamp, request.Body, signature) {
+		t.Error("expected the signature to verify with the endpoint secret")
+	}
+	if verifySignature("whsec_other_secret", timestamp, request.Body, signature) {
+		t.Error("expected the signature not to verify with another secret")
+	}
+	if verifySignature(webhookSecret, timestamp, []byte(strings.Replace(string(request.Body), "order-1", "order-2", 1)), signature) {
+		t.Error("expected the signature not to verify a tampered body")
+	}
+	if verifySignature(webhookSecret, timestamp+1, request.Body, signature) {
+		t.Error("expected the signature not to verify another timestamp")
+	}
+
+	if repo.saved.Status != constants.WebhookDeliveryStatusSucceeded {
+		t.Errorf("expected the delivery to succeed, got %s", repo.saved.Status)
+	}
+}
+
+func TestWebhookFailedDeliveryIsRetriedWithBackoff(t *testing.T) {
+	testCases := []struct {
+		name           string
+		attempts       int
+		expectedStatus string
+		expectedDelay  time.Duration
+	}{
+		{
+			name:           "First failure waits the base delay",
+			attempts:       0,
+			expectedStatus: constants.WebhookDeliveryStatusPending,
+			expectedDelay:  constants.WebhookRetryBaseDelay,
+		},
+		{
+			name:           "Third failure doubles the delay twice",
+			attempts:       2,
+			expectedStatus: constants.WebhookDeliveryStatusPending,
+			expectedDelay:  4 * constants.WebhookRetryBaseDelay,
+		},
+		{
+			name:           "Last attempt fails the delivery",
+			attempts:       constants.WebhookMaxAttempts - 1,
+			expectedStatus: constants.WebhookDeliveryStatusFailed,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			delivery := newWebhookDelivery(`{}`)
+			delivery.Attempts = tc.attempts
+			repo := &fakeWebhookRepo{delivery: delivery}
+			service := services.NewWebhookService(repo, &fakeWebhookSender{status: 500}, true)
+
+			if _, err := service.ProcessDueDeliveries(context.Background()); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			saved := repo.saved
+			if saved.Status != tc.expectedStatus {
+				t.Fatalf("expected status %s, got %s", tc.expectedStatus, saved.Status)
+			}
+			if tc.expectedDelay == 0 {
+				if saved.NextAttemptAt != nil {
+					t.Errorf("expected no next attempt, got %v", *saved.NextAttemptAt)
+				}
+				return
+			}
+			if delay := saved.NextAttemptAt.Sub(*saved.LastAttemptAt); delay != tc.expectedDelay {
+				t.Errorf("expected a delay of %v, got %v", tc.expectedDelay, delay)
+			}
+		})
+	}
+}
+
+func newWebhookDelivery(payload string) *entities.WebhookDelivery {
+	return &entities.WebhookDelivery{
+		ID:      "delivery-1",
+		Event:   constants.WebhookEventOrderDelivered,
+		Payload: payload,
+		Status:  constants.WebhookDeliveryStatusPending,
+		Endpoint: &entities.WebhookEndpoint{
+			ID:       "endpoint-1",
+			URL:      "https://example.com/webhooks",
+			Secret:   webhookSecret,
+			IsActive: true,
+		},
+	}
+}
+
+// parseSignatureHeader separa la marca de tiempo y la firma del encabezado "t=<unix>,v1=<hex>"
+func parseSignatureHeader(t *testing.T, header string) (int64, string) {
+	parts := strings.Split(header, ",")
+	if len(parts) != 2 || !strings.HasPrefix(parts[0], "t=") || !strings.HasPrefix(parts[1], "v1=") {
+		t.Fatalf("unexpected signature header %q", header)
+	}
+
+	timestamp, err := strconv.ParseInt(strings.TrimPrefix(parts[0], "t="), 10, 64)
+	if err != nil {
+		t.Fatalf("invalid signature timestamp in %q", header)
+	}
+
+	return timestamp, strings.TrimPrefix(parts[1], "v1=")
+}
+
+// verifySignature verifica la firma como lo haría el receptor del webhook
+func verifySignature(secret string, timestamp int64, body []byte, signature string) bool {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(fmt.Sprintf("%d.%s", timestamp, body)))
+	expected := hex.EncodeToString(mac.Sum(nil))
+
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
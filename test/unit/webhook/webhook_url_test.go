@@ -0,0 +1,143 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/constants"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/models/entities"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/services"
+	"github.com/MarlonG1/delivery-backend/internal/domain/delivery/value_objects"
+	errPackage "github.com/MarlonG1/delivery-backend/internal/domain/error"
+	"github.com/MarlonG1/delivery-backend/internal/infrastructure/adapters/webhook"
+	infraErr "github.com/MarlonG1/delivery-backend/internal/infrastructure/error"
+	"github.com/MarlonG1/delivery-backend/test/unit/testutil"
+)
+
+func TestIsPublicIP(t *testing.T) {
+	cases := map[string]bool{
+		"127.0.0.1":       false,
+		"10.1.2.3":        false,
+		"172.16.0.10":     false,
+		"192.168.1.1":     false,
+		"169.254.169.254": false,
+		"100.64.0.1":      false,
+		"0.0.0.0":         false,
+		"::1":             false,
+		"fe80::1":         false,
+		"fd00::1":         false,
+		"8.8.8.8":         true,
+		"2001:4860::8888": true,
+	}
+
+	for address, public := range cases {
+		if got := value_objects.IsPublicIP(net.ParseIP(address)); got != public {
+			t.Errorf("IsPublicIP(%s): expected %v, got %v", address, public, got)
+		}
+	}
+}
+
+func TestWebhookURLValidation(t *testing.T) {
+	cases := map[string]bool{
+		"https://hooks.example.com/orders": true,
+		"http://hooks.example.com/orders":  true,
+		"https://8.8.8.8/orders":           true,
+		"ftp://hooks.example.com/orders":   false,
+		"https://localhost/orders":         false,
+		"https://api.localhost/orders":     false,
+		"https://127.0.0.1:8080/orders":    false,
+		"https://169.254.169.254/latest":   false,
+		"https://[::1]/orders":             false,
+		"https:///orders":                  false,
+	}
+
+	for raw, valid := range cases {
+		if got := value_objects.NewWebhookURL(raw).IsValid(); got != valid {
+			t.Errorf("NewWebhookURL(%s).IsValid(): expected %v, got %v", raw, valid, got)
+		}
+	}
+
+	if value_objects.NewWebhookURL("http://hooks.example.com").IsSecure() {
+		t.Error("expected an http webhook not to be secure")
+	}
+	if !value_objects.NewWebhookURL("https://hooks.example.com").IsSecure() {
+		t.Error("expected an https webhook to be secure")
+	}
+}
+
+func TestCreateEndpointRejectsUnsafeURLs(t *testing.T) {
+	cases := []struct {
+		name         string
+		url          string
+		requireHTTPS bool
+		expected     error
+	}{
+		{name: "loopback", url: "https://127.0.0.1/hooks", expected: errPackage.ErrWebhookURLNotPublic},
+		{name: "private network", url: "https://10.0.0.5/hooks", expected: errPackage.ErrWebhookURLNotPublic},
+		{name: "cloud metadata", url: "http://169.254.169.254/latest/meta-data", expected: errPackage.ErrWebhookURLNotPublic},
+		{name: "localhost", url: "https://localhost:9000/hooks", expected: errPackage.ErrWebhookURLNotPublic},
+		{name: "http outside dev", url: "http://hooks.example.com/orders", requireHTTPS: true, expected: errPackage.ErrWebhookURLNotSecure},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := &fakeWebhookRepo{}
+			service := services.NewWebhookService(repo, &fakeWebhookSender{}, tc.requireHTTPS)
+
+			err := service.CreateEndpoint(context.Background(), &entities.WebhookEndpoint{
+				CompanyID: "company-1",
+				URL:       tc.url,
+				Events:    constants.WebhookEventOrderDelivered,
+			})
+			if cause := testutil.DomainCause(err); !errors.Is(cause, tc.expected) {
+				t.Fatalf("expected %v, got %v", tc.expected, err)
+			}
+			if repo.created != nil {
+				t.Error("expected the endpoint not to be saved")
+			}
+		})
+	}
+}
+
+func TestCreateEndpointAllowsHTTPInDevelopment(t *testing.T) {
+	repo := &fakeWebhookRepo{}
+	service := services.NewWebhookService(repo, &fakeWebhookSender{}, false)
+
+	err := service.CreateEndpoint(context.Background(), &entities.WebhookEndpoint{
+		CompanyID: "company-1",
+		URL:       "http://hooks.example.com/orders",
+		Events:    constants.WebhookEventOrderDelivered,
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if repo.created == nil || repo.created.Secret == "" {
+		t.Error("expected the endpoint to be saved with a secret")
+	}
+}
+
+func TestHTTPWebhookSenderRefusesLoopbackTargets(t *testing.T) {
+	called := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		called = true
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	_, err := webhook.NewHTTPWebhookSender().Send(context.Background(), &entities.WebhookRequest{
+		URL:  server.URL,
+		Body: []byte(`{}`),
+	})
+
+	var serviceErr *infraErr.ServiceError
+	if !errors.As(err, &serviceErr) || !errors.Is(serviceErr.Err, infraErr.ErrWebhookTargetNotAllowed) {
+		t.Fatalf("expected the connection to be refused, got %v", err)
+	}
+	if called {
+		t.Error("expected the request never to reach the loopback server")
+	}
+}